@@ -8,7 +8,6 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -20,9 +19,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/ocsp"
 
+	"github.com/letsencrypt/boulder/cdn"
 	"github.com/letsencrypt/boulder/core"
 	blog "github.com/letsencrypt/boulder/log"
-	"github.com/letsencrypt/boulder/metrics"
 )
 
 const (
@@ -31,17 +30,6 @@ const (
 	v3PurgeTagPath  = "/ccu/v3/delete/tag/"
 )
 
-var (
-	// ErrAllRetriesFailed indicates that all purge submission attempts have
-	// failed.
-	ErrAllRetriesFailed = errors.New("all attempts to submit purge request failed")
-
-	// errFatal is returned by the purge method of CachePurgeClient to indicate
-	// that it failed for a reason that cannot be remediated by retrying the
-	// request.
-	errFatal = errors.New("fatal error")
-)
-
 type v3PurgeRequest struct {
 	Objects []string `json:"objects"`
 }
@@ -56,7 +44,7 @@ type purgeResponse struct {
 // CachePurgeClient talks to the Akamai CCU REST API. It is safe to make
 // concurrent requests using this client.
 type CachePurgeClient struct {
-	client       *http.Client
+	*cdn.Retrier
 	apiEndpoint  string
 	apiHost      string
 	apiScheme    string
@@ -64,14 +52,12 @@ type CachePurgeClient struct {
 	clientSecret string
 	accessToken  string
 	v3Network    string
-	retries      int
-	retryBackoff time.Duration
 	log          blog.Logger
-	purgeLatency prometheus.Histogram
-	purges       *prometheus.CounterVec
 	clk          clock.Clock
 }
 
+var _ cdn.TagPurger = (*CachePurgeClient)(nil)
+
 // NewCachePurgeClient performs some basic validation of supplied configuration
 // and returns a newly constructed CachePurgeClient.
 func NewCachePurgeClient(
@@ -82,7 +68,9 @@ func NewCachePurgeClient(
 	network string,
 	retries int,
 	retryBackoff time.Duration,
-	log blog.Logger, scope prometheus.Registerer,
+	log blog.Logger,
+	scope prometheus.Registerer,
+	clk clock.Clock,
 ) (*CachePurgeClient, error) {
 	if network != "production" && network != "staging" {
 		return nil, fmt.Errorf("'V3Network' must be \"staging\" or \"production\", got %q", network)
@@ -93,21 +81,8 @@ func NewCachePurgeClient(
 		return nil, fmt.Errorf("failed to parse 'BaseURL' as a URL: %s", err)
 	}
 
-	purgeLatency := prometheus.NewHistogram(prometheus.HistogramOpts{
-		Name:    "ccu_purge_latency",
-		Help:    "Histogram of latencies of CCU purges",
-		Buckets: metrics.InternetFacingBuckets,
-	})
-	scope.MustRegister(purgeLatency)
-
-	purges := prometheus.NewCounterVec(prometheus.CounterOpts{
-		Name: "ccu_purges",
-		Help: "A counter of CCU purges labelled by the result",
-	}, []string{"type"})
-	scope.MustRegister(purges)
-
 	return &CachePurgeClient{
-		client:       new(http.Client),
+		Retrier:      cdn.NewRetrier(retries, retryBackoff, log, scope, clk),
 		apiEndpoint:  endpoint.String(),
 		apiHost:      endpoint.Host,
 		apiScheme:    strings.ToLower(endpoint.Scheme),
@@ -115,12 +90,8 @@ func NewCachePurgeClient(
 		clientSecret: secret,
 		accessToken:  accessToken,
 		v3Network:    network,
-		retries:      retries,
-		retryBackoff: retryBackoff,
 		log:          log,
-		clk:          clock.New(),
-		purgeLatency: purgeLatency,
-		purges:       purges,
+		clk:          clk,
 	}, nil
 }
 
@@ -172,13 +143,15 @@ func signingKey(clientSecret string, timestamp string) []byte {
 	return key
 }
 
-// PurgeTags constructs and dispatches a request to purge a batch of Tags.
+// PurgeTags constructs and dispatches a request to purge a batch of Tags. The
+// request will be retried as many times as configured before giving up and
+// returning cdn.ErrAllRetriesFailed.
 func (cpc *CachePurgeClient) PurgeTags(tags []string) error {
 	purgeReq := v3PurgeRequest{
 		Objects: tags,
 	}
 	endpoint := fmt.Sprintf("%s%s%s", cpc.apiEndpoint, v3PurgeTagPath, cpc.v3Network)
-	return cpc.authedRequest(endpoint, purgeReq)
+	return cpc.Retry("akamai", func() error { return cpc.authedRequest(endpoint, purgeReq) })
 }
 
 // purgeURLs constructs and dispatches a request to purge a batch of URLs.
@@ -195,17 +168,17 @@ func (cpc *CachePurgeClient) purgeURLs(urls []string) error {
 func (cpc *CachePurgeClient) authedRequest(endpoint string, body v3PurgeRequest) error {
 	reqBody, err := json.Marshal(body)
 	if err != nil {
-		return fmt.Errorf("%s: %w", err, errFatal)
+		return fmt.Errorf("%s: %w", err, cdn.ErrFatal)
 	}
 
 	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(reqBody))
 	if err != nil {
-		return fmt.Errorf("%s: %w", err, errFatal)
+		return fmt.Errorf("%s: %w", err, cdn.ErrFatal)
 	}
 
 	endpointURL, err := url.Parse(endpoint)
 	if err != nil {
-		return fmt.Errorf("while parsing %q as URL: %s: %w", endpoint, err, errFatal)
+		return fmt.Errorf("while parsing %q as URL: %s: %w", endpoint, err, cdn.ErrFatal)
 	}
 
 	authorization := cpc.makeAuthHeader(reqBody, endpointURL.Path, core.RandomString(16))
@@ -213,9 +186,7 @@ func (cpc *CachePurgeClient) authedRequest(endpoint string, body v3PurgeRequest)
 	req.Header.Set("Content-Type", "application/json")
 	cpc.log.Debugf("POSTing to endpoint %q (header %q) (body %q)", endpoint, authorization, reqBody)
 
-	start := cpc.clk.Now()
-	resp, err := cpc.client.Do(req)
-	cpc.purgeLatency.Observe(cpc.clk.Since(start).Seconds())
+	resp, err := cpc.Do("akamai", req)
 	if err != nil {
 		return fmt.Errorf("while POSTing to endpoint %q: %w", endpointURL, err)
 	}
@@ -237,7 +208,7 @@ func (cpc *CachePurgeClient) authedRequest(endpoint string, body v3PurgeRequest)
 		switch resp.StatusCode {
 		// https://techdocs.akamai.com/purge-cache/reference/403
 		case http.StatusForbidden:
-			return fmt.Errorf("client not authorized to make requests for URL %q: %w", resp.Request.URL, errFatal)
+			return fmt.Errorf("client not authorized to make requests for URL %q: %w", resp.Request.URL, cdn.ErrFatal)
 
 		// https://techdocs.akamai.com/purge-cache/reference/504
 		case http.StatusGatewayTimeout:
@@ -265,7 +236,7 @@ func (cpc *CachePurgeClient) authedRequest(endpoint string, body v3PurgeRequest)
 	// received.
 	if purgeInfo.HTTPStatus != http.StatusCreated {
 		if purgeInfo.HTTPStatus == http.StatusForbidden {
-			return fmt.Errorf("client not authorized to make requests to URL %q: %w", resp.Request.URL, errFatal)
+			return fmt.Errorf("client not authorized to make requests to URL %q: %w", resp.Request.URL, cdn.ErrFatal)
 		}
 		return fmt.Errorf("unmarshaled HTTP %d (body %q) from URL %q", purgeInfo.HTTPStatus, respBody, resp.Request.URL)
 	}
@@ -276,34 +247,10 @@ func (cpc *CachePurgeClient) authedRequest(endpoint string, body v3PurgeRequest)
 }
 
 // Purge dispatches the provided URLs in a request to the Akamai Fast-Purge API.
-// The request will be attempted cpc.retries number of times before giving up
-// and returning ErrAllRetriesFailed.
+// The request will be retried as many times as configured before giving up and
+// returning cdn.ErrAllRetriesFailed.
 func (cpc *CachePurgeClient) Purge(urls []string) error {
-	successful := false
-	for i := range cpc.retries + 1 {
-		cpc.clk.Sleep(core.RetryBackoff(i, cpc.retryBackoff, time.Minute, 1.3))
-
-		err := cpc.purgeURLs(urls)
-		if err != nil {
-			if errors.Is(err, errFatal) {
-				cpc.purges.WithLabelValues("fatal failure").Inc()
-				return err
-			}
-			cpc.log.AuditErrf("Akamai cache purge failed, retrying: %s", err)
-			cpc.purges.WithLabelValues("retryable failure").Inc()
-			continue
-		}
-		successful = true
-		break
-	}
-
-	if !successful {
-		cpc.purges.WithLabelValues("fatal failure").Inc()
-		return ErrAllRetriesFailed
-	}
-
-	cpc.purges.WithLabelValues("success").Inc()
-	return nil
+	return cpc.Retry("akamai", func() error { return cpc.purgeURLs(urls) })
 }
 
 // CheckSignature is exported for use in tests and akamai-test-srv.
@@ -12,6 +12,7 @@ import (
 
 	"github.com/jmhodges/clock"
 
+	"github.com/letsencrypt/boulder/cdn"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/test"
@@ -20,6 +21,7 @@ import (
 func TestMakeAuthHeader(t *testing.T) {
 	log := blog.NewMock()
 	stats := metrics.NoopRegisterer
+	fc := clock.NewFake()
 	cpc, err := NewCachePurgeClient(
 		"https://akaa-baseurl-xxxxxxxxxxx-xxxxxxxxxxxxx.luna.akamaiapis.net",
 		"akab-client-token-xxx-xxxxxxxxxxxxxxxx",
@@ -30,10 +32,9 @@ func TestMakeAuthHeader(t *testing.T) {
 		time.Second,
 		log,
 		stats,
+		fc,
 	)
 	test.AssertNotError(t, err, "Failed to create cache purge client")
-	fc := clock.NewFake()
-	cpc.clk = fc
 	wantedTimestamp, err := time.Parse(timestampFormat, "20140321T19:34:21+0000")
 	test.AssertNotError(t, err, "Failed to parse timestamp")
 	fc.Set(wantedTimestamp)
@@ -123,6 +124,7 @@ func TestV3Purge(t *testing.T) {
 	defer as.Close()
 
 	// Client is a purge client with a "production" v3Network parameter
+	clk := clock.NewFake()
 	client, err := NewCachePurgeClient(
 		as.URL,
 		"token",
@@ -133,28 +135,28 @@ func TestV3Purge(t *testing.T) {
 		time.Second,
 		blog.NewMock(),
 		metrics.NoopRegisterer,
+		clk,
 	)
 	test.AssertNotError(t, err, "Failed to create CachePurgeClient")
-	client.clk = clock.NewFake()
 
 	err = client.Purge([]string{"http://test.com"})
 	test.AssertNotError(t, err, "Purge failed; expected 201 response")
 
-	started := client.clk.Now()
+	started := clk.Now()
 	as.responseCode = http.StatusInternalServerError
 	err = client.Purge([]string{"http://test.com"})
-	test.AssertError(t, err, "Purge succeeded; expected 500 response")
-	t.Log(client.clk.Since(started))
+	test.AssertErrorIs(t, err, cdn.ErrAllRetriesFailed)
+	t.Log(clk.Since(started))
 	// Given 3 retries, with a retry interval of 1 second, a growth factor of 1.3,
 	// and a jitter of 0.2, the minimum amount of elapsed time is:
 	// (1 * 0.8) + (1 * 1.3 * 0.8) + (1 * 1.3 * 1.3 * 0.8) = 3.192s
-	test.Assert(t, client.clk.Since(started) > (time.Second*3), "Retries should've taken at least 3.192 seconds")
+	test.Assert(t, clk.Since(started) > (time.Second*3), "Retries should've taken at least 3.192 seconds")
 
-	started = client.clk.Now()
+	started = clk.Now()
 	as.responseCode = http.StatusCreated
 	err = client.Purge([]string{"http:/test.com"})
-	test.AssertError(t, err, "Purge succeeded; expected a 403 response from malformed URL")
-	test.Assert(t, client.clk.Since(started) < time.Second, "Purge should've failed out immediately")
+	test.AssertErrorIs(t, err, cdn.ErrFatal)
+	test.Assert(t, clk.Since(started) < time.Second, "Purge should've failed out immediately")
 }
 
 func TestPurgeTags(t *testing.T) {
@@ -172,17 +174,16 @@ func TestPurgeTags(t *testing.T) {
 		time.Second,
 		blog.NewMock(),
 		metrics.NoopRegisterer,
+		clock.NewFake(),
 	)
 	test.AssertNotError(t, err, "Failed to create CachePurgeClient")
-	fc := clock.NewFake()
-	client.clk = fc
 
 	err = client.PurgeTags([]string{"ff"})
 	test.AssertNotError(t, err, "Purge failed; expected response 201")
 
 	as.responseCode = http.StatusForbidden
 	err = client.PurgeTags([]string{"http://test.com"})
-	test.AssertError(t, err, "Purge succeeded; expected Forbidden response")
+	test.AssertErrorIs(t, err, cdn.ErrFatal)
 }
 
 func TestNewCachePurgeClient(t *testing.T) {
@@ -197,6 +198,7 @@ func TestNewCachePurgeClient(t *testing.T) {
 		time.Second,
 		blog.NewMock(),
 		metrics.NoopRegisterer,
+		clock.NewFake(),
 	)
 	test.AssertError(t, err, "NewCachePurgeClient with invalid network parameter didn't error")
 
@@ -211,6 +213,7 @@ func TestNewCachePurgeClient(t *testing.T) {
 		time.Second,
 		blog.NewMock(),
 		metrics.NoopRegisterer,
+		clock.NewFake(),
 	)
 	test.AssertNotError(t, err, "NewCachePurgeClient with valid network parameter errored")
 
@@ -225,6 +228,7 @@ func TestNewCachePurgeClient(t *testing.T) {
 		time.Second,
 		blog.NewMock(),
 		metrics.NoopRegisterer,
+		clock.NewFake(),
 	)
 	test.AssertError(t, err, "NewCachePurgeClient with invalid server url parameter didn't error")
 }
@@ -244,6 +248,7 @@ func TestBigBatchPurge(t *testing.T) {
 		time.Second,
 		log,
 		metrics.NoopRegisterer,
+		clock.NewFake(),
 	)
 	test.AssertNotError(t, err, "Failed to create CachePurgeClient")
 
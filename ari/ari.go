@@ -0,0 +1,342 @@
+// Package ari implements the policy which determines the suggested renewal
+// windows served by the WFE's ACME Renewal Information (RFC 9773) endpoint.
+package ari
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/issuance"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// defaultRetryAfter is the Retry-After duration served alongside renewal
+// information when none is configured.
+const defaultRetryAfter = 6 * time.Hour
+
+// Config configures the suggested renewal windows served by the WFE.
+type Config struct {
+	// RetryAfter is the duration clients are asked to wait before polling for
+	// updated renewal information, via the Retry-After header. Defaults to 6h.
+	RetryAfter config.Duration `validate:"-"`
+
+	// LoadSpread is the width of the range over which the default suggested
+	// window is shifted, deterministically per serial, to spread renewals of
+	// certificates issued at the same time across the fleet. The shift is
+	// capped at a sixth of the certificate's validity period in either
+	// direction. If zero, every certificate gets the same window relative to
+	// its validity period.
+	LoadSpread config.Duration `validate:"-"`
+
+	// RevokedExplanationURL is served as the explanationURL for revoked
+	// certificates. Optional.
+	RevokedExplanationURL string `validate:"omitempty,url"`
+
+	// IssuerRotations lists issuers being retired. Certificates issued by
+	// them which would otherwise be renewed after the issuer's RenewBy time
+	// are asked to renew during the rotation instead.
+	IssuerRotations []IssuerRotationConfig `validate:"omitempty,dive"`
+}
+
+// IssuerRotationConfig describes the retirement of a single issuer.
+type IssuerRotationConfig struct {
+	// IssuerCert is the path to the PEM-encoded certificate of the issuer
+	// being retired.
+	IssuerCert string `validate:"required"`
+
+	// Start is the beginning of the suggested window for affected
+	// certificates. If it is earlier than a certificate's issuance, the
+	// certificate's issuance time is used instead.
+	Start time.Time
+
+	// RenewBy is the time by which all affected certificates should have
+	// been renewed. It is the end of their suggested window.
+	RenewBy time.Time `validate:"required"`
+
+	// ExplanationURL is served as the explanationURL for affected
+	// certificates. Optional.
+	ExplanationURL string `validate:"omitempty,url"`
+}
+
+// Certificate is the certificate whose renewal window is being determined. It
+// is identified by its serial, and its contents are only fetched from its SA
+// if a Rule (or the default window) needs them.
+type Certificate struct {
+	Serial string
+
+	sa     sapb.StorageAuthorityReadOnlyClient
+	cert   *corepb.Certificate
+	parsed *x509.Certificate
+}
+
+// SA returns the SA from which the certificate's contents, status, and
+// incidents can be looked up.
+func (c *Certificate) SA() sapb.StorageAuthorityReadOnlyClient {
+	return c.sa
+}
+
+// Get returns the certificate, fetching it from the SA on first use. If the
+// certificate does not exist the returned error is a berrors.NotFound.
+func (c *Certificate) Get(ctx context.Context) (*corepb.Certificate, error) {
+	if c.cert != nil {
+		return c.cert, nil
+	}
+	// It's okay to use GetCertificate (vs trying to get a precertificate),
+	// because we don't intend to serve ARI for certs that never made it past
+	// the precert stage.
+	cert, err := c.sa.GetCertificate(ctx, &sapb.Serial{Serial: c.Serial})
+	if err != nil {
+		if errors.Is(err, berrors.NotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to retrieve existing certificate: %w", err)
+	}
+	c.cert = cert
+	return cert, nil
+}
+
+// Parsed returns the parsed certificate, fetching it from the SA on first use.
+func (c *Certificate) Parsed(ctx context.Context) (*x509.Certificate, error) {
+	if c.parsed != nil {
+		return c.parsed, nil
+	}
+	cert, err := c.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParseCertificate(cert.Der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing existing certificate: %w", err)
+	}
+	c.parsed = parsed
+	return parsed, nil
+}
+
+// Rule determines the suggested renewal window for some certificates. A Rule
+// returns a nil RenewalInfo for certificates it has no opinion about, in which
+// case the next Rule of the Policy is consulted.
+type Rule interface {
+	RenewalInfo(ctx context.Context, cert *Certificate) (*core.RenewalInfo, error)
+}
+
+// Policy produces suggested renewal windows by consulting its Rules in order.
+// The first Rule to return a RenewalInfo wins. If no Rule applies, the window
+// is two days wide, centered two thirds of the way through the certificate's
+// validity period, and shifted by a per-serial load-spreading offset.
+type Policy struct {
+	clk        clock.Clock
+	rules      []Rule
+	retryAfter time.Duration
+	loadSpread time.Duration
+}
+
+// NewPolicy returns a Policy which consults the given rules, in order. Rules
+// which only need the certificate's serial should come first, so that they
+// apply even to certificates the SA cannot return.
+func NewPolicy(clk clock.Clock, retryAfter, loadSpread time.Duration, rules ...Rule) *Policy {
+	if retryAfter <= 0 {
+		retryAfter = defaultRetryAfter
+	}
+	return &Policy{
+		clk:        clk,
+		rules:      rules,
+		retryAfter: retryAfter,
+		loadSpread: loadSpread,
+	}
+}
+
+// New returns a Policy with Boulder's standard rules, in order: incidents,
+// revocation, then the configured issuer rotations.
+func New(c Config, clk clock.Clock) (*Policy, error) {
+	rules := []Rule{
+		NewIncidentRule(clk),
+		NewRevokedRule(clk, c.RevokedExplanationURL),
+	}
+	for _, rc := range c.IssuerRotations {
+		issuer, err := issuance.LoadCertificate(rc.IssuerCert)
+		if err != nil {
+			return nil, fmt.Errorf("loading rotated issuer certificate: %w", err)
+		}
+		if !rc.Start.IsZero() && !rc.Start.Before(rc.RenewBy) {
+			return nil, fmt.Errorf("rotation of issuer %q starts at or after its renewBy time", issuer.Subject.CommonName)
+		}
+		rules = append(rules, NewIssuerRotationRule(clk, issuer.NameID(), rc.Start, rc.RenewBy, rc.ExplanationURL))
+	}
+	return NewPolicy(clk, c.RetryAfter.Duration, c.LoadSpread.Duration, rules...), nil
+}
+
+// RetryAfter returns how long clients should wait before polling for updated
+// renewal information.
+func (p *Policy) RetryAfter() time.Duration {
+	return p.retryAfter
+}
+
+// RenewalInfo returns the suggested renewal window for the certificate with the
+// given serial, using the given SA to look up its incidents, status and
+// contents. If the certificate is needed but does not exist the returned error
+// is a berrors.NotFound.
+func (p *Policy) RenewalInfo(ctx context.Context, sa sapb.StorageAuthorityReadOnlyClient, serial string) (core.RenewalInfo, error) {
+	cert := &Certificate{Serial: serial, sa: sa}
+	for _, rule := range p.rules {
+		ri, err := rule.RenewalInfo(ctx, cert)
+		if err != nil {
+			return core.RenewalInfo{}, err
+		}
+		if ri != nil {
+			return *ri, nil
+		}
+	}
+
+	c, err := cert.Get(ctx)
+	if err != nil {
+		return core.RenewalInfo{}, err
+	}
+	issued, expires := c.Issued.AsTime(), c.Expires.AsTime()
+	ri := core.RenewalInfoSimple(issued, expires)
+	offset := spreadOffset(serial, p.loadSpread, expires.Sub(issued))
+	ri.SuggestedWindow.Start = ri.SuggestedWindow.Start.Add(offset)
+	ri.SuggestedWindow.End = ri.SuggestedWindow.End.Add(offset)
+	return ri, nil
+}
+
+// spreadOffset returns a duration in [-spread/2, spread/2), derived from a hash
+// of the serial so that it is stable across requests. The spread is capped at
+// a third of the validity period.
+func spreadOffset(serial string, spread time.Duration, validity time.Duration) time.Duration {
+	spread = min(spread, validity/3).Truncate(time.Second)
+	if spread <= 0 {
+		return 0
+	}
+	sum := sha256.Sum256([]byte(serial))
+	seconds := binary.BigEndian.Uint64(sum[:8]) % uint64(spread/time.Second)
+	return time.Duration(seconds)*time.Second - spread/2
+}
+
+// IncidentRule asks certificates impacted by an incident to renew
+// immediately, explained by the URL of the incident with the earliest renewBy.
+type IncidentRule struct {
+	clk clock.Clock
+}
+
+var _ Rule = (*IncidentRule)(nil)
+
+// NewIncidentRule returns a Rule which asks certificates impacted by an
+// incident to renew immediately.
+func NewIncidentRule(clk clock.Clock) *IncidentRule {
+	return &IncidentRule{clk: clk}
+}
+
+// RenewalInfo implements Rule.
+func (r *IncidentRule) RenewalInfo(ctx context.Context, cert *Certificate) (*core.RenewalInfo, error) {
+	result, err := cert.SA().IncidentsForSerial(ctx, &sapb.Serial{Serial: cert.Serial})
+	if err != nil {
+		return nil, fmt.Errorf("checking if existing certificate is impacted by an incident: %w", err)
+	}
+	if len(result.Incidents) == 0 {
+		return nil, nil
+	}
+
+	// Find the earliest incident.
+	var earliest *sapb.Incident
+	for _, incident := range result.Incidents {
+		if earliest == nil || incident.RenewBy.AsTime().Before(earliest.RenewBy.AsTime()) {
+			earliest = incident
+		}
+	}
+	// The existing cert is impacted by an incident, renew immediately.
+	ri := core.RenewalInfoImmediate(r.clk.Now(), earliest.Url)
+	return &ri, nil
+}
+
+// RevokedRule asks revoked certificates to renew immediately.
+type RevokedRule struct {
+	clk            clock.Clock
+	explanationURL string
+}
+
+var _ Rule = (*RevokedRule)(nil)
+
+// NewRevokedRule returns a Rule which asks revoked certificates to renew
+// immediately, with the given optional explanation URL.
+func NewRevokedRule(clk clock.Clock, explanationURL string) *RevokedRule {
+	return &RevokedRule{clk: clk, explanationURL: explanationURL}
+}
+
+// RenewalInfo implements Rule.
+func (r *RevokedRule) RenewalInfo(ctx context.Context, cert *Certificate) (*core.RenewalInfo, error) {
+	status, err := cert.SA().GetCertificateStatus(ctx, &sapb.Serial{Serial: cert.Serial})
+	if err != nil {
+		return nil, fmt.Errorf("checking if existing certificate has been revoked: %w", err)
+	}
+	if status.Status != string(core.OCSPStatusRevoked) {
+		return nil, nil
+	}
+	// The existing certificate is revoked, renew immediately.
+	ri := core.RenewalInfoImmediate(r.clk.Now(), r.explanationURL)
+	return &ri, nil
+}
+
+// IssuerRotationRule moves the renewal of certificates issued by a retiring
+// issuer before the time by which that issuer should no longer be relied upon.
+// Certificates which expire before then are unaffected.
+type IssuerRotationRule struct {
+	clk            clock.Clock
+	issuer         issuance.NameID
+	start          time.Time
+	renewBy        time.Time
+	explanationURL string
+}
+
+var _ Rule = (*IssuerRotationRule)(nil)
+
+// NewIssuerRotationRule returns a Rule which suggests that certificates issued
+// by the given issuer renew between start and renewBy.
+func NewIssuerRotationRule(clk clock.Clock, issuer issuance.NameID, start, renewBy time.Time, explanationURL string) *IssuerRotationRule {
+	return &IssuerRotationRule{
+		clk:            clk,
+		issuer:         issuer,
+		start:          start,
+		renewBy:        renewBy,
+		explanationURL: explanationURL,
+	}
+}
+
+// RenewalInfo implements Rule.
+func (r *IssuerRotationRule) RenewalInfo(ctx context.Context, cert *Certificate) (*core.RenewalInfo, error) {
+	parsed, err := cert.Parsed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if issuance.IssuerNameID(parsed) != r.issuer || !parsed.NotAfter.After(r.renewBy) {
+		return nil, nil
+	}
+
+	now := r.clk.Now()
+	if !now.Before(r.renewBy) {
+		ri := core.RenewalInfoImmediate(now, r.explanationURL)
+		return &ri, nil
+	}
+
+	start := r.start
+	if start.Before(parsed.NotBefore) {
+		start = parsed.NotBefore
+	}
+	return &core.RenewalInfo{
+		SuggestedWindow: core.SuggestedWindow{
+			Start: start.Truncate(time.Second),
+			End:   r.renewBy.Truncate(time.Second),
+		},
+		ExplanationURL: r.explanationURL,
+	}, nil
+}
@@ -0,0 +1,228 @@
+package ari
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSA serves a single certificate, optionally revoked and optionally
+// impacted by an incident.
+type mockSA struct {
+	sapb.StorageAuthorityReadOnlyClient
+	cert     *corepb.Certificate
+	revoked  bool
+	incident string
+}
+
+func (sa *mockSA) IncidentsForSerial(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*sapb.Incidents, error) {
+	if sa.incident == "" || req.Serial != sa.cert.Serial {
+		return &sapb.Incidents{}, nil
+	}
+	return &sapb.Incidents{Incidents: []*sapb.Incident{
+		{Url: "http://example.com/later", RenewBy: timestamppb.New(time.Now().Add(time.Hour))},
+		{Url: sa.incident, RenewBy: timestamppb.New(time.Now())},
+	}}, nil
+}
+
+func (sa *mockSA) GetCertificateStatus(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.CertificateStatus, error) {
+	if sa.revoked && req.Serial == sa.cert.Serial {
+		return &corepb.CertificateStatus{Status: string(core.OCSPStatusRevoked)}, nil
+	}
+	return &corepb.CertificateStatus{Status: string(core.OCSPStatusGood)}, nil
+}
+
+func (sa *mockSA) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	if req.Serial != sa.cert.Serial {
+		return nil, berrors.NotFoundError("certificate with serial %q not found", req.Serial)
+	}
+	return sa.cert, nil
+}
+
+func newMockSA(t *testing.T) *mockSA {
+	t.Helper()
+	cert, err := core.LoadCert("../test/hierarchy/ee-r3.cert.pem")
+	test.AssertNotError(t, err, "loading test certificate")
+	return &mockSA{cert: &corepb.Certificate{
+		Serial:  core.SerialToString(cert.SerialNumber),
+		Der:     cert.Raw,
+		Issued:  timestamppb.New(cert.NotBefore),
+		Expires: timestamppb.New(cert.NotAfter),
+	}}
+}
+
+func TestDefaultWindow(t *testing.T) {
+	sa := newMockSA(t)
+	fc := clock.NewFake()
+	p, err := New(Config{}, fc)
+	test.AssertNotError(t, err, "creating policy")
+	test.AssertEquals(t, p.RetryAfter(), 6*time.Hour)
+
+	ri, err := p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, ri, core.RenewalInfoSimple(sa.cert.Issued.AsTime(), sa.cert.Expires.AsTime()))
+
+	_, err = p.RenewalInfo(context.Background(), sa, "00")
+	test.AssertErrorIs(t, err, berrors.NotFound)
+}
+
+func TestLoadSpread(t *testing.T) {
+	sa := newMockSA(t)
+	fc := clock.NewFake()
+	p, err := New(Config{
+		RetryAfter: config.Duration{Duration: time.Hour},
+		LoadSpread: config.Duration{Duration: 48 * time.Hour},
+	}, fc)
+	test.AssertNotError(t, err, "creating policy")
+	test.AssertEquals(t, p.RetryAfter(), time.Hour)
+
+	simple := core.RenewalInfoSimple(sa.cert.Issued.AsTime(), sa.cert.Expires.AsTime())
+	ri, err := p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	offset := ri.SuggestedWindow.Start.Sub(simple.SuggestedWindow.Start)
+	test.AssertEquals(t, ri.SuggestedWindow.End.Sub(simple.SuggestedWindow.End), offset)
+	test.Assert(t, offset >= -24*time.Hour && offset < 24*time.Hour, "offset outside of load spread")
+
+	// The window must be stable across requests.
+	again, err := p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, again, ri)
+
+	// Different serials should be spread out.
+	offsets := make(map[time.Duration]bool)
+	for _, serial := range []string{"01", "02", "03", "04", "05"} {
+		offsets[spreadOffset(serial, 48*time.Hour, 90*24*time.Hour)] = true
+	}
+	test.Assert(t, len(offsets) > 1, "load spread offsets should differ between serials")
+
+	// The spread is capped by the validity period.
+	for _, serial := range []string{"01", "02", "03", "04", "05"} {
+		offset := spreadOffset(serial, 48*time.Hour, 6*time.Hour)
+		test.Assert(t, offset >= -time.Hour && offset < time.Hour, "offset outside of capped load spread")
+	}
+	test.AssertEquals(t, spreadOffset("01", 0, 90*24*time.Hour), time.Duration(0))
+}
+
+func TestIncidentRule(t *testing.T) {
+	sa := newMockSA(t)
+	sa.incident = "http://example.com/incident"
+	sa.revoked = true
+	fc := clock.NewFake()
+	p, err := New(Config{RevokedExplanationURL: "http://example.com/revoked"}, fc)
+	test.AssertNotError(t, err, "creating policy")
+
+	ri, err := p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, ri, core.RenewalInfoImmediate(fc.Now(), "http://example.com/incident"))
+
+	// Incidents apply even if the certificate itself can't be found.
+	missing := &mockSA{cert: &corepb.Certificate{Serial: "00"}, incident: "http://example.com/incident"}
+	ri, err = p.RenewalInfo(context.Background(), missing, "00")
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertEquals(t, ri.ExplanationURL, "http://example.com/incident")
+}
+
+func TestRevokedRule(t *testing.T) {
+	sa := newMockSA(t)
+	sa.revoked = true
+	fc := clock.NewFake()
+	p, err := New(Config{RevokedExplanationURL: "http://example.com/revoked"}, fc)
+	test.AssertNotError(t, err, "creating policy")
+
+	ri, err := p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, ri, core.RenewalInfoImmediate(fc.Now(), "http://example.com/revoked"))
+}
+
+func TestIssuerRotationRule(t *testing.T) {
+	sa := newMockSA(t)
+	issued, expires := sa.cert.Issued.AsTime(), sa.cert.Expires.AsTime()
+	fc := clock.NewFake()
+	fc.Set(issued.Add(time.Hour))
+
+	rotation := func(issuerCert string, start, renewBy time.Time) *Policy {
+		t.Helper()
+		p, err := New(Config{IssuerRotations: []IssuerRotationConfig{{
+			IssuerCert:     issuerCert,
+			Start:          start,
+			RenewBy:        renewBy,
+			ExplanationURL: "http://example.com/rotation",
+		}}}, fc)
+		test.AssertNotError(t, err, "creating policy")
+		return p
+	}
+
+	// A certificate from the rotated issuer which outlives renewBy is asked to
+	// renew between the start of the rotation and renewBy.
+	start := issued.Add(24 * time.Hour).Truncate(time.Second)
+	renewBy := issued.Add(30 * 24 * time.Hour).Truncate(time.Second)
+	p := rotation("../test/hierarchy/int-r3.cert.pem", start, renewBy)
+	ri, err := p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, ri, core.RenewalInfo{
+		SuggestedWindow: core.SuggestedWindow{Start: start, End: renewBy},
+		ExplanationURL:  "http://example.com/rotation",
+	})
+
+	// Without a start, the window begins at issuance.
+	p = rotation("../test/hierarchy/int-r3.cert.pem", time.Time{}, renewBy)
+	ri, err = p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertEquals(t, ri.SuggestedWindow.Start, issued.Truncate(time.Second))
+
+	// After renewBy, renewal is immediate.
+	fc.Set(renewBy.Add(time.Hour))
+	ri, err = p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, ri, core.RenewalInfoImmediate(fc.Now(), "http://example.com/rotation"))
+
+	// Certificates which expire before renewBy are unaffected.
+	p = rotation("../test/hierarchy/int-r3.cert.pem", start, expires.Add(time.Hour))
+	ri, err = p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, ri, core.RenewalInfoSimple(issued, expires))
+
+	// Certificates from other issuers are unaffected.
+	p = rotation("../test/hierarchy/int-e1.cert.pem", start, renewBy)
+	ri, err = p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertNotError(t, err, "getting renewal info")
+	test.AssertDeepEquals(t, ri, core.RenewalInfoSimple(issued, expires))
+
+	// A rotation must start before renewBy.
+	_, err = New(Config{IssuerRotations: []IssuerRotationConfig{{
+		IssuerCert: "../test/hierarchy/int-r3.cert.pem",
+		Start:      renewBy,
+		RenewBy:    renewBy,
+	}}}, fc)
+	test.AssertError(t, err, "rotation starting at renewBy should be rejected")
+}
+
+// erroringSA fails all status lookups.
+type erroringSA struct {
+	mockSA
+}
+
+func (sa *erroringSA) GetCertificateStatus(context.Context, *sapb.Serial, ...grpc.CallOption) (*corepb.CertificateStatus, error) {
+	return nil, errors.New("oops")
+}
+
+func TestRuleError(t *testing.T) {
+	sa := &erroringSA{*newMockSA(t)}
+	p, err := New(Config{}, clock.NewFake())
+	test.AssertNotError(t, err, "creating policy")
+	_, err = p.RenewalInfo(context.Background(), sa, sa.cert.Serial)
+	test.AssertError(t, err, "rule errors should be returned")
+	test.AssertContains(t, err.Error(), "revoked")
+}
@@ -35,8 +35,10 @@ import (
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	csrlib "github.com/letsencrypt/boulder/csr"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/issuance"
 	"github.com/letsencrypt/boulder/linter"
 	blog "github.com/letsencrypt/boulder/log"
@@ -152,10 +154,13 @@ type certificateAuthorityImpl struct {
 	prefix    byte
 	maxNames  int
 	keyPolicy goodkey.KeyPolicy
-	clk       clock.Clock
-	log       blog.Logger
-	metrics   *caMetrics
-	tracer    trace.Tracer
+	// ctLogs are the logs whose SCTs may be embedded in final certificates. If
+	// empty, embedded SCTs are not verified.
+	ctLogs  loglist.List
+	clk     clock.Clock
+	log     blog.Logger
+	metrics *caMetrics
+	tracer  trace.Tracer
 }
 
 var _ capb.CertificateAuthorityServer = (*certificateAuthorityImpl)(nil)
@@ -260,6 +265,7 @@ func NewCertificateAuthorityImpl(
 	serialPrefix byte,
 	maxNames int,
 	keyPolicy goodkey.KeyPolicy,
+	ctLogs loglist.List,
 	logger blog.Logger,
 	metrics *caMetrics,
 	clk clock.Clock,
@@ -294,6 +300,7 @@ func NewCertificateAuthorityImpl(
 		prefix:       serialPrefix,
 		maxNames:     maxNames,
 		keyPolicy:    keyPolicy,
+		ctLogs:       ctLogs,
 		log:          logger,
 		metrics:      metrics,
 		tracer:       otel.GetTracerProvider().Tracer("github.com/letsencrypt/boulder/ca"),
@@ -441,6 +448,12 @@ func (ca *certificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		return nil, berrors.InternalServerError("no issuer found for Issuer Name %s", precert.Issuer)
 	}
 
+	err = ca.verifySCTs(precert, issuer, scts)
+	if err != nil {
+		ca.log.AuditErrf("Invalid SCTs for precertificate: serial=[%s] err=[%v]", serialHex, err)
+		return nil, berrors.InternalServerError("invalid SCTs for precertificate %s: %s", serialHex, err)
+	}
+
 	issuanceReq, err := issuance.RequestFromPrecert(precert, scts)
 	if err != nil {
 		return nil, err
@@ -593,6 +606,7 @@ func (ca *certificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 		SubjectKeyId:      subjectKeyId,
 		Serial:            serialBigInt.Bytes(),
 		DNSNames:          names.SANs,
+		IPAddresses:       identifier.FromCSR(csr).IPAddresses(),
 		CommonName:        names.CN,
 		IncludeCTPoison:   true,
 		IncludeMustStaple: issuance.ContainsMustStaple(csr.Extensions),
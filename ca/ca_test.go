@@ -148,7 +148,7 @@ func setup(t *testing.T) *testCtx {
 	fc := clock.NewFake()
 	fc.Add(1 * time.Hour)
 
-	pa, err := policy.New(nil, blog.NewMock())
+	pa, err := policy.New(nil, nil, blog.NewMock())
 	test.AssertNotError(t, err, "Couldn't create PA")
 	err = pa.LoadHostnamePolicyFile("../test/hostname-policy.yaml")
 	test.AssertNotError(t, err, "Couldn't set hostname policy")
@@ -260,6 +260,7 @@ func TestSerialPrefix(t *testing.T) {
 		0x00,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		nil,
 		testCtx.fc)
@@ -274,6 +275,7 @@ func TestSerialPrefix(t *testing.T) {
 		0x80,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		nil,
 		testCtx.fc)
@@ -370,6 +372,7 @@ func issueCertificateSubTestSetup(t *testing.T) (*certificateAuthorityImpl, *moc
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -409,6 +412,7 @@ func TestNoIssuers(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -430,6 +434,7 @@ func TestMultipleIssuers(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -504,6 +509,7 @@ func TestUnpredictableIssuance(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -600,7 +606,7 @@ func TestMakeCertificateProfilesMap(t *testing.T) {
 			expectedProfiles: []nameToHash{
 				{
 					name: "empty",
-					hash: [32]byte{0x25, 0x27, 0x72, 0xa1, 0xaf, 0x95, 0xfe, 0xc7, 0x32, 0x78, 0x38, 0x97, 0xd0, 0xf1, 0x83, 0x92, 0xc3, 0xac, 0x60, 0x91, 0x68, 0x4f, 0x22, 0xb6, 0x57, 0x2f, 0x89, 0x1a, 0x54, 0xe5, 0xd8, 0xa3},
+					hash: [32]byte{0xb5, 0xf5, 0x5a, 0xf8, 0x9d, 0x36, 0x73, 0x32, 0x7e, 0x7f, 0xf0, 0x41, 0xd7, 0x81, 0x97, 0xe5, 0xe3, 0x7c, 0xaf, 0xcd, 0x8e, 0xbd, 0x36, 0x20, 0x41, 0x64, 0x95, 0x2c, 0xcd, 0x4a, 0x3f, 0xbb},
 				},
 			},
 		},
@@ -611,11 +617,11 @@ func TestMakeCertificateProfilesMap(t *testing.T) {
 			expectedProfiles: []nameToHash{
 				{
 					name: "legacy",
-					hash: [32]byte{0x44, 0xc5, 0xbc, 0x73, 0x8, 0x95, 0xba, 0x4c, 0x13, 0x12, 0xc4, 0xc, 0x5d, 0x77, 0x2f, 0x54, 0xf8, 0x54, 0x1, 0xb8, 0x84, 0xaf, 0x6c, 0x58, 0x74, 0x6, 0xac, 0xda, 0x3e, 0x37, 0xfc, 0x88},
+					hash: [32]byte{0x17, 0xb3, 0x56, 0x87, 0xeb, 0xb9, 0x4e, 0x8, 0x4a, 0x1c, 0x4a, 0x6a, 0x7e, 0xf5, 0x1c, 0x8b, 0x6a, 0x49, 0x4c, 0x99, 0x23, 0xf4, 0xb7, 0xaa, 0x46, 0x1, 0x1a, 0xd1, 0x9b, 0x7, 0xb8, 0xfa},
 				},
 				{
 					name: "modern",
-					hash: [32]byte{0x58, 0x7, 0xea, 0x3a, 0x85, 0xcd, 0xf9, 0xd1, 0x7a, 0x9a, 0x59, 0x76, 0xfc, 0x92, 0xea, 0x1b, 0x69, 0x54, 0xe4, 0xbe, 0xcf, 0xe3, 0x91, 0xfa, 0x85, 0x4, 0xbf, 0x1f, 0x55, 0x97, 0x2c, 0x8b},
+					hash: [32]byte{0x42, 0xd1, 0xc3, 0xea, 0xfa, 0x1a, 0x7c, 0xed, 0xe9, 0x44, 0xe3, 0x46, 0xd9, 0x19, 0x7a, 0x8f, 0x3, 0x42, 0xb6, 0xec, 0xe0, 0x14, 0xfe, 0x6d, 0x11, 0x7e, 0x2, 0xf8, 0x3, 0xe0, 0x16, 0xed},
 				},
 			},
 		},
@@ -709,6 +715,7 @@ func TestInvalidCSRs(t *testing.T) {
 			testCtx.serialPrefix,
 			testCtx.maxNames,
 			testCtx.keyPolicy,
+			nil,
 			testCtx.logger,
 			testCtx.metrics,
 			testCtx.fc)
@@ -748,6 +755,7 @@ func TestRejectValidityTooLong(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -841,6 +849,7 @@ func TestIssueCertificateForPrecertificate(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -906,6 +915,7 @@ func TestIssueCertificateForPrecertificateWithSpecificCertificateProfile(t *test
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -1022,6 +1032,7 @@ func TestIssueCertificateForPrecertificateDuplicateSerial(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
@@ -1069,6 +1080,7 @@ func TestIssueCertificateForPrecertificateDuplicateSerial(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
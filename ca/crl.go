@@ -116,6 +116,9 @@ func (ci *crlImpl) GenerateCRL(stream grpc.BidiStreamingServer[capb.GenerateCRLR
 		"Signing CRL: logID=[%s] issuer=[%s] number=[%s] shard=[%d] thisUpdate=[%s] numEntries=[%d]",
 		logID, issuer.Cert.Subject.CommonName, req.Number.String(), req.Shard, req.ThisUpdate, len(rcs),
 	)
+	if req.BaseNumber != nil {
+		ci.log.AuditInfof("Signing CRL: logID=[%s] delta=[true] baseNumber=[%s]", logID, req.BaseNumber.String())
+	}
 
 	if len(rcs) > 0 {
 		builder := strings.Builder{}
@@ -177,11 +180,21 @@ func (ci *crlImpl) metadataToRequest(meta *capb.CRLMetadata) (*issuance.CRLReque
 	thisUpdate := meta.ThisUpdate.AsTime()
 	number := bcrl.Number(thisUpdate)
 
-	return &issuance.CRLRequest{
+	req := &issuance.CRLRequest{
 		Number:     number,
 		Shard:      meta.ShardIdx,
 		ThisUpdate: thisUpdate,
-	}, nil
+	}
+
+	if meta.BaseThisUpdate != nil {
+		baseThisUpdate := meta.BaseThisUpdate.AsTime()
+		if !baseThisUpdate.Before(thisUpdate) {
+			return nil, fmt.Errorf("delta CRL thisUpdate %s must be after base thisUpdate %s", thisUpdate, baseThisUpdate)
+		}
+		req.BaseNumber = bcrl.Number(baseThisUpdate)
+	}
+
+	return req, nil
 }
 
 func (ci *crlImpl) entryToRevokedCertificate(entry *corepb.CRLEntry) (*x509.RevocationListEntry, error) {
@@ -4,7 +4,9 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io"
+	"math/big"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -269,3 +271,42 @@ func TestGenerateCRL(t *testing.T) {
 	err = crl.CheckSignatureFrom(testCtx.boulderIssuers[0].Cert.Certificate)
 	test.AssertNotError(t, err, "CRL signature should validate")
 }
+
+func TestMetadataToRequestDelta(t *testing.T) {
+	t.Parallel()
+	testCtx := setup(t)
+	crli := testCtx.crl
+	now := testCtx.fc.Now()
+	base := now.Add(-6 * time.Hour)
+
+	// Without a base, the request is for a full CRL.
+	req, err := crli.metadataToRequest(&capb.CRLMetadata{
+		IssuerNameID: 1,
+		ThisUpdate:   timestamppb.New(now),
+		ShardIdx:     1,
+	})
+	test.AssertNotError(t, err, "converting full CRL metadata")
+	test.Assert(t, req.BaseNumber == nil, "full CRL request should have no base number")
+
+	// With a base, the request is for a delta CRL whose base number is derived
+	// from the base's thisUpdate.
+	req, err = crli.metadataToRequest(&capb.CRLMetadata{
+		IssuerNameID:   1,
+		ThisUpdate:     timestamppb.New(now),
+		ShardIdx:       1,
+		BaseThisUpdate: timestamppb.New(base),
+	})
+	test.AssertNotError(t, err, "converting delta CRL metadata")
+	test.AssertDeepEquals(t, req.BaseNumber, big.NewInt(base.UnixNano()))
+	test.AssertDeepEquals(t, req.Number, big.NewInt(now.UnixNano()))
+
+	// The base must be older than the delta.
+	_, err = crli.metadataToRequest(&capb.CRLMetadata{
+		IssuerNameID:   1,
+		ThisUpdate:     timestamppb.New(now),
+		ShardIdx:       1,
+		BaseThisUpdate: timestamppb.New(now),
+	})
+	test.AssertError(t, err, "converting delta CRL metadata with stale base")
+	test.AssertContains(t, err.Error(), "must be after base thisUpdate")
+}
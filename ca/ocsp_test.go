@@ -38,6 +38,7 @@ func TestOCSP(t *testing.T) {
 		testCtx.serialPrefix,
 		testCtx.maxNames,
 		testCtx.keyPolicy,
+		nil,
 		testCtx.logger,
 		testCtx.metrics,
 		testCtx.fc)
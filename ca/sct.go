@@ -0,0 +1,87 @@
+package ca
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+
+	"github.com/letsencrypt/boulder/issuance"
+)
+
+// maxSCTClockSkew is how far into the future an SCT's timestamp may be, to
+// allow for clock skew between the CA and the log. It matches the tolerance
+// the publisher applies when it receives the SCT.
+const maxSCTClockSkew = time.Minute
+
+// verifySCTs checks that each of the given SCTs was issued for the given
+// precertificate by a log in the CA's log list: that its signature verifies
+// under that log's public key, and that its timestamp falls between the
+// precertificate's notBefore and maxSCTClockSkew past the current time. It
+// returns an error describing the first SCT which fails these checks. If the
+// CA was not configured with a log list, no checks are performed.
+func (ca *certificateAuthorityImpl) verifySCTs(precert *x509.Certificate, issuer *issuance.Issuer, scts []ct.SignedCertificateTimestamp) error {
+	if len(ca.ctLogs) == 0 {
+		return nil
+	}
+
+	// Every SCT signs over the same precertificate entry, which identifies the
+	// issuer by the hash of its key and omits the poison extension from the
+	// TBSCertificate.
+	tbs, err := ctx509.BuildPrecertTBS(precert.RawTBSCertificate, nil)
+	if err != nil {
+		return fmt.Errorf("building precertificate TBS: %w", err)
+	}
+	entry := ct.LogEntry{
+		Leaf: ct.MerkleTreeLeaf{
+			Version:  ct.V1,
+			LeafType: ct.TimestampedEntryLeafType,
+			TimestampedEntry: &ct.TimestampedEntry{
+				EntryType: ct.PrecertLogEntryType,
+				PrecertEntry: &ct.PreCert{
+					IssuerKeyHash:  sha256.Sum256(issuer.Cert.RawSubjectPublicKeyInfo),
+					TBSCertificate: tbs,
+				},
+			},
+		},
+	}
+
+	now := ca.clk.Now()
+	for i, sct := range scts {
+		if sct.SCTVersion != ct.V1 {
+			return fmt.Errorf("SCT %d has unsupported version %d", i, sct.SCTVersion)
+		}
+
+		log, err := ca.ctLogs.LogForID(base64.StdEncoding.EncodeToString(sct.LogID.KeyID[:]))
+		if err != nil {
+			return fmt.Errorf("SCT %d: %w", i, err)
+		}
+
+		timestamp := ct.TimestampToTime(sct.Timestamp)
+		if timestamp.Before(precert.NotBefore) {
+			return fmt.Errorf("SCT %d from log %q has timestamp %s before the precertificate's notBefore %s", i, log.Name, timestamp, precert.NotBefore)
+		}
+		if timestamp.After(now.Add(maxSCTClockSkew)) {
+			return fmt.Errorf("SCT %d from log %q has timestamp %s in the future", i, log.Name, timestamp)
+		}
+
+		pubKey, err := ct.PublicKeyFromB64(log.Key)
+		if err != nil {
+			return fmt.Errorf("parsing public key of log %q: %w", log.Name, err)
+		}
+		verifier, err := ct.NewSignatureVerifier(pubKey)
+		if err != nil {
+			return fmt.Errorf("making signature verifier for log %q: %w", log.Name, err)
+		}
+		entry.Leaf.TimestampedEntry.Timestamp = sct.Timestamp
+		err = verifier.VerifySCTSignature(sct, entry)
+		if err != nil {
+			return fmt.Errorf("SCT %d from log %q has invalid signature: %w", i, log.Name, err)
+		}
+	}
+	return nil
+}
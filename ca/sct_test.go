@@ -0,0 +1,156 @@
+package ca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+
+	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// signSCT returns a serialized SCT for the given precertificate, signed by key
+// on behalf of the log with the given ID.
+func signSCT(t *testing.T, key crypto.Signer, logID [32]byte, precertDER []byte, issuer *issuance.Issuer, timestamp time.Time) []byte {
+	t.Helper()
+	precert, err := ctx509.ParseCertificate(precertDER)
+	test.AssertNotError(t, err, "parsing precert")
+	issuerCert, err := ctx509.ParseCertificate(issuer.Cert.Raw)
+	test.AssertNotError(t, err, "parsing issuer")
+	leaf, err := ct.MerkleTreeLeafFromChain([]*ctx509.Certificate{precert, issuerCert}, ct.PrecertLogEntryType, uint64(timestamp.UnixMilli()))
+	test.AssertNotError(t, err, "building leaf")
+
+	sct := ct.SignedCertificateTimestamp{
+		SCTVersion: ct.V1,
+		LogID:      ct.LogID{KeyID: logID},
+		Timestamp:  uint64(timestamp.UnixMilli()),
+	}
+	input, err := ct.SerializeSCTSignatureInput(sct, ct.LogEntry{Leaf: *leaf})
+	test.AssertNotError(t, err, "serializing SCT signature input")
+	digest := sha256.Sum256(input)
+	sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	test.AssertNotError(t, err, "signing SCT")
+	sct.Signature = ct.DigitallySigned{
+		Algorithm: cttls.SignatureAndHashAlgorithm{
+			Hash:      cttls.SHA256,
+			Signature: cttls.ECDSA,
+		},
+		Signature: sig,
+	}
+
+	sctBytes, err := cttls.Marshal(sct)
+	test.AssertNotError(t, err, "marshalling SCT")
+	return sctBytes
+}
+
+func TestIssueCertificateForPrecertificateVerifiesSCTs(t *testing.T) {
+	t.Parallel()
+	testCtx := setup(t)
+
+	logKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating log key")
+	spki, err := x509.MarshalPKIXPublicKey(logKey.Public())
+	test.AssertNotError(t, err, "marshalling log key")
+	logID := sha256.Sum256(spki)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating other key")
+
+	ctLogs := loglist.List{
+		"Operator": {
+			base64.StdEncoding.EncodeToString(logID[:]): loglist.Log{
+				Name: "Log",
+				Key:  base64.StdEncoding.EncodeToString(spki),
+			},
+		},
+	}
+
+	ca, err := NewCertificateAuthorityImpl(
+		&mockSA{},
+		testCtx.pa,
+		testCtx.boulderIssuers,
+		testCtx.defaultCertProfileName,
+		testCtx.certProfiles,
+		testCtx.serialPrefix,
+		testCtx.maxNames,
+		testCtx.keyPolicy,
+		ctLogs,
+		testCtx.logger,
+		testCtx.metrics,
+		testCtx.fc)
+	test.AssertNotError(t, err, "Failed to create CA")
+
+	precert, err := ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID})
+	test.AssertNotError(t, err, "Failed to issue precert")
+	parsedPrecert, err := x509.ParseCertificate(precert.DER)
+	test.AssertNotError(t, err, "Failed to parse precert")
+	issuer := ca.issuers.byNameID[issuance.IssuerNameID(parsedPrecert)]
+
+	now := testCtx.fc.Now()
+	testCases := []struct {
+		name        string
+		sct         []byte
+		expectedErr string
+	}{
+		{
+			name:        "unknown log",
+			sct:         signSCT(t, otherKey, [32]byte{1}, precert.DER, issuer, now),
+			expectedErr: "no log with ID",
+		},
+		{
+			name:        "wrong signer",
+			sct:         signSCT(t, otherKey, logID, precert.DER, issuer, now),
+			expectedErr: "invalid signature",
+		},
+		{
+			name:        "before notBefore",
+			sct:         signSCT(t, logKey, logID, precert.DER, issuer, parsedPrecert.NotBefore.Add(-time.Minute)),
+			expectedErr: "before the precertificate's notBefore",
+		},
+		{
+			name:        "in the future",
+			sct:         signSCT(t, logKey, logID, precert.DER, issuer, now.Add(time.Hour)),
+			expectedErr: "in the future",
+		},
+		{
+			name:        "beyond clock skew",
+			sct:         signSCT(t, logKey, logID, precert.DER, issuer, now.Add(maxSCTClockSkew+time.Second)),
+			expectedErr: "in the future",
+		},
+		{
+			name: "valid",
+			sct:  signSCT(t, logKey, logID, precert.DER, issuer, now),
+		},
+		{
+			name: "within clock skew",
+			sct:  signSCT(t, logKey, logID, precert.DER, issuer, now.Add(maxSCTClockSkew)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ca.IssueCertificateForPrecertificate(ctx, &capb.IssueCertificateForPrecertificateRequest{
+				DER:             precert.DER,
+				SCTs:            [][]byte{tc.sct},
+				RegistrationID:  arbitraryRegID,
+				CertProfileHash: precert.CertProfileHash,
+			})
+			if tc.expectedErr == "" {
+				test.AssertNotError(t, err, "Failed to issue cert with valid SCT")
+			} else {
+				test.AssertError(t, err, "Issued cert with invalid SCT")
+				test.AssertContains(t, err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
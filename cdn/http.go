@@ -0,0 +1,123 @@
+package cdn
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// HTTPPurger purges URLs from a cache which accepts requests using the PURGE or
+// BAN HTTP methods, such as Varnish, nginx, or Squid. It is safe to make
+// concurrent requests using this client.
+type HTTPPurger struct {
+	*Retrier
+	method   string
+	endpoint *url.URL
+}
+
+var _ Purger = (*HTTPPurger)(nil)
+
+// NewHTTPPurger returns a Purger which sends one request per URL, using the
+// given method, which must be "PURGE" or "BAN". If endpoint is empty, each
+// request is sent directly to the URL being purged. Otherwise each request is
+// sent to the scheme and host of endpoint, with the path and query of the URL
+// being purged, and with the Host header set to that URL's host; this allows
+// purging a cache which cannot be reached at its public hostname.
+func NewHTTPPurger(
+	method,
+	endpoint string,
+	retries int,
+	retryBackoff time.Duration,
+	log blog.Logger,
+	scope prometheus.Registerer,
+) (*HTTPPurger, error) {
+	if method != "PURGE" && method != "BAN" {
+		return nil, fmt.Errorf("method must be \"PURGE\" or \"BAN\", got %q", method)
+	}
+
+	var endpointURL *url.URL
+	if endpoint != "" {
+		var err error
+		endpointURL, err = url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse endpoint as a URL: %s", err)
+		}
+		if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+			return nil, fmt.Errorf("endpoint must be an http or https URL, got %q", endpoint)
+		}
+	}
+
+	return &HTTPPurger{
+		Retrier:  NewRetrier(retries, retryBackoff, log, scope, clock.New()),
+		method:   method,
+		endpoint: endpointURL,
+	}, nil
+}
+
+// Purge sends a request to purge each of the provided URLs in turn. Each
+// request will be attempted hp.retries number of times before giving up on that
+// URL with ErrAllRetriesFailed. A URL which can't be purged doesn't prevent
+// attempts to purge the rest; the errors for every failed URL are returned
+// together.
+func (hp *HTTPPurger) Purge(urls []string) error {
+	var errs []error
+	for _, u := range urls {
+		err := hp.Retry("http", func() error { return hp.purgeURL(u) })
+		if err != nil {
+			errs = append(errs, fmt.Errorf("purging %q: %w", u, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// purgeURL makes a single attempt to purge the given URL.
+func (hp *HTTPPurger) purgeURL(target string) error {
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf("while parsing %q as URL: %s: %w", target, err, ErrFatal)
+	}
+
+	reqURL := target
+	if hp.endpoint != nil {
+		reqURL = hp.endpoint.Scheme + "://" + hp.endpoint.Host + targetURL.RequestURI()
+	}
+
+	req, err := http.NewRequest(hp.method, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", err, ErrFatal)
+	}
+	req.Host = targetURL.Host
+
+	resp, err := hp.Do("http", req)
+	if err != nil {
+		return fmt.Errorf("while sending %s to %q: %w", hp.method, reqURL, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+	// Some caches (e.g. nginx) respond 404 when there was nothing cached at the
+	// given URL, which is just as good as a successful purge.
+	case resp.StatusCode == http.StatusNotFound:
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("received HTTP %d (body %q) for %s %q", resp.StatusCode, respBody, hp.method, reqURL)
+	default:
+		return fmt.Errorf("received HTTP %d (body %q) for %s %q: %w", resp.StatusCode, respBody, hp.method, reqURL, ErrFatal)
+	}
+
+	hp.log.AuditInfof("Purge request sent successfully (%s %s)", hp.method, target)
+	return nil
+}
@@ -0,0 +1,122 @@
+package cdn
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeCache records the requests it receives and responds to each with the
+// next of its configured status codes, repeating the last one once they run
+// out.
+type fakeCache struct {
+	sync.Mutex
+	codes    []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (fc *fakeCache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fc.Lock()
+	defer fc.Unlock()
+	body, _ := io.ReadAll(r.Body)
+	fc.requests = append(fc.requests, r)
+	fc.bodies = append(fc.bodies, body)
+	code := fc.codes[0]
+	if len(fc.codes) > 1 {
+		fc.codes = fc.codes[1:]
+	}
+	w.WriteHeader(code)
+}
+
+func TestNewHTTPPurger(t *testing.T) {
+	_, err := NewHTTPPurger("GET", "", 0, 0, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertError(t, err, "GET should not be an acceptable purge method")
+
+	_, err = NewHTTPPurger("PURGE", "ftp://cache.example", 0, 0, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertError(t, err, "ftp should not be an acceptable endpoint scheme")
+
+	_, err = NewHTTPPurger("BAN", "http://cache.example:6081", 0, 0, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "creating BAN purger")
+}
+
+func TestHTTPPurgerDirect(t *testing.T) {
+	fc := &fakeCache{codes: []int{http.StatusOK}}
+	srv := httptest.NewServer(fc)
+	defer srv.Close()
+
+	hp, err := NewHTTPPurger("PURGE", "", 0, time.Millisecond, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "creating purger")
+
+	err = hp.Purge([]string{srv.URL + "/ocsp/MFQw", srv.URL + "/crl/1.crl"})
+	test.AssertNotError(t, err, "purging URLs")
+	test.AssertEquals(t, len(fc.requests), 2)
+	test.AssertEquals(t, fc.requests[0].Method, "PURGE")
+	test.AssertEquals(t, fc.requests[0].URL.Path, "/ocsp/MFQw")
+	test.AssertEquals(t, fc.requests[1].URL.Path, "/crl/1.crl")
+}
+
+func TestHTTPPurgerEndpoint(t *testing.T) {
+	fc := &fakeCache{codes: []int{http.StatusNotFound}}
+	srv := httptest.NewServer(fc)
+	defer srv.Close()
+
+	hp, err := NewHTTPPurger("BAN", srv.URL, 0, time.Millisecond, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "creating purger")
+
+	// A 404 means there was nothing to purge, which counts as success.
+	err = hp.Purge([]string{"http://o.lencr.org/MFQw%2BTQ%3D?x=y"})
+	test.AssertNotError(t, err, "purging URL via endpoint")
+	test.AssertEquals(t, len(fc.requests), 1)
+	test.AssertEquals(t, fc.requests[0].Method, "BAN")
+	test.AssertEquals(t, fc.requests[0].Host, "o.lencr.org")
+	test.AssertEquals(t, fc.requests[0].RequestURI, "/MFQw%2BTQ%3D?x=y")
+}
+
+func TestHTTPPurgerRetries(t *testing.T) {
+	fc := &fakeCache{codes: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}}
+	srv := httptest.NewServer(fc)
+	defer srv.Close()
+
+	hp, err := NewHTTPPurger("PURGE", "", 2, time.Millisecond, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "creating purger")
+	hp.clk = clock.NewFake()
+
+	err = hp.Purge([]string{srv.URL + "/ocsp/MFQw"})
+	test.AssertNotError(t, err, "purging URL after retryable failures")
+	test.AssertEquals(t, len(fc.requests), 3)
+	test.AssertMetricWithLabelsEquals(t, hp.purges, prometheus.Labels{"purger": "http", "type": "retryable failure"}, 2)
+	test.AssertMetricWithLabelsEquals(t, hp.purges, prometheus.Labels{"purger": "http", "type": "success"}, 1)
+
+	// Exhausting all retries should return ErrAllRetriesFailed.
+	fc.codes = []int{http.StatusBadGateway}
+	err = hp.Purge([]string{srv.URL + "/ocsp/MFQw"})
+	test.AssertErrorIs(t, err, ErrAllRetriesFailed)
+	test.AssertEquals(t, len(fc.requests), 6)
+
+	// A fatal error should not be retried, and should not stop the rest of the
+	// batch from being purged.
+	fc.codes = []int{http.StatusMethodNotAllowed, http.StatusOK}
+	err = hp.Purge([]string{srv.URL + "/ocsp/MFQw", srv.URL + "/crl/1.crl"})
+	test.AssertErrorIs(t, err, ErrFatal)
+	test.AssertEquals(t, len(fc.requests), 8)
+	test.AssertEquals(t, fc.requests[7].URL.Path, "/crl/1.crl")
+
+	// Every URL which fails should be reported.
+	fc.codes = []int{http.StatusMethodNotAllowed}
+	err = hp.Purge([]string{srv.URL + "/ocsp/MFQw", srv.URL + "/crl/1.crl"})
+	test.AssertErrorIs(t, err, ErrFatal)
+	test.AssertContains(t, err.Error(), "/ocsp/MFQw")
+	test.AssertContains(t, err.Error(), "/crl/1.crl")
+	test.AssertEquals(t, len(fc.requests), 10)
+}
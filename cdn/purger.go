@@ -0,0 +1,119 @@
+// Package cdn contains clients for purging cached OCSP responses and CRLs from
+// the CDNs which front them.
+package cdn
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+)
+
+var (
+	// ErrAllRetriesFailed indicates that all purge submission attempts have
+	// failed.
+	ErrAllRetriesFailed = errors.New("all attempts to submit purge request failed")
+
+	// ErrFatal is wrapped by the error from a single purge attempt to indicate
+	// that it failed for a reason that cannot be remediated by retrying the
+	// request.
+	ErrFatal = errors.New("fatal error")
+)
+
+// Purger is implemented by clients which can remove a batch of URLs from a
+// CDN's cache. Implementations must be safe for concurrent use. The
+// akamai-purger service dispatches each batch of URLs taken from its queue to a
+// single Purger.
+type Purger interface {
+	Purge(urls []string) error
+}
+
+// TagPurger is implemented by Purgers which can additionally remove every
+// cached object labelled with any of a batch of tags (also known as cache tags
+// or surrogate keys, depending on the CDN).
+type TagPurger interface {
+	Purger
+	PurgeTags(tags []string) error
+}
+
+// Retrier holds the HTTP client, retry policy, and metrics shared by every
+// Purger, including the Akamai client, so that they all retry and report their
+// results the same way.
+type Retrier struct {
+	client       *http.Client
+	retries      int
+	retryBackoff time.Duration
+	log          blog.Logger
+	purgeLatency *prometheus.HistogramVec
+	purges       *prometheus.CounterVec
+	clk          clock.Clock
+}
+
+// NewRetrier returns a Retrier which makes up to retries+1 attempts at each
+// purge, and registers the cdn_purge_latency and cdn_purges metrics with the
+// given scope. It must only be called once per scope.
+func NewRetrier(retries int, retryBackoff time.Duration, log blog.Logger, scope prometheus.Registerer, clk clock.Clock) *Retrier {
+	purgeLatency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cdn_purge_latency",
+		Help:    "Histogram of latencies of individual CDN purge requests, labelled by purger",
+		Buckets: metrics.InternetFacingBuckets,
+	}, []string{"purger"})
+	scope.MustRegister(purgeLatency)
+
+	purges := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cdn_purges",
+		Help: "A counter of CDN purges labelled by purger and result",
+	}, []string{"purger", "type"})
+	scope.MustRegister(purges)
+
+	return &Retrier{
+		client:       new(http.Client),
+		retries:      retries,
+		retryBackoff: retryBackoff,
+		log:          log,
+		purgeLatency: purgeLatency,
+		purges:       purges,
+		clk:          clk,
+	}
+}
+
+// Do sends the given request, recording its latency under the given
+// purger name, and returns the response. The caller is responsible for closing
+// the response body.
+func (r *Retrier) Do(purger string, req *http.Request) (*http.Response, error) {
+	start := r.clk.Now()
+	resp, err := r.client.Do(req)
+	r.purgeLatency.WithLabelValues(purger).Observe(r.clk.Since(start).Seconds())
+	return resp, err
+}
+
+// Retry calls attempt up to r.retries+1 times, backing off between each call,
+// until it succeeds or returns an error wrapping ErrFatal. If every attempt
+// fails with a retryable error, it returns ErrAllRetriesFailed.
+func (r *Retrier) Retry(purger string, attempt func() error) error {
+	for i := range r.retries + 1 {
+		r.clk.Sleep(core.RetryBackoff(i, r.retryBackoff, time.Minute, 1.3))
+
+		err := attempt()
+		if err != nil {
+			if errors.Is(err, ErrFatal) {
+				r.purges.WithLabelValues(purger, "fatal failure").Inc()
+				return err
+			}
+			r.log.AuditErrf("%s cache purge failed, retrying: %s", purger, err)
+			r.purges.WithLabelValues(purger, "retryable failure").Inc()
+			continue
+		}
+		r.purges.WithLabelValues(purger, "success").Inc()
+		return nil
+	}
+
+	r.purges.WithLabelValues(purger, "fatal failure").Inc()
+	return ErrAllRetriesFailed
+}
@@ -0,0 +1,142 @@
+package cdn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+type surrogateKeyPurgeRequest struct {
+	SurrogateKeys []string `json:"surrogate_keys"`
+}
+
+// SurrogateKeyPurger purges objects from a CDN which supports purging by
+// surrogate key (also known as a cache tag), such as Fastly. It is safe to make
+// concurrent requests using this client.
+type SurrogateKeyPurger struct {
+	*Retrier
+	endpoint     string
+	apiKeyHeader string
+	apiKey       string
+}
+
+var _ TagPurger = (*SurrogateKeyPurger)(nil)
+
+// NewSurrogateKeyPurger returns a TagPurger which POSTs batches of surrogate
+// keys to the given endpoint, as a JSON object of the form
+// {"surrogate_keys": ["key1", "key2"]}. The apiKey is sent verbatim in the
+// apiKeyHeader header of each request.
+func NewSurrogateKeyPurger(
+	endpoint,
+	apiKeyHeader,
+	apiKey string,
+	retries int,
+	retryBackoff time.Duration,
+	log blog.Logger,
+	scope prometheus.Registerer,
+) (*SurrogateKeyPurger, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint as a URL: %s", err)
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint must be an http or https URL, got %q", endpoint)
+	}
+	if apiKeyHeader == "" {
+		return nil, errors.New("API key header name must not be empty")
+	}
+
+	return &SurrogateKeyPurger{
+		Retrier:      NewRetrier(retries, retryBackoff, log, scope, clock.New()),
+		endpoint:     endpointURL.String(),
+		apiKeyHeader: apiKeyHeader,
+		apiKey:       apiKey,
+	}, nil
+}
+
+// URLSurrogateKey returns the surrogate key by which SurrogateKeyPurger.Purge
+// purges the given URL: the hex-encoded SHA-256 hash of the full URL. The CDN
+// must be configured to label each cached object with this key.
+func URLSurrogateKey(u string) string {
+	hash := sha256.Sum256([]byte(u))
+	return hex.EncodeToString(hash[:])
+}
+
+// Purge converts each of the provided URLs to its surrogate key, as computed
+// by URLSurrogateKey, and purges them all in a single request. The request will
+// be attempted skp.retries number of times before giving up and returning
+// ErrAllRetriesFailed.
+func (skp *SurrogateKeyPurger) Purge(urls []string) error {
+	keys := make([]string, 0, len(urls))
+	for _, u := range urls {
+		keys = append(keys, URLSurrogateKey(u))
+	}
+	return skp.Retry("surrogate-key", func() error { return skp.purgeKeys(keys) })
+}
+
+// PurgeTags purges the provided surrogate keys, as-is, in a single request.
+// Empty keys are ignored.
+func (skp *SurrogateKeyPurger) PurgeTags(tags []string) error {
+	var keys []string
+	for _, tag := range tags {
+		if tag != "" {
+			keys = append(keys, tag)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return skp.Retry("surrogate-key", func() error { return skp.purgeKeys(keys) })
+}
+
+// purgeKeys makes a single attempt to purge the given surrogate keys.
+func (skp *SurrogateKeyPurger) purgeKeys(keys []string) error {
+	reqBody, err := json.Marshal(surrogateKeyPurgeRequest{SurrogateKeys: keys})
+	if err != nil {
+		return fmt.Errorf("%s: %w", err, ErrFatal)
+	}
+
+	req, err := http.NewRequest("POST", skp.endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("%s: %w", err, ErrFatal)
+	}
+	req.Header.Set(skp.apiKeyHeader, skp.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := skp.Do("surrogate-key", req)
+	if err != nil {
+		return fmt.Errorf("while POSTing to endpoint %q: %w", skp.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("client not authorized to make requests for URL %q: %w", skp.endpoint, ErrFatal)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("received HTTP %d (body %q) for URL %q", resp.StatusCode, respBody, skp.endpoint)
+	default:
+		return fmt.Errorf("received HTTP %d (body %q) for URL %q: %w", resp.StatusCode, respBody, skp.endpoint, ErrFatal)
+	}
+
+	skp.log.AuditInfof("Purge request sent successfully (body %s)", reqBody)
+	return nil
+}
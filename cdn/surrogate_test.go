@@ -0,0 +1,88 @@
+package cdn
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestNewSurrogateKeyPurger(t *testing.T) {
+	_, err := NewSurrogateKeyPurger("api.example/purge", "Fastly-Key", "key", 0, 0, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertError(t, err, "endpoint without scheme should be rejected")
+
+	_, err = NewSurrogateKeyPurger("https://api.example/purge", "", "key", 0, 0, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertError(t, err, "empty API key header should be rejected")
+
+	_, err = NewSurrogateKeyPurger("https://api.example/purge", "Fastly-Key", "key", 0, 0, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "creating surrogate key purger")
+}
+
+func TestURLSurrogateKey(t *testing.T) {
+	test.AssertEquals(t, URLSurrogateKey("http://c.lencr.org/1.crl"), "156503f60490f74a78d6662476ac097d74e9c84be28e0922a6bd2b310c14a094")
+	test.AssertNotEquals(t, URLSurrogateKey("http://c.lencr.org/1.crl"), URLSurrogateKey("http://c.lencr.org/2.crl"))
+}
+
+func TestSurrogateKeyPurger(t *testing.T) {
+	fc := &fakeCache{codes: []int{http.StatusOK}}
+	srv := httptest.NewServer(fc)
+	defer srv.Close()
+
+	skp, err := NewSurrogateKeyPurger(srv.URL+"/service/abc/purge", "Fastly-Key", "sekrit", 0, time.Millisecond, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "creating purger")
+
+	urls := []string{"http://c.lencr.org/1.crl", "http://o.lencr.org/MFQw"}
+	err = skp.Purge(urls)
+	test.AssertNotError(t, err, "purging URLs")
+	test.AssertEquals(t, len(fc.requests), 1)
+	test.AssertEquals(t, fc.requests[0].Method, "POST")
+	test.AssertEquals(t, fc.requests[0].URL.Path, "/service/abc/purge")
+	test.AssertEquals(t, fc.requests[0].Header.Get("Fastly-Key"), "sekrit")
+	test.AssertEquals(t, fc.requests[0].Header.Get("Content-Type"), "application/json")
+
+	var body surrogateKeyPurgeRequest
+	err = json.Unmarshal(fc.bodies[0], &body)
+	test.AssertNotError(t, err, "unmarshalling purge request")
+	test.AssertDeepEquals(t, body.SurrogateKeys, []string{URLSurrogateKey(urls[0]), URLSurrogateKey(urls[1])})
+
+	// Tags are purged as-is, skipping empty ones such as those left by a
+	// trailing newline in a tag file.
+	err = skp.PurgeTags([]string{"1f", "", "a0"})
+	test.AssertNotError(t, err, "purging tags")
+	test.AssertEquals(t, len(fc.requests), 2)
+	err = json.Unmarshal(fc.bodies[1], &body)
+	test.AssertNotError(t, err, "unmarshalling purge request")
+	test.AssertDeepEquals(t, body.SurrogateKeys, []string{"1f", "a0"})
+}
+
+func TestSurrogateKeyPurgerFailures(t *testing.T) {
+	fc := &fakeCache{codes: []int{http.StatusInternalServerError, http.StatusOK}}
+	srv := httptest.NewServer(fc)
+	defer srv.Close()
+
+	skp, err := NewSurrogateKeyPurger(srv.URL, "Authorization", "Bearer sekrit", 1, time.Millisecond, blog.NewMock(), metrics.NoopRegisterer)
+	test.AssertNotError(t, err, "creating purger")
+	skp.clk = clock.NewFake()
+
+	err = skp.Purge([]string{"http://c.lencr.org/1.crl"})
+	test.AssertNotError(t, err, "purging URL after one retryable failure")
+	test.AssertEquals(t, len(fc.requests), 2)
+
+	fc.codes = []int{http.StatusServiceUnavailable}
+	err = skp.Purge([]string{"http://c.lencr.org/1.crl"})
+	test.AssertErrorIs(t, err, ErrAllRetriesFailed)
+	test.AssertEquals(t, len(fc.requests), 4)
+
+	// Authorization failures are fatal and not retried.
+	fc.codes = []int{http.StatusForbidden}
+	err = skp.Purge([]string{"http://c.lencr.org/1.crl"})
+	test.AssertErrorIs(t, err, ErrFatal)
+	test.AssertEquals(t, len(fc.requests), 5)
+}
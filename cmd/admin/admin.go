@@ -21,6 +21,7 @@ import (
 // actions on a boulder deployment.
 type admin struct {
 	rac   rapb.RegistrationAuthorityClient
+	rlc   rapb.RateLimitsClient
 	sac   sapb.StorageAuthorityClient
 	saroc sapb.StorageAuthorityReadOnlyClient
 	// TODO: Remove this and only use sac and saroc to interact with the db.
@@ -60,15 +61,22 @@ func newAdmin(configFile string, dryRun bool) (*admin, error) {
 		return nil, fmt.Errorf("loading TLS config: %w", err)
 	}
 
+	raConn, err := bgrpc.ClientSetup(c.Admin.RAService, tlsConfig, scope, clk)
+	if err != nil {
+		return nil, fmt.Errorf("creating RA gRPC client: %w", err)
+	}
+
 	var rac rapb.RegistrationAuthorityClient = dryRunRAC{log: logger}
 	if !dryRun {
-		raConn, err := bgrpc.ClientSetup(c.Admin.RAService, tlsConfig, scope, clk)
-		if err != nil {
-			return nil, fmt.Errorf("creating RA gRPC client: %w", err)
-		}
 		rac = rapb.NewRegistrationAuthorityClient(raConn)
 	}
 
+	// Inspecting rate limits is read-only, so it is permitted in dry-run mode.
+	var rlc rapb.RateLimitsClient = dryRunRLC{RateLimitsClient: rapb.NewRateLimitsClient(raConn), log: logger}
+	if !dryRun {
+		rlc = rapb.NewRateLimitsClient(raConn)
+	}
+
 	saConn, err := bgrpc.ClientSetup(c.Admin.SAService, tlsConfig, scope, clk)
 	if err != nil {
 		return nil, fmt.Errorf("creating SA gRPC client: %w", err)
@@ -87,6 +95,7 @@ func newAdmin(configFile string, dryRun bool) (*admin, error) {
 
 	return &admin{
 		rac:    rac,
+		rlc:    rlc,
 		sac:    sac,
 		saroc:  saroc,
 		dbMap:  dbMap,
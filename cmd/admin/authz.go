@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// subcommandAuthzPerspectives encapsulates the "admin authz-perspectives"
+// command.
+type subcommandAuthzPerspectives struct {
+	authzID int64
+}
+
+var _ subcommand = (*subcommandAuthzPerspectives)(nil)
+
+func (s *subcommandAuthzPerspectives) Desc() string {
+	return "Show the per-perspective DCV and CAA results recorded for an authorization"
+}
+
+func (s *subcommandAuthzPerspectives) Flags(flag *flag.FlagSet) {
+	flag.Int64Var(&s.authzID, "authz-id", 0, "The ID of the authorization")
+}
+
+func (s *subcommandAuthzPerspectives) Run(ctx context.Context, a *admin) error {
+	authz, err := a.getAuthzPerspectives(ctx, s.authzID)
+	if err != nil {
+		return err
+	}
+	fmt.Print(formatPerspectiveResults(authz))
+	return nil
+}
+
+// getAuthzPerspectives asks the SA for the authorization with the given ID,
+// including the per-perspective results recorded when it was finalized.
+func (a *admin) getAuthzPerspectives(ctx context.Context, authzID int64) (*corepb.Authorization, error) {
+	if authzID == 0 {
+		return nil, errors.New("the -authz-id flag is required")
+	}
+
+	authz, err := a.saroc.GetAuthorization2(ctx, &sapb.AuthorizationID2{Id: authzID, IncludePerspectiveResults: true})
+	if err != nil {
+		return nil, fmt.Errorf("getting authorization %d: %w", authzID, err)
+	}
+	return authz, nil
+}
+
+// formatPerspectiveResults returns a human-readable description of the
+// per-perspective results of the given authorization, suitable for printing to
+// the terminal.
+func formatPerspectiveResults(authz *corepb.Authorization) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Authorization: %s\n", authz.Id)
+	fmt.Fprintf(&b, "Identifier:    %s\n", authz.Identifier.GetValue())
+	fmt.Fprintf(&b, "Status:        %s\n", authz.Status)
+	if len(authz.PerspectiveResults) == 0 {
+		fmt.Fprintf(&b, "No perspective results recorded\n")
+		return b.String()
+	}
+	for _, pr := range authz.PerspectiveResults {
+		result := "passed"
+		if !pr.Passed {
+			result = "failed"
+		}
+		perspective := pr.Perspective
+		if pr.Rir != "" {
+			perspective = fmt.Sprintf("%s (%s)", pr.Perspective, pr.Rir)
+		}
+		fmt.Fprintf(&b, "\n%s %s: %s in %s\n", strings.ToUpper(pr.Operation), perspective, result, pr.Latency.AsDuration())
+		if len(pr.ResolverAddrs) > 0 {
+			fmt.Fprintf(&b, "  Resolvers: %s\n", strings.Join(pr.ResolverAddrs, ", "))
+		}
+		if pr.Problem != nil {
+			fmt.Fprintf(&b, "  Problem:   %s: %s\n", pr.Problem.ProblemType, pr.Problem.Detail)
+		}
+	}
+	return b.String()
+}
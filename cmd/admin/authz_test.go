@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSAWithAuthz is a mock which records the authorization IDs it is asked
+// for, and responds with a fixed authorization.
+type mockSAWithAuthz struct {
+	sapb.StorageAuthorityReadOnlyClient
+	requests []int64
+}
+
+func (m *mockSAWithAuthz) GetAuthorization2(_ context.Context, req *sapb.AuthorizationID2, _ ...grpc.CallOption) (*corepb.Authorization, error) {
+	if !req.IncludePerspectiveResults {
+		return nil, errors.New("perspective results not requested")
+	}
+	m.requests = append(m.requests, req.Id)
+	return &corepb.Authorization{Id: "1234"}, nil
+}
+
+func TestGetAuthzPerspectives(t *testing.T) {
+	msa := mockSAWithAuthz{}
+	a := admin{saroc: &msa}
+
+	_, err := a.getAuthzPerspectives(context.Background(), 0)
+	test.AssertError(t, err, "getting perspectives without an authz ID should fail")
+	test.AssertEquals(t, len(msa.requests), 0)
+
+	authz, err := a.getAuthzPerspectives(context.Background(), 1234)
+	test.AssertNotError(t, err, "getting perspectives")
+	test.AssertDeepEquals(t, msa.requests, []int64{1234})
+	test.AssertEquals(t, authz.Id, "1234")
+}
+
+func TestFormatPerspectiveResults(t *testing.T) {
+	t.Parallel()
+
+	authz := &corepb.Authorization{
+		Id:         "1234",
+		Identifier: &corepb.Identifier{Type: "dns", Value: "example.com"},
+		Status:     "invalid",
+	}
+	out := formatPerspectiveResults(authz)
+	test.AssertContains(t, out, "Identifier:    example.com\n")
+	test.AssertContains(t, out, "No perspective results recorded\n")
+
+	authz.PerspectiveResults = []*corepb.PerspectiveResult{
+		{
+			Operation:     "dcv",
+			Perspective:   "primary",
+			Passed:        true,
+			ResolverAddrs: []string{"A:127.0.0.1:53", "AAAA:[::1]:53"},
+			Latency:       durationpb.New(120 * time.Millisecond),
+		},
+		{
+			Operation:   "caa",
+			Perspective: "dadaist",
+			Rir:         "ARIN",
+			Latency:     durationpb.New(2 * time.Second),
+			Problem:     &corepb.ProblemDetails{ProblemType: "caa", Detail: "CAA record forbids issuance"},
+		},
+	}
+	out = formatPerspectiveResults(authz)
+	test.AssertNotContains(t, out, "No perspective results recorded")
+	test.AssertContains(t, out, "DCV primary: passed in 120ms\n")
+	test.AssertContains(t, out, "  Resolvers: A:127.0.0.1:53, AAAA:[::1]:53\n")
+	test.AssertContains(t, out, "CAA dadaist (ARIN): failed in 2s\n")
+	test.AssertContains(t, out, "  Problem:   caa: CAA record forbids issuance\n")
+}
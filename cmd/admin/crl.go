@@ -0,0 +1,282 @@
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/crl/checker"
+	"github.com/letsencrypt/boulder/issuance"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// subcommandAuditCRLs encapsulates the "admin audit-crls" command.
+type subcommandAuditCRLs struct {
+	issuerFile string
+	urlFile    string
+	dir        string
+}
+
+var _ subcommand = (*subcommandAuditCRLs)(nil)
+
+func (s *subcommandAuditCRLs) Desc() string {
+	return "Check that every revoked certificate known to the SA appears correctly in exactly one published CRL"
+}
+
+func (s *subcommandAuditCRLs) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.issuerFile, "issuer", "", "Path to the certificate of the issuer whose CRLs should be audited")
+	flag.StringVar(&s.urlFile, "crls", "", "Path to a file containing a JSON Array of the issuer's CRL URLs")
+	flag.StringVar(&s.dir, "dir", "", "Path to a directory containing the issuer's DER-encoded CRLs, as saved by crl-checker -save")
+}
+
+func (s *subcommandAuditCRLs) Run(ctx context.Context, a *admin) error {
+	if s.issuerFile == "" {
+		return errors.New("the -issuer flag is required")
+	}
+	issuer, err := issuance.LoadCertificate(s.issuerFile)
+	if err != nil {
+		return fmt.Errorf("loading issuer certificate: %w", err)
+	}
+
+	// This is a map of all input-selection flags to whether or not they were set
+	// to a non-default value. We use this to ensure that exactly one input
+	// selection flag was given on the command line.
+	setInputs := map[string]bool{
+		"-crls": s.urlFile != "",
+		"-dir":  s.dir != "",
+	}
+	activeFlag, err := findActiveInputMethodFlag(setInputs)
+	if err != nil {
+		return err
+	}
+
+	var crls []*x509.RevocationList
+	switch activeFlag {
+	case "-crls":
+		crls, err = crlsFromURLFile(s.urlFile)
+	case "-dir":
+		crls, err = crlsFromDir(s.dir)
+	default:
+		return errors.New("no recognized input method flag set (this shouldn't happen)")
+	}
+	if err != nil {
+		return fmt.Errorf("collecting CRLs to audit: %w", err)
+	}
+
+	res, err := a.auditCRLs(ctx, issuer, crls)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatAuditResult(res))
+	if !res.OK() {
+		return errors.New("CRLs are inconsistent with the SA's revoked certificates")
+	}
+	return nil
+}
+
+// auditCRLs checks the given CRLs, all of which must have been signed by the
+// given issuer, against the revoked certificates held by the SA. It only
+// expects the CRLs to contain certificates which were revoked before the
+// oldest CRL's thisUpdate and which were unexpired at the newest CRL's
+// thisUpdate, since every shard is required to contain those.
+func (a *admin) auditCRLs(ctx context.Context, issuer *issuance.Certificate, crls []*x509.RevocationList) (*checker.AuditResult, error) {
+	if len(crls) == 0 {
+		return nil, errors.New("no CRLs to audit")
+	}
+
+	var oldest, newest time.Time
+	for _, crl := range crls {
+		err := crl.CheckSignatureFrom(issuer.Certificate)
+		if err != nil {
+			return nil, fmt.Errorf("checking signature of CRL number %d: %w", crl.Number, err)
+		}
+		if oldest.IsZero() || crl.ThisUpdate.Before(oldest) {
+			oldest = crl.ThisUpdate
+		}
+		if newest.IsZero() || crl.ThisUpdate.After(newest) {
+			newest = crl.ThisUpdate
+		}
+	}
+
+	maxExpiry, err := a.saroc.GetMaxExpiration(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("getting max expiration: %w", err)
+	}
+
+	stream, err := a.saroc.GetRevokedCerts(ctx, &sapb.GetRevokedCertsRequest{
+		IssuerNameID: int64(issuer.NameID()),
+		ExpiresAfter: timestamppb.New(newest),
+		// The end of the range is exclusive, so push it past the farthest-future
+		// expiration.
+		ExpiresBefore: timestamppb.New(maxExpiry.AsTime().Add(time.Second)),
+		RevokedBefore: timestamppb.New(oldest),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting revoked certs: %w", err)
+	}
+
+	var revoked []*corepb.CRLEntry
+	for {
+		entry, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("streaming revoked certs: %w", err)
+		}
+		revoked = append(revoked, entry)
+	}
+
+	a.log.Infof("Auditing %d CRLs against %d revoked certificates", len(crls), len(revoked))
+
+	return checker.Audit(revoked, crls)
+}
+
+// formatAuditResult returns a human-readable description of the given audit
+// result, suitable for printing to the terminal.
+func formatAuditResult(res *checker.AuditResult) string {
+	var b strings.Builder
+	for _, serial := range res.Missing {
+		fmt.Fprintf(&b, "missing: %s\n", serial)
+	}
+	for _, serial := range res.Duplicated {
+		fmt.Fprintf(&b, "duplicated: %s\n", serial)
+	}
+	for _, m := range res.WrongReason {
+		fmt.Fprintf(&b, "wrong reason: %s expected=%s actual=%s\n", m.Serial, m.Expected, m.Actual)
+	}
+	for _, m := range res.WrongDate {
+		fmt.Fprintf(&b, "wrong date: %s expected=%s actual=%s\n", m.Serial, m.Expected, m.Actual)
+	}
+	fmt.Fprintf(&b, "Found %d missing, %d duplicated, %d with wrong reason, %d with wrong date\n",
+		len(res.Missing), len(res.Duplicated), len(res.WrongReason), len(res.WrongDate))
+	return b.String()
+}
+
+// subcommandDiffCRLs encapsulates the "admin diff-crls" command.
+type subcommandDiffCRLs struct {
+	oldDir string
+	newDir string
+}
+
+var _ subcommand = (*subcommandDiffCRLs)(nil)
+
+func (s *subcommandDiffCRLs) Desc() string {
+	return "Show the revoked serials added and removed between two generations of an issuer's CRLs"
+}
+
+func (s *subcommandDiffCRLs) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.oldDir, "old-dir", "", "Path to a directory containing the older generation of DER-encoded CRLs")
+	flag.StringVar(&s.newDir, "new-dir", "", "Path to a directory containing the newer generation of DER-encoded CRLs")
+}
+
+func (s *subcommandDiffCRLs) Run(ctx context.Context, a *admin) error {
+	if s.oldDir == "" || s.newDir == "" {
+		return errors.New("both the -old-dir and -new-dir flags are required")
+	}
+
+	oldCRLs, err := crlsFromDir(s.oldDir)
+	if err != nil {
+		return fmt.Errorf("reading old CRLs: %w", err)
+	}
+	newCRLs, err := crlsFromDir(s.newDir)
+	if err != nil {
+		return fmt.Errorf("reading new CRLs: %w", err)
+	}
+
+	res, err := checker.DiffGenerations(oldCRLs, newCRLs)
+	if err != nil {
+		return fmt.Errorf("diffing CRLs: %w", err)
+	}
+
+	var b strings.Builder
+	for _, serial := range res.Added {
+		fmt.Fprintf(&b, "added: %s\n", core.SerialToString(serial))
+	}
+	for _, serial := range res.Removed {
+		fmt.Fprintf(&b, "removed: %s\n", core.SerialToString(serial))
+	}
+	fmt.Fprintf(&b, "Found %d added, %d removed\n", len(res.Added), len(res.Removed))
+	fmt.Print(b.String())
+	return nil
+}
+
+// crlsFromURLFile downloads and parses each CRL listed in the given file, which
+// must contain a JSON Array of CRL URLs, as published in CCADB.
+func crlsFromURLFile(filename string) ([]*x509.RevocationList, error) {
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading CRL URLs file: %w", err)
+	}
+
+	var urls []string
+	err = json.Unmarshal(contents, &urls)
+	if err != nil {
+		return nil, fmt.Errorf("parsing JSON Array of CRL URLs: %w", err)
+	}
+
+	crls := make([]*x509.RevocationList, 0, len(urls))
+	for _, url := range urls {
+		resp, err := http.Get(url)
+		if err != nil {
+			return nil, fmt.Errorf("downloading CRL %q: %w", url, err)
+		}
+		der, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading CRL %q: %w", url, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("downloading CRL %q: http status %d", url, resp.StatusCode)
+		}
+
+		crl, err := x509.ParseRevocationList(der)
+		if err != nil {
+			return nil, fmt.Errorf("parsing CRL %q: %w", url, err)
+		}
+		crls = append(crls, crl)
+	}
+	return crls, nil
+}
+
+// crlsFromDir parses every file in the given directory as a DER-encoded CRL.
+func crlsFromDir(dir string) ([]*x509.RevocationList, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading CRL directory: %w", err)
+	}
+
+	var crls []*x509.RevocationList
+	for _, file := range files {
+		if !file.Type().IsRegular() {
+			continue
+		}
+
+		der, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading CRL %q: %w", file.Name(), err)
+		}
+
+		crl, err := x509.ParseRevocationList(der)
+		if err != nil {
+			return nil, fmt.Errorf("parsing CRL %q: %w", file.Name(), err)
+		}
+		crls = append(crls, crl)
+	}
+	return crls, nil
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"math/big"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/issuance"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/mocks"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSAWithRevokedCerts is a mock which only implements the GetRevokedCerts
+// and GetMaxExpiration gRPC methods. It records the last GetRevokedCerts
+// request it received.
+type mockSAWithRevokedCerts struct {
+	sapb.StorageAuthorityReadOnlyClient
+	revoked    []*corepb.CRLEntry
+	maxExpiry  time.Time
+	revokedReq *sapb.GetRevokedCertsRequest
+}
+
+func (msa *mockSAWithRevokedCerts) GetRevokedCerts(_ context.Context, req *sapb.GetRevokedCertsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[corepb.CRLEntry], error) {
+	msa.revokedReq = req
+	return &mocks.ServerStreamClient[corepb.CRLEntry]{Results: msa.revoked}, nil
+}
+
+func (msa *mockSAWithRevokedCerts) GetMaxExpiration(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*timestamppb.Timestamp, error) {
+	return timestamppb.New(msa.maxExpiry), nil
+}
+
+// issueTestCRL signs a CRL for the given shard containing the given serials,
+// each revoked for keyCompromise an hour before thisUpdate.
+func issueTestCRL(t *testing.T, issuer *issuance.Issuer, shard int64, thisUpdate time.Time, serials ...int64) *x509.RevocationList {
+	t.Helper()
+
+	profile, err := issuance.NewCRLProfile(issuance.CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+	})
+	test.AssertNotError(t, err, "creating test CRL profile")
+
+	var entries []x509.RevocationListEntry
+	for _, serial := range serials {
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   big.NewInt(serial),
+			RevocationTime: thisUpdate.Add(-time.Hour),
+			ReasonCode:     1,
+		})
+	}
+	der, err := issuer.IssueCRL(profile, &issuance.CRLRequest{
+		Number:     big.NewInt(thisUpdate.UnixNano()),
+		Shard:      shard,
+		ThisUpdate: thisUpdate,
+		Entries:    entries,
+	})
+	test.AssertNotError(t, err, "issuing test CRL")
+	crl, err := x509.ParseRevocationList(der)
+	test.AssertNotError(t, err, "parsing test CRL")
+	return crl
+}
+
+func loadTestIssuer(t *testing.T, clk clock.Clock) *issuance.Issuer {
+	t.Helper()
+	issuer, err := issuance.LoadIssuer(
+		issuance.IssuerConfig{
+			Location: issuance.IssuerLoc{
+				File:     "../../test/hierarchy/int-e1.key.pem",
+				CertFile: "../../test/hierarchy/int-e1.cert.pem",
+			},
+			IssuerURL:  "http://not-example.com/issuer-url",
+			OCSPURL:    "http://not-example.com/ocsp",
+			CRLURLBase: "http://not-example.com/crl/",
+		}, clk)
+	test.AssertNotError(t, err, "loading test issuer")
+	return issuer
+}
+
+func TestAuditCRLs(t *testing.T) {
+	t.Parallel()
+
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	issuer := loadTestIssuer(t, clk)
+
+	older := clk.Now().Add(-10 * time.Minute)
+	newer := clk.Now()
+	crls := []*x509.RevocationList{
+		issueTestCRL(t, issuer, 1, older, 1, 2),
+		issueTestCRL(t, issuer, 2, newer, 2, 3),
+	}
+
+	revokedAt := timestamppb.New(older.Add(-time.Hour))
+	msa := &mockSAWithRevokedCerts{
+		revoked: []*corepb.CRLEntry{
+			{Serial: core.SerialToString(big.NewInt(1)), Reason: 1, RevokedAt: revokedAt},
+			{Serial: core.SerialToString(big.NewInt(3)), Reason: 4, RevokedAt: timestamppb.New(newer.Add(-time.Hour))},
+			{Serial: core.SerialToString(big.NewInt(4)), Reason: 1, RevokedAt: revokedAt},
+		},
+		maxExpiry: clk.Now().Add(90 * 24 * time.Hour),
+	}
+	a := admin{saroc: msa, log: blog.NewMock()}
+
+	res, err := a.auditCRLs(context.Background(), issuer.Cert, crls)
+	test.AssertNotError(t, err, "auditing CRLs")
+	test.AssertDeepEquals(t, res.Missing, []string{core.SerialToString(big.NewInt(4))})
+	test.AssertDeepEquals(t, res.Duplicated, []string{core.SerialToString(big.NewInt(2))})
+	test.AssertEquals(t, len(res.WrongReason), 1)
+	test.AssertEquals(t, res.WrongReason[0].Serial, core.SerialToString(big.NewInt(3)))
+	test.AssertEquals(t, len(res.WrongDate), 0)
+
+	// Only certs which every shard must contain should be requested from the SA.
+	test.AssertEquals(t, msa.revokedReq.IssuerNameID, int64(issuer.NameID()))
+	test.Assert(t, msa.revokedReq.RevokedBefore.AsTime().Equal(older.Truncate(time.Second)), "RevokedBefore should be the oldest thisUpdate")
+	test.Assert(t, msa.revokedReq.ExpiresAfter.AsTime().Equal(newer.Truncate(time.Second)), "ExpiresAfter should be the newest thisUpdate")
+	test.Assert(t, msa.revokedReq.ExpiresBefore.AsTime().After(msa.maxExpiry), "ExpiresBefore should be after max expiry")
+
+	// CRLs from a different issuer must be rejected.
+	other, err := issuance.LoadCertificate("../../test/hierarchy/int-r3.cert.pem")
+	test.AssertNotError(t, err, "loading other issuer")
+	_, err = a.auditCRLs(context.Background(), other, crls)
+	test.AssertError(t, err, "auditing CRLs from the wrong issuer")
+	test.AssertContains(t, err.Error(), "checking signature")
+
+	_, err = a.auditCRLs(context.Background(), issuer.Cert, nil)
+	test.AssertError(t, err, "auditing no CRLs")
+}
+
+func TestDiffCRLs(t *testing.T) {
+	t.Parallel()
+
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	issuer := loadTestIssuer(t, clk)
+
+	writeGeneration := func(crls ...*x509.RevocationList) string {
+		dir := t.TempDir()
+		for i, crl := range crls {
+			err := os.WriteFile(path.Join(dir, big.NewInt(int64(i)).String()+".crl"), crl.Raw, 0600)
+			test.AssertNotError(t, err, "writing test CRL")
+		}
+		return dir
+	}
+
+	oldTime := clk.Now().Add(-time.Hour)
+	newTime := clk.Now()
+	oldDir := writeGeneration(issueTestCRL(t, issuer, 1, oldTime, 1, 2), issueTestCRL(t, issuer, 2, oldTime, 3))
+	newDir := writeGeneration(issueTestCRL(t, issuer, 1, newTime, 1), issueTestCRL(t, issuer, 2, newTime, 3, 4))
+
+	crls, err := crlsFromDir(oldDir)
+	test.AssertNotError(t, err, "reading CRLs from directory")
+	test.AssertEquals(t, len(crls), 2)
+
+	a := admin{log: blog.NewMock()}
+	err = (&subcommandDiffCRLs{oldDir: oldDir, newDir: newDir}).Run(context.Background(), &a)
+	test.AssertNotError(t, err, "diffing CRLs")
+
+	err = (&subcommandDiffCRLs{oldDir: newDir, newDir: oldDir}).Run(context.Background(), &a)
+	test.AssertError(t, err, "diffing CRLs in the wrong order")
+
+	err = (&subcommandDiffCRLs{oldDir: oldDir}).Run(context.Background(), &a)
+	test.AssertError(t, err, "diffing CRLs without -new-dir")
+
+	err = os.WriteFile(path.Join(oldDir, "garbage"), []byte("not a crl"), 0600)
+	test.AssertNotError(t, err, "writing garbage file")
+	_, err = crlsFromDir(oldDir)
+	test.AssertError(t, err, "reading directory containing a non-CRL")
+}
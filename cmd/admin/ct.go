@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+)
+
+// subcommandResubmitToCT encapsulates the "admin resubmit-to-ct" command.
+type subcommandResubmitToCT struct {
+	serial string
+}
+
+var _ subcommand = (*subcommandResubmitToCT)(nil)
+
+func (s *subcommandResubmitToCT) Desc() string {
+	return "Submit a final certificate to the configured final CT logs again"
+}
+
+func (s *subcommandResubmitToCT) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.serial, "serial", "", "The serial of the certificate to resubmit")
+}
+
+func (s *subcommandResubmitToCT) Run(ctx context.Context, a *admin) error {
+	return a.resubmitToCT(ctx, s.serial)
+}
+
+// resubmitToCT asks the RA to submit the final certificate with the given
+// serial to its final CT logs again.
+func (a *admin) resubmitToCT(ctx context.Context, serial string) error {
+	if serial == "" {
+		return errors.New("the -serial flag is required")
+	}
+	cleanedSerial, err := cleanSerial(serial)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.rac.ResubmitToCT(ctx, &rapb.ResubmitToCTRequest{Serial: cleanedSerial})
+	if err != nil {
+		return fmt.Errorf("resubmitting %s to CT: %w", cleanedSerial, err)
+	}
+	a.log.Infof("Resubmitted %s to CT", cleanedSerial)
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	blog "github.com/letsencrypt/boulder/log"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockRACResubmitToCT records the serials of ResubmitToCT requests.
+type mockRACResubmitToCT struct {
+	rapb.RegistrationAuthorityClient
+	serials []string
+}
+
+func (ra *mockRACResubmitToCT) ResubmitToCT(_ context.Context, req *rapb.ResubmitToCTRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	ra.serials = append(ra.serials, req.Serial)
+	return &emptypb.Empty{}, nil
+}
+
+func TestResubmitToCT(t *testing.T) {
+	t.Parallel()
+
+	ra := &mockRACResubmitToCT{}
+	a := admin{rac: ra, log: blog.NewMock()}
+
+	err := a.resubmitToCT(context.Background(), "")
+	test.AssertError(t, err, "missing serial should fail")
+
+	err = a.resubmitToCT(context.Background(), "not a serial")
+	test.AssertError(t, err, "invalid serial should fail")
+
+	err = a.resubmitToCT(context.Background(), "03:7d:10:a3:d5:2c:8e:e1:e9:3a:8c:d5:6a:c2:a7:4e:cb:d9")
+	test.AssertNotError(t, err, "resubmitting serial")
+	test.AssertDeepEquals(t, ra.serials, []string{"037d10a3d52c8ee1e93a8cd56ac2a74ecbd9"})
+}
@@ -26,6 +26,31 @@ func (d dryRunRAC) AdministrativelyRevokeCertificate(_ context.Context, req *rap
 	return &emptypb.Empty{}, nil
 }
 
+func (d dryRunRAC) ResubmitToCT(_ context.Context, req *rapb.ResubmitToCTRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	b, err := prototext.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	d.log.Infof("dry-run: %#v", string(b))
+	return &emptypb.Empty{}, nil
+}
+
+// dryRunRLC passes InspectRateLimit requests through to the RA, because they
+// do not modify any state, and logs ResetRateLimit requests instead.
+type dryRunRLC struct {
+	rapb.RateLimitsClient
+	log blog.Logger
+}
+
+func (d dryRunRLC) ResetRateLimit(_ context.Context, req *rapb.RateLimitBucketRequest, _ ...grpc.CallOption) (*rapb.RateLimitBucketState, error) {
+	b, err := prototext.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	d.log.Infof("dry-run: %#v", string(b))
+	return &rapb.RateLimitBucketState{Name: req.Name}, nil
+}
+
 type dryRunSAC struct {
 	sapb.StorageAuthorityClient
 	log blog.Logger
@@ -39,3 +64,37 @@ func (d dryRunSAC) AddBlockedKey(_ context.Context, req *sapb.AddBlockedKeyReque
 	d.log.Infof("dry-run: %#v", string(b))
 	return &emptypb.Empty{}, nil
 }
+
+// AddExternalAccountKey deliberately logs only the key ID, and not the whole
+// request, so that the MAC key doesn't end up in the system logs.
+func (d dryRunSAC) AddExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKey, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	d.log.Infof("dry-run: add external account key %q", req.KeyID)
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) RevokeExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKeyID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	b, err := prototext.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	d.log.Infof("dry-run: %#v", string(b))
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) AddRateLimitOverride(_ context.Context, req *sapb.RateLimitOverride, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	b, err := prototext.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	d.log.Infof("dry-run: %#v", string(b))
+	return &emptypb.Empty{}, nil
+}
+
+func (d dryRunSAC) RemoveRateLimitOverride(_ context.Context, req *sapb.RateLimitOverrideID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	b, err := prototext.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	d.log.Infof("dry-run: %#v", string(b))
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// eabHMACKeyLen is the length, in bytes, of newly generated External Account
+// Binding MAC keys. This is long enough to be used with any of HS256, HS384,
+// or HS512.
+const eabHMACKeyLen = 64
+
+// subcommandCreateEABKey encapsulates the "admin create-eab-key" command.
+type subcommandCreateEABKey struct {
+	keyID string
+}
+
+var _ subcommand = (*subcommandCreateEABKey)(nil)
+
+func (s *subcommandCreateEABKey) Desc() string {
+	return "Create a new External Account Binding MAC key for use when creating accounts"
+}
+
+func (s *subcommandCreateEABKey) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.keyID, "key-id", "", "The key ID (for instance, a customer identifier) to associate with the new MAC key")
+}
+
+func (s *subcommandCreateEABKey) Run(ctx context.Context, a *admin) error {
+	hmacKey, err := a.createExternalAccountKey(ctx, s.keyID)
+	if err != nil {
+		return err
+	}
+
+	// The MAC key is printed rather than logged, so that it doesn't end up in
+	// the system logs. It must be handed to the customer out-of-band.
+	fmt.Printf("Key ID: %s\nHMAC key: %s\n", s.keyID, base64.RawURLEncoding.EncodeToString(hmacKey))
+	return nil
+}
+
+// createExternalAccountKey generates a new random External Account Binding
+// MAC key, stores it under the given key ID, and returns it.
+func (a *admin) createExternalAccountKey(ctx context.Context, keyID string) ([]byte, error) {
+	if keyID == "" {
+		return nil, errors.New("the -key-id flag is required")
+	}
+
+	hmacKey := make([]byte, eabHMACKeyLen)
+	_, err := rand.Read(hmacKey)
+	if err != nil {
+		return nil, fmt.Errorf("generating MAC key: %w", err)
+	}
+
+	_, err = a.sac.AddExternalAccountKey(ctx, &sapb.ExternalAccountKey{KeyID: keyID, HmacKey: hmacKey})
+	if err != nil {
+		return nil, fmt.Errorf("storing external account key %q: %w", keyID, err)
+	}
+	a.log.Infof("Created external account key %q", keyID)
+
+	return hmacKey, nil
+}
+
+// subcommandRevokeEABKey encapsulates the "admin revoke-eab-key" command.
+type subcommandRevokeEABKey struct {
+	keyID string
+}
+
+var _ subcommand = (*subcommandRevokeEABKey)(nil)
+
+func (s *subcommandRevokeEABKey) Desc() string {
+	return "Revoke an External Account Binding MAC key so that it can no longer be used to create accounts"
+}
+
+func (s *subcommandRevokeEABKey) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.keyID, "key-id", "", "The key ID of the MAC key to revoke")
+}
+
+func (s *subcommandRevokeEABKey) Run(ctx context.Context, a *admin) error {
+	return a.revokeExternalAccountKey(ctx, s.keyID)
+}
+
+// revokeExternalAccountKey revokes the External Account Binding MAC key with
+// the given key ID. Accounts which were already bound to the key are not
+// affected.
+func (a *admin) revokeExternalAccountKey(ctx context.Context, keyID string) error {
+	if keyID == "" {
+		return errors.New("the -key-id flag is required")
+	}
+
+	_, err := a.sac.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: keyID})
+	if err != nil {
+		return fmt.Errorf("revoking external account key %q: %w", keyID, err)
+	}
+	a.log.Infof("Revoked external account key %q", keyID)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	blog "github.com/letsencrypt/boulder/log"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSARecordingEABKeys is a mock which records the external account key
+// requests it receives.
+type mockSARecordingEABKeys struct {
+	sapb.StorageAuthorityClient
+	addRequests    []*sapb.ExternalAccountKey
+	revokeRequests []*sapb.ExternalAccountKeyID
+}
+
+func (msa *mockSARecordingEABKeys) AddExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKey, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msa.addRequests = append(msa.addRequests, req)
+	return &emptypb.Empty{}, nil
+}
+
+func (msa *mockSARecordingEABKeys) RevokeExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKeyID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msa.revokeRequests = append(msa.revokeRequests, req)
+	return &emptypb.Empty{}, nil
+}
+
+func TestCreateExternalAccountKey(t *testing.T) {
+	log := blog.NewMock()
+	msa := mockSARecordingEABKeys{}
+	a := admin{sac: &msa, log: log}
+
+	_, err := a.createExternalAccountKey(context.Background(), "")
+	test.AssertError(t, err, "creating a key with no key ID should fail")
+	test.AssertEquals(t, len(msa.addRequests), 0)
+
+	hmacKey, err := a.createExternalAccountKey(context.Background(), "customer-1")
+	test.AssertNotError(t, err, "creating external account key")
+	test.AssertEquals(t, len(hmacKey), eabHMACKeyLen)
+	test.AssertEquals(t, len(msa.addRequests), 1)
+	test.AssertEquals(t, msa.addRequests[0].KeyID, "customer-1")
+	test.AssertByteEquals(t, msa.addRequests[0].HmacKey, hmacKey)
+
+	// A dry-run should not log the MAC key.
+	log.Clear()
+	a.sac = dryRunSAC{log: log}
+	_, err = a.createExternalAccountKey(context.Background(), "customer-2")
+	test.AssertNotError(t, err, "creating external account key in dry-run mode")
+	test.AssertEquals(t, len(log.GetAllMatching(`dry-run: add external account key "customer-2"`)), 1)
+	test.AssertEquals(t, len(log.GetAllMatching("hmacKey")), 0)
+}
+
+func TestRevokeExternalAccountKey(t *testing.T) {
+	log := blog.NewMock()
+	msa := mockSARecordingEABKeys{}
+	a := admin{sac: &msa, log: log}
+
+	err := a.revokeExternalAccountKey(context.Background(), "")
+	test.AssertError(t, err, "revoking a key with no key ID should fail")
+	test.AssertEquals(t, len(msa.revokeRequests), 0)
+
+	err = a.revokeExternalAccountKey(context.Background(), "customer-1")
+	test.AssertNotError(t, err, "revoking external account key")
+	test.AssertEquals(t, len(msa.revokeRequests), 1)
+	test.AssertEquals(t, msa.revokeRequests[0].KeyID, "customer-1")
+}
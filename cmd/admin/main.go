@@ -71,11 +71,21 @@ func main() {
 
 	// This is the registry of all subcommands that the admin tool can run.
 	subcommands := map[string]subcommand{
-		"revoke-cert":      &subcommandRevokeCert{},
-		"block-key":        &subcommandBlockKey{},
-		"update-email":     &subcommandUpdateEmail{},
-		"pause-identifier": &subcommandPauseIdentifier{},
-		"unpause-account":  &subcommandUnpauseAccount{},
+		"revoke-cert":               &subcommandRevokeCert{},
+		"block-key":                 &subcommandBlockKey{},
+		"update-email":              &subcommandUpdateEmail{},
+		"pause-identifier":          &subcommandPauseIdentifier{},
+		"unpause-account":           &subcommandUnpauseAccount{},
+		"create-eab-key":            &subcommandCreateEABKey{},
+		"revoke-eab-key":            &subcommandRevokeEABKey{},
+		"ratelimit-inspect":         &subcommandRateLimitInspect{},
+		"ratelimit-reset":           &subcommandRateLimitReset{},
+		"ratelimit-override-add":    &subcommandAddRateLimitOverride{},
+		"ratelimit-override-remove": &subcommandRemoveRateLimitOverride{},
+		"resubmit-to-ct":            &subcommandResubmitToCT{},
+		"authz-perspectives":        &subcommandAuthzPerspectives{},
+		"audit-crls":                &subcommandAuditCRLs{},
+		"diff-crls":                 &subcommandDiffCRLs{},
 	}
 
 	defaultUsage := flag.Usage
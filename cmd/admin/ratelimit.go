@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+)
+
+// subcommandRateLimitInspect encapsulates the "admin ratelimit-inspect"
+// command.
+type subcommandRateLimitInspect struct {
+	name string
+	id   string
+}
+
+var _ subcommand = (*subcommandRateLimitInspect)(nil)
+
+func (s *subcommandRateLimitInspect) Desc() string {
+	return "Show the limit which applies to a rate limit bucket, and the bucket's current state"
+}
+
+func (s *subcommandRateLimitInspect) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.name, "limit", "", "The name of the rate limit, e.g. NewOrdersPerAccount")
+	flag.StringVar(&s.id, "id", "", "The bucket id, formatted as in the overrides file, e.g. a registration ID, an IP address, or regId:domain")
+}
+
+func (s *subcommandRateLimitInspect) Run(ctx context.Context, a *admin) error {
+	state, err := a.inspectRateLimit(ctx, s.name, s.id)
+	if err != nil {
+		return err
+	}
+	fmt.Print(formatBucketState(state))
+	return nil
+}
+
+// subcommandRateLimitReset encapsulates the "admin ratelimit-reset" command.
+type subcommandRateLimitReset struct {
+	name string
+	id   string
+}
+
+var _ subcommand = (*subcommandRateLimitReset)(nil)
+
+func (s *subcommandRateLimitReset) Desc() string {
+	return "Reset a rate limit bucket to its maximum capacity"
+}
+
+func (s *subcommandRateLimitReset) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.name, "limit", "", "The name of the rate limit, e.g. NewOrdersPerAccount")
+	flag.StringVar(&s.id, "id", "", "The bucket id, formatted as in the overrides file, e.g. a registration ID, an IP address, or regId:domain")
+}
+
+func (s *subcommandRateLimitReset) Run(ctx context.Context, a *admin) error {
+	state, err := a.resetRateLimit(ctx, s.name, s.id)
+	if err != nil {
+		return err
+	}
+	if a.dryRun {
+		return nil
+	}
+	fmt.Print(formatBucketState(state))
+	return nil
+}
+
+func validateRateLimitFlags(name, id string) error {
+	if name == "" {
+		return errors.New("the -limit flag is required")
+	}
+	if id == "" {
+		return errors.New("the -id flag is required")
+	}
+	return nil
+}
+
+// inspectRateLimit asks the RA for the limit which applies to, and the current
+// state of, the bucket with the given limit name and id.
+func (a *admin) inspectRateLimit(ctx context.Context, name, id string) (*rapb.RateLimitBucketState, error) {
+	err := validateRateLimitFlags(name, id)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := a.rlc.InspectRateLimit(ctx, &rapb.RateLimitBucketRequest{Name: name, Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("inspecting %s bucket %q: %w", name, id, err)
+	}
+	return state, nil
+}
+
+// resetRateLimit asks the RA to reset the bucket with the given limit name and
+// id to its maximum capacity, and returns the bucket's new state.
+func (a *admin) resetRateLimit(ctx context.Context, name, id string) (*rapb.RateLimitBucketState, error) {
+	err := validateRateLimitFlags(name, id)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := a.rlc.ResetRateLimit(ctx, &rapb.RateLimitBucketRequest{Name: name, Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("resetting %s bucket %q: %w", name, id, err)
+	}
+	a.log.Infof("Reset %s bucket %q", name, id)
+	return state, nil
+}
+
+// formatBucketState returns a human-readable description of the given bucket
+// state, suitable for printing to the terminal.
+func formatBucketState(state *rapb.RateLimitBucketState) string {
+	var b strings.Builder
+	source := "default"
+	if state.Override {
+		source = "override"
+	}
+	fmt.Fprintf(&b, "Limit:      %s (%s)\n", state.Name, source)
+	fmt.Fprintf(&b, "Bucket key: %s\n", state.BucketKey)
+	fmt.Fprintf(&b, "Burst:      %d\n", state.Burst)
+	fmt.Fprintf(&b, "Count:      %d per %s\n", state.Count, state.Period.AsDuration())
+	fmt.Fprintf(&b, "Remaining:  %d\n", state.Remaining)
+	if state.Tat == nil {
+		fmt.Fprintf(&b, "TAT:        none (bucket is full)\n")
+	} else {
+		fmt.Fprintf(&b, "TAT:        %s\n", state.Tat.AsTime().Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, "Reset at:   %s\n", state.ResetAt.AsTime().Format(time.RFC3339))
+	return b.String()
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/ratelimits"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// subcommandAddRateLimitOverride encapsulates the "admin
+// ratelimit-override-add" command.
+type subcommandAddRateLimitOverride struct {
+	name    string
+	id      string
+	burst   int64
+	count   int64
+	period  time.Duration
+	expires string
+	comment string
+}
+
+var _ subcommand = (*subcommandAddRateLimitOverride)(nil)
+
+func (s *subcommandAddRateLimitOverride) Desc() string {
+	return "Add or replace a rate limit override, which the WFE and RA load without a restart"
+}
+
+func (s *subcommandAddRateLimitOverride) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.name, "limit", "", "The name of the rate limit, e.g. NewOrdersPerAccount")
+	flag.StringVar(&s.id, "id", "", "The id to override, formatted as in the overrides file, e.g. a registration ID or an IP address")
+	flag.Int64Var(&s.burst, "burst", 0, "The maximum number of requests allowed at once")
+	flag.Int64Var(&s.count, "count", 0, "The number of requests allowed per period")
+	flag.DurationVar(&s.period, "period", 0, "The period over which count requests are allowed, e.g. 3h")
+	flag.StringVar(&s.expires, "expires", "", "When the override expires, as a date (2006-01-02) or an RFC 3339 timestamp")
+	flag.StringVar(&s.comment, "comment", "", "A justification for the override, e.g. a ticket reference")
+}
+
+func (s *subcommandAddRateLimitOverride) Run(ctx context.Context, a *admin) error {
+	expires, err := parseOverrideExpiry(s.expires)
+	if err != nil {
+		return err
+	}
+	return a.addRateLimitOverride(ctx, s.name, s.id, s.burst, s.count, s.period, expires, s.comment)
+}
+
+// subcommandRemoveRateLimitOverride encapsulates the "admin
+// ratelimit-override-remove" command.
+type subcommandRemoveRateLimitOverride struct {
+	name string
+	id   string
+}
+
+var _ subcommand = (*subcommandRemoveRateLimitOverride)(nil)
+
+func (s *subcommandRemoveRateLimitOverride) Desc() string {
+	return "Remove a rate limit override previously added with ratelimit-override-add"
+}
+
+func (s *subcommandRemoveRateLimitOverride) Flags(flag *flag.FlagSet) {
+	flag.StringVar(&s.name, "limit", "", "The name of the rate limit, e.g. NewOrdersPerAccount")
+	flag.StringVar(&s.id, "id", "", "The overridden id, exactly as it was given to ratelimit-override-add")
+}
+
+func (s *subcommandRemoveRateLimitOverride) Run(ctx context.Context, a *admin) error {
+	return a.removeRateLimitOverride(ctx, s.name, s.id)
+}
+
+// parseOverrideExpiry parses the value of the -expires flag, which may be
+// either a date, taken to mean midnight UTC, or an RFC 3339 timestamp.
+func parseOverrideExpiry(expires string) (time.Time, error) {
+	if expires == "" {
+		return time.Time{}, errors.New("the -expires flag is required")
+	}
+	t, err := time.Parse(time.DateOnly, expires)
+	if err == nil {
+		return t, nil
+	}
+	t, err = time.Parse(time.RFC3339, expires)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing -expires %q: must be a date (2006-01-02) or an RFC 3339 timestamp", expires)
+	}
+	return t, nil
+}
+
+// addRateLimitOverride validates the given override and asks the SA to store
+// it, replacing any existing override for the same limit and id.
+func (a *admin) addRateLimitOverride(ctx context.Context, name, id string, burst, count int64, period time.Duration, expires time.Time, comment string) error {
+	err := validateRateLimitFlags(name, id)
+	if err != nil {
+		return err
+	}
+	if comment == "" {
+		return errors.New("the -comment flag is required")
+	}
+	if !expires.After(a.clk.Now()) {
+		return fmt.Errorf("expiry %s is not in the future", expires.Format(time.RFC3339))
+	}
+
+	limit, err := ratelimits.NameFromString(name)
+	if err != nil {
+		return err
+	}
+	err = ratelimits.Override{Name: limit, Id: id, Burst: burst, Count: count, Period: period}.Validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = a.sac.AddRateLimitOverride(ctx, &sapb.RateLimitOverride{
+		LimitEnum: int64(limit),
+		BucketId:  id,
+		Burst:     burst,
+		Count:     count,
+		Period:    durationpb.New(period),
+		Comment:   comment,
+		ExpiresAt: timestamppb.New(expires),
+	})
+	if err != nil {
+		return fmt.Errorf("adding override for %s %q: %w", name, id, err)
+	}
+	a.log.Infof("Added %s override for %q (burst %d, count %d per %s) until %s: %s",
+		name, id, burst, count, period, expires.Format(time.RFC3339), comment)
+	return nil
+}
+
+// removeRateLimitOverride asks the SA to delete the override for the given
+// limit and id.
+func (a *admin) removeRateLimitOverride(ctx context.Context, name, id string) error {
+	err := validateRateLimitFlags(name, id)
+	if err != nil {
+		return err
+	}
+	limit, err := ratelimits.NameFromString(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.sac.RemoveRateLimitOverride(ctx, &sapb.RateLimitOverrideID{
+		LimitEnum: int64(limit),
+		BucketId:  id,
+	})
+	if err != nil {
+		return fmt.Errorf("removing override for %s %q: %w", name, id, err)
+	}
+	a.log.Infof("Removed %s override for %q", name, id)
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/ratelimits"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockSARecordingOverrides is a mock which records the rate limit override
+// requests it receives.
+type mockSARecordingOverrides struct {
+	sapb.StorageAuthorityClient
+	addRequests    []*sapb.RateLimitOverride
+	removeRequests []*sapb.RateLimitOverrideID
+}
+
+func (msa *mockSARecordingOverrides) AddRateLimitOverride(_ context.Context, req *sapb.RateLimitOverride, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msa.addRequests = append(msa.addRequests, req)
+	return &emptypb.Empty{}, nil
+}
+
+func (msa *mockSARecordingOverrides) RemoveRateLimitOverride(_ context.Context, req *sapb.RateLimitOverrideID, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msa.removeRequests = append(msa.removeRequests, req)
+	return &emptypb.Empty{}, nil
+}
+
+func TestParseOverrideExpiry(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseOverrideExpiry("")
+	test.AssertError(t, err, "empty expiry should fail")
+	_, err = parseOverrideExpiry("next tuesday")
+	test.AssertError(t, err, "malformed expiry should fail")
+
+	got, err := parseOverrideExpiry("2025-02-01")
+	test.AssertNotError(t, err, "parsing date")
+	test.AssertEquals(t, got, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	got, err = parseOverrideExpiry("2025-02-01T12:30:00Z")
+	test.AssertNotError(t, err, "parsing timestamp")
+	test.AssertEquals(t, got, time.Date(2025, 2, 1, 12, 30, 0, 0, time.UTC))
+}
+
+func TestAddRateLimitOverride(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	log := blog.NewMock()
+	msa := mockSARecordingOverrides{}
+	a := admin{sac: &msa, clk: fc, log: log}
+
+	expires := fc.Now().Add(30 * 24 * time.Hour)
+	testCases := []struct {
+		name    string
+		limit   string
+		id      string
+		expires time.Time
+		comment string
+	}{
+		{name: "no limit", id: "1234", expires: expires, comment: "ticket 42"},
+		{name: "unknown limit", limit: "NoSuchLimit", id: "1234", expires: expires, comment: "ticket 42"},
+		{name: "invalid id", limit: "NewOrdersPerAccount", id: "not-a-regid", expires: expires, comment: "ticket 42"},
+		{name: "no comment", limit: "NewOrdersPerAccount", id: "1234", expires: expires},
+		{name: "already expired", limit: "NewOrdersPerAccount", id: "1234", expires: fc.Now(), comment: "ticket 42"},
+	}
+	for _, tc := range testCases {
+		err := a.addRateLimitOverride(context.Background(), tc.limit, tc.id, 100, 100, time.Hour, tc.expires, tc.comment)
+		test.AssertError(t, err, tc.name)
+	}
+	err := a.addRateLimitOverride(context.Background(), "NewOrdersPerAccount", "1234", 0, 100, time.Hour, expires, "ticket 42")
+	test.AssertError(t, err, "zero burst should fail")
+	test.AssertEquals(t, len(msa.addRequests), 0)
+
+	err = a.addRateLimitOverride(context.Background(), "NewOrdersPerAccount", "1234", 100, 50, time.Hour, expires, "ticket 42")
+	test.AssertNotError(t, err, "adding override")
+	test.AssertEquals(t, len(msa.addRequests), 1)
+	req := msa.addRequests[0]
+	test.AssertEquals(t, req.LimitEnum, int64(ratelimits.NewOrdersPerAccount))
+	test.AssertEquals(t, req.BucketId, "1234")
+	test.AssertEquals(t, req.Burst, int64(100))
+	test.AssertEquals(t, req.Count, int64(50))
+	test.AssertEquals(t, req.Period.AsDuration(), time.Hour)
+	test.AssertEquals(t, req.Comment, "ticket 42")
+	test.AssertEquals(t, req.ExpiresAt.AsTime(), expires)
+
+	// A dry-run should log the override instead of sending it to the SA.
+	log.Clear()
+	a.sac = dryRunSAC{log: log}
+	err = a.addRateLimitOverride(context.Background(), "NewOrdersPerAccount", "5678", 100, 50, time.Hour, expires, "ticket 43")
+	test.AssertNotError(t, err, "adding override in dry-run mode")
+	test.AssertEquals(t, len(msa.addRequests), 1)
+	test.AssertEquals(t, len(log.GetAllMatching(`dry-run: .*5678.*ticket 43`)), 1)
+}
+
+func TestRemoveRateLimitOverride(t *testing.T) {
+	msa := mockSARecordingOverrides{}
+	a := admin{sac: &msa, log: blog.NewMock()}
+
+	err := a.removeRateLimitOverride(context.Background(), "NewOrdersPerAccount", "")
+	test.AssertError(t, err, "removing an override with no id should fail")
+	err = a.removeRateLimitOverride(context.Background(), "NoSuchLimit", "1234")
+	test.AssertError(t, err, "removing an override for an unknown limit should fail")
+	test.AssertEquals(t, len(msa.removeRequests), 0)
+
+	err = a.removeRateLimitOverride(context.Background(), "NewOrdersPerAccount", "1234")
+	test.AssertNotError(t, err, "removing override")
+	test.AssertEquals(t, len(msa.removeRequests), 1)
+	test.AssertEquals(t, msa.removeRequests[0].LimitEnum, int64(ratelimits.NewOrdersPerAccount))
+	test.AssertEquals(t, msa.removeRequests[0].BucketId, "1234")
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	blog "github.com/letsencrypt/boulder/log"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockRLCRecordingRequests is a mock which records the rate limit requests it
+// receives, and responds with a fixed bucket state.
+type mockRLCRecordingRequests struct {
+	rapb.RateLimitsClient
+	inspectRequests []*rapb.RateLimitBucketRequest
+	resetRequests   []*rapb.RateLimitBucketRequest
+}
+
+func (m *mockRLCRecordingRequests) state(req *rapb.RateLimitBucketRequest) *rapb.RateLimitBucketState {
+	return &rapb.RateLimitBucketState{
+		Name:      req.Name,
+		BucketKey: "3:" + req.Id,
+		Burst:     1500,
+		Count:     1500,
+		Period:    durationpb.New(3 * time.Hour),
+		Remaining: 1500,
+		ResetAt:   timestamppb.New(time.Unix(0, 0)),
+	}
+}
+
+func (m *mockRLCRecordingRequests) InspectRateLimit(_ context.Context, req *rapb.RateLimitBucketRequest, _ ...grpc.CallOption) (*rapb.RateLimitBucketState, error) {
+	m.inspectRequests = append(m.inspectRequests, req)
+	return m.state(req), nil
+}
+
+func (m *mockRLCRecordingRequests) ResetRateLimit(_ context.Context, req *rapb.RateLimitBucketRequest, _ ...grpc.CallOption) (*rapb.RateLimitBucketState, error) {
+	m.resetRequests = append(m.resetRequests, req)
+	return m.state(req), nil
+}
+
+func TestInspectRateLimit(t *testing.T) {
+	mrlc := mockRLCRecordingRequests{}
+	a := admin{rlc: &mrlc, log: blog.NewMock()}
+
+	_, err := a.inspectRateLimit(context.Background(), "", "1")
+	test.AssertError(t, err, "inspecting without a limit name should fail")
+	_, err = a.inspectRateLimit(context.Background(), "NewOrdersPerAccount", "")
+	test.AssertError(t, err, "inspecting without an id should fail")
+	test.AssertEquals(t, len(mrlc.inspectRequests), 0)
+
+	state, err := a.inspectRateLimit(context.Background(), "NewOrdersPerAccount", "1")
+	test.AssertNotError(t, err, "inspecting rate limit")
+	test.AssertEquals(t, len(mrlc.inspectRequests), 1)
+	test.AssertEquals(t, mrlc.inspectRequests[0].Name, "NewOrdersPerAccount")
+	test.AssertEquals(t, mrlc.inspectRequests[0].Id, "1")
+	test.AssertEquals(t, state.BucketKey, "3:1")
+}
+
+func TestResetRateLimit(t *testing.T) {
+	log := blog.NewMock()
+	mrlc := mockRLCRecordingRequests{}
+	a := admin{rlc: &mrlc, log: log}
+
+	_, err := a.resetRateLimit(context.Background(), "", "1")
+	test.AssertError(t, err, "resetting without a limit name should fail")
+	test.AssertEquals(t, len(mrlc.resetRequests), 0)
+
+	_, err = a.resetRateLimit(context.Background(), "NewOrdersPerAccount", "1")
+	test.AssertNotError(t, err, "resetting rate limit")
+	test.AssertEquals(t, len(mrlc.resetRequests), 1)
+	test.AssertEquals(t, mrlc.resetRequests[0].Name, "NewOrdersPerAccount")
+	test.AssertEquals(t, mrlc.resetRequests[0].Id, "1")
+
+	// A dry-run should log the reset instead of sending it to the RA.
+	log.Clear()
+	a.dryRun = true
+	a.rlc = dryRunRLC{RateLimitsClient: &mrlc, log: log}
+	_, err = a.resetRateLimit(context.Background(), "NewOrdersPerAccount", "2")
+	test.AssertNotError(t, err, "resetting rate limit in dry-run mode")
+	test.AssertEquals(t, len(mrlc.resetRequests), 1)
+	test.AssertEquals(t, len(log.GetAllMatching(`dry-run: .*NewOrdersPerAccount.*2`)), 1)
+}
+
+func TestFormatBucketState(t *testing.T) {
+	t.Parallel()
+
+	state := &rapb.RateLimitBucketState{
+		Name:      "NewOrdersPerAccount",
+		BucketKey: "3:1",
+		Burst:     1500,
+		Count:     1500,
+		Period:    durationpb.New(3 * time.Hour),
+		Override:  true,
+		Tat:       timestamppb.New(time.Date(2025, 1, 1, 0, 1, 12, 0, time.UTC)),
+		Remaining: 1490,
+		ResetAt:   timestamppb.New(time.Date(2025, 1, 1, 0, 1, 12, 0, time.UTC)),
+	}
+	out := formatBucketState(state)
+	test.AssertContains(t, out, "Limit:      NewOrdersPerAccount (override)\n")
+	test.AssertContains(t, out, "Count:      1500 per 3h0m0s\n")
+	test.AssertContains(t, out, "Remaining:  1490\n")
+	test.AssertContains(t, out, "TAT:        2025-01-01T00:01:12Z\n")
+
+	state.Override = false
+	state.Tat = nil
+	out = formatBucketState(state)
+	test.AssertContains(t, out, "Limit:      NewOrdersPerAccount (default)\n")
+	test.AssertContains(t, out, "TAT:        none (bucket is full)\n")
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/letsencrypt/boulder/akamai"
 	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
+	"github.com/letsencrypt/boulder/cdn"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/config"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
@@ -152,14 +153,29 @@ type Config struct {
 		// isn't provided it will default to `defaultQueueSize`.
 		MaxQueueSize int
 
-		BaseURL      string `validate:"required,url"`
-		ClientToken  string `validate:"required"`
-		ClientSecret string `validate:"required"`
-		AccessToken  string `validate:"required"`
-		V3Network    string `validate:"required,oneof=staging production"`
+		// BaseURL, ClientToken, ClientSecret, AccessToken, and V3Network
+		// configure the Akamai Fast-Purge API client. They are required unless
+		// one of HTTPPurge or SurrogateKeyPurge is set, in which case they are
+		// ignored.
+		BaseURL      string `validate:"required_without_all=HTTPPurge SurrogateKeyPurge,omitempty,url"`
+		ClientToken  string `validate:"required_without_all=HTTPPurge SurrogateKeyPurge"`
+		ClientSecret string `validate:"required_without_all=HTTPPurge SurrogateKeyPurge"`
+		AccessToken  string `validate:"required_without_all=HTTPPurge SurrogateKeyPurge"`
+		V3Network    string `validate:"required_without_all=HTTPPurge SurrogateKeyPurge,omitempty,oneof=staging production"`
+
+		// HTTPPurge, if set, configures the purger to purge each URL by sending
+		// it a PURGE or BAN request, instead of using Akamai's Fast-Purge API.
+		// Cannot be set at the same time as SurrogateKeyPurge.
+		HTTPPurge *HTTPPurgeConfig
+
+		// SurrogateKeyPurge, if set, configures the purger to purge each URL by
+		// its surrogate key, as computed by cdn.URLSurrogateKey, instead of
+		// using Akamai's Fast-Purge API.
+		SurrogateKeyPurge *SurrogateKeyPurgeConfig
 
 		// Throughput is a container for all throughput related akamai-purger
-		// settings.
+		// settings. Akamai's rate limits are enforced regardless of which CDN
+		// is configured, as a conservative default.
 		Throughput Throughput
 
 		// PurgeRetries is the maximum number of attempts that will be made to purge a
@@ -175,15 +191,36 @@ type Config struct {
 	OpenTelemetry cmd.OpenTelemetryConfig
 }
 
-// cachePurgeClient is testing interface.
-type cachePurgeClient interface {
-	Purge(urls []string) error
+// HTTPPurgeConfig configures a cdn.HTTPPurger.
+type HTTPPurgeConfig struct {
+	// Method is the HTTP method used to purge each URL.
+	Method string `validate:"required,oneof=PURGE BAN"`
+
+	// Endpoint, if set, is the base URL of the cache to which purge requests
+	// are sent, in place of the host of each URL being purged. The Host header
+	// of each request is still set to that of the URL being purged.
+	Endpoint string `validate:"omitempty,url"`
+}
+
+// SurrogateKeyPurgeConfig configures a cdn.SurrogateKeyPurger.
+type SurrogateKeyPurgeConfig struct {
+	// Endpoint is the URL to which batches of surrogate keys are POSTed.
+	Endpoint string `validate:"required,url"`
+
+	// APIKeyHeader is the name of the HTTP header in which the API key is
+	// sent, e.g. "Fastly-Key" or "Authorization".
+	APIKeyHeader string `validate:"required"`
+
+	// APIKey is the path to a file containing the API key, which is sent
+	// verbatim in the APIKeyHeader header.
+	APIKey cmd.PasswordConfig
 }
 
 // akamaiPurger is a mutex protected container for a gRPC server which receives
-// requests containing a slice of URLs associated with an OCSP response cached
-// by Akamai. This slice of URLs is stored on a stack, and dispatched in batches
-// to Akamai's Fast Purge API at regular intervals.
+// requests containing a slice of URLs associated with an OCSP response or CRL
+// cached by a CDN. This slice of URLs is stored on a stack, and dispatched in batches
+// to the configured cdn.Purger (by default, Akamai's Fast Purge API) at regular
+// intervals.
 type akamaiPurger struct {
 	sync.Mutex
 	akamaipb.UnsafeAkamaiPurgerServer
@@ -193,7 +230,7 @@ type akamaiPurger struct {
 	toPurge         [][]string
 	maxStackSize    int
 	entriesPerBatch int
-	client          cachePurgeClient
+	client          cdn.Purger
 	log             blog.Logger
 }
 
@@ -214,7 +251,7 @@ func (ap *akamaiPurger) purgeBatch(batch [][]string) error {
 
 	err := ap.client.Purge(urls)
 	if err != nil {
-		ap.log.Errf("Failed to purge %d OCSP responses (%s): %s", len(batch), strings.Join(urls, ","), err)
+		ap.log.Errf("Failed to purge %d URLs (%s): %s", len(urls), strings.Join(urls, ","), err)
 		return err
 	}
 	return nil
@@ -338,23 +375,55 @@ func main() {
 		apc.MaxQueueSize = defaultQueueSize
 	}
 
-	ccu, err := akamai.NewCachePurgeClient(
-		apc.BaseURL,
-		apc.ClientToken,
-		apc.ClientSecret,
-		apc.AccessToken,
-		apc.V3Network,
-		apc.PurgeRetries,
-		apc.PurgeRetryBackoff.Duration,
-		logger,
-		scope,
-	)
-	cmd.FailOnError(err, "Failed to setup Akamai CCU client")
+	if apc.HTTPPurge != nil && apc.SurrogateKeyPurge != nil {
+		cmd.Fail("Cannot specify both of httpPurge and surrogateKeyPurge")
+	}
+
+	var purger cdn.Purger
+	switch {
+	case apc.HTTPPurge != nil:
+		purger, err = cdn.NewHTTPPurger(
+			apc.HTTPPurge.Method,
+			apc.HTTPPurge.Endpoint,
+			apc.PurgeRetries,
+			apc.PurgeRetryBackoff.Duration,
+			logger,
+			scope,
+		)
+		cmd.FailOnError(err, "Failed to setup HTTP purge client")
+	case apc.SurrogateKeyPurge != nil:
+		apiKey, err := apc.SurrogateKeyPurge.APIKey.Pass()
+		cmd.FailOnError(err, "Failed to load surrogate key purge API key")
+		purger, err = cdn.NewSurrogateKeyPurger(
+			apc.SurrogateKeyPurge.Endpoint,
+			apc.SurrogateKeyPurge.APIKeyHeader,
+			apiKey,
+			apc.PurgeRetries,
+			apc.PurgeRetryBackoff.Duration,
+			logger,
+			scope,
+		)
+		cmd.FailOnError(err, "Failed to setup surrogate key purge client")
+	default:
+		purger, err = akamai.NewCachePurgeClient(
+			apc.BaseURL,
+			apc.ClientToken,
+			apc.ClientSecret,
+			apc.AccessToken,
+			apc.V3Network,
+			apc.PurgeRetries,
+			apc.PurgeRetryBackoff.Duration,
+			logger,
+			scope,
+			cmd.Clock(),
+		)
+		cmd.FailOnError(err, "Failed to setup Akamai CCU client")
+	}
 
 	ap := &akamaiPurger{
 		maxStackSize:    apc.MaxQueueSize,
 		entriesPerBatch: apc.Throughput.QueueEntriesPerBatch,
-		client:          ccu,
+		client:          purger,
 		log:             logger,
 	}
 
@@ -368,17 +437,22 @@ func main() {
 	scope.MustRegister(gaugePurgeQueueLength)
 
 	if manualMode {
-		manualPurge(ccu, *tag, *tagFile)
+		tagPurger, ok := purger.(cdn.TagPurger)
+		if !ok {
+			cmd.Fail("The configured purger does not support purging tags")
+		}
+		manualPurge(tagPurger, *tag, *tagFile)
 	} else {
 		daemon(c, ap, logger, scope)
 	}
 }
 
 // manualPurge is called ad-hoc to purge either a single tag, or a batch of tags,
-// passed on the CLI. All tags will be added to a single request, please ensure
-// that you don't violate the Fast-Purge API limits for tags detailed here:
+// passed on the CLI. All tags will be added to a single request. When using
+// Akamai, please ensure that you don't violate the Fast-Purge API limits for
+// tags detailed here:
 // https://techdocs.akamai.com/purge-cache/reference/rate-limiting
-func manualPurge(purgeClient *akamai.CachePurgeClient, tag, tagFile string) {
+func manualPurge(purgeClient cdn.TagPurger, tag, tagFile string) {
 	var tags []string
 	if tag != "" {
 		tags = []string{tag}
@@ -120,6 +120,8 @@ type Config struct {
 		// CTLogListFile is the path to a JSON file on disk containing the set of
 		// all logs trusted by Chrome. The file must match the v3 log list schema:
 		// https://www.gstatic.com/ct/log_list/v3/log_list_schema.json
+		// If set, the SCTs embedded in final certificates must verify under the
+		// public key of one of these logs.
 		CTLogListFile string
 
 		// DisableCertService causes the CertificateAuthority gRPC service to not
@@ -195,8 +197,9 @@ func main() {
 	metrics := ca.NewCAMetrics(scope)
 
 	cmd.FailOnError(c.PA.CheckChallenges(), "Invalid PA configuration")
+	cmd.FailOnError(c.PA.CheckIdentifiers(), "Invalid PA configuration")
 
-	pa, err := policy.New(c.PA.Challenges, logger)
+	pa, err := policy.New(c.PA.Identifiers, c.PA.Challenges, logger)
 	cmd.FailOnError(err, "Couldn't create PA")
 
 	if c.CA.HostnamePolicyFile == "" {
@@ -302,6 +305,7 @@ func main() {
 			serialPrefix,
 			c.CA.MaxNames,
 			kp,
+			loglist.GetLintList(),
 			logger,
 			metrics,
 			clk)
@@ -2,6 +2,7 @@ package notmain
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/letsencrypt/boulder/cmd"
@@ -9,35 +10,46 @@ import (
 	"github.com/letsencrypt/boulder/strictyaml"
 )
 
-func main() {
-	debugAddr := flag.String("debug-addr", "", "Debug server address override")
-	configPath := flag.String(
-		"config", "config.yml", "Path to boulder-observer configuration file")
-	flag.Parse()
-
-	configYAML, err := os.ReadFile(*configPath)
-	cmd.FailOnError(err, "failed to read config file")
+// loadConfig reads and parses the YAML config file at configPath, applying
+// the debugAddr override if it is non-empty.
+func loadConfig(configPath, debugAddr string) (*observer.ObsConf, error) {
+	configYAML, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
 
 	// Parse the YAML config file.
 	var config observer.ObsConf
 	err = strictyaml.Unmarshal(configYAML, &config)
-
-	if *debugAddr != "" {
-		config.DebugAddr = *debugAddr
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse YAML config: %w", err)
 	}
 
-	if err != nil {
-		cmd.FailOnError(err, "failed to parse YAML config")
+	if debugAddr != "" {
+		config.DebugAddr = debugAddr
 	}
+	return &config, nil
+}
+
+func main() {
+	debugAddr := flag.String("debug-addr", "", "Debug server address override")
+	configPath := flag.String(
+		"config", "config.yml", "Path to boulder-observer configuration file")
+	flag.Parse()
+
+	config, err := loadConfig(*configPath, *debugAddr)
+	cmd.FailOnError(err, "failed to load config")
 
 	// Make an `Observer` object.
-	observer, err := config.MakeObserver()
+	obs, err := config.MakeObserver()
 	if err != nil {
 		cmd.FailOnError(err, "config failed validation")
 	}
 
-	// Start the `Observer` daemon.
-	observer.Start()
+	// Start the `Observer` daemon, reloading the config file on SIGHUP.
+	obs.Start(func() (*observer.ObsConf, error) {
+		return loadConfig(*configPath, *debugAddr)
+	})
 }
 
 func init() {
@@ -2,7 +2,9 @@ package notmain
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"os"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/ratelimits"
 	bredis "github.com/letsencrypt/boulder/redis"
+	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/va"
 	vapb "github.com/letsencrypt/boulder/va/proto"
@@ -47,9 +50,15 @@ type Config struct {
 
 		Limiter struct {
 			// Redis contains the configuration necessary to connect to Redis
-			// for rate limiting. This field is required to enable rate
+			// for rate limiting. Either this field or DB is required to enable
+			// rate limiting.
+			Redis *bredis.Config `validate:"excluded_with=DB"`
+
+			// DB contains the configuration necessary to connect to a database
+			// holding the rateLimitBuckets table, for deployments which do not
+			// run Redis. Either this field or Redis is required to enable rate
 			// limiting.
-			Redis *bredis.Config `validate:"required_with=Defaults"`
+			DB *cmd.DBConfig `validate:"excluded_with=Redis"`
 
 			// Defaults is a path to a YAML file containing default rate limits.
 			// See: ratelimits/README.md for details. This field is required to
@@ -59,7 +68,7 @@ type Config struct {
 			//
 			// Note: At this time, only the Failed Authorizations rate limit is
 			// necessary in the RA.
-			Defaults string `validate:"required_with=Redis"`
+			Defaults string `validate:"required_with=Redis DB"`
 
 			// Overrides is a path to a YAML file containing overrides for the
 			// default rate limits. See: ratelimits/README.md for details. If
@@ -70,6 +79,20 @@ type Config struct {
 			// Note: At this time, only the Failed Authorizations overrides are
 			// necessary in the RA.
 			Overrides string
+
+			// OverridesFromSA, if true, additionally loads the unexpired
+			// overrides from the SA's rateLimitOverrides table, which are
+			// managed with the admin tool. These take precedence over the
+			// Overrides file. If the SA is unavailable at startup, only the
+			// Overrides file is used until the next reload, so ReloadInterval
+			// is required.
+			OverridesFromSA bool
+
+			// ReloadInterval is how often the Defaults and Overrides files,
+			// and the overrides from the SA if enabled, are reloaded. If a
+			// reload fails, the current limits remain in effect. If this field
+			// is not set, limits are only loaded at startup.
+			ReloadInterval config.Duration `validate:"-"`
 		}
 
 		// MaxNames is the maximum number of subjectAltNames in a single cert.
@@ -125,6 +148,16 @@ type Config struct {
 		// generate OCSP URLs to purge during revocation.
 		IssuerCerts []string `validate:"min=1,dive,required"`
 
+		// CAConfigFile is the path to the CA's JSON config file, from which
+		// the RA reads the CA's certificate profiles and default profile name,
+		// so that the two can't disagree. The RA uses each profile's MaxNames,
+		// IdentifierTypes, and ChallengeTypes to reject new orders, and its
+		// AllowedKeys to reject finalization requests, which the CA would
+		// refuse; fields which only affect the contents of certificates are
+		// ignored. If this field is not set, no profile-specific restrictions
+		// are applied by the RA.
+		CAConfigFile string `validate:"omitempty"`
+
 		Features features.Config
 	}
 
@@ -134,6 +167,45 @@ type Config struct {
 	OpenTelemetry cmd.OpenTelemetryConfig
 }
 
+// caConfig is the subset of the CA's config which the RA reads in order to
+// enforce the CA's certificate profiles.
+type caConfig struct {
+	CA struct {
+		Issuance struct {
+			DefaultCertificateProfileName string
+			Profile                       issuance.ProfileConfig
+			CertProfiles                  map[string]*issuance.ProfileConfig
+		}
+	}
+}
+
+// loadCAProfiles reads the certificate profiles, and the name of the default
+// profile, from the CA's config file. Like the CA, it treats the deprecated
+// top-level Profile as the only profile if no CertProfiles are configured.
+func loadCAProfiles(filename string) (map[string]*issuance.ProfileConfig, string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, "", err
+	}
+	var c caConfig
+	err = json.Unmarshal(data, &c)
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	iss := c.CA.Issuance
+	if iss.DefaultCertificateProfileName == "" {
+		iss.DefaultCertificateProfileName = "defaultBoulderCertificateProfile"
+	}
+	// TODO(#7414) Remove this fallback along with the CA's.
+	if len(iss.CertProfiles) == 0 {
+		iss.CertProfiles = map[string]*issuance.ProfileConfig{
+			iss.DefaultCertificateProfileName: &iss.Profile,
+		}
+	}
+	return iss.CertProfiles, iss.DefaultCertificateProfileName, nil
+}
+
 func main() {
 	grpcAddr := flag.String("addr", "", "gRPC listen address override")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
@@ -163,8 +235,9 @@ func main() {
 
 	// Validate PA config and set defaults if needed
 	cmd.FailOnError(c.PA.CheckChallenges(), "Invalid PA configuration")
+	cmd.FailOnError(c.PA.CheckIdentifiers(), "Invalid PA configuration")
 
-	pa, err := policy.New(c.PA.Challenges, logger)
+	pa, err := policy.New(c.PA.Identifiers, c.PA.Challenges, logger)
 	cmd.FailOnError(err, "Couldn't create PA")
 
 	if c.RA.HostnamePolicyFile == "" {
@@ -210,6 +283,13 @@ func main() {
 		cmd.FailOnError(err, "Failed to load issuer certificate")
 	}
 
+	var certProfiles map[string]*issuance.ProfileConfig
+	var defaultCertProfileName string
+	if c.RA.CAConfigFile != "" {
+		certProfiles, defaultCertProfileName, err = loadCAProfiles(c.RA.CAConfigFile)
+		cmd.FailOnError(err, "Failed to load certificate profiles from CA config")
+	}
+
 	// Boulder's components assume that there will always be CT logs configured.
 	// Issuing a certificate without SCTs embedded is a misissuance event as per
 	// our CPS 4.4.2, which declares we will always include at least two SCTs.
@@ -231,7 +311,13 @@ func main() {
 	finalLogs, err := allLogs.SubsetForPurpose(c.RA.CTLogs.FinalLogs, loglist.Informational)
 	cmd.FailOnError(err, "Failed to load final logs")
 
-	ctp = ctpolicy.New(pubc, sctLogs, infoLogs, finalLogs, c.RA.CTLogs.Stagger.Duration, logger, scope)
+	var ctQueue *ctpolicy.Queue
+	if c.RA.CTLogs.SubmissionQueue != nil {
+		ctQueue = ctpolicy.NewQueue(sac, pubc, *c.RA.CTLogs.SubmissionQueue, clk, logger, scope)
+		go ctQueue.Run(context.Background())
+	}
+
+	ctp = ctpolicy.New(pubc, sctLogs, infoLogs, finalLogs, c.RA.CTLogs.Stagger.Duration, ctQueue, logger, scope)
 
 	// Baseline Requirements v1.8.1 section 4.2.1: "any reused data, document,
 	// or completed validation MUST be obtained no more than 398 days prior
@@ -267,14 +353,35 @@ func main() {
 	var limiterRedis *bredis.Ring
 	if c.RA.Limiter.Defaults != "" {
 		// Setup rate limiting.
-		limiterRedis, err = bredis.NewRingFromConfig(*c.RA.Limiter.Redis, scope, logger)
-		cmd.FailOnError(err, "Failed to create Redis ring")
+		var source ratelimits.Source
+		if c.RA.Limiter.DB != nil {
+			dbMap, err := sa.InitWrappedDb(*c.RA.Limiter.DB, scope, logger)
+			cmd.FailOnError(err, "Failed to connect to rate limits database")
+			dbSource := ratelimits.NewDBSource(dbMap, clk, scope)
+			go dbSource.PurgeExpired(context.Background(), logger)
+			source = dbSource
+		} else if c.RA.Limiter.Redis != nil {
+			limiterRedis, err = bredis.NewRingFromConfig(*c.RA.Limiter.Redis, scope, logger)
+			cmd.FailOnError(err, "Failed to create Redis ring")
+			source = ratelimits.NewRedisSource(limiterRedis.Ring, clk, scope)
+		} else {
+			cmd.Fail("Either limiter.redis or limiter.db must be configured to enable rate limiting")
+		}
 
-		source := ratelimits.NewRedisSource(limiterRedis.Ring, clk, scope)
 		limiter, err = ratelimits.NewLimiter(clk, source, scope)
 		cmd.FailOnError(err, "Failed to create rate limiter")
-		txnBuilder, err = ratelimits.NewTransactionBuilder(c.RA.Limiter.Defaults, c.RA.Limiter.Overrides)
+		var overridesSource ratelimits.OverridesSource
+		if c.RA.Limiter.OverridesFromSA {
+			if c.RA.Limiter.ReloadInterval.Duration <= 0 {
+				cmd.Fail("limiter.reloadInterval must be set when limiter.overridesFromSA is true")
+			}
+			overridesSource = ratelimits.NewSAOverridesSource(sac)
+		}
+		txnBuilder, err = ratelimits.NewReloadableTransactionBuilder(c.RA.Limiter.Defaults, c.RA.Limiter.Overrides, overridesSource, scope, logger)
 		cmd.FailOnError(err, "Failed to create rate limits transaction builder")
+		if c.RA.Limiter.ReloadInterval.Duration > 0 {
+			go txnBuilder.ReloadLimitsPeriodically(context.Background(), c.RA.Limiter.ReloadInterval.Duration, logger)
+		}
 	}
 
 	rai := ra.NewRegistrationAuthorityImpl(
@@ -294,6 +401,8 @@ func main() {
 		ctp,
 		apc,
 		issuerCerts,
+		certProfiles,
+		defaultCertProfileName,
 	)
 	defer rai.Drain()
 
@@ -310,7 +419,8 @@ func main() {
 	rai.SA = sac
 
 	start, err := bgrpc.NewServer(c.RA.GRPC, logger).Add(
-		&rapb.RegistrationAuthority_ServiceDesc, rai).Build(tlsConfig, scope, clk)
+		&rapb.RegistrationAuthority_ServiceDesc, rai).Add(
+		&rapb.RateLimits_ServiceDesc, rai).Build(tlsConfig, scope, clk)
 	cmd.FailOnError(err, "Unable to setup RA gRPC server")
 
 	cmd.FailOnError(start(), "RA gRPC service failed")
@@ -1 +1,26 @@
 package notmain
+
+import (
+	"testing"
+
+	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestLoadCAProfiles(t *testing.T) {
+	profiles, defaultName, err := loadCAProfiles("../../test/config-next/ca.json")
+	test.AssertNotError(t, err, "loading profiles from CA config")
+	test.AssertEquals(t, defaultName, "legacy")
+	test.AssertEquals(t, len(profiles), 2)
+	test.AssertDeepEquals(t, profiles["modern"].IdentifierTypes, []identifier.IdentifierType{identifier.TypeDNS})
+
+	// The deprecated top-level profile is used under the default name.
+	profiles, defaultName, err = loadCAProfiles("../../test/config/ca.json")
+	test.AssertNotError(t, err, "loading profiles from CA config")
+	test.AssertEquals(t, defaultName, "defaultBoulderCertificateProfile")
+	test.AssertEquals(t, len(profiles), 1)
+	test.Assert(t, profiles[defaultName] != nil, "default profile missing")
+
+	_, _, err = loadCAProfiles("../../test/config-next/does-not-exist.json")
+	test.AssertError(t, err, "loading profiles from a missing file")
+}
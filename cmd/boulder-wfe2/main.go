@@ -10,6 +10,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/letsencrypt/boulder/ari"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/features"
@@ -22,6 +23,7 @@ import (
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/ratelimits"
 	bredis "github.com/letsencrypt/boulder/redis"
+	"github.com/letsencrypt/boulder/sa"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/unpause"
 	"github.com/letsencrypt/boulder/web"
@@ -109,6 +111,11 @@ type Config struct {
 		// DirectoryWebsite is used for the /directory response's "meta" element's
 		// "website" field.
 		DirectoryWebsite string `validate:"required,url"`
+		// RequireExternalAccountBinding, if true, requires that all new accounts
+		// be bound to an external account using a MAC key provisioned with the
+		// admin tool, per RFC 8555 Section 7.3.4. Accounts may always include a
+		// binding, regardless of this setting.
+		RequireExternalAccountBinding bool
 
 		// ACMEv2 requests (outside some registration/revocation messages) use a JWS with
 		// a KeyID header containing the full account URL. For new accounts this
@@ -141,16 +148,22 @@ type Config struct {
 
 		Limiter struct {
 			// Redis contains the configuration necessary to connect to Redis
-			// for rate limiting. This field is required to enable rate
+			// for rate limiting. Either this field or DB is required to enable
+			// rate limiting.
+			Redis *bredis.Config `validate:"excluded_with=DB"`
+
+			// DB contains the configuration necessary to connect to a database
+			// holding the rateLimitBuckets table, for deployments which do not
+			// run Redis. Either this field or Redis is required to enable rate
 			// limiting.
-			Redis *bredis.Config `validate:"required_with=Defaults"`
+			DB *cmd.DBConfig `validate:"excluded_with=Redis"`
 
 			// Defaults is a path to a YAML file containing default rate limits.
 			// See: ratelimits/README.md for details. This field is required to
 			// enable rate limiting. If any individual rate limit is not set,
 			// that limit will be disabled. Failed Authorizations limits passed
 			// in this file must be identical to those in the RA.
-			Defaults string `validate:"required_with=Redis"`
+			Defaults string `validate:"required_with=Redis DB"`
 
 			// Overrides is a path to a YAML file containing overrides for the
 			// default rate limits. See: ratelimits/README.md for details. If
@@ -159,6 +172,20 @@ type Config struct {
 			// overrides passed in this file must be identical to those in the
 			// RA.
 			Overrides string
+
+			// OverridesFromSA, if true, additionally loads the unexpired
+			// overrides from the SA's rateLimitOverrides table, which are
+			// managed with the admin tool. These take precedence over the
+			// Overrides file. If the SA is unavailable at startup, only the
+			// Overrides file is used until the next reload, so ReloadInterval
+			// is required.
+			OverridesFromSA bool
+
+			// ReloadInterval is how often the Defaults and Overrides files,
+			// and the overrides from the SA if enabled, are reloaded. If a
+			// reload fails, the current limits remain in effect. If this field
+			// is not set, limits are only loaded at startup.
+			ReloadInterval config.Duration `validate:"-"`
 		}
 
 		// MaxNames is the maximum number of subjectAltNames in a single cert.
@@ -174,6 +201,12 @@ type Config struct {
 		// This field is optional; if unset, no profile names are accepted.
 		CertProfiles map[string]string `validate:"omitempty,dive,keys,alphanum,min=1,max=32,endkeys"`
 
+		// ARI configures the suggested renewal windows served by the
+		// renewalInfo endpoint. This field is optional; if unset, windows are
+		// two thirds of the way through each certificate's validity period and
+		// clients are asked to poll every 6 hours.
+		ARI ari.Config
+
 		Unpause struct {
 			// HMACKey signs outgoing JWTs for redemption at the unpause
 			// endpoint. This key must match the one configured for all SFEs.
@@ -359,14 +392,35 @@ func main() {
 	var limiterRedis *bredis.Ring
 	if c.WFE.Limiter.Defaults != "" {
 		// Setup rate limiting.
-		limiterRedis, err = bredis.NewRingFromConfig(*c.WFE.Limiter.Redis, stats, logger)
-		cmd.FailOnError(err, "Failed to create Redis ring")
+		var source ratelimits.Source
+		if c.WFE.Limiter.DB != nil {
+			dbMap, err := sa.InitWrappedDb(*c.WFE.Limiter.DB, stats, logger)
+			cmd.FailOnError(err, "Failed to connect to rate limits database")
+			dbSource := ratelimits.NewDBSource(dbMap, clk, stats)
+			go dbSource.PurgeExpired(context.Background(), logger)
+			source = dbSource
+		} else if c.WFE.Limiter.Redis != nil {
+			limiterRedis, err = bredis.NewRingFromConfig(*c.WFE.Limiter.Redis, stats, logger)
+			cmd.FailOnError(err, "Failed to create Redis ring")
+			source = ratelimits.NewRedisSource(limiterRedis.Ring, clk, stats)
+		} else {
+			cmd.Fail("Either limiter.redis or limiter.db must be configured to enable rate limiting")
+		}
 
-		source := ratelimits.NewRedisSource(limiterRedis.Ring, clk, stats)
 		limiter, err = ratelimits.NewLimiter(clk, source, stats)
 		cmd.FailOnError(err, "Failed to create rate limiter")
-		txnBuilder, err = ratelimits.NewTransactionBuilder(c.WFE.Limiter.Defaults, c.WFE.Limiter.Overrides)
+		var overridesSource ratelimits.OverridesSource
+		if c.WFE.Limiter.OverridesFromSA {
+			if c.WFE.Limiter.ReloadInterval.Duration <= 0 {
+				cmd.Fail("limiter.reloadInterval must be set when limiter.overridesFromSA is true")
+			}
+			overridesSource = ratelimits.NewSAOverridesSource(sac)
+		}
+		txnBuilder, err = ratelimits.NewReloadableTransactionBuilder(c.WFE.Limiter.Defaults, c.WFE.Limiter.Overrides, overridesSource, stats, logger)
 		cmd.FailOnError(err, "Failed to create rate limits transaction builder")
+		if c.WFE.Limiter.ReloadInterval.Duration > 0 {
+			go txnBuilder.ReloadLimitsPeriodically(context.Background(), c.WFE.Limiter.ReloadInterval.Duration, logger)
+		}
 	}
 
 	var accountGetter wfe2.AccountGetter
@@ -379,6 +433,9 @@ func main() {
 	} else {
 		accountGetter = sac
 	}
+	renewalInfoPolicy, err := ari.New(c.WFE.ARI, clk)
+	cmd.FailOnError(err, "Unable to create renewal info policy")
+
 	wfe, err := wfe2.NewWebFrontEndImpl(
 		stats,
 		clk,
@@ -403,6 +460,7 @@ func main() {
 		unpauseSigner,
 		c.WFE.Unpause.JWTLifetime.Duration,
 		c.WFE.Unpause.URL,
+		renewalInfoPolicy,
 	)
 	cmd.FailOnError(err, "Unable to create WFE")
 
@@ -410,6 +468,7 @@ func main() {
 	wfe.AllowOrigins = c.WFE.AllowOrigins
 	wfe.DirectoryCAAIdentity = c.WFE.DirectoryCAAIdentity
 	wfe.DirectoryWebsite = c.WFE.DirectoryWebsite
+	wfe.RequireExternalAccountBinding = c.WFE.RequireExternalAccountBinding
 	wfe.LegacyKeyIDPrefix = c.WFE.LegacyKeyIDPrefix
 
 	logger.Infof("WFE using key policy: %#v", kp)
@@ -51,7 +51,7 @@ var (
 
 func init() {
 	var err error
-	pa, err = policy.New(map[core.AcmeChallenge]bool{}, blog.NewMock())
+	pa, err = policy.New(nil, map[core.AcmeChallenge]bool{}, blog.NewMock())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -638,6 +638,7 @@ func TestIgnoredLint(t *testing.T) {
 		"zlint warn: w_subject_common_name_included",
 		"zlint info: w_ct_sct_policy_count_unsatisfied Certificate had 0 embedded SCTs. Browser policy may require 2 for this certificate.",
 		"zlint error: e_scts_from_same_operator Certificate had too few embedded SCTs; browser policy requires 2.",
+		"zlint error: e_scts_insufficient_for_lifetime Certificate has SCTs from 0 distinct known logs; browser policy requires 2 for its lifetime.",
 	}
 	sort.Strings(expectedProblems)
 
@@ -654,6 +655,7 @@ func TestIgnoredLint(t *testing.T) {
 		"w_subject_common_name_included":           true,
 		"w_ct_sct_policy_count_unsatisfied":        true,
 		"e_scts_from_same_operator":                true,
+		"e_scts_insufficient_for_lifetime":         true,
 	})
 	test.AssertEquals(t, len(problems), 0)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/crl/checker"
+	"github.com/letsencrypt/boulder/crl/delta"
 )
 
 func downloadShard(url string) (*x509.RevocationList, error) {
@@ -39,12 +40,28 @@ func downloadShard(url string) (*x509.RevocationList, error) {
 	return crl, nil
 }
 
+// saveShard writes the given CRL to a file in the current directory, named
+// after the URL it was downloaded from.
+func saveShard(u string, crl *x509.RevocationList) error {
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("parsing url: %w", err)
+	}
+	filename := fmt.Sprintf("%s%s", parsedURL.Host, strings.ReplaceAll(parsedURL.Path, "/", "_"))
+	err = os.WriteFile(filename, crl.Raw, 0660)
+	if err != nil {
+		return fmt.Errorf("writing file: %w", err)
+	}
+	return nil
+}
+
 func main() {
 	urlFile := flag.String("crls", "", "path to a file containing a JSON Array of CRL URLs")
 	issuerFile := flag.String("issuer", "", "path to an issuer certificate on disk, required, '-' to disable validation")
 	ageLimitStr := flag.String("ageLimit", "168h", "maximum allowable age of a CRL shard")
 	emitRevoked := flag.Bool("emitRevoked", false, "emit revoked serial numbers on stdout, one per line, hex-encoded")
 	save := flag.Bool("save", false, "save CRLs to files named after the URL")
+	deltas := flag.Bool("deltas", false, "also fetch each CRL's delta CRL from its freshest CRL URL, and check that the two are consistent")
 	flag.Parse()
 
 	logger := cmd.NewLogger(cmd.SyslogConfig{StdoutLevel: 6, SyslogLevel: -1})
@@ -85,15 +102,9 @@ func main() {
 		}
 
 		if *save {
-			parsedURL, err := url.Parse(u)
+			err = saveShard(u, crl)
 			if err != nil {
-				logger.Errf("parsing url: %s", err)
-				continue
-			}
-			filename := fmt.Sprintf("%s%s", parsedURL.Host, strings.ReplaceAll(parsedURL.Path, "/", "_"))
-			err = os.WriteFile(filename, crl.Raw, 0660)
-			if err != nil {
-				logger.Errf("writing file: %s", err)
+				logger.Errf("saving CRL %q: %s", u, err)
 				continue
 			}
 		}
@@ -107,18 +118,62 @@ func main() {
 			continue
 		}
 
-		err = checker.Validate(zcrl, issuer, ageLimit)
-		if err != nil {
-			errCount += 1
-			logger.Errf("checking CRL %q failed: %s", u, err)
-			continue
+		entries := crl.RevokedCertificateEntries
+		if *deltas {
+			deltaURLs, err := delta.GetFreshestCRLURIs(zcrl.Extensions)
+			if err != nil {
+				errCount += 1
+				logger.Errf("getting delta CRL URL for %q failed: %s", u, err)
+				continue
+			}
+			if len(deltaURLs) == 0 {
+				errCount += 1
+				logger.Errf("CRL %q has no delta CRL URL", u)
+				continue
+			}
+
+			deltaCRL, err := downloadShard(deltaURLs[0])
+			if err != nil {
+				errCount += 1
+				logger.Errf("fetching delta CRL %q failed: %s", deltaURLs[0], err)
+				continue
+			}
+
+			if *save {
+				err = saveShard(deltaURLs[0], deltaCRL)
+				if err != nil {
+					logger.Errf("saving delta CRL %q: %s", deltaURLs[0], err)
+					continue
+				}
+			}
+
+			totalBytes += len(deltaCRL.Raw)
+
+			err = checker.ValidateDelta(zcrl, deltaCRL, issuer, ageLimit)
+			if err != nil {
+				errCount += 1
+				logger.Errf("checking CRL %q and its delta %q failed: %s", u, deltaURLs[0], err)
+				continue
+			}
+
+			// ValidateDelta ensures that the delta's entries are disjoint from
+			// its base's, so together they're checked for duplicates across
+			// shards below.
+			entries = append(entries, deltaCRL.RevokedCertificateEntries...)
+		} else {
+			err = checker.Validate(zcrl, issuer, ageLimit)
+			if err != nil {
+				errCount += 1
+				logger.Errf("checking CRL %q failed: %s", u, err)
+				continue
+			}
 		}
 
 		if oldestTimestamp.IsZero() || crl.ThisUpdate.Before(oldestTimestamp) {
 			oldestTimestamp = crl.ThisUpdate
 		}
 
-		for _, c := range crl.RevokedCertificateEntries {
+		for _, c := range entries {
 			serial := core.SerialToString(c.SerialNumber)
 			if _, seen := seenSerials[serial]; seen {
 				errCount += 1
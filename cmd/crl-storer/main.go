@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	awsl "github.com/aws/smithy-go/logging"
 
+	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/crl/storer"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
@@ -35,8 +36,9 @@ type Config struct {
 		// blank by default.
 		S3Endpoint string
 		// S3Bucket is the AWS Bucket that uploads should go to. Must be created
-		// (and have appropriate permissions set) beforehand.
-		S3Bucket string
+		// (and have appropriate permissions set) beforehand. If blank, CRLs are
+		// not uploaded to S3, and LocalDir must be set instead.
+		S3Bucket string `validate:"required_without=LocalDir"`
 		// AWSConfigFile is the path to a file on disk containing an AWS config.
 		// The format of the configuration file is specified at
 		// https://docs.aws.amazon.com/sdkref/latest/guide/file-format.html.
@@ -46,6 +48,19 @@ type Config struct {
 		// https://docs.aws.amazon.com/sdkref/latest/guide/file-format.html.
 		AWSCredsFile string
 
+		// LocalDir is the path to a directory on disk to which CRLs should be
+		// written, in addition to (or instead of) S3. The directory must already
+		// exist. Each CRL is written atomically, alongside a sidecar file holding
+		// its SHA-256 checksum in the format understood by `sha256sum -c`. If
+		// blank, CRLs are not written to disk.
+		LocalDir string `validate:"required_without=S3Bucket"`
+
+		// AkamaiPurgerService, if set, configures the crl-storer to submit the
+		// URL of each CRL it uploads to the akamai-purger service, which purges
+		// it from the CDN in front of the storage backends. If nil, CRLs are not
+		// purged, and stay cached until they expire from the CDN.
+		AkamaiPurgerService *cmd.GRPCClientConfig
+
 		Features features.Config
 	}
 
@@ -105,31 +120,48 @@ func main() {
 		issuers = append(issuers, cert)
 	}
 
-	// Load the "default" AWS configuration, but override the set of config and
-	// credential files it reads from to just those specified in our JSON config,
-	// to ensure that it's not accidentally reading anything from the homedir or
-	// its other default config locations.
-	awsConfig, err := config.LoadDefaultConfig(
-		context.Background(),
-		config.WithSharedConfigFiles([]string{c.CRLStorer.AWSConfigFile}),
-		config.WithSharedCredentialsFiles([]string{c.CRLStorer.AWSCredsFile}),
-		config.WithHTTPClient(new(http.Client)),
-		config.WithLogger(awsLogger{logger}),
-		config.WithClientLogMode(aws.LogRequestEventMessage|aws.LogResponseEventMessage),
-	)
-	cmd.FailOnError(err, "Failed to load AWS config")
-
-	s3opts := make([]func(*s3.Options), 0)
-	if c.CRLStorer.S3Endpoint != "" {
-		s3opts = append(
-			s3opts,
-			s3.WithEndpointResolver(s3.EndpointResolverFromURL(c.CRLStorer.S3Endpoint)),
-			func(o *s3.Options) { o.UsePathStyle = true },
+	var backends []storer.Backend
+	if c.CRLStorer.S3Bucket != "" {
+		// Load the "default" AWS configuration, but override the set of config and
+		// credential files it reads from to just those specified in our JSON config,
+		// to ensure that it's not accidentally reading anything from the homedir or
+		// its other default config locations.
+		awsConfig, err := config.LoadDefaultConfig(
+			context.Background(),
+			config.WithSharedConfigFiles([]string{c.CRLStorer.AWSConfigFile}),
+			config.WithSharedCredentialsFiles([]string{c.CRLStorer.AWSCredsFile}),
+			config.WithHTTPClient(new(http.Client)),
+			config.WithLogger(awsLogger{logger}),
+			config.WithClientLogMode(aws.LogRequestEventMessage|aws.LogResponseEventMessage),
 		)
+		cmd.FailOnError(err, "Failed to load AWS config")
+
+		s3opts := make([]func(*s3.Options), 0)
+		if c.CRLStorer.S3Endpoint != "" {
+			s3opts = append(
+				s3opts,
+				s3.WithEndpointResolver(s3.EndpointResolverFromURL(c.CRLStorer.S3Endpoint)),
+				func(o *s3.Options) { o.UsePathStyle = true },
+			)
+		}
+		s3client := s3.NewFromConfig(awsConfig, s3opts...)
+		backends = append(backends, storer.NewS3Backend(s3client, c.CRLStorer.S3Bucket))
+	}
+
+	if c.CRLStorer.LocalDir != "" {
+		fsBackend, err := storer.NewFilesystemBackend(c.CRLStorer.LocalDir)
+		cmd.FailOnError(err, "Failed to set up local CRL directory")
+		backends = append(backends, fsBackend)
+	}
+
+	var purger akamaipb.AkamaiPurgerClient
+	if c.CRLStorer.AkamaiPurgerService != nil {
+		apConn, err := bgrpc.ClientSetup(c.CRLStorer.AkamaiPurgerService, tlsConfig, scope, clk)
+		cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to akamai-purger service")
+		purger = akamaipb.NewAkamaiPurgerClient(apConn)
 	}
-	s3client := s3.NewFromConfig(awsConfig, s3opts...)
 
-	csi, err := storer.New(issuers, s3client, c.CRLStorer.S3Bucket, scope, logger, clk)
+	csi, err := storer.New(issuers, backends, purger, scope, logger, clk)
 	cmd.FailOnError(err, "Failed to create CRLStorer impl")
 
 	start, err := bgrpc.NewServer(c.CRLStorer.GRPC, logger).Add(
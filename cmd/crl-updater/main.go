@@ -2,9 +2,12 @@ package notmain
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	capb "github.com/letsencrypt/boulder/ca/proto"
@@ -40,6 +43,27 @@ type Config struct {
 		// in CCADB MUST be updated.
 		NumShards int `validate:"min=1"`
 
+		// ShardingStrategies controls how revoked certificates are assigned to
+		// shards. "expiry" maps each certificate to a shard based on its notAfter
+		// date, using ShardWidth. "serial" uses the shard which the CA chose from
+		// a hash of the certificate's serial and embedded in its CRL Distribution
+		// Point; this requires that the CA's issuers set CRLShards to NumShards.
+		// To migrate from "expiry" to "serial", first configure both strategies,
+		// then enable CRLShards in the CA, and finally remove "expiry" once all
+		// certificates issued without a CRL Distribution Point have expired.
+		// After that, revoked certificates which have no serial shard, such as
+		// malformed certificates revoked by an admin, are included in the
+		// highest-numbered shard. Defaults to ["expiry"].
+		ShardingStrategies []string `validate:"omitempty,dive,oneof=expiry serial"`
+
+		// CAConfigFile is the path to the CA's JSON config file. If set, the
+		// updater refuses to start if any issuer it publishes CRLs for has
+		// CRLShards set in the CA's config, unless the "serial" strategy is
+		// enabled and CRLShards equals NumShards. Otherwise, the CA could embed
+		// CRL Distribution Points for shards which the updater never publishes,
+		// or which don't contain the certificate.
+		CAConfigFile string `validate:"omitempty"`
+
 		// ShardWidth is the amount of time (width on a timeline) that a single
 		// shard should cover. Ideally, NumShards*ShardWidth should be an amount of
 		// time noticeably larger than the current longest certificate lifetime,
@@ -74,6 +98,15 @@ type Config struct {
 		// recommend an UpdatePeriod of 6 hours.
 		UpdatePeriod config.Duration
 
+		// DeltaPeriod controls how frequently the crl-updater publishes a delta
+		// CRL for every CRL shard, containing only the certificates revoked since
+		// that shard's most recent full CRL. If zero, the default, no delta CRLs
+		// are published. Otherwise it must be strictly less than the UpdatePeriod,
+		// and the CA's CRL profile must have DeltaCRLs enabled. Each full CRL is
+		// immediately followed by a delta based on it, replacing the shard's
+		// previous delta; in -runOnce mode, those are the only deltas published.
+		DeltaPeriod config.Duration `validate:"-"`
+
 		// UpdateOffset controls the times at which crl-updater runs, to avoid
 		// scheduling the batch job at exactly midnight. The updater runs every
 		// UpdatePeriod, starting from the Unix Epoch plus UpdateOffset, and
@@ -110,6 +143,56 @@ type Config struct {
 	OpenTelemetry cmd.OpenTelemetryConfig
 }
 
+// caConfig is the subset of the CA's config which the crl-updater reads in
+// order to check the CA's CRL sharding.
+type caConfig struct {
+	CA struct {
+		Issuance struct {
+			Issuers []issuance.IssuerConfig
+		}
+	}
+}
+
+// checkCAShards reads the issuers from the CA's config file, and returns an
+// error if the CA assigns certificates from any of the given issuers to
+// serial-hash CRL shards which this updater would not publish correctly.
+func checkCAShards(filename string, issuers []*issuance.Certificate, numShards int, strategies []updater.ShardingStrategy) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	var c caConfig
+	err = json.Unmarshal(data, &c)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	published := make(map[issuance.NameID]bool, len(issuers))
+	for _, issuer := range issuers {
+		published[issuer.NameID()] = true
+	}
+
+	for _, ic := range c.CA.Issuance.Issuers {
+		if ic.CRLShards == 0 {
+			continue
+		}
+		cert, err := issuance.LoadCertificate(ic.Location.CertFile)
+		if err != nil {
+			return fmt.Errorf("loading CA issuer certificate: %w", err)
+		}
+		if !published[cert.NameID()] {
+			continue
+		}
+		if !slices.Contains(strategies, updater.ShardBySerial) {
+			return fmt.Errorf("issuer %q has crlShards set, but the %q sharding strategy is not enabled", cert.Subject.CommonName, updater.ShardBySerial)
+		}
+		if ic.CRLShards != numShards {
+			return fmt.Errorf("issuer %q has crlShards %d, which does not match numShards %d", cert.Subject.CommonName, ic.CRLShards, numShards)
+		}
+	}
+	return nil
+}
+
 func main() {
 	configFile := flag.String("config", "", "File path to the configuration file for this service")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
@@ -154,6 +237,17 @@ func main() {
 	if c.CRLUpdater.UpdateTimeout.Duration == 0 {
 		c.CRLUpdater.UpdateTimeout.Duration = 10 * time.Minute
 	}
+	strategies := []updater.ShardingStrategy{updater.ShardByExpiry}
+	if len(c.CRLUpdater.ShardingStrategies) != 0 {
+		strategies = nil
+		for _, strategy := range c.CRLUpdater.ShardingStrategies {
+			strategies = append(strategies, updater.ShardingStrategy(strategy))
+		}
+	}
+	if c.CRLUpdater.CAConfigFile != "" {
+		err = checkCAShards(c.CRLUpdater.CAConfigFile, issuers, c.CRLUpdater.NumShards, strategies)
+		cmd.FailOnError(err, "CA's CRL sharding is incompatible with this updater")
+	}
 
 	saConn, err := bgrpc.ClientSetup(c.CRLUpdater.SAService, tlsConfig, scope, clk)
 	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
@@ -170,9 +264,11 @@ func main() {
 	u, err := updater.NewUpdater(
 		issuers,
 		c.CRLUpdater.NumShards,
+		strategies,
 		c.CRLUpdater.ShardWidth.Duration,
 		c.CRLUpdater.LookbackPeriod.Duration,
 		c.CRLUpdater.UpdatePeriod.Duration,
+		c.CRLUpdater.DeltaPeriod.Duration,
 		c.CRLUpdater.UpdateTimeout.Duration,
 		c.CRLUpdater.MaxParallelism,
 		c.CRLUpdater.MaxAttempts,
@@ -0,0 +1,54 @@
+package notmain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/letsencrypt/boulder/crl/updater"
+	"github.com/letsencrypt/boulder/issuance"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestCheckCAShards(t *testing.T) {
+	// Write a CA config with one sharded and one unsharded issuer.
+	caConfigFile := filepath.Join(t.TempDir(), "ca.json")
+	err := os.WriteFile(caConfigFile, []byte(fmt.Sprintf(`{
+		"ca": {
+			"issuance": {
+				"issuers": [
+					{"crlShards": 10, "location": {"certFile": %q}},
+					{"location": {"certFile": %q}}
+				]
+			}
+		}
+	}`, "../../test/hierarchy/int-r3.cert.pem", "../../test/hierarchy/int-e1.cert.pem")), 0600)
+	test.AssertNotError(t, err, "writing CA config")
+
+	sharded, err := issuance.LoadCertificate("../../test/hierarchy/int-r3.cert.pem")
+	test.AssertNotError(t, err, "loading issuer")
+	unsharded, err := issuance.LoadCertificate("../../test/hierarchy/int-e1.cert.pem")
+	test.AssertNotError(t, err, "loading issuer")
+	both := []updater.ShardingStrategy{updater.ShardByExpiry, updater.ShardBySerial}
+	expiry := []updater.ShardingStrategy{updater.ShardByExpiry}
+
+	err = checkCAShards(caConfigFile, []*issuance.Certificate{sharded, unsharded}, 10, both)
+	test.AssertNotError(t, err, "matching crlShards")
+
+	err = checkCAShards(caConfigFile, []*issuance.Certificate{sharded}, 5, both)
+	test.AssertError(t, err, "crlShards differing from numShards")
+	test.AssertContains(t, err.Error(), "does not match numShards")
+
+	err = checkCAShards(caConfigFile, []*issuance.Certificate{sharded}, 10, expiry)
+	test.AssertError(t, err, "crlShards without the serial strategy")
+	test.AssertContains(t, err.Error(), "not enabled")
+
+	// Issuers which don't set crlShards, or which this updater doesn't publish
+	// CRLs for, are ignored.
+	err = checkCAShards(caConfigFile, []*issuance.Certificate{unsharded}, 5, expiry)
+	test.AssertNotError(t, err, "only unsharded issuers")
+
+	err = checkCAShards(filepath.Join(t.TempDir(), "does-not-exist.json"), []*issuance.Certificate{sharded}, 10, both)
+	test.AssertError(t, err, "missing CA config")
+}
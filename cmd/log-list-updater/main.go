@@ -0,0 +1,486 @@
+package notmain
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist/schema"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+type Config struct {
+	LogListUpdater struct {
+		// LogListURL is the URL from which to fetch the log list, for example
+		// https://www.gstatic.com/ct/log_list/v3/log_list.json. It must match
+		// Chrome's schema:
+		// https://www.gstatic.com/ct/log_list/v3/log_list_schema.json
+		LogListURL string `validate:"required,url"`
+
+		// SignatureURL is the URL from which to fetch the detached signature
+		// over the log list, for example
+		// https://www.gstatic.com/ct/log_list/v3/log_list.sig.
+		SignatureURL string `validate:"required,url"`
+
+		// PublicKeyFile is the path to the PEM-encoded public key with which
+		// the log list is signed. RSA keys are verified using PKCS #1 v1.5 and
+		// ECDSA keys using ASN.1 signatures, both over a SHA-256 digest.
+		PublicKeyFile string `validate:"required"`
+
+		// CTLogs is the CT configuration of the RA whose log list is being
+		// updated. The fetched list replaces the file at CTLogs.LogListFile
+		// only if every log named in SCTLogs, InfoLogs, and FinalLogs can
+		// still be found in it, and if SCTLogs can still satisfy Chrome's
+		// policy for every certificate expiring within Lookahead.
+		CTLogs ctconfig.CTConfig
+
+		// Lookahead is how far into the future certificate expirations must be
+		// covered by logs from at least two operators. It should be at least
+		// as long as the longest certificate validity period.
+		Lookahead config.Duration `validate:"required"`
+
+		// Timeout is the time allowed for each HTTP request. Defaults to 30s.
+		Timeout config.Duration
+	}
+
+	Syslog cmd.SyslogConfig
+}
+
+// updater fetches, validates, and installs a new log list.
+type updater struct {
+	client       *http.Client
+	logListURL   string
+	signatureURL string
+	pubKey       crypto.PublicKey
+	ctLogs       ctconfig.CTConfig
+	lookahead    time.Duration
+	clk          clock.Clock
+	log          blog.Logger
+}
+
+// fetch returns the body of a successful GET request to the given URL.
+func (u *updater) fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request for %q: %w", url, err)
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %q: http status %d", url, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %w", url, err)
+	}
+	return body, nil
+}
+
+// update fetches the log list and its signature, verifies them, and checks
+// that the new list is newer than the current one and that the RA's
+// configuration can still be satisfied by it. Unless dryRun is true, it then
+// atomically replaces the current log list file with the new one.
+func (u *updater) update(ctx context.Context, dryRun bool) error {
+	data, err := u.fetch(ctx, u.logListURL)
+	if err != nil {
+		return err
+	}
+	sig, err := u.fetch(ctx, u.signatureURL)
+	if err != nil {
+		return err
+	}
+	err = verifySignature(u.pubKey, data, sig)
+	if err != nil {
+		return fmt.Errorf("verifying log list signature: %w", err)
+	}
+
+	path := u.ctLogs.LogListFile
+	current, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("reading current log list: %w", err)
+	}
+	if bytes.Equal(current, data) {
+		u.log.Infof("Log list at %q is up to date", path)
+		return nil
+	}
+	if current != nil {
+		// A validly signed but older list could be served to roll us back to a
+		// set of logs which has since changed, so only ever move forward.
+		err = checkNewer(current, data)
+		if err != nil {
+			return fmt.Errorf("refusing to install new log list: %w", err)
+		}
+	}
+
+	// The new list is written to a temporary file alongside the current one,
+	// both so that it can be parsed by loglist.New and so that it can be
+	// renamed over the current list once it has been validated.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating temporary log list file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	closeErr := tmp.Close()
+	if err != nil || closeErr != nil {
+		return fmt.Errorf("writing temporary log list file: %w", errors.Join(err, closeErr))
+	}
+
+	newList, err := loglist.New(tmp.Name())
+	if err != nil {
+		return err
+	}
+	oldList := make(loglist.List)
+	if current != nil {
+		oldList, err = loglist.New(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	changes := diffLists(oldList, newList)
+	for _, change := range changes {
+		u.log.Infof("Log list change: %s", change)
+	}
+
+	err = u.validate(newList)
+	if err != nil {
+		return fmt.Errorf("refusing to install new log list: %w", err)
+	}
+
+	if dryRun {
+		u.log.Infof("Dry run: not replacing log list at %q with %d change(s)", path, len(changes))
+		return nil
+	}
+
+	// CreateTemp restricts the file to its owner, so give it the mode of the
+	// list it replaces.
+	mode := os.FileMode(0644)
+	info, err := os.Stat(path)
+	if err == nil {
+		mode = info.Mode().Perm()
+	}
+	err = os.Chmod(tmp.Name(), mode)
+	if err != nil {
+		return fmt.Errorf("setting log list file mode: %w", err)
+	}
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("replacing log list: %w", err)
+	}
+	u.log.AuditInfof("Replaced log list at %q with %d change(s)", path, len(changes))
+	return nil
+}
+
+// validate checks that every log configured for use by the RA is present in
+// the given list, and that the logs configured for issuance can supply SCTs
+// from at least two operators for every expiration within the lookahead.
+func (u *updater) validate(list loglist.List) error {
+	sctLogs, err := list.SubsetForPurpose(u.ctLogs.SCTLogs, loglist.Issuance)
+	if err != nil {
+		return fmt.Errorf("sctLogs: %w", err)
+	}
+	_, err = list.SubsetForPurpose(u.ctLogs.InfoLogs, loglist.Informational)
+	if err != nil {
+		return fmt.Errorf("infoLogs: %w", err)
+	}
+	_, err = list.SubsetForPurpose(u.ctLogs.FinalLogs, loglist.Informational)
+	if err != nil {
+		return fmt.Errorf("finalLogs: %w", err)
+	}
+
+	now := u.clk.Now()
+	return checkCoverage(sctLogs, now, now.Add(u.lookahead))
+}
+
+// checkNewer returns an error unless the version and log_list_timestamp of the
+// next log list are both later than those of the current one. A field which is
+// absent from the current list is not checked.
+func checkNewer(current, next []byte) error {
+	var cur, nxt schema.LogListSchemaJson
+	err := json.Unmarshal(current, &cur)
+	if err != nil {
+		return fmt.Errorf("parsing current log list: %w", err)
+	}
+	err = json.Unmarshal(next, &nxt)
+	if err != nil {
+		return fmt.Errorf("parsing new log list: %w", err)
+	}
+
+	if cur.Version != nil {
+		if nxt.Version == nil {
+			return fmt.Errorf("new log list has no version, current version is %q", *cur.Version)
+		}
+		order, err := compareVersions(*nxt.Version, *cur.Version)
+		if err != nil {
+			return err
+		}
+		if order <= 0 {
+			return fmt.Errorf("new log list version %q is not newer than current version %q", *nxt.Version, *cur.Version)
+		}
+	}
+
+	if cur.LogListTimestamp != nil {
+		if nxt.LogListTimestamp == nil {
+			return fmt.Errorf("new log list has no log_list_timestamp, current timestamp is %q", *cur.LogListTimestamp)
+		}
+		curTime, err := time.Parse(time.RFC3339, *cur.LogListTimestamp)
+		if err != nil {
+			return fmt.Errorf("parsing current log_list_timestamp: %w", err)
+		}
+		nxtTime, err := time.Parse(time.RFC3339, *nxt.LogListTimestamp)
+		if err != nil {
+			return fmt.Errorf("parsing new log_list_timestamp: %w", err)
+		}
+		if !nxtTime.After(curTime) {
+			return fmt.Errorf("new log list timestamp %q is not newer than current timestamp %q", *nxt.LogListTimestamp, *cur.LogListTimestamp)
+		}
+	}
+	return nil
+}
+
+// compareVersions compares two dotted numeric log list versions, such as
+// "46.12", returning -1, 0, or 1 as a is older than, equal to, or newer than b.
+// Missing trailing components are treated as zero.
+func compareVersions(a, b string) (int, error) {
+	parse := func(v string) ([]int, error) {
+		var res []int
+		for _, part := range strings.Split(v, ".") {
+			n, err := strconv.Atoi(part)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("malformed log list version %q", v)
+			}
+			res = append(res, n)
+		}
+		return res, nil
+	}
+	av, err := parse(a)
+	if err != nil {
+		return 0, err
+	}
+	bv, err := parse(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range max(len(av), len(bv)) {
+		var x, y int
+		if i < len(av) {
+			x = av[i]
+		}
+		if i < len(bv) {
+			y = bv[i]
+		}
+		if x != y {
+			return cmp.Compare(x, y), nil
+		}
+	}
+	return 0, nil
+}
+
+// verifySignature checks that sig is a valid signature by pubKey over the
+// SHA-256 digest of data.
+func verifySignature(pubKey crypto.PublicKey, data, sig []byte) error {
+	digest := sha256.Sum256(data)
+	switch k := pubKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig)
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], sig) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported public key type %T", pubKey)
+}
+
+// loadPublicKey reads a PEM-encoded PKIX public key from the given file.
+func loadPublicKey(path string) (crypto.PublicKey, error) {
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("no PEM PUBLIC KEY block found in %q", path)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// checkCoverage returns an error if there is any time within [start, end) at
+// which a certificate expiring then could not get SCTs from logs run by at
+// least two distinct operators, as required by Chrome's CT policy. This is
+// the case whenever a temporal shard would be left without a usable log by
+// enough operators. Logs without a temporal interval cover all times.
+func checkCoverage(list loglist.List, start, end time.Time) error {
+	// The number of operators covering a time can only change at the start or
+	// end of some log's interval, so it suffices to check the start of the
+	// window and each of those boundaries within it.
+	points := []time.Time{start}
+	for _, group := range list {
+		for _, log := range group {
+			for _, t := range []time.Time{log.StartInclusive, log.EndExclusive} {
+				if t.After(start) && t.Before(end) {
+					points = append(points, t)
+				}
+			}
+		}
+	}
+	slices.SortFunc(points, func(a, b time.Time) int { return a.Compare(b) })
+	points = slices.CompactFunc(points, func(a, b time.Time) bool { return a.Equal(b) })
+
+	for i, t := range points {
+		var operators int
+		for _, group := range list {
+			if groupCovers(group, t) {
+				operators++
+			}
+		}
+		if operators < 2 {
+			until := end
+			if i+1 < len(points) {
+				until = points[i+1]
+			}
+			return fmt.Errorf("expirations from %s until %s are covered by logs from %d operator(s), need at least 2",
+				t.Format(time.RFC3339), until.Format(time.RFC3339), operators)
+		}
+	}
+	return nil
+}
+
+// groupCovers returns true if any log in the group would accept a certificate
+// expiring at the given time.
+func groupCovers(group loglist.OperatorGroup, t time.Time) bool {
+	for _, log := range group {
+		if log.StartInclusive.IsZero() || log.EndExclusive.IsZero() {
+			return true
+		}
+		if !t.Before(log.StartInclusive) && t.Before(log.EndExclusive) {
+			return true
+		}
+	}
+	return false
+}
+
+// diffLists returns a sorted, human-readable description of each log which
+// was added to, removed from, or changed between the old and new lists.
+func diffLists(oldList, newList loglist.List) []string {
+	type entry struct {
+		operator string
+		log      loglist.Log
+	}
+	flatten := func(list loglist.List) map[string]entry {
+		res := make(map[string]entry)
+		for operator, group := range list {
+			for id, log := range group {
+				res[id] = entry{operator, log}
+			}
+		}
+		return res
+	}
+	oldLogs := flatten(oldList)
+	newLogs := flatten(newList)
+
+	var changes []string
+	for id, o := range oldLogs {
+		if _, ok := newLogs[id]; !ok {
+			changes = append(changes, fmt.Sprintf("log %q (%s) of operator %q removed", o.log.Name, id, o.operator))
+		}
+	}
+	for id, n := range newLogs {
+		o, ok := oldLogs[id]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("log %q (%s) of operator %q added in state %s", n.log.Name, id, n.operator, n.log.State))
+			continue
+		}
+		if o.operator != n.operator {
+			changes = append(changes, fmt.Sprintf("log %q (%s) operator changed from %q to %q", n.log.Name, id, o.operator, n.operator))
+		}
+		if o.log.State != n.log.State {
+			changes = append(changes, fmt.Sprintf("log %q (%s) state changed from %s to %s", n.log.Name, id, o.log.State, n.log.State))
+		}
+		if o.log.Url != n.log.Url {
+			changes = append(changes, fmt.Sprintf("log %q (%s) URL changed from %q to %q", n.log.Name, id, o.log.Url, n.log.Url))
+		}
+		if o.log.Name != n.log.Name {
+			changes = append(changes, fmt.Sprintf("log %q (%s) renamed from %q", n.log.Name, id, o.log.Name))
+		}
+		if !o.log.StartInclusive.Equal(n.log.StartInclusive) || !o.log.EndExclusive.Equal(n.log.EndExclusive) {
+			changes = append(changes, fmt.Sprintf("log %q (%s) temporal interval changed", n.log.Name, id))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+func main() {
+	configFile := flag.String("config", "", "File path to the configuration file for this service")
+	dryRun := flag.Bool("dry-run", false, "Fetch and validate the log list, but don't replace the current one")
+	flag.Parse()
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var c Config
+	err := cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+
+	logger := cmd.NewLogger(c.Syslog)
+	logger.Info(cmd.VersionString())
+
+	pubKey, err := loadPublicKey(c.LogListUpdater.PublicKeyFile)
+	cmd.FailOnError(err, "Loading log list public key")
+
+	timeout := c.LogListUpdater.Timeout.Duration
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+
+	u := &updater{
+		client:       &http.Client{Timeout: timeout},
+		logListURL:   c.LogListUpdater.LogListURL,
+		signatureURL: c.LogListUpdater.SignatureURL,
+		pubKey:       pubKey,
+		ctLogs:       c.LogListUpdater.CTLogs,
+		lookahead:    c.LogListUpdater.Lookahead.Duration,
+		clk:          cmd.Clock(),
+		log:          logger,
+	}
+	err = u.update(context.Background(), *dryRun)
+	cmd.FailOnError(err, "Updating log list")
+}
+
+func init() {
+	cmd.RegisterCommand("log-list-updater", main, &cmd.ConfigValidator{Config: &Config{}})
+}
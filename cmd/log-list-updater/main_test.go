@@ -0,0 +1,414 @@
+package notmain
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// testLog describes a log to be included in a log list built by makeLogList.
+// Its name doubles as its log ID. A zero start or end omits the log's
+// temporal interval.
+type testLog struct {
+	operator string
+	name     string
+	state    string
+	start    time.Time
+	end      time.Time
+}
+
+// makeLogList builds a log list with the given version and log_list_timestamp,
+// either of which is omitted if empty.
+func makeLogList(t *testing.T, version, timestamp string, logs ...testLog) []byte {
+	t.Helper()
+	type operator struct {
+		Name  string           `json:"name"`
+		Email []string         `json:"email"`
+		Logs  []map[string]any `json:"logs"`
+	}
+	var operators []*operator
+	byName := make(map[string]*operator)
+	for _, l := range logs {
+		op, ok := byName[l.operator]
+		if !ok {
+			op = &operator{Name: l.operator, Email: []string{"fake@example.org"}, Logs: []map[string]any{}}
+			byName[l.operator] = op
+			operators = append(operators, op)
+		}
+		log := map[string]any{
+			"description": l.name,
+			"log_id":      l.name,
+			"key":         "key",
+			"url":         "https://" + l.name + ".example.com/",
+			"mmd":         86400,
+			"state":       map[string]any{l.state: map[string]any{"timestamp": "2020-01-01T00:00:00Z"}},
+		}
+		if !l.start.IsZero() && !l.end.IsZero() {
+			log["temporal_interval"] = map[string]any{
+				"start_inclusive": l.start.Format(time.RFC3339),
+				"end_exclusive":   l.end.Format(time.RFC3339),
+			}
+		}
+		op.Logs = append(op.Logs, log)
+	}
+	list := map[string]any{"operators": operators}
+	if version != "" {
+		list["version"] = version
+	}
+	if timestamp != "" {
+		list["log_list_timestamp"] = timestamp
+	}
+	data, err := json.Marshal(list)
+	test.AssertNotError(t, err, "marshalling log list")
+	return data
+}
+
+func TestVerifySignature(t *testing.T) {
+	data := []byte("log list")
+	digest := sha256.Sum256(data)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	test.AssertNotError(t, err, "signing with ECDSA key")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "generating RSA key")
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	test.AssertNotError(t, err, "signing with RSA key")
+
+	test.AssertNotError(t, verifySignature(ecKey.Public(), data, ecSig), "valid ECDSA signature")
+	test.AssertNotError(t, verifySignature(rsaKey.Public(), data, rsaSig), "valid RSA signature")
+	test.AssertError(t, verifySignature(ecKey.Public(), []byte("other"), ecSig), "ECDSA signature over other data")
+	test.AssertError(t, verifySignature(rsaKey.Public(), []byte("other"), rsaSig), "RSA signature over other data")
+	test.AssertError(t, verifySignature(rsaKey.Public(), data, ecSig), "ECDSA signature checked with RSA key")
+	test.AssertError(t, verifySignature("key", data, ecSig), "unsupported key type")
+}
+
+func TestCheckCoverage(t *testing.T) {
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jul := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	next := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		list    loglist.List
+		wantErr string
+	}{
+		{
+			name: "two unsharded operators",
+			list: loglist.List{
+				"A": {"A1": {}},
+				"B": {"B1": {}},
+			},
+		},
+		{
+			name: "one operator",
+			list: loglist.List{
+				"A": {"A1": {}, "A2": {}},
+			},
+			wantErr: "expirations from 2025-03-01T00:00:00Z until 2025-09-01T00:00:00Z are covered by logs from 1 operator(s)",
+		},
+		{
+			name: "contiguous shards",
+			list: loglist.List{
+				"A": {"A1": {StartInclusive: jan, EndExclusive: jul}, "A2": {StartInclusive: jul, EndExclusive: next}},
+				"B": {"B1": {}},
+			},
+		},
+		{
+			name: "missing shard",
+			list: loglist.List{
+				"A": {"A1": {StartInclusive: jan, EndExclusive: jul}},
+				"B": {"B1": {}},
+			},
+			wantErr: "expirations from 2025-07-01T00:00:00Z until 2025-09-01T00:00:00Z are covered by logs from 1 operator(s)",
+		},
+		{
+			name: "shards end before lookahead",
+			list: loglist.List{
+				"A": {"A1": {StartInclusive: jan, EndExclusive: next}},
+				"B": {"B1": {StartInclusive: jan, EndExclusive: jul}},
+			},
+			wantErr: "expirations from 2025-07-01T00:00:00Z until 2025-09-01T00:00:00Z are covered by logs from 1 operator(s)",
+		},
+		{
+			name: "shards start after now",
+			list: loglist.List{
+				"A": {"A1": {StartInclusive: jul, EndExclusive: next}},
+				"B": {"B1": {StartInclusive: jul, EndExclusive: next}},
+			},
+			wantErr: "expirations from 2025-03-01T00:00:00Z until 2025-07-01T00:00:00Z are covered by logs from 0 operator(s)",
+		},
+	}
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkCoverage(tc.list, start, end)
+			if tc.wantErr == "" {
+				test.AssertNotError(t, err, "checking coverage")
+			} else {
+				test.AssertError(t, err, "checking coverage")
+				test.AssertContains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiffLists(t *testing.T) {
+	oldList := loglist.List{
+		"A": {"A1": {Name: "A1", Url: "https://a1/"}, "A2": {Name: "A2"}},
+		"B": {"B1": {Name: "B1"}},
+	}
+	newList := loglist.List{
+		"A": {"A1": {Name: "A1", Url: "https://a1.new/"}},
+		"C": {"B1": {Name: "B1"}, "C1": {Name: "C1"}},
+	}
+	test.AssertDeepEquals(t, diffLists(oldList, newList), []string{
+		`log "A1" (A1) URL changed from "https://a1/" to "https://a1.new/"`,
+		`log "A2" (A2) of operator "A" removed`,
+		`log "B1" (B1) operator changed from "B" to "C"`,
+		`log "C1" (C1) of operator "C" added in state unknown`,
+	})
+	test.AssertEquals(t, len(diffLists(oldList, oldList)), 0)
+}
+
+func TestUpdate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	jul := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	next := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	currentList := makeLogList(t, "10.9", "2025-02-01T00:00:00Z",
+		testLog{"A", "A1", "usable", jan, jul},
+		testLog{"A", "A2", "usable", jul, next},
+		testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+		testLog{"C", "C1", "qualified", time.Time{}, time.Time{}},
+	)
+
+	testCases := []struct {
+		name    string
+		list    []byte
+		badSig  bool
+		dryRun  bool
+		wantErr string
+	}{
+		{
+			name: "unchanged",
+			list: currentList,
+		},
+		{
+			name: "new log",
+			list: makeLogList(t, "10.10", "2025-02-15T00:00:00Z",
+				testLog{"A", "A1", "usable", jan, jul},
+				testLog{"A", "A2", "usable", jul, next},
+				testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+				testLog{"C", "C1", "usable", time.Time{}, time.Time{}},
+				testLog{"D", "D1", "pending", time.Time{}, time.Time{}},
+			),
+		},
+		{
+			name: "dry run",
+			list: makeLogList(t, "10.10", "2025-02-15T00:00:00Z",
+				testLog{"A", "A1", "usable", jan, jul},
+				testLog{"A", "A2", "usable", jul, next},
+				testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+				testLog{"C", "C1", "usable", time.Time{}, time.Time{}},
+			),
+			dryRun: true,
+		},
+		{
+			name:    "bad signature",
+			list:    makeLogList(t, "10.10", "2025-02-15T00:00:00Z", testLog{"B", "B1", "usable", time.Time{}, time.Time{}}),
+			badSig:  true,
+			wantErr: "verifying log list signature",
+		},
+		{
+			name: "configured log removed",
+			list: makeLogList(t, "10.10", "2025-02-15T00:00:00Z",
+				testLog{"A", "A1", "usable", jan, jul},
+				testLog{"A", "A2", "usable", jul, next},
+				testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+			),
+			wantErr: `infoLogs: failed to find logs matching name(s): "C1"`,
+		},
+		{
+			name: "shard retired",
+			list: makeLogList(t, "10.10", "2025-02-15T00:00:00Z",
+				testLog{"A", "A1", "usable", jan, jul},
+				testLog{"A", "A2", "retired", jul, next},
+				testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+				testLog{"C", "C1", "qualified", time.Time{}, time.Time{}},
+			),
+			wantErr: "expirations from 2025-07-01T00:00:00Z until 2025-08-30T00:00:00Z are covered by logs from 1 operator(s)",
+		},
+		{
+			name: "older version",
+			list: makeLogList(t, "10.8", "2025-02-15T00:00:00Z",
+				testLog{"A", "A1", "usable", jan, jul},
+				testLog{"A", "A2", "usable", jul, next},
+				testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+				testLog{"C", "C1", "qualified", time.Time{}, time.Time{}},
+			),
+			wantErr: `new log list version "10.8" is not newer than current version "10.9"`,
+		},
+		{
+			name: "same timestamp",
+			list: makeLogList(t, "10.10", "2025-02-01T00:00:00Z",
+				testLog{"A", "A1", "usable", jan, jul},
+				testLog{"A", "A2", "usable", jul, next},
+				testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+				testLog{"C", "C1", "qualified", time.Time{}, time.Time{}},
+			),
+			wantErr: `new log list timestamp "2025-02-01T00:00:00Z" is not newer than current timestamp`,
+		},
+		{
+			name: "missing version",
+			list: makeLogList(t, "", "2025-02-15T00:00:00Z",
+				testLog{"A", "A1", "usable", jan, jul},
+				testLog{"A", "A2", "usable", jul, next},
+				testLog{"B", "B1", "usable", time.Time{}, time.Time{}},
+				testLog{"C", "C1", "qualified", time.Time{}, time.Time{}},
+			),
+			wantErr: "new log list has no version",
+		},
+		{
+			name:    "malformed list",
+			list:    []byte("{"),
+			wantErr: "parsing new log list",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			digest := sha256.Sum256(tc.list)
+			sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+			test.AssertNotError(t, err, "signing log list")
+			if tc.badSig {
+				sig[len(sig)-1] ^= 1
+			}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/log_list.json":
+					_, _ = w.Write(tc.list)
+				case "/log_list.sig":
+					_, _ = w.Write(sig)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			path := filepath.Join(t.TempDir(), "log_list.json")
+			err = os.WriteFile(path, currentList, 0640)
+			test.AssertNotError(t, err, "writing current log list")
+
+			clk := clock.NewFake()
+			clk.Set(now)
+			u := &updater{
+				client:       srv.Client(),
+				logListURL:   srv.URL + "/log_list.json",
+				signatureURL: srv.URL + "/log_list.sig",
+				pubKey:       key.Public(),
+				ctLogs: ctconfig.CTConfig{
+					LogListFile: path,
+					SCTLogs:     []string{"A1", "A2", "B1"},
+					InfoLogs:    []string{"C1"},
+					FinalLogs:   []string{"B1"},
+				},
+				lookahead: 182 * 24 * time.Hour,
+				clk:       clk,
+				log:       blog.NewMock(),
+			}
+			err = u.update(context.Background(), tc.dryRun)
+
+			installed, readErr := os.ReadFile(path)
+			test.AssertNotError(t, readErr, "reading log list")
+			if tc.wantErr != "" {
+				test.AssertError(t, err, "update should fail")
+				test.AssertContains(t, err.Error(), tc.wantErr)
+				test.AssertByteEquals(t, installed, currentList)
+			} else if tc.dryRun {
+				test.AssertNotError(t, err, "update failed")
+				test.AssertByteEquals(t, installed, currentList)
+			} else {
+				test.AssertNotError(t, err, "update failed")
+				test.AssertByteEquals(t, installed, tc.list)
+				info, err := os.Stat(path)
+				test.AssertNotError(t, err, "stat of log list")
+				test.AssertEquals(t, info.Mode().Perm(), os.FileMode(0640))
+			}
+
+			// No temporary files should be left behind.
+			entries, err := os.ReadDir(filepath.Dir(path))
+			test.AssertNotError(t, err, "reading log list directory")
+			test.AssertEquals(t, len(entries), 1)
+		})
+	}
+
+	// A list which cannot be fetched is an error.
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	u := &updater{
+		client:       srv.Client(),
+		logListURL:   srv.URL + "/log_list.json",
+		signatureURL: srv.URL + "/log_list.sig",
+		log:          blog.NewMock(),
+	}
+	err = u.update(context.Background(), false)
+	test.AssertError(t, err, "update should fail when the list can't be fetched")
+	test.AssertContains(t, err.Error(), "http status 404")
+}
+
+// TestTestConfigs checks that the log list served by ct-test-srv is signed by
+// the key the test configs trust, and satisfies their CT configuration.
+func TestTestConfigs(t *testing.T) {
+	for _, dir := range []string{"../../test/config", "../../test/config-next"} {
+		t.Run(dir, func(t *testing.T) {
+			var c Config
+			err := cmd.ReadConfigFile(filepath.Join(dir, "log-list-updater.json"), &c)
+			test.AssertNotError(t, err, "reading config")
+			conf := c.LogListUpdater
+
+			pubKey, err := loadPublicKey(filepath.Join("../..", conf.PublicKeyFile))
+			test.AssertNotError(t, err, "loading public key")
+			data, err := os.ReadFile("../../test/ct-test-srv/log_list.json")
+			test.AssertNotError(t, err, "reading log list")
+			sig, err := os.ReadFile("../../test/ct-test-srv/log_list.sig")
+			test.AssertNotError(t, err, "reading log list signature")
+			err = verifySignature(pubKey, data, sig)
+			test.AssertNotError(t, err, "log list signature should verify; see test/ct-test-srv/main.go to re-sign")
+
+			list, err := loglist.New(filepath.Join("../..", conf.CTLogs.LogListFile))
+			test.AssertNotError(t, err, "parsing log list")
+			u := &updater{ctLogs: conf.CTLogs, lookahead: conf.Lookahead.Duration, clk: clock.New()}
+			err = u.validate(list)
+			test.AssertNotError(t, err, "validating log list")
+		})
+	}
+}
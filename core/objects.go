@@ -53,15 +53,17 @@ type AcmeChallenge string
 
 // These types are the available challenges
 const (
-	ChallengeTypeHTTP01    = AcmeChallenge("http-01")
-	ChallengeTypeDNS01     = AcmeChallenge("dns-01")
-	ChallengeTypeTLSALPN01 = AcmeChallenge("tls-alpn-01")
+	ChallengeTypeHTTP01       = AcmeChallenge("http-01")
+	ChallengeTypeDNS01        = AcmeChallenge("dns-01")
+	ChallengeTypeTLSALPN01    = AcmeChallenge("tls-alpn-01")
+	ChallengeTypeDNSAccount01 = AcmeChallenge("dns-account-01")
+	ChallengeTypeDNSPersist01 = AcmeChallenge("dns-persist-01")
 )
 
 // IsValid tests whether the challenge is a known challenge
 func (c AcmeChallenge) IsValid() bool {
 	switch c {
-	case ChallengeTypeHTTP01, ChallengeTypeDNS01, ChallengeTypeTLSALPN01:
+	case ChallengeTypeHTTP01, ChallengeTypeDNS01, ChallengeTypeTLSALPN01, ChallengeTypeDNSAccount01, ChallengeTypeDNSPersist01:
 		return true
 	default:
 		return false
@@ -90,6 +92,9 @@ var OCSPStatusToInt = map[OCSPStatus]int{
 // DNSPrefix is attached to DNS names in DNS challenges
 const DNSPrefix = "_acme-challenge"
 
+// DNSPersistPrefix is attached to DNS names in DNS-PERSIST-01 challenges
+const DNSPersistPrefix = "_validation-persist"
+
 type RawCertificateRequest struct {
 	CSR JSONBuffer `json:"csr"` // The encoded CSR
 }
@@ -179,6 +184,11 @@ type Challenge struct {
 	// Contains information about URLs used or redirected to and IPs resolved and
 	// used
 	ValidationRecord []ValidationRecord `json:"validationRecord,omitempty"`
+
+	// IssuerDomainNames lists the issuer domain names which the CA accepts in a
+	// DNS-PERSIST-01 record. It is not stored, and is only populated by the WFE
+	// when presenting a dns-persist-01 challenge to the client.
+	IssuerDomainNames []string `json:"issuer-domain-names,omitempty"`
 }
 
 // ExpectedKeyAuthorization computes the expected KeyAuthorization value for
@@ -226,7 +236,7 @@ func (ch Challenge) RecordsSane() bool {
 			ch.ValidationRecord[0].AddressUsed == nil || len(ch.ValidationRecord[0].AddressesResolved) == 0 {
 			return false
 		}
-	case ChallengeTypeDNS01:
+	case ChallengeTypeDNS01, ChallengeTypeDNSAccount01, ChallengeTypeDNSPersist01:
 		if len(ch.ValidationRecord) > 1 {
 			return false
 		}
@@ -452,7 +462,7 @@ func (window SuggestedWindow) IsWithin(now time.Time) bool {
 }
 
 // RenewalInfo is a type which is exposed to clients which query the renewalInfo
-// endpoint specified in draft-aaron-ari.
+// endpoint specified in RFC 9773.
 type RenewalInfo struct {
 	SuggestedWindow SuggestedWindow `json:"suggestedWindow"`
 	ExplanationURL  string          `json:"explanationURL,omitempty"`
@@ -475,11 +485,11 @@ func RenewalInfoSimple(issued time.Time, expires time.Time) RenewalInfo {
 }
 
 // RenewalInfoImmediate constructs a `RenewalInfo` object with a suggested
-// window in the past. Per the draft-ietf-acme-ari-01 spec, clients should
-// attempt to renew immediately if the suggested window is in the past. The
-// passed `now` is assumed to be a timestamp representing the current moment in
-// time. The `explanationURL` is an optional URL that the subscriber can use to
-// learn more about why the renewal is suggested.
+// window in the past. Per RFC 9773, clients should attempt to renew
+// immediately if the suggested window is in the past. The passed `now` is
+// assumed to be a timestamp representing the current moment in time. The
+// `explanationURL` is an optional URL that the subscriber can use to learn
+// more about why the renewal is suggested.
 func RenewalInfoImmediate(now time.Time, explanationURL string) RenewalInfo {
 	oneHourAgo := now.Add(-1 * time.Hour)
 	return RenewalInfo{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -21,7 +22,6 @@ import (
 	"path"
 	"reflect"
 	"regexp"
-	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -148,6 +148,12 @@ func PublicKeysEqual(a, b crypto.PublicKey) (bool, error) {
 		return ak.Equal(b), nil
 	case *ecdsa.PublicKey:
 		return ak.Equal(b), nil
+	case ed25519.PublicKey:
+		return ak.Equal(b), nil
+	case interface{ Equal(crypto.PublicKey) bool }:
+		// Other key types which implement the informal extended
+		// crypto.PublicKey interface, such as *mldsa.PublicKey.
+		return ak.Equal(b), nil
 	default:
 		return false, fmt.Errorf("unsupported public key type %T", ak)
 	}
@@ -231,6 +237,10 @@ func IsAnyNilOrZero(vals ...interface{}) bool {
 			if len(v) == 0 {
 				return true
 			}
+		case identifier.ACMEIdentifiers:
+			if len(v) == 0 {
+				return true
+			}
 		case byte:
 			// Byte is an alias for uint8 and will cover that case.
 			if v == 0 {
@@ -322,21 +332,6 @@ func UniqueLowerNames(names []string) (unique []string) {
 	return
 }
 
-// NormalizeIdentifiers returns the set of all unique ACME identifiers in the
-// input after all of them are lowercased. The returned identifier values will
-// be in their lowercased form and sorted alphabetically by value.
-func NormalizeIdentifiers(identifiers []identifier.ACMEIdentifier) []identifier.ACMEIdentifier {
-	for i := range identifiers {
-		identifiers[i].Value = strings.ToLower(identifiers[i].Value)
-	}
-
-	sort.Slice(identifiers, func(i, j int) bool {
-		return fmt.Sprintf("%s:%s", identifiers[i].Type, identifiers[i].Value) < fmt.Sprintf("%s:%s", identifiers[j].Type, identifiers[j].Value)
-	})
-
-	return slices.Compact(identifiers)
-}
-
 // HashNames returns a hash of the names requested. This is intended for use
 // when interacting with the orderFqdnSets table and rate limiting.
 func HashNames(names []string) []byte {
@@ -345,6 +340,14 @@ func HashNames(names []string) []byte {
 	return hash[:]
 }
 
+// HashIdentifiers returns a hash of the identifiers requested. This is intended
+// for use when interacting with the orderFqdnSets table and rate limiting. The
+// hash covers only identifier values, so that sets containing only DNS
+// identifiers hash identically to the same names passed to HashNames.
+func HashIdentifiers(idents identifier.ACMEIdentifiers) []byte {
+	return HashNames(idents.ToValues())
+}
+
 // LoadCert loads a PEM certificate specified by filename or returns an error
 func LoadCert(filename string) (*x509.Certificate, error) {
 	certPEM, err := os.ReadFile(filename)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"net/netip"
 	"os"
 	"sort"
 	"strings"
@@ -255,24 +256,15 @@ func TestUniqueLowerNames(t *testing.T) {
 	test.AssertDeepEquals(t, []string{"a.com", "bar.com", "baz.com", "foobar.com"}, u)
 }
 
-func TestNormalizeIdentifiers(t *testing.T) {
-	identifiers := []identifier.ACMEIdentifier{
-		{Type: "DNS", Value: "foobar.com"},
-		{Type: "DNS", Value: "fooBAR.com"},
-		{Type: "DNS", Value: "baz.com"},
-		{Type: "DNS", Value: "foobar.com"},
-		{Type: "DNS", Value: "bar.com"},
-		{Type: "DNS", Value: "bar.com"},
-		{Type: "DNS", Value: "a.com"},
-	}
-	expected := []identifier.ACMEIdentifier{
-		{Type: "DNS", Value: "a.com"},
-		{Type: "DNS", Value: "bar.com"},
-		{Type: "DNS", Value: "baz.com"},
-		{Type: "DNS", Value: "foobar.com"},
+func TestHashIdentifiers(t *testing.T) {
+	dnsOnly := identifier.NewDNSSlice([]string{"a.com", "b.com"})
+	test.AssertByteEquals(t, HashIdentifiers(dnsOnly), HashNames([]string{"b.com", "a.com"}))
+
+	mixed := identifier.ACMEIdentifiers{
+		identifier.NewDNS("a.com"),
+		identifier.NewIP(netip.MustParseAddr("10.0.0.1")),
 	}
-	u := NormalizeIdentifiers(identifiers)
-	test.AssertDeepEquals(t, expected, u)
+	test.AssertByteEquals(t, HashIdentifiers(mixed), HashNames([]string{"10.0.0.1", "a.com"}))
 }
 
 func TestValidSerial(t *testing.T) {
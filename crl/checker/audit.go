@@ -0,0 +1,116 @@
+package checker
+
+import (
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/revocation"
+)
+
+// Mismatch describes a CRL entry whose contents disagree with the revocation
+// information held by the SA.
+type Mismatch struct {
+	Serial   string
+	Expected string
+	Actual   string
+}
+
+// AuditResult describes the ways in which a set of published CRLs disagrees
+// with the set of revoked certificates known to the SA. All lists are sorted by
+// serial.
+type AuditResult struct {
+	// Missing holds serials which the SA says are revoked, but which do not
+	// appear in any CRL.
+	Missing []string
+	// Duplicated holds serials which appear more than once across all CRLs,
+	// whether in multiple shards or repeatedly within a single shard.
+	Duplicated []string
+	// WrongReason holds entries whose revocation reason differs from the SA's.
+	WrongReason []Mismatch
+	// WrongDate holds entries whose revocation date differs from the SA's.
+	WrongDate []Mismatch
+}
+
+// OK returns true if no discrepancies were found.
+func (r *AuditResult) OK() bool {
+	return len(r.Missing) == 0 && len(r.Duplicated) == 0 && len(r.WrongReason) == 0 && len(r.WrongDate) == 0
+}
+
+// Audit compares the given revoked certificates, as returned by the SA's
+// GetRevokedCerts, against the entries of the given CRLs. Every revoked
+// certificate must appear in exactly one CRL, with the same reason and
+// revocation date. Entries present in the CRLs but not in revoked are ignored,
+// since the CRLs may legitimately include certificates which the SA query did
+// not cover (for instance, those which have recently expired). Revocation dates
+// are compared at one-second granularity, the precision of a CRL entry's
+// revocationDate.
+func Audit(revoked []*corepb.CRLEntry, crls []*x509.RevocationList) (*AuditResult, error) {
+	published := make(map[string][]x509.RevocationListEntry)
+	for _, crl := range crls {
+		for _, entry := range crl.RevokedCertificateEntries {
+			serial := core.SerialToString(entry.SerialNumber)
+			published[serial] = append(published[serial], entry)
+		}
+	}
+
+	res := &AuditResult{}
+	for serial, entries := range published {
+		if len(entries) > 1 {
+			res.Duplicated = append(res.Duplicated, serial)
+		}
+	}
+
+	for _, want := range revoked {
+		if want.RevokedAt == nil {
+			return nil, fmt.Errorf("revoked cert %q has no revocation date", want.Serial)
+		}
+
+		entries, ok := published[want.Serial]
+		if !ok {
+			res.Missing = append(res.Missing, want.Serial)
+			continue
+		}
+
+		// If the serial is duplicated, it's enough to report the first entry's
+		// discrepancies; the duplication is reported separately.
+		got := entries[0]
+		if got.ReasonCode != int(want.Reason) {
+			res.WrongReason = append(res.WrongReason, Mismatch{
+				Serial:   want.Serial,
+				Expected: reasonString(int(want.Reason)),
+				Actual:   reasonString(got.ReasonCode),
+			})
+		}
+
+		wantDate := want.RevokedAt.AsTime().Truncate(time.Second)
+		gotDate := got.RevocationTime.Truncate(time.Second)
+		if !wantDate.Equal(gotDate) {
+			res.WrongDate = append(res.WrongDate, Mismatch{
+				Serial:   want.Serial,
+				Expected: wantDate.UTC().Format(time.RFC3339),
+				Actual:   gotDate.UTC().Format(time.RFC3339),
+			})
+		}
+	}
+
+	sort.Strings(res.Missing)
+	sort.Strings(res.Duplicated)
+	sort.Slice(res.WrongReason, func(i, j int) bool { return res.WrongReason[i].Serial < res.WrongReason[j].Serial })
+	sort.Slice(res.WrongDate, func(i, j int) bool { return res.WrongDate[i].Serial < res.WrongDate[j].Serial })
+
+	return res, nil
+}
+
+// reasonString returns the human-readable name of a revocation reason code,
+// falling back to the bare number for unrecognized codes.
+func reasonString(code int) string {
+	name, ok := revocation.ReasonToString[revocation.Reason(code)]
+	if !ok {
+		return fmt.Sprintf("%d", code)
+	}
+	return name
+}
@@ -0,0 +1,67 @@
+package checker
+
+import (
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestAudit(t *testing.T) {
+	t.Parallel()
+
+	revokedAt := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	serial := func(i int64) string {
+		return core.SerialToString(big.NewInt(i))
+	}
+	revoked := []*corepb.CRLEntry{
+		// Correct, despite sub-second precision in the SA.
+		{Serial: serial(1), Reason: 1, RevokedAt: timestamppb.New(revokedAt.Add(500 * time.Millisecond))},
+		// Missing from all CRLs.
+		{Serial: serial(2), Reason: 1, RevokedAt: timestamppb.New(revokedAt)},
+		// Wrong reason.
+		{Serial: serial(3), Reason: 1, RevokedAt: timestamppb.New(revokedAt)},
+		// Wrong date.
+		{Serial: serial(4), Reason: 4, RevokedAt: timestamppb.New(revokedAt)},
+		// Duplicated across shards.
+		{Serial: serial(5), Reason: 5, RevokedAt: timestamppb.New(revokedAt)},
+	}
+	crls := []*x509.RevocationList{
+		{
+			RevokedCertificateEntries: []x509.RevocationListEntry{
+				{SerialNumber: big.NewInt(1), ReasonCode: 1, RevocationTime: revokedAt},
+				{SerialNumber: big.NewInt(3), ReasonCode: 4, RevocationTime: revokedAt},
+				{SerialNumber: big.NewInt(5), ReasonCode: 5, RevocationTime: revokedAt},
+			},
+		},
+		{
+			RevokedCertificateEntries: []x509.RevocationListEntry{
+				{SerialNumber: big.NewInt(4), ReasonCode: 4, RevocationTime: revokedAt.Add(time.Hour)},
+				{SerialNumber: big.NewInt(5), ReasonCode: 5, RevocationTime: revokedAt},
+				// Not known to the SA, so not reported.
+				{SerialNumber: big.NewInt(6), ReasonCode: 1, RevocationTime: revokedAt},
+			},
+		},
+	}
+
+	res, err := Audit(revoked, crls)
+	test.AssertNotError(t, err, "auditing CRLs")
+	test.Assert(t, !res.OK(), "audit should have found discrepancies")
+	test.AssertDeepEquals(t, res.Missing, []string{serial(2)})
+	test.AssertDeepEquals(t, res.Duplicated, []string{serial(5)})
+	test.AssertDeepEquals(t, res.WrongReason, []Mismatch{{Serial: serial(3), Expected: "keyCompromise", Actual: "superseded"}})
+	test.AssertDeepEquals(t, res.WrongDate, []Mismatch{{Serial: serial(4), Expected: "2024-01-01T12:00:00Z", Actual: "2024-01-01T13:00:00Z"}})
+
+	res, err = Audit(revoked[:1], crls[:1])
+	test.AssertNotError(t, err, "auditing CRLs")
+	test.Assert(t, res.OK(), "audit should have found no discrepancies")
+
+	_, err = Audit([]*corepb.CRLEntry{{Serial: serial(1), Reason: 1}}, crls)
+	test.AssertError(t, err, "auditing entry without revocation date")
+}
@@ -3,14 +3,20 @@ package checker
 import (
 	"bytes"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	zlint_x509 "github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
 
+	"github.com/letsencrypt/boulder/crl/delta"
+	"github.com/letsencrypt/boulder/crl/idp"
 	"github.com/letsencrypt/boulder/linter"
 )
 
@@ -19,12 +25,110 @@ import (
 // less than ageLimit old. It returns an error if any of these conditions are
 // not met.
 func Validate(crl *x509.RevocationList, issuer *x509.Certificate, ageLimit time.Duration) error {
+	return validate(crl, issuer, ageLimit, lint.GlobalRegistry())
+}
+
+// ValidateDelta validates both a full CRL and its delta CRL as Validate does,
+// except that neither is required to be a non-delta CRL. It also checks that
+// the two are consistent with each other: that the delta is based on the full
+// CRL, covers the same scope, is newer, and contains no entries already in the
+// full CRL. It returns an error if any of these conditions are not met.
+func ValidateDelta(base, deltaCRL *x509.RevocationList, issuer *x509.Certificate, ageLimit time.Duration) error {
+	reg, err := linter.NewRegistry([]string{"e_crl_is_not_delta"})
+	if err != nil {
+		return fmt.Errorf("creating lint registry: %w", err)
+	}
+
+	err = validate(base, issuer, ageLimit, reg)
+	if err != nil {
+		return fmt.Errorf("validating base CRL: %w", err)
+	}
+
+	err = validate(deltaCRL, issuer, ageLimit, reg)
+	if err != nil {
+		return fmt.Errorf("validating delta CRL: %w", err)
+	}
+
+	baseBase, err := delta.GetBaseNumber(base.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting base CRL's delta CRL indicator: %w", err)
+	}
+	if baseBase != nil {
+		return errors.New("base CRL is itself a delta CRL")
+	}
+
+	freshest, err := delta.GetFreshestCRLURIs(base.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting base CRL's freshest CRL: %w", err)
+	}
+	if len(freshest) == 0 {
+		return errors.New("base CRL has no freshest CRL extension")
+	}
+
+	baseNumber, err := delta.GetBaseNumber(deltaCRL.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting delta CRL indicator: %w", err)
+	}
+	if baseNumber == nil {
+		return errors.New("delta CRL has no delta CRL indicator")
+	}
+	if baseNumber.Cmp(base.Number) != 0 {
+		return fmt.Errorf("delta CRL is based on CRL %d, not %d", baseNumber, base.Number)
+	}
+
+	deltaFreshest, err := delta.GetFreshestCRLURIs(deltaCRL.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting delta CRL's freshest CRL: %w", err)
+	}
+	if deltaFreshest != nil {
+		return errors.New("delta CRL has a freshest CRL extension")
+	}
+
+	if !bytes.Equal(base.AuthorityKeyId, deltaCRL.AuthorityKeyId) {
+		return errors.New("CRLs were not issued by same issuer")
+	}
+
+	if deltaCRL.Number.Cmp(base.Number) <= 0 || !deltaCRL.ThisUpdate.After(base.ThisUpdate) {
+		return errors.New("base CRL does not precede delta CRL")
+	}
+
+	baseURIs, err := idp.GetIDPURIs(base.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting base CRL's IDP: %w", err)
+	}
+	deltaURIs, err := idp.GetIDPURIs(deltaCRL.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting delta CRL's IDP: %w", err)
+	}
+	if !slices.Equal(baseURIs, deltaURIs) {
+		return fmt.Errorf("delta CRL's IDP does not match base: %v != %v", deltaURIs, baseURIs)
+	}
+
+	baseSerials := make(map[string]struct{}, len(base.RevokedCertificateEntries))
+	for _, rc := range base.RevokedCertificateEntries {
+		baseSerials[rc.SerialNumber.String()] = struct{}{}
+	}
+	for _, rc := range deltaCRL.RevokedCertificateEntries {
+		if _, ok := baseSerials[rc.SerialNumber.String()]; ok {
+			return fmt.Errorf("serial %x is in both base and delta CRLs", rc.SerialNumber)
+		}
+		if rc.RevocationTime.Before(base.ThisUpdate) {
+			return fmt.Errorf("serial %x was revoked at %s, before the base CRL", rc.SerialNumber, rc.RevocationTime)
+		}
+	}
+
+	return nil
+}
+
+// validate implements Validate and ValidateDelta, using the given lint
+// registry.
+func validate(crl *x509.RevocationList, issuer *x509.Certificate, ageLimit time.Duration, reg lint.Registry) error {
 	zcrl, err := zlint_x509.ParseRevocationList(crl.Raw)
 	if err != nil {
 		return fmt.Errorf("parsing CRL: %w", err)
 	}
 
-	err = linter.ProcessResultSet(zlint.LintRevocationList(zcrl))
+	err = linter.ProcessResultSet(zlint.LintRevocationListEx(zcrl, reg))
 	if err != nil {
 		return fmt.Errorf("linting CRL: %w", err)
 	}
@@ -55,29 +159,112 @@ type diffResult struct {
 // be given in the correct order (the "old" CRL's Number and ThisUpdate must
 // both precede the "new" CRL's).
 func Diff(old, new *x509.RevocationList) (*diffResult, error) {
+	err := checkPrecedes(old, new)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffEntries(old.RevokedCertificateEntries, new.RevokedCertificateEntries), nil
+}
+
+// DiffGenerations returns the sets of serials that were added and removed
+// between two generations of an issuer's sharded CRLs. Each generation must
+// contain exactly one full CRL per Issuing Distribution Point, and each shard
+// in the "old" generation must precede its counterpart in the "new" generation,
+// as required by Diff. Delta CRLs in either generation are ignored. Serials
+// which move between shards are not reported.
+func DiffGenerations(old, new []*x509.RevocationList) (*diffResult, error) {
+	oldShards, err := shardsByIDP(old)
+	if err != nil {
+		return nil, fmt.Errorf("indexing old generation: %w", err)
+	}
+	newShards, err := shardsByIDP(new)
+	if err != nil {
+		return nil, fmt.Errorf("indexing new generation: %w", err)
+	}
+	if len(oldShards) != len(newShards) {
+		return nil, fmt.Errorf("generations have different numbers of shards: %d != %d", len(oldShards), len(newShards))
+	}
+
+	var oldEntries, newEntries []x509.RevocationListEntry
+	for uris, newCRL := range newShards {
+		oldCRL, ok := oldShards[uris]
+		if !ok {
+			return nil, fmt.Errorf("shard %q has no counterpart in old generation", uris)
+		}
+		err = checkPrecedes(oldCRL, newCRL)
+		if err != nil {
+			return nil, fmt.Errorf("shard %q: %w", uris, err)
+		}
+		oldEntries = append(oldEntries, oldCRL.RevokedCertificateEntries...)
+		newEntries = append(newEntries, newCRL.RevokedCertificateEntries...)
+	}
+
+	return diffEntries(oldEntries, newEntries), nil
+}
+
+// shardsByIDP maps each of the given full CRLs by its Issuing Distribution
+// Point URIs, returning an error if any CRL lacks an IDP or if two CRLs share
+// one. Delta CRLs are skipped, since each shares the IDP of its base.
+func shardsByIDP(crls []*x509.RevocationList) (map[string]*x509.RevocationList, error) {
+	res := make(map[string]*x509.RevocationList, len(crls))
+	for _, crl := range crls {
+		baseNumber, err := delta.GetBaseNumber(crl.Extensions)
+		if err != nil {
+			return nil, fmt.Errorf("getting delta CRL indicator: %w", err)
+		}
+		if baseNumber != nil {
+			continue
+		}
+
+		uris, err := idp.GetIDPURIs(crl.Extensions)
+		if err != nil {
+			return nil, fmt.Errorf("getting IDP: %w", err)
+		}
+		if len(uris) == 0 {
+			return nil, fmt.Errorf("CRL number %d has no IDP URIs", crl.Number)
+		}
+		key := strings.Join(uris, " ")
+		if _, ok := res[key]; ok {
+			return nil, fmt.Errorf("multiple CRLs with IDP %q", key)
+		}
+		res[key] = crl
+	}
+	return res, nil
+}
+
+// checkPrecedes returns an error unless the two CRLs come from the same issuer
+// and the old CRL's Number and ThisUpdate both precede the new CRL's.
+func checkPrecedes(old, new *x509.RevocationList) error {
 	if !bytes.Equal(old.AuthorityKeyId, new.AuthorityKeyId) {
-		return nil, fmt.Errorf("CRLs were not issued by same issuer")
+		return fmt.Errorf("CRLs were not issued by same issuer")
 	}
 
 	if !old.ThisUpdate.Before(new.ThisUpdate) {
-		return nil, fmt.Errorf("old CRL does not precede new CRL")
+		return fmt.Errorf("old CRL does not precede new CRL")
 	}
 
 	if old.Number.Cmp(new.Number) >= 0 {
-		return nil, fmt.Errorf("old CRL does not precede new CRL")
+		return fmt.Errorf("old CRL does not precede new CRL")
 	}
 
+	return nil
+}
+
+// diffEntries returns the serials which appear in newEntries but not
+// oldEntries (added), and vice versa (removed).
+func diffEntries(oldEntries, newEntries []x509.RevocationListEntry) *diffResult {
 	// Sort both sets of serials so we can march through them in order.
-	oldSerials := make([]*big.Int, len(old.RevokedCertificateEntries))
-	for i, rc := range old.RevokedCertificateEntries {
+	oldSerials := make([]*big.Int, len(oldEntries))
+	for i, rc := range oldEntries {
 		oldSerials[i] = rc.SerialNumber
 	}
 	sort.Slice(oldSerials, func(i, j int) bool {
 		return oldSerials[i].Cmp(oldSerials[j]) < 0
 	})
 
-	newSerials := make([]*big.Int, len(new.RevokedCertificateEntries))
-	for j, rc := range new.RevokedCertificateEntries {
+	newSerials := make([]*big.Int, len(newEntries))
+	for j, rc := range newEntries {
 		newSerials[j] = rc.SerialNumber
 	}
 	sort.Slice(newSerials, func(i, j int) bool {
@@ -112,5 +299,5 @@ func Diff(old, new *x509.RevocationList) (*diffResult, error) {
 		}
 	}
 
-	return &diffResult{added, removed}, nil
+	return &diffResult{added, removed}
 }
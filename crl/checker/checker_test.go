@@ -12,6 +12,7 @@ import (
 
 	"github.com/jmhodges/clock"
 
+	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/issuance"
 	"github.com/letsencrypt/boulder/test"
@@ -115,3 +116,198 @@ func TestDiff(t *testing.T) {
 	test.AssertEquals(t, len(res.Added), 1)
 	test.AssertEquals(t, len(res.Removed), 1)
 }
+
+func TestDiffGenerations(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	issuer, err := issuance.LoadIssuer(
+		issuance.IssuerConfig{
+			Location: issuance.IssuerLoc{
+				File:     "../../test/hierarchy/int-e1.key.pem",
+				CertFile: "../../test/hierarchy/int-e1.cert.pem",
+			},
+			IssuerURL:  "http://not-example.com/issuer-url",
+			OCSPURL:    "http://not-example.com/ocsp",
+			CRLURLBase: "http://not-example.com/crl/",
+		}, clk)
+	test.AssertNotError(t, err, "loading test issuer")
+
+	profile, err := issuance.NewCRLProfile(issuance.CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+	})
+	test.AssertNotError(t, err, "creating test CRL profile")
+
+	issue := func(shard int64, thisUpdate time.Time, serials ...int64) *x509.RevocationList {
+		t.Helper()
+		var entries []x509.RevocationListEntry
+		for _, serial := range serials {
+			entries = append(entries, x509.RevocationListEntry{
+				SerialNumber:   big.NewInt(serial),
+				RevocationTime: thisUpdate.Add(-time.Hour),
+			})
+		}
+		der, err := issuer.IssueCRL(profile, &issuance.CRLRequest{
+			Number:     big.NewInt(thisUpdate.UnixNano()),
+			Shard:      shard,
+			ThisUpdate: thisUpdate,
+			Entries:    entries,
+		})
+		test.AssertNotError(t, err, "issuing test crl")
+		crl, err := x509.ParseRevocationList(der)
+		test.AssertNotError(t, err, "parsing test crl")
+		return crl
+	}
+
+	oldTime := clk.Now().Add(-time.Hour)
+	newTime := clk.Now()
+	old := []*x509.RevocationList{issue(1, oldTime, 1, 2), issue(2, oldTime, 3)}
+	new := []*x509.RevocationList{issue(1, newTime, 1), issue(2, newTime, 3, 4)}
+
+	res, err := DiffGenerations(old, new)
+	test.AssertNotError(t, err, "diffing generations")
+	test.AssertDeepEquals(t, res.Added, []*big.Int{big.NewInt(4)})
+	test.AssertDeepEquals(t, res.Removed, []*big.Int{big.NewInt(2)})
+
+	// Generations must be given in order.
+	_, err = DiffGenerations(new, old)
+	test.AssertError(t, err, "diffing generations in the wrong order")
+	test.AssertContains(t, err.Error(), "does not precede")
+
+	// Every shard must have a counterpart in the other generation.
+	_, err = DiffGenerations(old, []*x509.RevocationList{issue(1, newTime, 1), issue(3, newTime, 3)})
+	test.AssertError(t, err, "diffing generations with mismatched shards")
+	test.AssertContains(t, err.Error(), "no counterpart")
+
+	_, err = DiffGenerations(old, new[:1])
+	test.AssertError(t, err, "diffing generations with different shard counts")
+	test.AssertContains(t, err.Error(), "different numbers of shards")
+
+	// Shards must not be repeated within a generation.
+	_, err = DiffGenerations(old, []*x509.RevocationList{issue(1, newTime, 1), issue(1, newTime, 3)})
+	test.AssertError(t, err, "diffing generation with repeated shard")
+	test.AssertContains(t, err.Error(), "multiple CRLs")
+
+	// Delta CRLs, saved alongside their bases, are ignored.
+	deltaProfile, err := issuance.NewCRLProfile(issuance.CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+		DeltaCRLs:        true,
+	})
+	test.AssertNotError(t, err, "creating test delta CRL profile")
+	deltaTime := newTime.Add(-time.Minute)
+	der, err := issuer.IssueCRL(deltaProfile, &issuance.CRLRequest{
+		Number:     big.NewInt(deltaTime.UnixNano()),
+		Shard:      1,
+		BaseNumber: old[0].Number,
+		ThisUpdate: deltaTime,
+		Entries: []x509.RevocationListEntry{
+			{SerialNumber: big.NewInt(5), RevocationTime: deltaTime.Add(-time.Minute)},
+		},
+	})
+	test.AssertNotError(t, err, "issuing test delta crl")
+	deltaCRL, err := x509.ParseRevocationList(der)
+	test.AssertNotError(t, err, "parsing test delta crl")
+
+	res, err = DiffGenerations(append(old, deltaCRL), new)
+	test.AssertNotError(t, err, "diffing generations with a delta")
+	test.AssertDeepEquals(t, res.Added, []*big.Int{big.NewInt(4)})
+	test.AssertDeepEquals(t, res.Removed, []*big.Int{big.NewInt(2)})
+}
+
+func TestValidateDelta(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	issuer, err := issuance.LoadIssuer(
+		issuance.IssuerConfig{
+			Location: issuance.IssuerLoc{
+				File:     "../../test/hierarchy/int-e1.key.pem",
+				CertFile: "../../test/hierarchy/int-e1.cert.pem",
+			},
+			IssuerURL:  "http://not-example.com/issuer-url",
+			OCSPURL:    "http://not-example.com/ocsp",
+			CRLURLBase: "http://not-example.com/crl/",
+		}, clk)
+	test.AssertNotError(t, err, "loading test issuer")
+
+	profile, err := issuance.NewCRLProfile(issuance.CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+		DeltaCRLs:        true,
+	})
+	test.AssertNotError(t, err, "creating test CRL profile")
+
+	issue := func(req *issuance.CRLRequest) *x509.RevocationList {
+		t.Helper()
+		der, err := issuer.IssueCRL(profile, req)
+		test.AssertNotError(t, err, "issuing test crl")
+		crl, err := x509.ParseRevocationList(der)
+		test.AssertNotError(t, err, "parsing test crl")
+		return crl
+	}
+
+	baseTime := clk.Now().Add(-time.Hour)
+	base := issue(&issuance.CRLRequest{
+		Number:     big.NewInt(baseTime.UnixNano()),
+		Shard:      1,
+		ThisUpdate: baseTime,
+		Entries: []x509.RevocationListEntry{
+			{SerialNumber: big.NewInt(1), RevocationTime: baseTime.Add(-time.Hour), ReasonCode: 1},
+		},
+	})
+
+	deltaTime := clk.Now()
+	deltaReq := issuance.CRLRequest{
+		Number:     big.NewInt(deltaTime.UnixNano()),
+		Shard:      1,
+		BaseNumber: base.Number,
+		ThisUpdate: deltaTime,
+		Entries: []x509.RevocationListEntry{
+			{SerialNumber: big.NewInt(2), RevocationTime: baseTime.Add(time.Minute), ReasonCode: 1},
+		},
+	}
+	deltaCRL := issue(&deltaReq)
+
+	err = ValidateDelta(base, deltaCRL, issuer.Cert.Certificate, 24*time.Hour)
+	test.AssertNotError(t, err, "validating consistent base and delta")
+
+	// Neither CRL passes plain validation, since they're part of a delta pair.
+	err = Validate(base, issuer.Cert.Certificate, 24*time.Hour)
+	test.AssertError(t, err, "validating base without deltas")
+	test.AssertContains(t, err.Error(), "linting")
+
+	// The base and delta can't be swapped.
+	err = ValidateDelta(deltaCRL, base, issuer.Cert.Certificate, 24*time.Hour)
+	test.AssertError(t, err, "validating swapped base and delta")
+	test.AssertContains(t, err.Error(), "base CRL is itself a delta CRL")
+
+	// The delta must be based on this base.
+	req := deltaReq
+	req.BaseNumber = big.NewInt(baseTime.UnixNano() - 1)
+	err = ValidateDelta(base, issue(&req), issuer.Cert.Certificate, 24*time.Hour)
+	test.AssertError(t, err, "validating delta with wrong base")
+	test.AssertContains(t, err.Error(), "delta CRL is based on CRL")
+
+	// The delta must cover the same shard.
+	req = deltaReq
+	req.Shard = 2
+	err = ValidateDelta(base, issue(&req), issuer.Cert.Certificate, 24*time.Hour)
+	test.AssertError(t, err, "validating delta for wrong shard")
+	test.AssertContains(t, err.Error(), "IDP does not match base")
+
+	// The delta must not repeat entries from the base.
+	req = deltaReq
+	req.Entries = append(req.Entries, base.RevokedCertificateEntries[0])
+	err = ValidateDelta(base, issue(&req), issuer.Cert.Certificate, 24*time.Hour)
+	test.AssertError(t, err, "validating delta repeating base entries")
+	test.AssertContains(t, err.Error(), "in both base and delta CRLs")
+
+	// The delta must only contain entries revoked since the base.
+	req = deltaReq
+	req.Entries = []x509.RevocationListEntry{
+		{SerialNumber: big.NewInt(3), RevocationTime: baseTime.Add(-time.Minute), ReasonCode: 1},
+	}
+	err = ValidateDelta(base, issue(&req), issuer.Cert.Certificate, 24*time.Hour)
+	test.AssertError(t, err, "validating delta with old entries")
+	test.AssertContains(t, err.Error(), "before the base CRL")
+}
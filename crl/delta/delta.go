@@ -0,0 +1,110 @@
+package delta
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/letsencrypt/boulder/crl/idp"
+)
+
+var (
+	deltaCRLIndicatorOID = asn1.ObjectIdentifier{2, 5, 29, 27} // id-ce-deltaCRLIndicator
+	freshestCRLOID       = asn1.ObjectIdentifier{2, 5, 29, 46} // id-ce-freshestCRL
+)
+
+// MakeIndicatorExt returns a critical DeltaCRLIndicator extension, as defined
+// in RFC 5280 Section 5.2.4, containing the CRL Number of the given base CRL.
+func MakeIndicatorExt(baseNumber *big.Int) (pkix.Extension, error) {
+	if baseNumber == nil || baseNumber.Sign() < 0 {
+		return pkix.Extension{}, errors.New("base CRL number must be non-negative")
+	}
+
+	valBytes, err := asn1.Marshal(baseNumber)
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+
+	return pkix.Extension{
+		Id:       deltaCRLIndicatorOID,
+		Value:    valBytes,
+		Critical: true,
+	}, nil
+}
+
+// GetBaseNumber returns the base CRL number contained within the
+// DeltaCRLIndicator extension, if present, or nil otherwise.
+func GetBaseNumber(exts []pkix.Extension) (*big.Int, error) {
+	for _, ext := range exts {
+		if ext.Id.Equal(deltaCRLIndicatorOID) {
+			baseNumber := new(big.Int)
+			rest, err := asn1.Unmarshal(ext.Value, &baseNumber)
+			if err != nil {
+				return nil, fmt.Errorf("parsing DeltaCRLIndicator extension: %w", err)
+			}
+			if len(rest) != 0 {
+				return nil, fmt.Errorf("parsing DeltaCRLIndicator extension: got %d unexpected trailing bytes", len(rest))
+			}
+			return baseNumber, nil
+		}
+	}
+	return nil, nil
+}
+
+// MakeFreshestCRLExt returns a non-critical FreshestCRL extension, as defined
+// in RFC 5280 Section 5.2.6, containing a single distribution point with the
+// given URLs at which the delta CRL for this CRL can be found.
+func MakeFreshestCRLExt(urls []string) (pkix.Extension, error) {
+	if len(urls) == 0 {
+		return pkix.Extension{}, errors.New("at least one delta CRL URL is required")
+	}
+
+	var gns []asn1.RawValue
+	for _, url := range urls {
+		gns = append(gns, asn1.RawValue{ // GeneralName
+			Class: 2, // context-specific
+			Tag:   6, // uniformResourceIdentifier, IA5String
+			Bytes: []byte(url),
+		})
+	}
+
+	val := []idp.DistributionPoint{{DistributionPoint: idp.DistributionPointName{FullName: gns}}}
+
+	valBytes, err := asn1.Marshal(val)
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+
+	return pkix.Extension{
+		Id:       freshestCRLOID,
+		Value:    valBytes,
+		Critical: false,
+	}, nil
+}
+
+// GetFreshestCRLURIs returns the URIs contained within the FreshestCRL
+// extension, if present, or nil otherwise.
+func GetFreshestCRLURIs(exts []pkix.Extension) ([]string, error) {
+	for _, ext := range exts {
+		if ext.Id.Equal(freshestCRLOID) {
+			var val []idp.DistributionPoint
+			rest, err := asn1.Unmarshal(ext.Value, &val)
+			if err != nil {
+				return nil, fmt.Errorf("parsing FreshestCRL extension: %w", err)
+			}
+			if len(rest) != 0 {
+				return nil, fmt.Errorf("parsing FreshestCRL extension: got %d unexpected trailing bytes", len(rest))
+			}
+			var uris []string
+			for _, dp := range val {
+				for _, generalName := range dp.DistributionPoint.FullName {
+					uris = append(uris, string(generalName.Bytes))
+				}
+			}
+			return uris, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,54 @@
+package delta
+
+import (
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestIndicatorExt(t *testing.T) {
+	t.Parallel()
+
+	base := big.NewInt(1738281600000000000)
+	ext, err := MakeIndicatorExt(base)
+	test.AssertNotError(t, err, "making DeltaCRLIndicator extension")
+	test.AssertDeepEquals(t, ext.Id, deltaCRLIndicatorOID)
+	test.AssertEquals(t, ext.Critical, true)
+
+	got, err := GetBaseNumber([]pkix.Extension{{Id: freshestCRLOID}, ext})
+	test.AssertNotError(t, err, "parsing DeltaCRLIndicator extension")
+	test.AssertEquals(t, got.Cmp(base), 0)
+
+	got, err = GetBaseNumber(nil)
+	test.AssertNotError(t, err, "no DeltaCRLIndicator extension")
+	test.Assert(t, got == nil, "expected no base number")
+
+	_, err = GetBaseNumber([]pkix.Extension{{Id: deltaCRLIndicatorOID, Value: []byte{0x05, 0x00}}})
+	test.AssertError(t, err, "parsing malformed DeltaCRLIndicator extension")
+
+	_, err = MakeIndicatorExt(big.NewInt(-1))
+	test.AssertError(t, err, "making DeltaCRLIndicator with negative base")
+}
+
+func TestFreshestCRLExt(t *testing.T) {
+	t.Parallel()
+
+	urls := []string{"http://c.boulder.test/66283756913588288/0-delta.crl", "http://other.test/0-delta.crl"}
+	ext, err := MakeFreshestCRLExt(urls)
+	test.AssertNotError(t, err, "making FreshestCRL extension")
+	test.AssertDeepEquals(t, ext.Id, freshestCRLOID)
+	test.AssertEquals(t, ext.Critical, false)
+
+	got, err := GetFreshestCRLURIs([]pkix.Extension{ext})
+	test.AssertNotError(t, err, "parsing FreshestCRL extension")
+	test.AssertDeepEquals(t, got, urls)
+
+	got, err = GetFreshestCRLURIs(nil)
+	test.AssertNotError(t, err, "no FreshestCRL extension")
+	test.Assert(t, got == nil, "expected no URIs")
+
+	_, err = MakeFreshestCRLExt(nil)
+	test.AssertError(t, err, "making FreshestCRL extension without URLs")
+}
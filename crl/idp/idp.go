@@ -13,15 +13,22 @@ var idpOID = asn1.ObjectIdentifier{2, 5, 29, 28} // id-ce-issuingDistributionPoi
 // SEQUENCE as defined in RFC 5280 Section 5.2.5. We only use three of the
 // fields, so the others are omitted.
 type issuingDistributionPoint struct {
-	DistributionPoint     distributionPointName `asn1:"optional,tag:0"`
+	DistributionPoint     DistributionPointName `asn1:"optional,tag:0"`
 	OnlyContainsUserCerts bool                  `asn1:"optional,tag:1"`
 	OnlyContainsCACerts   bool                  `asn1:"optional,tag:2"`
 }
 
-// distributionPointName represents the ASN.1 DistributionPointName CHOICE as
+// DistributionPoint represents the ASN.1 DistributionPoint SEQUENCE as defined
+// in RFC 5280 Section 4.2.1.13. We only use one of the fields, so the others
+// are omitted.
+type DistributionPoint struct {
+	DistributionPoint DistributionPointName `asn1:"optional,tag:0"`
+}
+
+// DistributionPointName represents the ASN.1 DistributionPointName CHOICE as
 // defined in RFC 5280 Section 4.2.1.13. We only use one of the fields, so the
 // others are omitted.
-type distributionPointName struct {
+type DistributionPointName struct {
 	// Technically, FullName is of type GeneralNames, which is of type SEQUENCE OF
 	// GeneralName. But GeneralName itself is of type CHOICE, and the asn1.Marshal
 	// function doesn't support marshalling structs to CHOICEs, so we have to use
@@ -43,7 +50,7 @@ func MakeUserCertsExt(urls []string) (pkix.Extension, error) {
 	}
 
 	val := issuingDistributionPoint{
-		DistributionPoint:     distributionPointName{FullName: gns},
+		DistributionPoint:     DistributionPointName{FullName: gns},
 		OnlyContainsUserCerts: true,
 	}
 
@@ -0,0 +1,91 @@
+package storer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// Backend is a storage location to which the crlStorer publishes CRLs. Keys
+// are slash-separated paths of the form "<issuerNameID>/<shardIdx>.crl", which
+// the backend maps onto its own namespace.
+type Backend interface {
+	// Name identifies the backend in log lines and errors.
+	Name() string
+	// Get returns the bytes of the CRL stored under the given key, or nil
+	// (without error) if no CRL is stored there.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Put stores the given CRL bytes under the given key, replacing any CRL
+	// already stored there. The crlNumber is provided for backends which can
+	// record it alongside the object.
+	Put(ctx context.Context, key string, crlBytes []byte, crlNumber *big.Int) error
+}
+
+// simpleS3 matches the subset of the s3.Client interface which we use, to allow
+// simpler mocking in tests.
+type simpleS3 interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+}
+
+// s3Backend stores CRLs as objects in an S3 (or S3-API-compatible) bucket.
+type s3Backend struct {
+	client simpleS3
+	bucket string
+}
+
+var _ Backend = (*s3Backend)(nil)
+
+// NewS3Backend returns a Backend which stores CRLs in the given S3 bucket.
+func NewS3Backend(client simpleS3, bucket string) *s3Backend {
+	return &s3Backend{client: client, bucket: bucket}
+}
+
+func (b *s3Backend) Name() string {
+	return fmt.Sprintf("s3://%s", b.bucket)
+}
+
+func (b *s3Backend) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := b.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &b.bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		var smithyErr *smithyhttp.ResponseError
+		if errors.As(err, &smithyErr) && smithyErr.HTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	crlBytes, err := io.ReadAll(obj.Body)
+	if err != nil {
+		return nil, fmt.Errorf("downloading: %w", err)
+	}
+	return crlBytes, nil
+}
+
+func (b *s3Backend) Put(ctx context.Context, key string, crlBytes []byte, crlNumber *big.Int) error {
+	checksum := sha256.Sum256(crlBytes)
+	checksumb64 := base64.StdEncoding.EncodeToString(checksum[:])
+	crlContentType := "application/pkix-crl"
+	_, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:            &b.bucket,
+		Key:               &key,
+		Body:              bytes.NewReader(crlBytes),
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ChecksumSHA256:    &checksumb64,
+		ContentType:       &crlContentType,
+		Metadata:          map[string]string{"crlNumber": crlNumber.String()},
+	})
+	return err
+}
@@ -0,0 +1,143 @@
+package storer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// checksumSuffix is appended to a CRL's filename to name its checksum sidecar.
+const checksumSuffix = ".sha256"
+
+// fsBackend stores CRLs as files in a local directory tree, for example one
+// served by a static web server. Each CRL is written to a temporary file and
+// atomically renamed into place, so readers never observe a partially-written
+// CRL. Next to each CRL it writes a sidecar file in the format produced by
+// sha256sum, which mirrors and consumers can use to verify what they fetched.
+type fsBackend struct {
+	dir string
+}
+
+var _ Backend = (*fsBackend)(nil)
+
+// NewFilesystemBackend returns a Backend which stores CRLs beneath the given
+// directory, which must already exist.
+func NewFilesystemBackend(dir string) (*fsBackend, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("checking CRL directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("CRL directory %q is not a directory", dir)
+	}
+	return &fsBackend{dir: dir}, nil
+}
+
+func (b *fsBackend) Name() string {
+	return fmt.Sprintf("file://%s", b.dir)
+}
+
+// filename returns the path at which the CRL with the given key is stored. It
+// refuses keys which would escape the backend's directory.
+func (b *fsBackend) filename(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return filepath.Join(b.dir, filepath.FromSlash(key)), nil
+}
+
+func (b *fsBackend) Get(_ context.Context, key string) ([]byte, error) {
+	filename, err := b.filename(key)
+	if err != nil {
+		return nil, err
+	}
+
+	crlBytes, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading: %w", err)
+	}
+	return crlBytes, nil
+}
+
+func (b *fsBackend) Put(_ context.Context, key string, crlBytes []byte, _ *big.Int) error {
+	filename, err := b.filename(key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(filename)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+
+	checksum := sha256.Sum256(crlBytes)
+	sidecar := fmt.Sprintf("%s  %s\n", hex.EncodeToString(checksum[:]), path.Base(key))
+
+	// Publish the CRL before its checksum, so that the checksum never describes
+	// a CRL which hasn't been published yet.
+	err = writeFileAtomic(filename, crlBytes)
+	if err != nil {
+		return fmt.Errorf("writing CRL: %w", err)
+	}
+	err = writeFileAtomic(filename+checksumSuffix, []byte(sidecar))
+	if err != nil {
+		return fmt.Errorf("writing checksum: %w", err)
+	}
+
+	// Ensure that the renames themselves are durable.
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("opening directory: %w", err)
+	}
+	defer d.Close()
+	err = d.Sync()
+	if err != nil {
+		return fmt.Errorf("syncing directory: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes the given contents to a temporary file in the same
+// directory as filename, syncs it to disk, and renames it over filename.
+func writeFileAtomic(filename string, contents []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	// Clean up the temporary file if anything below fails. After a successful
+	// rename this is a harmless no-op.
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(contents)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Sync()
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	// CreateTemp uses mode 0600, but the CRL must be readable by the web server.
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filename)
+}
@@ -0,0 +1,160 @@
+package storer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/crl/idp"
+	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestFilesystemBackend(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	backend, err := NewFilesystemBackend(dir)
+	test.AssertNotError(t, err, "creating filesystem backend")
+
+	_, err = NewFilesystemBackend(filepath.Join(dir, "nonexistent"))
+	test.AssertError(t, err, "creating backend for nonexistent directory")
+
+	ctx := context.Background()
+	got, err := backend.Get(ctx, "123/4.crl")
+	test.AssertNotError(t, err, "getting nonexistent CRL")
+	test.Assert(t, got == nil, "nonexistent CRL should be nil")
+
+	for _, contents := range [][]byte{[]byte("first"), []byte("second")} {
+		err = backend.Put(ctx, "123/4.crl", contents, big.NewInt(1))
+		test.AssertNotError(t, err, "putting CRL")
+
+		got, err = backend.Get(ctx, "123/4.crl")
+		test.AssertNotError(t, err, "getting CRL")
+		test.AssertByteEquals(t, got, contents)
+
+		info, err := os.Stat(filepath.Join(dir, "123", "4.crl"))
+		test.AssertNotError(t, err, "statting CRL")
+		test.AssertEquals(t, info.Mode().Perm(), os.FileMode(0644))
+
+		checksum := sha256.Sum256(contents)
+		sidecar, err := os.ReadFile(filepath.Join(dir, "123", "4.crl.sha256"))
+		test.AssertNotError(t, err, "reading checksum sidecar")
+		test.AssertEquals(t, string(sidecar), hex.EncodeToString(checksum[:])+"  4.crl\n")
+	}
+
+	// No temporary files should be left behind.
+	entries, err := os.ReadDir(filepath.Join(dir, "123"))
+	test.AssertNotError(t, err, "reading CRL directory")
+	test.AssertEquals(t, len(entries), 2)
+
+	// Keys must not escape the backend's directory.
+	err = backend.Put(ctx, "../escape.crl", []byte("nope"), big.NewInt(1))
+	test.AssertError(t, err, "putting CRL outside directory")
+	_, err = backend.Get(ctx, "/etc/passwd")
+	test.AssertError(t, err, "getting CRL outside directory")
+}
+
+// Test that a CRL is checked against every backend, and is not published to
+// any backend if it fails the checks against one of them.
+func TestUploadCRLMultipleBackends(t *testing.T) {
+	storer, iss := setupTestUploadCRL(t)
+
+	idpExt, err := idp.MakeUserCertsExt([]string{"http://c.ex.org"})
+	test.AssertNotError(t, err, "creating test IDP extension")
+
+	makeCRL := func(number int64) []byte {
+		t.Helper()
+		crlBytes, err := x509.CreateRevocationList(
+			rand.Reader,
+			&x509.RevocationList{
+				ThisUpdate:      storer.clk.Now(),
+				NextUpdate:      storer.clk.Now().Add(time.Hour),
+				Number:          big.NewInt(number),
+				ExtraExtensions: []pkix.Extension{idpExt},
+			},
+			iss.Cert.Certificate,
+			iss.Signer,
+		)
+		test.AssertNotError(t, err, "creating test CRL")
+		return crlBytes
+	}
+
+	upload := func(number int64, crlBytes []byte) error {
+		errs := make(chan error, 1)
+		ins := make(chan *cspb.UploadCRLRequest)
+		go func() {
+			errs <- storer.UploadCRL(&fakeUploadCRLServerStream{input: ins})
+		}()
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_Metadata{
+				Metadata: &cspb.CRLMetadata{
+					IssuerNameID: int64(iss.Cert.NameID()),
+					Number:       number,
+				},
+			},
+		}
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_CrlChunk{
+				CrlChunk: crlBytes,
+			},
+		}
+		close(ins)
+		return <-errs
+	}
+
+	key := fmt.Sprintf("%d/0.crl", iss.Cert.NameID())
+	fakeS3 := &keyedSimpleS3{objects: map[string][]byte{}}
+	fsBackend, err := NewFilesystemBackend(t.TempDir())
+	test.AssertNotError(t, err, "creating filesystem backend")
+	storer.backends = []Backend{NewS3Backend(fakeS3, "le-crl.s3.us-west.amazonaws.com"), fsBackend}
+
+	crl10 := makeCRL(10)
+	err = upload(10, crl10)
+	test.AssertNotError(t, err, "uploading CRL to both backends")
+	test.AssertByteEquals(t, fakeS3.objects[key], crl10)
+	got, err := fsBackend.Get(context.Background(), key)
+	test.AssertNotError(t, err, "getting CRL from filesystem")
+	test.AssertByteEquals(t, got, crl10)
+
+	// If the filesystem has a newer CRL than S3, the upload must be refused,
+	// and S3 must be left alone.
+	crl30 := makeCRL(30)
+	err = fsBackend.Put(context.Background(), key, crl30, big.NewInt(30))
+	test.AssertNotError(t, err, "putting newer CRL to filesystem")
+
+	err = upload(20, makeCRL(20))
+	test.AssertError(t, err, "uploading CRL older than one backend's")
+	test.AssertContains(t, err.Error(), "crlNumber not strictly increasing")
+	test.AssertContains(t, err.Error(), fsBackend.Name())
+	test.AssertByteEquals(t, fakeS3.objects[key], crl10)
+
+	// If an upload reached S3 but not the filesystem, retrying it with the
+	// identical CRL skips S3 and completes the upload to the filesystem.
+	crl40 := makeCRL(40)
+	fakeS3.objects[key] = crl40
+	err = upload(40, crl40)
+	test.AssertNotError(t, err, "retrying partially-uploaded CRL")
+	got, err = fsBackend.Get(context.Background(), key)
+	test.AssertNotError(t, err, "getting CRL from filesystem")
+	test.AssertByteEquals(t, got, crl40)
+
+	// Retrying again, now that both backends have it, is also a no-op.
+	err = upload(40, crl40)
+	test.AssertNotError(t, err, "retrying fully-uploaded CRL")
+
+	// But a different CRL with the same number is still refused.
+	err = upload(40, makeCRL(40))
+	test.AssertError(t, err, "uploading different CRL with same number")
+	test.AssertContains(t, err.Error(), "crlNumber not strictly increasing")
+	test.AssertByteEquals(t, fakeS3.objects[key], crl40)
+}
@@ -3,56 +3,50 @@ package storer
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
 	"crypto/x509"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"math/big"
 	"slices"
+	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
-	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/jmhodges/clock"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
 	"github.com/letsencrypt/boulder/crl"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 )
 
-// simpleS3 matches the subset of the s3.Client interface which we use, to allow
-// simpler mocking in tests.
-type simpleS3 interface {
-	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
-	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
-}
-
 type crlStorer struct {
 	cspb.UnsafeCRLStorerServer
-	s3Client         simpleS3
-	s3Bucket         string
+	backends         []Backend
 	issuers          map[issuance.NameID]*issuance.Certificate
 	uploadCount      *prometheus.CounterVec
 	sizeHistogram    *prometheus.HistogramVec
 	latencyHistogram *prometheus.HistogramVec
+	purger           akamaipb.AkamaiPurgerClient
 	log              blog.Logger
 	clk              clock.Clock
 }
 
 var _ cspb.CRLStorerServer = (*crlStorer)(nil)
 
+// New returns a crlStorer which uploads CRLs to the given backends. If purger
+// is non-nil, the URL of each uploaded CRL is also submitted to it, so that
+// stale copies are purged from the CDN in front of the backends.
 func New(
 	issuers []*issuance.Certificate,
-	s3Client simpleS3,
-	s3Bucket string,
+	backends []Backend,
+	purger akamaipb.AkamaiPurgerClient,
 	stats prometheus.Registerer,
 	log blog.Logger,
 	clk clock.Clock,
@@ -62,6 +56,10 @@ func New(
 		issuersByNameID[issuer.NameID()] = issuer
 	}
 
+	if len(backends) == 0 {
+		return nil, errors.New("must have at least one storage backend")
+	}
+
 	uploadCount := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "crl_storer_uploads",
 		Help: "A counter of the number of CRLs uploaded by crl-storer",
@@ -84,11 +82,11 @@ func New(
 
 	return &crlStorer{
 		issuers:          issuersByNameID,
-		s3Client:         s3Client,
-		s3Bucket:         s3Bucket,
+		backends:         backends,
 		uploadCount:      uploadCount,
 		sizeHistogram:    sizeHistogram,
 		latencyHistogram: latencyHistogram,
+		purger:           purger,
 		log:              log,
 		clk:              clk,
 	}, nil
@@ -99,11 +97,19 @@ func New(
 
 // UploadCRL implements the gRPC method of the same name. It takes a stream of
 // bytes as its input, parses and runs some sanity checks on the CRL, and then
-// uploads it to S3.
+// uploads it to every configured backend. Delta CRLs are uploaded next to their
+// base, with a "-delta" suffix, and must be based on the full CRL currently
+// stored there. The CRL is checked against the contents of every backend before
+// it is uploaded to any of them, so that one which fails the checks is not
+// published anywhere. Backends which already hold the identical CRL are
+// skipped, so that an upload which failed partway through can be retried. Once
+// the CRL is uploaded everywhere, its URL is purged from the CDN, if a purger
+// is configured.
 func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLRequest, emptypb.Empty]) error {
 	var issuer *issuance.Certificate
 	var shardIdx int64
 	var crlNumber *big.Int
+	var baseNumber *big.Int
 	crlBytes := make([]byte, 0)
 
 	// Read all of the messages from the input stream.
@@ -127,6 +133,9 @@ func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLR
 
 			shardIdx = payload.Metadata.ShardIdx
 			crlNumber = crl.Number(time.Unix(0, payload.Metadata.Number))
+			if payload.Metadata.BaseNumber != 0 {
+				baseNumber = crl.Number(time.Unix(0, payload.Metadata.BaseNumber))
+			}
 
 			var ok bool
 			issuer, ok = cs.issuers[issuance.NameID(payload.Metadata.IssuerNameID)]
@@ -162,89 +171,189 @@ func (cs *crlStorer) UploadCRL(stream grpc.ClientStreamingServer[cspb.UploadCRLR
 		return fmt.Errorf("validating signature for %s: %w", crlId, err)
 	}
 
-	// Before uploading this CRL, we want to compare it against the previous CRL
-	// to ensure that the CRL Number field is not going backwards. This is an
-	// additional safety check against clock skew and potential races, if multiple
-	// crl-updaters are working on the same shard at the same time. We only run
-	// these checks if we found a CRL, so we don't block uploading brand new CRLs.
-	filename := fmt.Sprintf("%d/%d.crl", issuer.NameID(), shardIdx)
-	prevObj, err := cs.s3Client.GetObject(stream.Context(), &s3.GetObjectInput{
-		Bucket: &cs.s3Bucket,
-		Key:    &filename,
-	})
+	indicatedBase, err := delta.GetBaseNumber(crl.Extensions)
 	if err != nil {
-		var smithyErr *smithyhttp.ResponseError
-		if !errors.As(err, &smithyErr) || smithyErr.HTTPStatusCode() != 404 {
-			return fmt.Errorf("getting previous CRL for %s: %w", crlId, err)
-		}
-		cs.log.Infof("No previous CRL found for %s, proceeding", crlId)
-	} else {
-		prevBytes, err := io.ReadAll(prevObj.Body)
+		return fmt.Errorf("getting base CRL number for %s: %w", crlId, err)
+	}
+	if baseNumber == nil && indicatedBase != nil {
+		return errors.New("got delta CRL without base number metadata")
+	}
+	if baseNumber != nil && (indicatedBase == nil || indicatedBase.Cmp(baseNumber) != 0) {
+		return errors.New("got mismatched base CRL Number")
+	}
+
+	filename := fmt.Sprintf("%d/%d.crl", issuer.NameID(), shardIdx)
+	baseFilename := ""
+	if baseNumber != nil {
+		baseFilename = filename
+		filename = fmt.Sprintf("%d/%d-delta.crl", issuer.NameID(), shardIdx)
+	}
+
+	var pending []Backend
+	for _, backend := range cs.backends {
+		published, err := cs.checkPublished(stream.Context(), backend, crl, filename, baseFilename, baseNumber)
 		if err != nil {
-			return fmt.Errorf("downloading previous CRL for %s: %w", crlId, err)
+			return fmt.Errorf("checking %s in %s: %w", crlId, backend.Name(), err)
 		}
+		if !published {
+			pending = append(pending, backend)
+		}
+	}
+
+	// Finally actually upload the new CRL.
+	for _, backend := range pending {
+		start := cs.clk.Now()
+
+		err = backend.Put(stream.Context(), filename, crlBytes, crlNumber)
+
+		latency := cs.clk.Now().Sub(start)
+		cs.latencyHistogram.WithLabelValues(issuer.Subject.CommonName).Observe(latency.Seconds())
 
-		prevCRL, err := x509.ParseRevocationList(prevBytes)
 		if err != nil {
-			return fmt.Errorf("parsing previous CRL for %s: %w", crlId, err)
+			cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, "failed").Inc()
+			cs.log.AuditErrf("CRL upload failed: id=[%s] backend=[%s] err=[%s]", crlId, backend.Name(), err)
+			return fmt.Errorf("uploading to %s: %w", backend.Name(), err)
 		}
+	}
 
-		if crl.Number.Cmp(prevCRL.Number) <= 0 {
-			return fmt.Errorf("crlNumber not strictly increasing: %d <= %d", crl.Number, prevCRL.Number)
-		}
+	cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, "success").Inc()
+	cs.log.AuditInfof(
+		"CRL uploaded: id=[%s] issuerCN=[%s] thisUpdate=[%s] nextUpdate=[%s] numEntries=[%d] delta=[%t]",
+		crlId, issuer.Subject.CommonName, crl.ThisUpdate, crl.NextUpdate, len(crl.RevokedCertificateEntries), baseNumber != nil,
+	)
 
-		idpURIs, err := idp.GetIDPURIs(crl.Extensions)
+	if cs.purger != nil {
+		// The CRL is already published, so a failure to purge it only delays
+		// relying parties from seeing it, and isn't returned to the caller.
+		err = cs.purgeCRL(stream.Context(), crl, baseNumber != nil)
 		if err != nil {
-			return fmt.Errorf("getting IDP for %s: %w", crlId, err)
+			cs.log.AuditErrf("CRL purge failed: id=[%s] err=[%s]", crlId, err)
 		}
+	}
 
-		prevURIs, err := idp.GetIDPURIs(prevCRL.Extensions)
-		if err != nil {
-			return fmt.Errorf("getting previous IDP for %s: %w", crlId, err)
+	return stream.SendAndClose(&emptypb.Empty{})
+}
+
+// purgeCRL submits the URLs at which the given CRL is served to the purger.
+// Those are the URIs of its issuingDistributionPoint or, for a delta CRL, which
+// shares the IDP of its base, the same URIs with the "-delta" suffix under
+// which deltas are published.
+func (cs *crlStorer) purgeCRL(ctx context.Context, crl *x509.RevocationList, isDelta bool) error {
+	uris, err := idp.GetIDPURIs(crl.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting IDP: %w", err)
+	}
+	if len(uris) == 0 {
+		return errors.New("CRL has no IDP URIs to purge")
+	}
+
+	urls := make([]string, 0, len(uris))
+	for _, uri := range uris {
+		if isDelta {
+			uri = strings.TrimSuffix(uri, ".crl") + "-delta.crl"
 		}
+		urls = append(urls, uri)
+	}
 
-		uriMatch := false
-		for _, uri := range idpURIs {
-			if slices.Contains(prevURIs, uri) {
-				uriMatch = true
-				break
-			}
+	_, err = cs.purger.Purge(ctx, &akamaipb.PurgeRequest{Urls: urls})
+	return err
+}
+
+// checkPublished checks the given CRL against the CRLs already published in
+// the given backend. If baseFilename is non-empty, the CRL is a delta which must
+// be based on the full CRL stored there, whose number is baseNumber. It returns
+// true if the backend already holds this exact CRL, in which case there's no
+// need to upload it again.
+func (cs *crlStorer) checkPublished(ctx context.Context, backend Backend, crl *x509.RevocationList, filename, baseFilename string, baseNumber *big.Int) (bool, error) {
+	if baseFilename != "" {
+		// A delta CRL must be based on the full CRL which is currently
+		// published for its shard, so that relying parties who fetch both get a
+		// consistent view. If the base has since been replaced, this delta is
+		// stale, and the next one will be based on the new full CRL.
+		baseCRL, err := getCRL(ctx, backend, baseFilename)
+		if err != nil {
+			return false, fmt.Errorf("getting base CRL: %w", err)
 		}
-		if !uriMatch {
-			return fmt.Errorf("IDP does not match previous: %v !∩ %v", idpURIs, prevURIs)
+		if baseCRL == nil {
+			return false, errors.New("no base CRL found")
+		}
+		if baseCRL.Number.Cmp(baseNumber) != 0 {
+			return false, fmt.Errorf("base crlNumber does not match current CRL: %d != %d", baseNumber, baseCRL.Number)
+		}
+		err = checkIDPMatch(crl, baseCRL)
+		if err != nil {
+			return false, fmt.Errorf("checking IDP against base: %w", err)
 		}
 	}
 
-	// Finally actually upload the new CRL.
-	start := cs.clk.Now()
-
-	checksum := sha256.Sum256(crlBytes)
-	checksumb64 := base64.StdEncoding.EncodeToString(checksum[:])
-	crlContentType := "application/pkix-crl"
-	_, err = cs.s3Client.PutObject(stream.Context(), &s3.PutObjectInput{
-		Bucket:            &cs.s3Bucket,
-		Key:               &filename,
-		Body:              bytes.NewReader(crlBytes),
-		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
-		ChecksumSHA256:    &checksumb64,
-		ContentType:       &crlContentType,
-		Metadata:          map[string]string{"crlNumber": crlNumber.String()},
-	})
-
-	latency := cs.clk.Now().Sub(start)
-	cs.latencyHistogram.WithLabelValues(issuer.Subject.CommonName).Observe(latency.Seconds())
+	// Before uploading this CRL, we want to compare it against the previous CRL
+	// to ensure that the CRL Number field is not going backwards. This is an
+	// additional safety check against clock skew and potential races, if multiple
+	// crl-updaters are working on the same shard at the same time. We only run
+	// these checks if we found a CRL, so we don't block uploading brand new CRLs.
+	prevCRL, err := getCRL(ctx, backend, filename)
+	if err != nil {
+		return false, fmt.Errorf("getting previous CRL: %w", err)
+	}
+	if prevCRL == nil {
+		cs.log.Infof("No previous CRL found in %s at %s, proceeding", backend.Name(), filename)
+		return false, nil
+	}
+
+	// A retry of an upload which only reached some of the backends finds the
+	// identical CRL already in the others.
+	if crl.Number.Cmp(prevCRL.Number) == 0 && bytes.Equal(crl.Raw, prevCRL.Raw) {
+		cs.log.Infof("Identical CRL already found in %s at %s, skipping", backend.Name(), filename)
+		return true, nil
+	}
+
+	if crl.Number.Cmp(prevCRL.Number) <= 0 {
+		return false, fmt.Errorf("crlNumber not strictly increasing: %d <= %d", crl.Number, prevCRL.Number)
+	}
 
+	err = checkIDPMatch(crl, prevCRL)
 	if err != nil {
-		cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, "failed").Inc()
-		cs.log.AuditErrf("CRL upload failed: id=[%s] err=[%s]", crlId, err)
-		return fmt.Errorf("uploading to S3: %w", err)
+		return false, fmt.Errorf("checking IDP against previous: %w", err)
 	}
+	return false, nil
+}
 
-	cs.uploadCount.WithLabelValues(issuer.Subject.CommonName, "success").Inc()
-	cs.log.AuditInfof(
-		"CRL uploaded: id=[%s] issuerCN=[%s] thisUpdate=[%s] nextUpdate=[%s] numEntries=[%d]",
-		crlId, issuer.Subject.CommonName, crl.ThisUpdate, crl.NextUpdate, len(crl.RevokedCertificateEntries),
-	)
+// getCRL fetches and parses the CRL stored in the given backend at the given
+// filename. It returns nil, without error, if no such CRL exists.
+func getCRL(ctx context.Context, backend Backend, filename string) (*x509.RevocationList, error) {
+	crlBytes, err := backend.Get(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	if crlBytes == nil {
+		return nil, nil
+	}
 
-	return stream.SendAndClose(&emptypb.Empty{})
+	crl, err := x509.ParseRevocationList(crlBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing: %w", err)
+	}
+
+	return crl, nil
+}
+
+// checkIDPMatch returns an error unless the issuingDistributionPoint URIs of
+// the two CRLs have at least one URI in common.
+func checkIDPMatch(crl, other *x509.RevocationList) error {
+	idpURIs, err := idp.GetIDPURIs(crl.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting IDP: %w", err)
+	}
+
+	otherURIs, err := idp.GetIDPURIs(other.Extensions)
+	if err != nil {
+		return fmt.Errorf("getting other IDP: %w", err)
+	}
+
+	for _, uri := range idpURIs {
+		if slices.Contains(otherURIs, uri) {
+			return nil
+		}
+	}
+	return fmt.Errorf("IDP does not match: %v !∩ %v", idpURIs, otherURIs)
 }
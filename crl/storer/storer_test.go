@@ -9,6 +9,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"fmt"
 	"io"
 	"math/big"
 	"net/http"
@@ -21,6 +22,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
 	"github.com/letsencrypt/boulder/issuance"
@@ -69,7 +72,7 @@ func setupTestUploadCRL(t *testing.T) (*crlStorer, *issuance.Issuer) {
 
 	storer, err := New(
 		[]*issuance.Certificate{r3, issuerE1.Cert},
-		nil, "le-crl.s3.us-west.amazonaws.com",
+		[]Backend{NewS3Backend(nil, "le-crl.s3.us-west.amazonaws.com")}, nil,
 		metrics.NoopRegisterer, blog.NewMock(), clock.NewFake(),
 	)
 	test.AssertNotError(t, err, "creating test crl-storer")
@@ -355,7 +358,7 @@ func TestUploadCRLSuccess(t *testing.T) {
 	)
 	test.AssertNotError(t, err, "creating test CRL")
 
-	storer.s3Client = &fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes}
+	storer.backends = []Backend{NewS3Backend(&fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes}, "le-crl.s3.us-west.amazonaws.com")}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -399,7 +402,7 @@ func TestUploadNewCRLSuccess(t *testing.T) {
 	)
 	test.AssertNotError(t, err, "creating test CRL")
 
-	storer.s3Client = &fakeSimpleS3{expectBytes: crlBytes}
+	storer.backends = []Backend{NewS3Backend(&fakeSimpleS3{expectBytes: crlBytes}, "le-crl.s3.us-west.amazonaws.com")}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -460,7 +463,7 @@ func TestUploadCRLBackwardsNumber(t *testing.T) {
 	)
 	test.AssertNotError(t, err, "creating test CRL")
 
-	storer.s3Client = &fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes}
+	storer.backends = []Backend{NewS3Backend(&fakeSimpleS3{prevBytes: prevCRLBytes, expectBytes: crlBytes}, "le-crl.s3.us-west.amazonaws.com")}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -515,7 +518,7 @@ func TestUploadCRLBrokenS3(t *testing.T) {
 		iss.Signer,
 	)
 	test.AssertNotError(t, err, "creating test CRL")
-	storer.s3Client = &brokenSimpleS3{}
+	storer.backends = []Backend{NewS3Backend(&brokenSimpleS3{}, "le-crl.s3.us-west.amazonaws.com")}
 	ins <- &cspb.UploadCRLRequest{
 		Payload: &cspb.UploadCRLRequest_CrlChunk{
 			CrlChunk: crlBytes,
@@ -526,3 +529,255 @@ func TestUploadCRLBrokenS3(t *testing.T) {
 	test.AssertError(t, err, "uploading to broken S3 should fail")
 	test.AssertContains(t, err.Error(), "getting previous CRL")
 }
+
+// keyedSimpleS3 implements the simpleS3 interface, serving downloads from and
+// storing uploads into a map of object keys to CRL bytes.
+type keyedSimpleS3 struct {
+	objects map[string][]byte
+}
+
+func (p *keyedSimpleS3) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	recvBytes, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	p.objects[*params.Key] = recvBytes
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (p *keyedSimpleS3) GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	obj, ok := p.objects[*params.Key]
+	if !ok {
+		return nil, &smithyhttp.ResponseError{Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}}}
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(obj))}, nil
+}
+
+// Test that delta CRLs are checked against, and uploaded next to, their base.
+func TestUploadDeltaCRL(t *testing.T) {
+	storer, iss := setupTestUploadCRL(t)
+
+	idpExt, err := idp.MakeUserCertsExt([]string{"http://c.ex.org"})
+	test.AssertNotError(t, err, "creating test IDP extension")
+	otherIDPExt, err := idp.MakeUserCertsExt([]string{"http://other.ex.org"})
+	test.AssertNotError(t, err, "creating test IDP extension")
+
+	makeCRL := func(number int64, baseNumber int64, idpExt pkix.Extension) []byte {
+		t.Helper()
+		exts := []pkix.Extension{idpExt}
+		if baseNumber != 0 {
+			indicator, err := delta.MakeIndicatorExt(big.NewInt(baseNumber))
+			test.AssertNotError(t, err, "creating test delta CRL indicator")
+			exts = append(exts, indicator)
+		}
+		crlBytes, err := x509.CreateRevocationList(
+			rand.Reader,
+			&x509.RevocationList{
+				ThisUpdate:      storer.clk.Now(),
+				NextUpdate:      storer.clk.Now().Add(time.Hour),
+				Number:          big.NewInt(number),
+				ExtraExtensions: exts,
+			},
+			iss.Cert.Certificate,
+			iss.Signer,
+		)
+		test.AssertNotError(t, err, "creating test CRL")
+		return crlBytes
+	}
+
+	baseKey := fmt.Sprintf("%d/0.crl", iss.Cert.NameID())
+	deltaKey := fmt.Sprintf("%d/0-delta.crl", iss.Cert.NameID())
+
+	testCases := []struct {
+		name        string
+		objects     map[string][]byte
+		number      int64
+		baseNumber  int64
+		crlBytes    []byte
+		expectedErr string
+	}{
+		{
+			name:       "valid delta",
+			objects:    map[string][]byte{baseKey: makeCRL(10, 0, idpExt)},
+			number:     20,
+			baseNumber: 10,
+			crlBytes:   makeCRL(20, 10, idpExt),
+		},
+		{
+			name:       "valid delta replacing older delta",
+			objects:    map[string][]byte{baseKey: makeCRL(10, 0, idpExt), deltaKey: makeCRL(15, 10, idpExt)},
+			number:     20,
+			baseNumber: 10,
+			crlBytes:   makeCRL(20, 10, idpExt),
+		},
+		{
+			name:        "no base",
+			objects:     map[string][]byte{},
+			number:      20,
+			baseNumber:  10,
+			crlBytes:    makeCRL(20, 10, idpExt),
+			expectedErr: "no base CRL found",
+		},
+		{
+			name:        "stale base",
+			objects:     map[string][]byte{baseKey: makeCRL(15, 0, idpExt)},
+			number:      20,
+			baseNumber:  10,
+			crlBytes:    makeCRL(20, 10, idpExt),
+			expectedErr: "base crlNumber does not match current CRL",
+		},
+		{
+			name:        "mismatched base metadata",
+			objects:     map[string][]byte{baseKey: makeCRL(10, 0, idpExt)},
+			number:      20,
+			baseNumber:  10,
+			crlBytes:    makeCRL(20, 5, idpExt),
+			expectedErr: "mismatched base CRL Number",
+		},
+		{
+			name:        "delta without base metadata",
+			objects:     map[string][]byte{baseKey: makeCRL(10, 0, idpExt)},
+			number:      20,
+			crlBytes:    makeCRL(20, 10, idpExt),
+			expectedErr: "delta CRL without base number metadata",
+		},
+		{
+			name:        "mismatched base IDP",
+			objects:     map[string][]byte{baseKey: makeCRL(10, 0, otherIDPExt)},
+			number:      20,
+			baseNumber:  10,
+			crlBytes:    makeCRL(20, 10, idpExt),
+			expectedErr: "IDP does not match",
+		},
+		{
+			name:        "backwards delta",
+			objects:     map[string][]byte{baseKey: makeCRL(10, 0, idpExt), deltaKey: makeCRL(25, 10, idpExt)},
+			number:      20,
+			baseNumber:  10,
+			crlBytes:    makeCRL(20, 10, idpExt),
+			expectedErr: "crlNumber not strictly increasing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeS3 := &keyedSimpleS3{objects: tc.objects}
+			storer.backends = []Backend{NewS3Backend(fakeS3, "le-crl.s3.us-west.amazonaws.com")}
+			baseBytes := tc.objects[baseKey]
+
+			errs := make(chan error, 1)
+			ins := make(chan *cspb.UploadCRLRequest)
+			go func() {
+				errs <- storer.UploadCRL(&fakeUploadCRLServerStream{input: ins})
+			}()
+			ins <- &cspb.UploadCRLRequest{
+				Payload: &cspb.UploadCRLRequest_Metadata{
+					Metadata: &cspb.CRLMetadata{
+						IssuerNameID: int64(iss.Cert.NameID()),
+						Number:       tc.number,
+						BaseNumber:   tc.baseNumber,
+					},
+				},
+			}
+			ins <- &cspb.UploadCRLRequest{
+				Payload: &cspb.UploadCRLRequest_CrlChunk{
+					CrlChunk: tc.crlBytes,
+				},
+			}
+			close(ins)
+			err := <-errs
+
+			if tc.expectedErr != "" {
+				test.AssertError(t, err, "uploading invalid delta CRL should fail")
+				test.AssertContains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			test.AssertNotError(t, err, "uploading valid delta CRL should work")
+			test.AssertDeepEquals(t, fakeS3.objects[deltaKey], tc.crlBytes)
+			test.AssertDeepEquals(t, fakeS3.objects[baseKey], baseBytes)
+		})
+	}
+}
+
+// fakePurger is a fake akamaipb.AkamaiPurgerClient which records the URLs it is
+// asked to purge, and returns the given error.
+type fakePurger struct {
+	urls [][]string
+	err  error
+}
+
+func (p *fakePurger) Purge(_ context.Context, req *akamaipb.PurgeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	p.urls = append(p.urls, req.Urls)
+	return &emptypb.Empty{}, p.err
+}
+
+func TestUploadCRLPurges(t *testing.T) {
+	storer, iss := setupTestUploadCRL(t)
+	purger := &fakePurger{}
+	storer.purger = purger
+	storer.backends = []Backend{NewS3Backend(&keyedSimpleS3{objects: map[string][]byte{}}, "le-crl.s3.us-west.amazonaws.com")}
+
+	idpExt, err := idp.MakeUserCertsExt([]string{"http://c.ex.org/0.crl"})
+	test.AssertNotError(t, err, "creating test IDP extension")
+
+	upload := func(number, baseNumber int64) error {
+		t.Helper()
+		exts := []pkix.Extension{idpExt}
+		if baseNumber != 0 {
+			indicator, err := delta.MakeIndicatorExt(big.NewInt(baseNumber))
+			test.AssertNotError(t, err, "creating test delta CRL indicator")
+			exts = append(exts, indicator)
+		}
+		crlBytes, err := x509.CreateRevocationList(
+			rand.Reader,
+			&x509.RevocationList{
+				ThisUpdate:      storer.clk.Now(),
+				NextUpdate:      storer.clk.Now().Add(time.Hour),
+				Number:          big.NewInt(number),
+				ExtraExtensions: exts,
+			},
+			iss.Cert.Certificate,
+			iss.Signer,
+		)
+		test.AssertNotError(t, err, "creating test CRL")
+
+		errs := make(chan error, 1)
+		ins := make(chan *cspb.UploadCRLRequest)
+		go func() {
+			errs <- storer.UploadCRL(&fakeUploadCRLServerStream{input: ins})
+		}()
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_Metadata{
+				Metadata: &cspb.CRLMetadata{
+					IssuerNameID: int64(iss.Cert.NameID()),
+					Number:       number,
+					BaseNumber:   baseNumber,
+				},
+			},
+		}
+		ins <- &cspb.UploadCRLRequest{
+			Payload: &cspb.UploadCRLRequest_CrlChunk{
+				CrlChunk: crlBytes,
+			},
+		}
+		close(ins)
+		return <-errs
+	}
+
+	// A full CRL is purged at its IDP, and a delta at the same URL with the
+	// "-delta" suffix.
+	err = upload(10, 0)
+	test.AssertNotError(t, err, "uploading full CRL")
+	err = upload(20, 10)
+	test.AssertNotError(t, err, "uploading delta CRL")
+	test.AssertDeepEquals(t, purger.urls, [][]string{
+		{"http://c.ex.org/0.crl"},
+		{"http://c.ex.org/0-delta.crl"},
+	})
+
+	// A CRL which was uploaded but couldn't be purged is still a success.
+	purger.err = errors.New("purger unavailable")
+	err = upload(30, 0)
+	test.AssertNotError(t, err, "uploading full CRL with failing purger")
+	test.AssertEquals(t, len(storer.log.(*blog.Mock).GetAllMatching("CRL purge failed")), 1)
+}
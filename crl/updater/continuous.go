@@ -12,7 +12,9 @@ import (
 
 // Run causes the crlUpdater to enter its processing loop. It starts one
 // goroutine for every shard it intends to update, each of which will wake at
-// the appropriate interval.
+// the appropriate interval. If a deltaPeriod is configured, each goroutine also
+// generates a delta CRL after each full update, and every deltaPeriod between
+// them.
 func (cu *crlUpdater) Run(ctx context.Context) error {
 	var wg sync.WaitGroup
 
@@ -33,6 +35,18 @@ func (cu *crlUpdater) Run(ctx context.Context) error {
 		// Do work, then sleep for updatePeriod. Rinse, and repeat.
 		ticker := time.NewTicker(cu.updatePeriod)
 		defer ticker.Stop()
+
+		// Receiving from a nil channel blocks forever, so deltas are only
+		// generated if a deltaPeriod is configured.
+		var deltaTicker *time.Ticker
+		var deltaTick <-chan time.Time
+		if cu.deltaPeriod > 0 {
+			deltaTicker = time.NewTicker(cu.deltaPeriod)
+			defer deltaTicker.Stop()
+			deltaTick = deltaTicker.C
+		}
+
+		full := true
 		for {
 			// Check for context cancellation before we do any real work, in case we
 			// overran the last tick and both cases were selectable at the same time.
@@ -41,18 +55,30 @@ func (cu *crlUpdater) Run(ctx context.Context) error {
 			}
 
 			atTime := cu.clk.Now()
-			err := cu.updateShardWithRetry(ctx, atTime, issuerNameID, shardIdx, nil)
+			var err error
+			if full {
+				err = cu.updateShardWithRetry(ctx, atTime, issuerNameID, shardIdx, nil)
+				if deltaTicker != nil {
+					// updateShardWithRetry has already replaced the shard's delta,
+					// so count the next one from this full CRL.
+					deltaTicker.Reset(cu.deltaPeriod)
+				}
+			} else {
+				err = cu.updateDeltaWithRetry(ctx, atTime, time.Time{}, issuerNameID, shardIdx)
+			}
 			if err != nil {
 				// We only log, rather than return, so that the long-lived process can
 				// continue and try again at the next tick.
 				cu.log.AuditErrf(
-					"Generating CRL failed: id=[%s] err=[%s]",
-					crl.Id(issuerNameID, shardIdx, crl.Number(atTime)), err)
+					"Generating CRL failed: id=[%s] delta=[%t] err=[%s]",
+					crl.Id(issuerNameID, shardIdx, crl.Number(atTime)), !full, err)
 			}
 
 			select {
 			case <-ticker.C:
-				continue
+				full = true
+			case <-deltaTick:
+				full = false
 			case <-ctx.Done():
 				return
 			}
@@ -24,12 +24,30 @@ import (
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
+// ShardingStrategy identifies a method of assigning revoked certificates to
+// CRL shards.
+type ShardingStrategy string
+
+const (
+	// ShardByExpiry places each certificate in the shard whose current chunk of
+	// the timeline contains the certificate's notAfter date. See
+	// getShardMappings for details.
+	ShardByExpiry ShardingStrategy = "expiry"
+	// ShardBySerial places each certificate in the shard which was chosen from
+	// a hash of its serial at issuance time, embedded in its CRL Distribution
+	// Point, and recorded in the revokedCertificates table at revocation time.
+	ShardBySerial ShardingStrategy = "serial"
+)
+
 type crlUpdater struct {
 	issuers        map[issuance.NameID]*issuance.Certificate
 	numShards      int
+	shardByExpiry  bool
+	shardBySerial  bool
 	shardWidth     time.Duration
 	lookbackPeriod time.Duration
 	updatePeriod   time.Duration
+	deltaPeriod    time.Duration
 	updateTimeout  time.Duration
 	maxParallelism int
 	maxAttempts    int
@@ -48,9 +66,11 @@ type crlUpdater struct {
 func NewUpdater(
 	issuers []*issuance.Certificate,
 	numShards int,
+	strategies []ShardingStrategy,
 	shardWidth time.Duration,
 	lookbackPeriod time.Duration,
 	updatePeriod time.Duration,
+	deltaPeriod time.Duration,
 	updateTimeout time.Duration,
 	maxParallelism int,
 	maxAttempts int,
@@ -70,6 +90,21 @@ func NewUpdater(
 		return nil, fmt.Errorf("must have positive number of shards, got: %d", numShards)
 	}
 
+	if len(strategies) == 0 {
+		return nil, errors.New("must have at least one sharding strategy")
+	}
+	var shardByExpiry, shardBySerial bool
+	for _, strategy := range strategies {
+		switch strategy {
+		case ShardByExpiry:
+			shardByExpiry = true
+		case ShardBySerial:
+			shardBySerial = true
+		default:
+			return nil, fmt.Errorf("unrecognized sharding strategy %q", strategy)
+		}
+	}
+
 	if updatePeriod >= 7*24*time.Hour {
 		return nil, fmt.Errorf("must update CRLs at least every 7 days, got: %s", updatePeriod)
 	}
@@ -78,6 +113,14 @@ func NewUpdater(
 		return nil, fmt.Errorf("update timeout must be less than period: %s !< %s", updateTimeout, updatePeriod)
 	}
 
+	if deltaPeriod < 0 || deltaPeriod >= updatePeriod {
+		return nil, fmt.Errorf("delta period must be zero, or positive and less than update period: %s !< %s", deltaPeriod, updatePeriod)
+	}
+
+	if deltaPeriod > 0 && updateTimeout >= deltaPeriod {
+		return nil, fmt.Errorf("update timeout must be less than delta period: %s !< %s", updateTimeout, deltaPeriod)
+	}
+
 	if lookbackPeriod < 2*updatePeriod {
 		return nil, fmt.Errorf("lookbackPeriod must be at least 2x updatePeriod: %s !< 2 * %s", lookbackPeriod, updatePeriod)
 	}
@@ -106,9 +149,12 @@ func NewUpdater(
 	return &crlUpdater{
 		issuersByNameID,
 		numShards,
+		shardByExpiry,
+		shardBySerial,
 		shardWidth,
 		lookbackPeriod,
 		updatePeriod,
+		deltaPeriod,
 		updateTimeout,
 		maxParallelism,
 		maxAttempts,
@@ -130,13 +176,12 @@ func (cu *crlUpdater) updateShardWithRetry(ctx context.Context, atTime time.Time
 	deadline, _ := ctx.Deadline()
 
 	if chunks == nil {
-		// Compute the shard map and relevant chunk boundaries, if not supplied.
-		// Batch mode supplies this to avoid duplicate computation.
-		shardMap, err := cu.getShardMappings(ctx, atTime)
+		// Compute the relevant chunk boundaries, if not supplied.
+		var err error
+		chunks, err = cu.getChunks(ctx, atTime, shardIdx)
 		if err != nil {
-			return fmt.Errorf("computing shardmap: %w", err)
+			return err
 		}
-		chunks = shardMap[shardIdx%cu.numShards]
 	}
 
 	_, err := cu.sa.LeaseCRLShard(ctx, &sapb.LeaseCRLShardRequest{
@@ -161,7 +206,7 @@ func (cu *crlUpdater) updateShardWithRetry(ctx context.Context, atTime time.Time
 		}
 		cu.clk.Sleep(sleepTime)
 
-		err = cu.updateShard(ctx, atTime, issuerNameID, shardIdx, chunks)
+		err = cu.updateShard(ctx, atTime, issuerNameID, shardIdx, chunks, time.Time{})
 		if err == nil {
 			break
 		}
@@ -175,24 +220,115 @@ func (cu *crlUpdater) updateShardWithRetry(ctx context.Context, atTime time.Time
 		IssuerNameID: int64(issuerNameID),
 		ShardIdx:     int64(shardIdx),
 		ThisUpdate:   timestamppb.New(atTime),
+		CrlNumber:    atTime.UnixNano(),
 	})
 	if err != nil {
 		return fmt.Errorf("updating db metadata: %w", err)
 	}
 
+	// The shard's current delta CRL is based on the full CRL we just replaced,
+	// so replace it too, rather than leaving relying parties with an
+	// inconsistent pair until the next delta is due.
+	if cu.deltaPeriod > 0 {
+		err = cu.updateDeltaWithRetry(ctx, cu.clk.Now(), atTime, issuerNameID, shardIdx)
+		if err != nil {
+			return fmt.Errorf("generating delta CRL for new base: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// updateDeltaWithRetry calls updateShard repeatedly (with exponential backoff
+// between attempts) to generate a delta CRL for the given shard, until it
+// succeeds or the max number of attempts is reached. The delta is based on the
+// full CRL generated at base or, if base is zero, on the most recent full CRL
+// for the shard, as recorded in the database. Unlike updateShardWithRetry, it
+// neither leases the shard nor updates its metadata, since the shard's base
+// remains the same.
+func (cu *crlUpdater) updateDeltaWithRetry(ctx context.Context, atTime time.Time, base time.Time, issuerNameID issuance.NameID, shardIdx int) error {
+	ctx, cancel := context.WithTimeout(ctx, cu.updateTimeout)
+	defer cancel()
+
+	if base.IsZero() {
+		shard, err := cu.sa.GetCRLShard(ctx, &sapb.GetCRLShardRequest{
+			IssuerNameID: int64(issuerNameID),
+			ShardIdx:     int64(shardIdx),
+		})
+		if err != nil {
+			return fmt.Errorf("getting base CRL metadata: %w", err)
+		}
+		// The base's thisUpdate is only stored to the second, so its exact time,
+		// and therefore its CRL Number, comes from the stored CRL Number.
+		if shard.CrlNumber == 0 {
+			return errors.New("no base CRL has been generated for this shard yet")
+		}
+		base = time.Unix(0, shard.CrlNumber).UTC()
+	}
+	if !base.Before(atTime) {
+		return fmt.Errorf("base CRL thisUpdate %s is not before delta thisUpdate %s", base, atTime)
+	}
+
+	chunks, err := cu.getChunks(ctx, atTime, shardIdx)
+	if err != nil {
+		return err
+	}
+
+	crlID := crl.Id(issuerNameID, shardIdx, crl.Number(atTime))
+
+	for i := range cu.maxAttempts {
+		// core.RetryBackoff always returns 0 when its first argument is zero.
+		sleepTime := core.RetryBackoff(i, time.Second, time.Minute, 2)
+		if i != 0 {
+			cu.log.Errf(
+				"Generating delta CRL failed, will retry in %vs: id=[%s] err=[%s]",
+				sleepTime.Seconds(), crlID, err)
+		}
+		cu.clk.Sleep(sleepTime)
+
+		err = cu.updateShard(ctx, atTime, issuerNameID, shardIdx, chunks, base)
+		if err == nil {
+			break
+		}
+	}
+	return err
+}
+
 // updateShard processes a single shard. It computes the shard's boundaries, gets
 // the list of revoked certs in that shard from the SA, gets the CA to sign the
 // resulting CRL, and gets the crl-storer to upload it. It returns an error if
 // any of these operations fail.
-func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerNameID issuance.NameID, shardIdx int, chunks []chunk) (err error) {
+//
+// The certs which expire within the given chunks are included. If the updater
+// shards by serial, the certs recorded in the revokedCertificates table for
+// this shard are also included, and serial-sharded certs are left out of the
+// chunks so that each cert appears exactly once. See getChunks for the chunks
+// each shard covers.
+//
+// If base is non-zero, it is the thisUpdate of the shard's most recent full
+// CRL, and updateShard instead produces a delta CRL containing only the certs
+// revoked since the start of that second. Certs which have expired since the
+// base are not marked for removal from it; the next full CRL drops them.
+func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerNameID issuance.NameID, shardIdx int, chunks []chunk, base time.Time) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	crlID := crl.Id(issuerNameID, shardIdx, crl.Number(atTime))
 
+	kind := "CRL shard"
+	var revokedAfter, baseThisUpdate *timestamppb.Timestamp
+	var baseNumber int64
+	if !base.IsZero() {
+		kind = "delta CRL shard"
+		// Revocation times are stored with only seconds precision, so a cert
+		// revoked later in the same second as the base is recorded as revoked
+		// before it. Include that whole second; a cert which is also in the
+		// base CRL is harmlessly listed again.
+		revokedAfter = timestamppb.New(base.Truncate(time.Second))
+		baseThisUpdate = timestamppb.New(base)
+		baseNumber = base.UnixNano()
+	}
+
 	start := cu.clk.Now()
 	defer func() {
 		// This func closes over the named return value `err`, so can reference it.
@@ -205,17 +341,12 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 	}()
 
 	cu.log.Infof(
-		"Generating CRL shard: id=[%s] numChunks=[%d]", crlID, len(chunks))
+		"Generating %s: id=[%s] numChunks=[%d]", kind, crlID, len(chunks))
 
 	// Get the full list of CRL Entries for this shard from the SA.
 	var crlEntries []*proto.CRLEntry
-	for _, chunk := range chunks {
-		saStream, err := cu.sa.GetRevokedCerts(ctx, &sapb.GetRevokedCertsRequest{
-			IssuerNameID:  int64(issuerNameID),
-			ExpiresAfter:  timestamppb.New(chunk.start),
-			ExpiresBefore: timestamppb.New(chunk.end),
-			RevokedBefore: timestamppb.New(atTime),
-		})
+	getEntries := func(req *sapb.GetRevokedCertsRequest) error {
+		saStream, err := cu.sa.GetRevokedCerts(ctx, req)
 		if err != nil {
 			return fmt.Errorf("connecting to SA: %w", err)
 		}
@@ -224,16 +355,50 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 			entry, err := saStream.Recv()
 			if err != nil {
 				if err == io.EOF {
-					break
+					return nil
 				}
 				return fmt.Errorf("retrieving entry from SA: %w", err)
 			}
 			crlEntries = append(crlEntries, entry)
 		}
+	}
+
+	for _, chunk := range chunks {
+		err = getEntries(&sapb.GetRevokedCertsRequest{
+			IssuerNameID:         int64(issuerNameID),
+			ExpiresAfter:         timestamppb.New(chunk.start),
+			ExpiresBefore:        timestamppb.New(chunk.end),
+			RevokedBefore:        timestamppb.New(atTime),
+			RevokedAfter:         revokedAfter,
+			ExcludeSerialSharded: cu.shardBySerial,
+		})
+		if err != nil {
+			return err
+		}
+
+		cu.log.Infof(
+			"Queried SA for %s: id=[%s] expiresAfter=[%s] expiresBefore=[%s] numEntries=[%d]",
+			kind, crlID, chunk.start, chunk.end, len(crlEntries))
+	}
+
+	if cu.shardBySerial {
+		// Certs which expired before the lookback period began will already have
+		// appeared in at least one CRL, so we don't need to include them again.
+		expiresAfter := atTime.Add(-cu.lookbackPeriod)
+		err = getEntries(&sapb.GetRevokedCertsRequest{
+			IssuerNameID:  int64(issuerNameID),
+			ShardIdx:      int64(shardIdx),
+			ExpiresAfter:  timestamppb.New(expiresAfter),
+			RevokedBefore: timestamppb.New(atTime),
+			RevokedAfter:  revokedAfter,
+		})
+		if err != nil {
+			return err
+		}
 
 		cu.log.Infof(
-			"Queried SA for CRL shard: id=[%s] expiresAfter=[%s] expiresBefore=[%s] numEntries=[%d]",
-			crlID, chunk.start, chunk.end, len(crlEntries))
+			"Queried SA for %s: id=[%s] shardIdx=[%d] expiresAfter=[%s] numEntries=[%d]",
+			kind, crlID, shardIdx, expiresAfter, len(crlEntries))
 	}
 
 	// Send the full list of CRL Entries to the CA.
@@ -245,9 +410,10 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 	err = caStream.Send(&capb.GenerateCRLRequest{
 		Payload: &capb.GenerateCRLRequest_Metadata{
 			Metadata: &capb.CRLMetadata{
-				IssuerNameID: int64(issuerNameID),
-				ThisUpdate:   timestamppb.New(atTime),
-				ShardIdx:     int64(shardIdx),
+				IssuerNameID:   int64(issuerNameID),
+				ThisUpdate:     timestamppb.New(atTime),
+				ShardIdx:       int64(shardIdx),
+				BaseThisUpdate: baseThisUpdate,
 			},
 		},
 	})
@@ -301,6 +467,7 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 				IssuerNameID: int64(issuerNameID),
 				Number:       atTime.UnixNano(),
 				ShardIdx:     int64(shardIdx),
+				BaseNumber:   baseNumber,
 			},
 		},
 	})
@@ -325,8 +492,8 @@ func (cu *crlUpdater) updateShard(ctx context.Context, atTime time.Time, issuerN
 	}
 
 	cu.log.Infof(
-		"Generated CRL shard: id=[%s] size=[%d] hash=[%x]",
-		crlID, crlLen, crlHash.Sum(nil))
+		"Generated %s: id=[%s] size=[%d] hash=[%x]",
+		kind, crlID, crlLen, crlHash.Sum(nil))
 
 	return nil
 }
@@ -357,6 +524,40 @@ type chunk struct {
 // mapped to a single shard.
 type shardMap [][]chunk
 
+// getChunks returns the chunks of the timeline whose revoked certs, other than
+// serial-sharded ones, belong in the given shard. If the updater shards by
+// expiry, those are the chunks mapped to the shard by getShardMappings.
+//
+// Otherwise, the updater shards only by serial, but some revoked certs may
+// still have no serial shard: the RA can't find the CRL Distribution Point of
+// a malformed cert revoked by an admin. So that those certs are never left out
+// of every CRL, the highest-numbered shard covers a single chunk spanning every
+// relevant expiration, and the other shards cover none.
+func (cu *crlUpdater) getChunks(ctx context.Context, atTime time.Time, shardIdx int) ([]chunk, error) {
+	if cu.shardByExpiry {
+		shardMap, err := cu.getShardMappings(ctx, atTime)
+		if err != nil {
+			return nil, fmt.Errorf("computing shardmap: %w", err)
+		}
+		return shardMap[shardIdx%cu.numShards], nil
+	}
+
+	if shardIdx != cu.numShards {
+		return nil, nil
+	}
+
+	lastExpiry, err := cu.sa.GetMaxExpiration(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("getting max expiration: %w", err)
+	}
+	// Certs expiring at exactly lastExpiry must fall inside the chunk, whose
+	// end is exclusive.
+	return []chunk{{
+		start: atTime.Add(-cu.lookbackPeriod),
+		end:   lastExpiry.AsTime().Add(time.Second),
+	}}, nil
+}
+
 // getShardMappings determines which chunks are currently relevant, based on
 // the current time, the configured lookbackPeriod, and the farthest-future
 // certificate expiration in the database. It then maps all of those chunks to
@@ -2,7 +2,9 @@ package updater
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"io"
 	"testing"
 	"time"
@@ -14,9 +16,14 @@ import (
 	"github.com/jmhodges/clock"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/letsencrypt/boulder/ca"
 	capb "github.com/letsencrypt/boulder/ca/proto"
+	"github.com/letsencrypt/boulder/config"
 	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/crl/delta"
+	"github.com/letsencrypt/boulder/crl/storer"
 	cspb "github.com/letsencrypt/boulder/crl/storer/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/issuance"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
@@ -47,19 +54,54 @@ func (f *fakeGRCC) Recv() (*corepb.CRLEntry, error) {
 }
 
 // fakeSAC is a fake sapb.StorageAuthorityClient which can be populated with a
-// fakeGRCC to be used as the return value for calls to GetRevokedCerts, and a
-// fake timestamp to serve as the database's maximum notAfter value.
+// fakeGRCC to be used as the return value for calls to GetRevokedCerts, a fake
+// timestamp to serve as the database's maximum notAfter value, and a fake CRL
+// shard to be used as the return value for calls to GetCRLShard.
 type fakeSAC struct {
 	sapb.StorageAuthorityClient
 	grcc        fakeGRCC
 	maxNotAfter time.Time
 	leaseError  error
+	crlShard    *sapb.CRLShard
+	revokedReqs []*sapb.GetRevokedCertsRequest
 }
 
-func (f *fakeSAC) GetRevokedCerts(ctx context.Context, _ *sapb.GetRevokedCertsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[corepb.CRLEntry], error) {
+func (f *fakeSAC) GetRevokedCerts(ctx context.Context, req *sapb.GetRevokedCertsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[corepb.CRLEntry], error) {
+	f.revokedReqs = append(f.revokedReqs, req)
+	if req.RevokedAfter != nil {
+		// Filter by revocation time as the SA does, returning a fresh stream so
+		// that the shared one's position is unaffected.
+		var entries []*corepb.CRLEntry
+		for _, entry := range f.grcc.entries {
+			if !entry.RevokedAt.AsTime().Before(req.RevokedAfter.AsTime()) {
+				entries = append(entries, entry)
+			}
+		}
+		return &fakeGRCC{entries: entries, err: f.grcc.err}, nil
+	}
 	return &f.grcc, nil
 }
 
+func (f *fakeSAC) GetCRLShard(_ context.Context, req *sapb.GetCRLShardRequest, _ ...grpc.CallOption) (*sapb.CRLShard, error) {
+	if f.crlShard == nil {
+		return nil, berrors.NotFoundError("no CRL shard %d", req.ShardIdx)
+	}
+	return f.crlShard, nil
+}
+
+// UpdateCRLShard records the shard's new full CRL as the database does, which
+// stores its thisUpdate with only seconds precision.
+func (f *fakeSAC) UpdateCRLShard(_ context.Context, req *sapb.UpdateCRLShardRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	f.crlShard = &sapb.CRLShard{
+		IssuerNameID: req.IssuerNameID,
+		ShardIdx:     req.ShardIdx,
+		ThisUpdate:   timestamppb.New(req.ThisUpdate.AsTime().Truncate(time.Second)),
+		NextUpdate:   req.NextUpdate,
+		CrlNumber:    req.CrlNumber,
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (f *fakeSAC) GetMaxExpiration(_ context.Context, req *emptypb.Empty, _ ...grpc.CallOption) (*timestamppb.Timestamp, error) {
 	return timestamppb.New(f.maxNotAfter), nil
 }
@@ -76,13 +118,21 @@ func (f *fakeSAC) LeaseCRLShard(_ context.Context, req *sapb.LeaseCRLShardReques
 // a faked GenerateCRL call.
 type fakeGCC struct {
 	grpc.ClientStream
-	chunks  [][]byte
-	nextIdx int
-	sendErr error
-	recvErr error
+	chunks   [][]byte
+	nextIdx  int
+	sendErr  error
+	recvErr  error
+	metadata *capb.CRLMetadata
+	entries  []*corepb.CRLEntry
 }
 
-func (f *fakeGCC) Send(*capb.GenerateCRLRequest) error {
+func (f *fakeGCC) Send(req *capb.GenerateCRLRequest) error {
+	if req.GetMetadata() != nil {
+		f.metadata = req.GetMetadata()
+	}
+	if req.GetEntry() != nil {
+		f.entries = append(f.entries, req.GetEntry())
+	}
 	return f.sendErr
 }
 
@@ -116,11 +166,15 @@ func (f *fakeCGC) GenerateCRL(ctx context.Context, opts ...grpc.CallOption) (grp
 // an error for use as the return value of a faked UploadCRL call.
 type fakeUCC struct {
 	grpc.ClientStream
-	sendErr error
-	recvErr error
+	sendErr  error
+	recvErr  error
+	metadata *cspb.CRLMetadata
 }
 
-func (f *fakeUCC) Send(*cspb.UploadCRLRequest) error {
+func (f *fakeUCC) Send(req *cspb.UploadCRLRequest) error {
+	if req.GetMetadata() != nil {
+		f.metadata = req.GetMetadata()
+	}
 	return f.sendErr
 }
 
@@ -141,6 +195,140 @@ func (f *fakeCSC) UploadCRL(ctx context.Context, opts ...grpc.CallOption) (grpc.
 	return &f.ucc, nil
 }
 
+// inProcessCGC is a capb.CRLGeneratorClient which streams its requests to a
+// real CRLGeneratorServer running in the same process.
+type inProcessCGC struct {
+	impl capb.CRLGeneratorServer
+}
+
+func (c *inProcessCGC) GenerateCRL(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[capb.GenerateCRLRequest, capb.GenerateCRLResponse], error) {
+	reqs := make(chan *capb.GenerateCRLRequest, 100)
+	resps := make(chan *capb.GenerateCRLResponse)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- c.impl.GenerateCRL(&inProcessGCS{ctx: ctx, reqs: reqs, resps: resps})
+		close(resps)
+	}()
+	return &inProcessGCC{reqs: reqs, resps: resps, errs: errs}, nil
+}
+
+// inProcessGCC is the client end of an in-process GenerateCRL stream.
+type inProcessGCC struct {
+	grpc.ClientStream
+	reqs  chan<- *capb.GenerateCRLRequest
+	resps <-chan *capb.GenerateCRLResponse
+	errs  <-chan error
+}
+
+func (s *inProcessGCC) Send(req *capb.GenerateCRLRequest) error {
+	s.reqs <- req
+	return nil
+}
+
+func (s *inProcessGCC) CloseSend() error {
+	close(s.reqs)
+	return nil
+}
+
+func (s *inProcessGCC) Recv() (*capb.GenerateCRLResponse, error) {
+	resp, ok := <-s.resps
+	if !ok {
+		err := <-s.errs
+		if err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return resp, nil
+}
+
+// inProcessGCS is the server end of an in-process GenerateCRL stream.
+type inProcessGCS struct {
+	grpc.ServerStream
+	ctx   context.Context
+	reqs  <-chan *capb.GenerateCRLRequest
+	resps chan<- *capb.GenerateCRLResponse
+}
+
+func (s *inProcessGCS) Context() context.Context {
+	return s.ctx
+}
+
+func (s *inProcessGCS) Recv() (*capb.GenerateCRLRequest, error) {
+	req, ok := <-s.reqs
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func (s *inProcessGCS) Send(resp *capb.GenerateCRLResponse) error {
+	s.resps <- resp
+	return nil
+}
+
+// inProcessCSC is a cspb.CRLStorerClient which streams its requests to a real
+// CRLStorerServer running in the same process. Each upload advances clk by
+// a millisecond, as real uploads take time.
+type inProcessCSC struct {
+	impl cspb.CRLStorerServer
+	clk  clock.FakeClock
+}
+
+func (c *inProcessCSC) UploadCRL(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[cspb.UploadCRLRequest, emptypb.Empty], error) {
+	reqs := make(chan *cspb.UploadCRLRequest, 100)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- c.impl.UploadCRL(&inProcessUCS{ctx: ctx, reqs: reqs})
+		c.clk.Add(time.Millisecond)
+	}()
+	return &inProcessUCC{reqs: reqs, errs: errs}, nil
+}
+
+// inProcessUCC is the client end of an in-process UploadCRL stream.
+type inProcessUCC struct {
+	grpc.ClientStream
+	reqs chan<- *cspb.UploadCRLRequest
+	errs <-chan error
+}
+
+func (s *inProcessUCC) Send(req *cspb.UploadCRLRequest) error {
+	s.reqs <- req
+	return nil
+}
+
+func (s *inProcessUCC) CloseAndRecv() (*emptypb.Empty, error) {
+	close(s.reqs)
+	err := <-s.errs
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// inProcessUCS is the server end of an in-process UploadCRL stream.
+type inProcessUCS struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs <-chan *cspb.UploadCRLRequest
+}
+
+func (s *inProcessUCS) Context() context.Context {
+	return s.ctx
+}
+
+func (s *inProcessUCS) Recv() (*cspb.UploadCRLRequest, error) {
+	req, ok := <-s.reqs
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func (s *inProcessUCS) SendAndClose(*emptypb.Empty) error {
+	return nil
+}
+
 func TestUpdateShard(t *testing.T) {
 	e1, err := issuance.LoadCertificate("../../test/hierarchy/int-e1.cert.pem")
 	test.AssertNotError(t, err, "loading test issuer")
@@ -155,8 +343,8 @@ func TestUpdateShard(t *testing.T) {
 	clk.Set(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
 	cu, err := NewUpdater(
 		[]*issuance.Certificate{e1, r3},
-		2, 18*time.Hour, 24*time.Hour,
-		6*time.Hour, time.Minute, 1, 1,
+		2, []ShardingStrategy{ShardByExpiry}, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, 0, time.Minute, 1, 1,
 		&fakeSAC{grcc: fakeGRCC{}, maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)},
 		&fakeCGC{gcc: fakeGCC{}},
 		&fakeCSC{ucc: fakeUCC{}},
@@ -169,7 +357,7 @@ func TestUpdateShard(t *testing.T) {
 	}
 
 	// Ensure that getting no results from the SA still works.
-	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks)
+	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks, time.Time{})
 	test.AssertNotError(t, err, "empty CRL")
 	test.AssertMetricWithLabelsEquals(t, cu.updatedCounter, prometheus.Labels{
 		"issuer": "(TEST) Elegant Elephant E1", "result": "success",
@@ -178,7 +366,7 @@ func TestUpdateShard(t *testing.T) {
 
 	// Errors closing the Storer upload stream should bubble up.
 	cu.cs = &fakeCSC{ucc: fakeUCC{recvErr: sentinelErr}}
-	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks)
+	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks, time.Time{})
 	test.AssertError(t, err, "storer error")
 	test.AssertContains(t, err.Error(), "closing CRLStorer upload stream")
 	test.AssertErrorIs(t, err, sentinelErr)
@@ -189,7 +377,7 @@ func TestUpdateShard(t *testing.T) {
 
 	// Errors sending to the Storer should bubble up sooner.
 	cu.cs = &fakeCSC{ucc: fakeUCC{sendErr: sentinelErr}}
-	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks)
+	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks, time.Time{})
 	test.AssertError(t, err, "storer error")
 	test.AssertContains(t, err.Error(), "sending CRLStorer metadata")
 	test.AssertErrorIs(t, err, sentinelErr)
@@ -200,7 +388,7 @@ func TestUpdateShard(t *testing.T) {
 
 	// Errors reading from the CA should bubble up sooner.
 	cu.ca = &fakeCGC{gcc: fakeGCC{recvErr: sentinelErr}}
-	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks)
+	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks, time.Time{})
 	test.AssertError(t, err, "CA error")
 	test.AssertContains(t, err.Error(), "receiving CRL bytes")
 	test.AssertErrorIs(t, err, sentinelErr)
@@ -211,7 +399,7 @@ func TestUpdateShard(t *testing.T) {
 
 	// Errors sending to the CA should bubble up sooner.
 	cu.ca = &fakeCGC{gcc: fakeGCC{sendErr: sentinelErr}}
-	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks)
+	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks, time.Time{})
 	test.AssertError(t, err, "CA error")
 	test.AssertContains(t, err.Error(), "sending CA metadata")
 	test.AssertErrorIs(t, err, sentinelErr)
@@ -222,7 +410,7 @@ func TestUpdateShard(t *testing.T) {
 
 	// Errors reading from the SA should bubble up soonest.
 	cu.sa = &fakeSAC{grcc: fakeGRCC{err: sentinelErr}, maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)}
-	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks)
+	err = cu.updateShard(ctx, cu.clk.Now(), e1.NameID(), 0, testChunks, time.Time{})
 	test.AssertError(t, err, "database error")
 	test.AssertContains(t, err.Error(), "retrieving entry from SA")
 	test.AssertErrorIs(t, err, sentinelErr)
@@ -248,8 +436,8 @@ func TestUpdateShardWithRetry(t *testing.T) {
 	// Build an updater that will always fail when it talks to the SA.
 	cu, err := NewUpdater(
 		[]*issuance.Certificate{e1, r3},
-		2, 18*time.Hour, 24*time.Hour,
-		6*time.Hour, time.Minute, 1, 1,
+		2, []ShardingStrategy{ShardByExpiry}, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, 0, time.Minute, 1, 1,
 		&fakeSAC{grcc: fakeGRCC{err: sentinelErr}, maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)},
 		&fakeCGC{gcc: fakeGCC{}},
 		&fakeCSC{ucc: fakeUCC{}},
@@ -283,6 +471,274 @@ func TestUpdateShardWithRetry(t *testing.T) {
 	test.Assert(t, startTime.Add(15*1.2*time.Second).After(cu.clk.Now()), "retries slept too much")
 }
 
+func TestUpdateDeltaWithRetry(t *testing.T) {
+	e1, err := issuance.LoadCertificate("../../test/hierarchy/int-e1.cert.pem")
+	test.AssertNotError(t, err, "loading test issuer")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	clk := clock.NewFake()
+	clk.Set(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	sac := &fakeSAC{grcc: fakeGRCC{}, maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)}
+	cgc := &fakeCGC{gcc: fakeGCC{}}
+	csc := &fakeCSC{ucc: fakeUCC{}}
+	cu, err := NewUpdater(
+		[]*issuance.Certificate{e1},
+		2, []ShardingStrategy{ShardByExpiry}, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, time.Hour, time.Minute, 1, 1,
+		sac, cgc, csc,
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertNotError(t, err, "building test crlUpdater")
+
+	// Without a shard in the database, there's no base for the delta.
+	err = cu.updateDeltaWithRetry(ctx, clk.Now(), time.Time{}, e1.NameID(), 1)
+	test.AssertError(t, err, "delta without shard")
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	// Nor without a full CRL for the shard.
+	sac.crlShard = &sapb.CRLShard{IssuerNameID: int64(e1.NameID()), ShardIdx: 1}
+	err = cu.updateDeltaWithRetry(ctx, clk.Now(), time.Time{}, e1.NameID(), 1)
+	test.AssertError(t, err, "delta without base")
+	test.AssertContains(t, err.Error(), "no base CRL")
+
+	// With a full CRL, the delta only asks for certs revoked since its base,
+	// and tells the CA and crl-storer which base it belongs to. The base's
+	// exact time comes from its CRL Number, not its truncated thisUpdate.
+	base := clk.Now().Add(-2*time.Hour + 123456789*time.Nanosecond)
+	sac.crlShard.ThisUpdate = timestamppb.New(base.Truncate(time.Second))
+	sac.crlShard.CrlNumber = base.UnixNano()
+	err = cu.updateDeltaWithRetry(ctx, clk.Now(), time.Time{}, e1.NameID(), 1)
+	test.AssertNotError(t, err, "delta with base")
+	test.Assert(t, len(sac.revokedReqs) > 0, "delta should have queried the SA")
+	for _, req := range sac.revokedReqs {
+		test.AssertEquals(t, req.RevokedAfter.AsTime(), base.Truncate(time.Second))
+	}
+	test.AssertEquals(t, cgc.gcc.metadata.BaseThisUpdate.AsTime(), base)
+	test.AssertEquals(t, csc.ucc.metadata.BaseNumber, base.UnixNano())
+	test.AssertEquals(t, csc.ucc.metadata.Number, clk.Now().UnixNano())
+
+	// A cert revoked later in the same second as the base is stored with its
+	// revocation time truncated to before the base, but is still included.
+	sac.grcc.entries = []*corepb.CRLEntry{
+		{Serial: "0311b5d430823cfa25b0fc85d14c54ee35", Reason: 1, RevokedAt: timestamppb.New(base.Add(-time.Hour))},
+		{Serial: "037d6a05a0f6a975380456ae605cee9889", Reason: 1, RevokedAt: timestamppb.New(base.Truncate(time.Second))},
+	}
+	cgc.gcc.entries = nil
+	err = cu.updateDeltaWithRetry(ctx, clk.Now(), time.Time{}, e1.NameID(), 1)
+	test.AssertNotError(t, err, "delta with same-second revocation")
+	test.Assert(t, len(cgc.gcc.entries) > 0, "delta should include the same-second revocation")
+	for _, entry := range cgc.gcc.entries {
+		test.AssertEquals(t, entry.Serial, "037d6a05a0f6a975380456ae605cee9889")
+	}
+	sac.grcc.entries = nil
+
+	// Full CRLs have no base.
+	sac.revokedReqs = nil
+	err = cu.updateShard(ctx, clk.Now(), e1.NameID(), 1, []chunk{{clk.Now(), clk.Now().Add(18 * time.Hour), 1}}, time.Time{})
+	test.AssertNotError(t, err, "full CRL")
+	test.Assert(t, len(sac.revokedReqs) > 0, "full CRL should have queried the SA")
+	for _, req := range sac.revokedReqs {
+		test.AssertBoxedNil(t, req.RevokedAfter, "full CRL should not filter by revocation time")
+	}
+	test.AssertBoxedNil(t, cgc.gcc.metadata.BaseThisUpdate, "full CRL should have no base")
+	test.AssertEquals(t, csc.ucc.metadata.BaseNumber, int64(0))
+
+	// The delta period must be shorter than the update period.
+	_, err = NewUpdater(
+		[]*issuance.Certificate{e1},
+		2, []ShardingStrategy{ShardByExpiry}, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, 6*time.Hour, time.Minute, 1, 1,
+		sac, cgc, csc,
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertError(t, err, "delta period equal to update period")
+	test.AssertContains(t, err.Error(), "delta period must be")
+}
+
+func TestDeltaCRLsFromUpdaterToStorer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// CRL Numbers have nanosecond precision, which the database's record of
+	// each shard's thisUpdate doesn't.
+	clk := clock.NewFake()
+	clk.Set(time.Date(2020, time.January, 1, 0, 0, 0, 123456789, time.UTC))
+
+	issuer, err := issuance.LoadIssuer(
+		issuance.IssuerConfig{
+			Location: issuance.IssuerLoc{
+				File:     "../../test/hierarchy/int-e1.key.pem",
+				CertFile: "../../test/hierarchy/int-e1.cert.pem",
+			},
+			IssuerURL:  "http://not-example.com/issuer-url",
+			OCSPURL:    "http://not-example.com/ocsp",
+			CRLURLBase: "http://not-example.com/crl/",
+		}, clk)
+	test.AssertNotError(t, err, "loading test issuer")
+
+	crlImpl, err := ca.NewCRLImpl(
+		[]*issuance.Issuer{issuer},
+		issuance.CRLProfileConfig{
+			ValidityInterval: config.Duration{Duration: 216 * time.Hour},
+			MaxBackdate:      config.Duration{Duration: time.Hour},
+			DeltaCRLs:        true,
+		},
+		100, blog.NewMock(), ca.NewCAMetrics(metrics.NoopRegisterer),
+	)
+	test.AssertNotError(t, err, "building test CA")
+
+	backend, err := storer.NewFilesystemBackend(t.TempDir())
+	test.AssertNotError(t, err, "building test backend")
+	cs, err := storer.New(
+		[]*issuance.Certificate{issuer.Cert}, []storer.Backend{backend}, nil,
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertNotError(t, err, "building test crl-storer")
+
+	sac := &fakeSAC{grcc: fakeGRCC{}, maxNotAfter: clk.Now().Add(90 * 24 * time.Hour)}
+	cu, err := NewUpdater(
+		[]*issuance.Certificate{issuer.Cert},
+		2, []ShardingStrategy{ShardByExpiry}, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, time.Hour, time.Minute, 1, 1,
+		sac, &inProcessCGC{impl: crlImpl}, &inProcessCSC{impl: cs, clk: clk},
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertNotError(t, err, "building test crlUpdater")
+
+	getCRL := func(key string) *x509.RevocationList {
+		t.Helper()
+		crlBytes, err := backend.Get(ctx, key)
+		test.AssertNotError(t, err, "getting stored CRL")
+		test.Assert(t, crlBytes != nil, "CRL not stored")
+		crl, err := x509.ParseRevocationList(crlBytes)
+		test.AssertNotError(t, err, "parsing stored CRL")
+		return crl
+	}
+	fullKey := fmt.Sprintf("%d/1.crl", issuer.NameID())
+	deltaKey := fmt.Sprintf("%d/1-delta.crl", issuer.NameID())
+
+	// Each full CRL is immediately followed by a delta based on it.
+	for range 2 {
+		atTime := clk.Now()
+		err = cu.updateShardWithRetry(ctx, atTime, issuer.NameID(), 1, nil)
+		test.AssertNotError(t, err, "generating full CRL")
+
+		full := getCRL(fullKey)
+		test.AssertEquals(t, full.Number.Int64(), atTime.UnixNano())
+		deltaCRL := getCRL(deltaKey)
+		base, err := delta.GetBaseNumber(deltaCRL.Extensions)
+		test.AssertNotError(t, err, "getting delta's base")
+		test.AssertEquals(t, base.Cmp(full.Number), 0)
+
+		// Later deltas take their base from the database, and the storer
+		// accepts them because it's still the full CRL in storage.
+		clk.Add(time.Hour)
+		atTime = clk.Now()
+		err = cu.updateDeltaWithRetry(ctx, atTime, time.Time{}, issuer.NameID(), 1)
+		test.AssertNotError(t, err, "generating delta CRL")
+		deltaCRL = getCRL(deltaKey)
+		test.AssertEquals(t, deltaCRL.Number.Int64(), atTime.UnixNano())
+		base, err = delta.GetBaseNumber(deltaCRL.Extensions)
+		test.AssertNotError(t, err, "getting delta's base")
+		test.AssertEquals(t, base.Cmp(full.Number), 0)
+
+		clk.Add(5 * time.Hour)
+	}
+}
+
+func TestUpdateShardShardingStrategies(t *testing.T) {
+	e1, err := issuance.LoadCertificate("../../test/hierarchy/int-e1.cert.pem")
+	test.AssertNotError(t, err, "loading test issuer")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	clk := clock.NewFake()
+	clk.Set(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	maxNotAfter := clk.Now().Add(90 * 24 * time.Hour)
+
+	testCases := []struct {
+		name             string
+		strategies       []ShardingStrategy
+		shardIdx         int
+		expectedBySerial bool
+	}{
+		{"expiry only", []ShardingStrategy{ShardByExpiry}, 2, false},
+		{"serial only", []ShardingStrategy{ShardBySerial}, 1, true},
+		{"serial only fallback shard", []ShardingStrategy{ShardBySerial}, 2, true},
+		{"migrating", []ShardingStrategy{ShardByExpiry, ShardBySerial}, 2, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sac := &fakeSAC{grcc: fakeGRCC{}, maxNotAfter: maxNotAfter}
+			cu, err := NewUpdater(
+				[]*issuance.Certificate{e1},
+				2, tc.strategies, 18*time.Hour, 24*time.Hour,
+				6*time.Hour, 0, time.Minute, 1, 1,
+				sac, &fakeCGC{gcc: fakeGCC{}}, &fakeCSC{ucc: fakeUCC{}},
+				metrics.NoopRegisterer, blog.NewMock(), clk,
+			)
+			test.AssertNotError(t, err, "building test crlUpdater")
+
+			chunks, err := cu.getChunks(ctx, clk.Now(), tc.shardIdx)
+			test.AssertNotError(t, err, "getChunks")
+			switch {
+			case cu.shardByExpiry:
+				test.Assert(t, len(chunks) > 0, "expiry-sharded shard should cover some chunks")
+			case tc.shardIdx == 2:
+				// When sharding only by serial, the highest-numbered shard covers
+				// every revoked cert without a serial shard.
+				test.AssertEquals(t, len(chunks), 1)
+				test.AssertEquals(t, chunks[0].start, clk.Now().Add(-24*time.Hour))
+				test.AssertEquals(t, chunks[0].end, maxNotAfter.Add(time.Second))
+			default:
+				test.AssertEquals(t, len(chunks), 0)
+			}
+
+			err = cu.updateShard(ctx, clk.Now(), e1.NameID(), tc.shardIdx, chunks, time.Time{})
+			test.AssertNotError(t, err, "updateShard")
+
+			var chunkReqs, serialReqs int
+			for _, req := range sac.revokedReqs {
+				if req.ShardIdx != 0 {
+					serialReqs++
+					test.AssertEquals(t, req.ShardIdx, int64(tc.shardIdx))
+					test.AssertEquals(t, req.ExpiresAfter.AsTime(), clk.Now().Add(-24*time.Hour))
+					continue
+				}
+				chunkReqs++
+				// Serial-sharded certs must be excluded from the chunks whenever
+				// the serial strategy is in use.
+				test.AssertEquals(t, req.ExcludeSerialSharded, tc.expectedBySerial)
+			}
+			test.AssertEquals(t, chunkReqs, len(chunks))
+			test.AssertEquals(t, serialReqs == 1, tc.expectedBySerial)
+		})
+	}
+
+	_, err = NewUpdater(
+		[]*issuance.Certificate{e1},
+		2, []ShardingStrategy{"bogus"}, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, 0, time.Minute, 1, 1,
+		&fakeSAC{}, &fakeCGC{}, &fakeCSC{},
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertError(t, err, "unrecognized sharding strategy")
+
+	_, err = NewUpdater(
+		[]*issuance.Certificate{e1},
+		2, nil, 18*time.Hour, 24*time.Hour,
+		6*time.Hour, 0, time.Minute, 1, 1,
+		&fakeSAC{}, &fakeCGC{}, &fakeCSC{},
+		metrics.NoopRegisterer, blog.NewMock(), clk,
+	)
+	test.AssertError(t, err, "no sharding strategies")
+}
+
 func TestGetShardMappings(t *testing.T) {
 	// We set atTime to be exactly one day (numShards * shardWidth) after the
 	// anchorTime for these tests, so that we know that the index of the first
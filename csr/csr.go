@@ -10,6 +10,7 @@ import (
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/identifier"
 )
 
 // maxCNLength is the maximum length allowed for the common name as specified in RFC 5280
@@ -19,6 +20,9 @@ const maxCNLength = 64
 // strong enough to use. Significantly the missing algorithms are:
 // * No algorithms using MD2, MD5, or SHA-1
 // * No DSA algorithms
+// Ed25519 and ML-DSA signatures can only be made by keys of the same type,
+// which must also be allowed by the key policy. The ML-DSA algorithms are
+// added in mldsa.go when built with a version of Go which supports them.
 var goodSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
 	x509.SHA256WithRSA:   true,
 	x509.SHA384WithRSA:   true,
@@ -26,6 +30,7 @@ var goodSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
 	x509.ECDSAWithSHA256: true,
 	x509.ECDSAWithSHA384: true,
 	x509.ECDSAWithSHA512: true,
+	x509.PureEd25519:     true,
 }
 
 var (
@@ -33,13 +38,12 @@ var (
 	unsupportedSigAlg   = berrors.BadCSRError("signature algorithm not supported")
 	invalidSig          = berrors.BadCSRError("invalid signature on CSR")
 	invalidEmailPresent = berrors.BadCSRError("CSR contains one or more email address fields")
-	invalidIPPresent    = berrors.BadCSRError("CSR contains one or more IP address fields")
-	invalidNoDNS        = berrors.BadCSRError("at least one DNS name is required")
+	invalidNoIdent      = berrors.BadCSRError("at least one identifier is required")
 )
 
 // VerifyCSR checks the validity of a x509.CertificateRequest. It uses
-// NamesFromCSR to normalize the DNS names before checking whether we'll issue
-// for them.
+// identifier.FromCSR to normalize the DNS names and IP addresses before
+// checking whether we'll issue for them.
 func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int, keyPolicy *goodkey.KeyPolicy, pa core.PolicyAuthority) error {
 	key, ok := csr.PublicKey.(crypto.PublicKey)
 	if !ok {
@@ -63,25 +67,18 @@ func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int,
 	if len(csr.EmailAddresses) > 0 {
 		return invalidEmailPresent
 	}
-	if len(csr.IPAddresses) > 0 {
-		return invalidIPPresent
-	}
-
-	// NamesFromCSR also performs normalization, returning values that may not
-	// match the literal CSR contents.
-	names := NamesFromCSR(csr)
 
-	if len(names.SANs) == 0 && names.CN == "" {
-		return invalidNoDNS
-	}
-	if len(names.CN) > maxCNLength {
-		return berrors.BadCSRError("CN was longer than %d bytes", maxCNLength)
+	// FromCSR also performs normalization, returning values that may not match
+	// the literal CSR contents.
+	idents := identifier.FromCSR(csr)
+	if len(idents) == 0 {
+		return invalidNoIdent
 	}
-	if len(names.SANs) > maxNames {
-		return berrors.BadCSRError("CSR contains more than %d DNS names", maxNames)
+	if len(idents) > maxNames {
+		return berrors.BadCSRError("CSR contains more than %d identifiers", maxNames)
 	}
 
-	err = pa.WillingToIssue(names.SANs)
+	err = pa.WillingToIssue(idents)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package csr
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -26,9 +27,9 @@ func (pa *mockPA) ChallengeTypesFor(identifier identifier.ACMEIdentifier) ([]cor
 	return []core.AcmeChallenge{}, nil
 }
 
-func (pa *mockPA) WillingToIssue(domains []string) error {
-	for _, domain := range domains {
-		if domain == "bad-name.com" || domain == "other-bad-name.com" {
+func (pa *mockPA) WillingToIssue(idents identifier.ACMEIdentifiers) error {
+	for _, ident := range idents {
+		if ident.Value == "bad-name.com" || ident.Value == "other-bad-name.com" {
 			return errors.New("policy forbids issuing for identifier")
 		}
 	}
@@ -103,7 +104,7 @@ func TestVerifyCSR(t *testing.T) {
 			signedReq,
 			100,
 			&mockPA{},
-			invalidNoDNS,
+			invalidNoIdent,
 		},
 		{
 			signedReqWithLongCN,
@@ -115,7 +116,7 @@ func TestVerifyCSR(t *testing.T) {
 			signedReqWithHosts,
 			1,
 			&mockPA{},
-			berrors.BadCSRError("CSR contains more than 1 DNS names"),
+			berrors.BadCSRError("CSR contains more than 1 identifiers"),
 		},
 		{
 			signedReqWithBadNames,
@@ -133,8 +134,9 @@ func TestVerifyCSR(t *testing.T) {
 			signedReqWithIPAddress,
 			100,
 			&mockPA{},
-			invalidIPPresent,
+			nil,
 		},
+
 		{
 			signedReqWithAllLongSANs,
 			100,
@@ -299,3 +301,24 @@ func TestDuplicateExtensionRejection(t *testing.T) {
 	_, err = x509.ParseCertificateRequest(csrBytes)
 	test.AssertError(t, err, "CSR with duplicate extension OID should fail to parse")
 }
+
+func TestVerifyCSREd25519(t *testing.T) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "error generating test key")
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{"example.com"}}, private)
+	test.AssertNotError(t, err, "creating test CSR")
+	csr, err := x509.ParseCertificateRequest(csrBytes)
+	test.AssertNotError(t, err, "parsing test CSR")
+
+	// Ed25519 keys are not allowed by the default key policy.
+	keyPolicy, err := goodkey.NewPolicy(nil, nil)
+	test.AssertNotError(t, err, "creating test keypolicy")
+	err = VerifyCSR(context.Background(), csr, 100, &keyPolicy, &mockPA{})
+	test.AssertErrorIs(t, err, berrors.BadCSR)
+	test.AssertContains(t, err.Error(), "Ed25519 keys not allowed")
+
+	keyPolicy, err = goodkey.NewPolicy(&goodkey.Config{AllowedKeys: &goodkey.AllowedKeys{Ed25519: true}}, nil)
+	test.AssertNotError(t, err, "creating test keypolicy")
+	err = VerifyCSR(context.Background(), csr, 100, &keyPolicy, &mockPA{})
+	test.AssertNotError(t, err, "Ed25519 CSR should verify")
+}
@@ -0,0 +1,37 @@
+//go:build go1.27
+
+package csr
+
+import (
+	"context"
+	"crypto/mldsa"
+	"crypto/rand"
+	"crypto/x509"
+	"testing"
+
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestVerifyCSRMLDSA(t *testing.T) {
+	private, err := mldsa.GenerateKey(mldsa.MLDSA65())
+	test.AssertNotError(t, err, "error generating test key")
+	csrBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{"example.com"}}, private)
+	test.AssertNotError(t, err, "creating test CSR")
+	csr, err := x509.ParseCertificateRequest(csrBytes)
+	test.AssertNotError(t, err, "parsing test CSR")
+	test.AssertEquals(t, csr.SignatureAlgorithm, x509.MLDSA65)
+
+	// ML-DSA keys are not allowed by the default key policy.
+	keyPolicy, err := goodkey.NewPolicy(nil, nil)
+	test.AssertNotError(t, err, "creating test keypolicy")
+	err = VerifyCSR(context.Background(), csr, 100, &keyPolicy, &mockPA{})
+	test.AssertErrorIs(t, err, berrors.BadCSR)
+	test.AssertContains(t, err.Error(), "ML-DSA-65 keys not allowed")
+
+	keyPolicy, err = goodkey.NewPolicy(&goodkey.Config{AllowedKeys: &goodkey.AllowedKeys{MLDSA65: true}}, nil)
+	test.AssertNotError(t, err, "creating test keypolicy")
+	err = VerifyCSR(context.Background(), csr, 100, &keyPolicy, &mockPA{})
+	test.AssertNotError(t, err, "ML-DSA CSR should verify")
+}
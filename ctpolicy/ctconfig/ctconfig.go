@@ -108,6 +108,33 @@ type CTConfig struct {
 	// This may include duplicates from the lists above, to submit both precerts
 	// and final certs to the same log.
 	FinalLogs []string
+	// SubmissionQueue, if present, causes submissions to InfoLogs and
+	// FinalLogs to be recorded in the SA and retried until they succeed,
+	// rather than being attempted once.
+	SubmissionQueue *SubmissionQueueConfig
+}
+
+// SubmissionQueueConfig configures the processing of the SA-backed queue of
+// CT submissions to informational and final logs.
+type SubmissionQueueConfig struct {
+	// PollInterval is how often to check the queue for submissions which are
+	// due to be attempted. Defaults to 10s.
+	PollInterval config.Duration
+	// BatchSize is the maximum number of submissions to lease from the queue
+	// and attempt at once. Defaults to 100.
+	BatchSize int64 `validate:"min=0"`
+	// LeaseDuration is how long a leased submission is hidden from other RAs
+	// processing the queue. It should be much longer than a single submission
+	// attempt can take. Defaults to 5m.
+	LeaseDuration config.Duration
+	// BaseBackoff and MaxBackoff bound the exponentially increasing delay
+	// before a failed submission is retried. They default to 1m and 4h.
+	BaseBackoff config.Duration
+	MaxBackoff  config.Duration
+	// ReportStats causes this RA to periodically query the SA for the depth
+	// and age of the whole queue and export them as metrics. Because every
+	// RA shares the same queue, it should be set on only one of them.
+	ReportStats bool
 }
 
 // LogID holds enough information to uniquely identify a CT Log: its log_id
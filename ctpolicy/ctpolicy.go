@@ -2,6 +2,7 @@ package ctpolicy
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"strings"
 	"time"
@@ -18,6 +19,7 @@ import (
 const (
 	succeeded = "succeeded"
 	failed    = "failed"
+	expired   = "expired"
 )
 
 // CTPolicy is used to hold information about SCTs required from various
@@ -28,14 +30,16 @@ type CTPolicy struct {
 	infoLogs            loglist.List
 	finalLogs           loglist.List
 	stagger             time.Duration
+	queue               *Queue
 	log                 blog.Logger
 	winnerCounter       *prometheus.CounterVec
 	operatorGroupsGauge *prometheus.GaugeVec
 	shardExpiryGauge    *prometheus.GaugeVec
 }
 
-// New creates a new CTPolicy struct
-func New(pub pubpb.PublisherClient, sctLogs loglist.List, infoLogs loglist.List, finalLogs loglist.List, stagger time.Duration, log blog.Logger, stats prometheus.Registerer) *CTPolicy {
+// New creates a new CTPolicy struct. If queue is nil, submissions to the
+// informational and final logs are attempted once, and not retried.
+func New(pub pubpb.PublisherClient, sctLogs loglist.List, infoLogs loglist.List, finalLogs loglist.List, stagger time.Duration, queue *Queue, log blog.Logger, stats prometheus.Registerer) *CTPolicy {
 	winnerCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "sct_winner",
@@ -90,6 +94,7 @@ func New(pub pubpb.PublisherClient, sctLogs loglist.List, infoLogs loglist.List,
 		infoLogs:            infoLogs,
 		finalLogs:           finalLogs,
 		stagger:             stagger,
+		queue:               queue,
 		log:                 log,
 		winnerCounter:       winnerCounter,
 		operatorGroupsGauge: operatorGroupsGauge,
@@ -130,22 +135,23 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 
 		// Pick a random log from among those in the group. In practice, very few
 		// operator groups have more than one log, so this loses little flexibility.
-		url, key, err := ctp.sctLogs.PickOne(g, expiration)
+		log, err := ctp.sctLogs.PickOne(g, expiration)
 		if err != nil {
 			return nil, "", fmt.Errorf("unable to get log info: %w", err)
 		}
 
 		sct, err := ctp.pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{
-			LogURL:       url,
-			LogPublicKey: key,
+			LogURL:       log.Url,
+			LogPublicKey: log.Key,
 			Der:          cert,
 			Kind:         pubpb.SubmissionType_sct,
+			StaticCT:     log.Tiled,
 		})
 		if err != nil {
-			return nil, url, fmt.Errorf("ct submission to %q (%q) failed: %w", g, url, err)
+			return nil, log.Url, fmt.Errorf("ct submission to %q (%q) failed: %w", g, log.Url, err)
 		}
 
-		return sct.Sct, url, nil
+		return sct.Sct, log.Url, nil
 	}
 
 	// Ensure that this channel has a buffer equal to the number of goroutines
@@ -163,7 +169,14 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 		}(i, group)
 	}
 
-	go ctp.submitPrecertInformational(cert, expiration)
+	go func() {
+		// The informational submissions must be queued even if this request
+		// finishes first.
+		err := ctp.submitPrecertInformational(context.WithoutCancel(ctx), cert, expiration)
+		if err != nil {
+			ctp.log.Errf("Failed to submit precertificate to informational logs: %s", err)
+		}
+	}()
 
 	// Finally, collect SCTs and/or errors from our results channel. We know that
 	// we will collect len(ctp.sctLogs) results from the channel because every
@@ -198,46 +211,62 @@ func (ctp *CTPolicy) GetSCTs(ctx context.Context, cert core.CertDER, expiration
 
 // submitAllBestEffort submits the given certificate or precertificate to every
 // log ("informational" for precerts, "final" for certs) configured in the policy.
-// It neither waits for these submission to complete, nor tracks their success.
-func (ctp *CTPolicy) submitAllBestEffort(blob core.CertDER, kind pubpb.SubmissionType, expiry time.Time) {
+// If the policy has a queue, the submissions are added to it, and are retried
+// until they succeed; otherwise they are attempted once. In either case, it
+// does not wait for the submissions to complete.
+func (ctp *CTPolicy) submitAllBestEffort(ctx context.Context, blob core.CertDER, kind pubpb.SubmissionType, expiry time.Time) error {
 	logs := ctp.finalLogs
 	if kind == pubpb.SubmissionType_info {
 		logs = ctp.infoLogs
 	}
 
+	var selected []loglist.Log
 	for _, group := range logs {
 		for _, log := range group {
 			if log.StartInclusive.After(expiry) || log.EndExclusive.Equal(expiry) || log.EndExclusive.Before(expiry) {
 				continue
 			}
+			selected = append(selected, log)
+		}
+	}
 
-			go func(log loglist.Log) {
-				_, err := ctp.pub.SubmitToSingleCTWithResult(
-					context.Background(),
-					&pubpb.Request{
-						LogURL:       log.Url,
-						LogPublicKey: log.Key,
-						Der:          blob,
-						Kind:         kind,
-					},
-				)
-				if err != nil {
-					ctp.log.Warningf("ct submission of cert to log %q failed: %s", log.Url, err)
-				}
-			}(log)
+	if ctp.queue != nil {
+		cert, err := x509.ParseCertificate(blob)
+		if err != nil {
+			return fmt.Errorf("parsing certificate for CT submission: %w", err)
 		}
+		return ctp.queue.Enqueue(ctx, core.SerialToString(cert.SerialNumber), kind, expiry, selected)
 	}
 
+	for _, log := range selected {
+		go func(log loglist.Log) {
+			_, err := ctp.pub.SubmitToSingleCTWithResult(
+				context.Background(),
+				&pubpb.Request{
+					LogURL:       log.Url,
+					LogPublicKey: log.Key,
+					Der:          blob,
+					Kind:         kind,
+					StaticCT:     log.Tiled,
+				},
+			)
+			if err != nil {
+				ctp.log.Warningf("ct submission of cert to log %q failed: %s", log.Url, err)
+			}
+		}(log)
+	}
+	return nil
 }
 
 // submitPrecertInformational submits precertificates to any configured
 // "informational" logs, but does not care about success or returned SCTs.
-func (ctp *CTPolicy) submitPrecertInformational(cert core.CertDER, expiration time.Time) {
-	ctp.submitAllBestEffort(cert, pubpb.SubmissionType_info, expiration)
+func (ctp *CTPolicy) submitPrecertInformational(ctx context.Context, cert core.CertDER, expiration time.Time) error {
+	return ctp.submitAllBestEffort(ctx, cert, pubpb.SubmissionType_info, expiration)
 }
 
 // SubmitFinalCert submits finalized certificates created from precertificates
-// to any configured "final" logs, but does not care about success.
-func (ctp *CTPolicy) SubmitFinalCert(cert core.CertDER, expiration time.Time) {
-	ctp.submitAllBestEffort(cert, pubpb.SubmissionType_final, expiration)
+// to any configured "final" logs, but does not wait for the submissions to
+// complete. It returns an error only if the submissions could not be queued.
+func (ctp *CTPolicy) SubmitFinalCert(ctx context.Context, cert core.CertDER, expiration time.Time) error {
+	return ctp.submitAllBestEffort(ctx, cert, pubpb.SubmissionType_final, expiration)
 }
@@ -3,6 +3,7 @@ package ctpolicy
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -111,7 +112,7 @@ func TestGetSCTs(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			ctp := New(tc.mock, tc.groups, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+			ctp := New(tc.mock, tc.groups, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 			ret, err := ctp.GetSCTs(tc.ctx, []byte{0}, time.Time{})
 			if tc.result != nil {
 				test.AssertDeepEquals(t, ret, tc.result)
@@ -138,6 +139,33 @@ func (mp *mockFailOnePub) SubmitToSingleCTWithResult(_ context.Context, req *pub
 	return &pubpb.Result{Sct: []byte{0}}, nil
 }
 
+// mockStaticCTPub only returns SCTs for requests whose StaticCT field matches
+// whether the log's URL is in staticCT.
+type mockStaticCTPub struct {
+	staticCT map[string]bool
+}
+
+func (mp *mockStaticCTPub) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	if req.StaticCT != mp.staticCT[req.LogURL] {
+		return nil, fmt.Errorf("wrong StaticCT %t for %q", req.StaticCT, req.LogURL)
+	}
+	return &pubpb.Result{Sct: []byte{0}}, nil
+}
+
+func TestGetSCTsStaticCT(t *testing.T) {
+	ctp := New(&mockStaticCTPub{staticCT: map[string]bool{"UrlB1": true}}, loglist.List{
+		"OperA": {
+			"LogA1": {Url: "UrlA1", Key: "KeyA1"},
+		},
+		"OperB": {
+			"LogB1": {Url: "UrlB1", Key: "KeyB1", Tiled: true},
+		},
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
+	_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
+	test.AssertNotError(t, err, "GetSCTs failed")
+	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"url": "UrlB1", "result": succeeded}, 1)
+}
+
 func TestGetSCTsMetrics(t *testing.T) {
 	ctp := New(&mockFailOnePub{badURL: "UrlA1"}, loglist.List{
 		"OperA": {
@@ -149,7 +177,7 @@ func TestGetSCTsMetrics(t *testing.T) {
 		"OperC": {
 			"LogC1": {Url: "UrlC1", Key: "KeyC1"},
 		},
-	}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
 	test.AssertNotError(t, err, "GetSCTs failed")
 	test.AssertMetricWithLabelsEquals(t, ctp.winnerCounter, prometheus.Labels{"url": "UrlB1", "result": succeeded}, 1)
@@ -162,7 +190,7 @@ func TestGetSCTsFailMetrics(t *testing.T) {
 		"OperA": {
 			"LogA1": {Url: "UrlA1", Key: "KeyA1"},
 		},
-	}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	_, err := ctp.GetSCTs(context.Background(), []byte{0}, time.Time{})
 	test.AssertError(t, err, "GetSCTs should have failed")
 	test.AssertErrorIs(t, err, berrors.MissingSCTs)
@@ -176,7 +204,7 @@ func TestGetSCTsFailMetrics(t *testing.T) {
 		"OperA": {
 			"LogA1": {Url: "UrlA1", Key: "KeyA1"},
 		},
-	}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	_, err = ctp.GetSCTs(ctx, []byte{0}, time.Time{})
 	test.AssertError(t, err, "GetSCTs should have timed out")
 	test.AssertErrorIs(t, err, berrors.MissingSCTs)
@@ -197,7 +225,7 @@ func TestLogListMetrics(t *testing.T) {
 		"OperC": {
 			"LogC1": {Url: "UrlC1", Key: "KeyC1"},
 		},
-	}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperA", "source": "sctLogs"}, 2)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperB", "source": "sctLogs"}, 1)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperC", "source": "sctLogs"}, 1)
@@ -220,7 +248,7 @@ func TestLogListMetrics(t *testing.T) {
 		"OperC": {
 			"LogC1": {Url: "UrlC1", Key: "KeyC1"},
 		},
-	}, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperA", "source": "sctLogs"}, 2)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperB", "source": "sctLogs"}, 1)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperC", "source": "sctLogs"}, 0)
@@ -232,14 +260,14 @@ func TestLogListMetrics(t *testing.T) {
 	ctp = New(&mockPub{}, loglist.List{
 		"OperA": {},
 		"OperB": {},
-	}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperA", "source": "sctLogs"}, 0)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperB", "source": "sctLogs"}, 0)
 
 	// Single operator group with no configured logs.
 	ctp = New(&mockPub{}, loglist.List{
 		"OperA": {},
-	}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	test.AssertMetricWithLabelsEquals(t, ctp.operatorGroupsGauge, prometheus.Labels{"operator": "OperA", "source": "allLogs"}, 0)
 
 	fc := clock.NewFake()
@@ -255,7 +283,7 @@ func TestLogListMetrics(t *testing.T) {
 		"OperB": {
 			"LogB1": {Url: "UrlB1", Key: "KeyB1", Name: "LogB1", EndExclusive: Tomorrow},
 		},
-	}, nil, nil, 0, blog.NewMock(), metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, blog.NewMock(), metrics.NoopRegisterer)
 	test.AssertMetricWithLabelsEquals(t, ctp.shardExpiryGauge, prometheus.Labels{"operator": "OperA", "logID": "LogA1"}, 86400)
 	test.AssertMetricWithLabelsEquals(t, ctp.shardExpiryGauge, prometheus.Labels{"operator": "OperA", "logID": "LogA2"}, 604800)
 	test.AssertMetricWithLabelsEquals(t, ctp.shardExpiryGauge, prometheus.Labels{"operator": "OperB", "logID": "LogB1"}, 86400)
@@ -11,7 +11,8 @@ var lintlist struct {
 // InitLintList creates and stores a loglist intended for linting (i.e. with
 // purpose Validation). We have to store this in a global because the zlint
 // framework doesn't (yet) support configuration, so the e_scts_from_same_operator
-// lint cannot load a log list on its own. Instead, we have the CA call this
+// and e_scts_insufficient_for_lifetime lints cannot load a log list on their
+// own. Instead, we have the CA call this
 // initialization function at startup, and have the lint call the getter below
 // to get access to the cached list.
 func InitLintList(path string) error {
@@ -53,6 +53,12 @@ type Log struct {
 	StartInclusive time.Time
 	EndExclusive   time.Time
 	State          state
+	// MMD is the log's Maximum Merge Delay: the longest it may take to
+	// incorporate a certificate after issuing an SCT for it.
+	MMD time.Duration
+	// Tiled is true if the log implements the Static CT API rather than RFC
+	// 6962, in which case Url is its submission prefix.
+	Tiled bool
 }
 
 // State is an enum representing the various states a CT log can be in. Only
@@ -70,6 +76,25 @@ const (
 	rejected
 )
 
+// String returns the name of the state as it appears in the log list.
+func (s state) String() string {
+	switch s {
+	case pending:
+		return "pending"
+	case qualified:
+		return "qualified"
+	case usable:
+		return "usable"
+	case readonly:
+		return "readonly"
+	case retired:
+		return "retired"
+	case rejected:
+		return "rejected"
+	}
+	return "unknown"
+}
+
 func stateFromState(s *schema.LogListSchemaJsonOperatorsElemLogsElemState) state {
 	if s == nil {
 		return unknown
@@ -128,39 +153,74 @@ func newHelper(file []byte) (List, error) {
 	for _, op := range parsed.Operators {
 		group := make(OperatorGroup)
 		for _, log := range op.Logs {
-			var name string
-			if log.Description != nil {
-				name = *log.Description
+			info, err := newLog(log.Description, log.Url, log.Key, log.Mmd, log.State, log.TemporalInterval)
+			if err != nil {
+				return nil, err
 			}
-
-			info := Log{
-				Name:  name,
-				Url:   log.Url,
-				Key:   log.Key,
-				State: stateFromState(log.State),
+			group[log.LogId] = info
+		}
+		for _, log := range op.TiledLogs {
+			// The schema generates distinct, but identical, types for the
+			// fields of tiled logs, so convert them to those of RFC 6962 logs.
+			info, err := newLog(
+				log.Description,
+				log.SubmissionUrl,
+				log.Key,
+				log.Mmd,
+				(*schema.LogListSchemaJsonOperatorsElemLogsElemState)(log.State),
+				(*schema.LogListSchemaJsonOperatorsElemLogsElemTemporalInterval)(log.TemporalInterval),
+			)
+			if err != nil {
+				return nil, err
 			}
+			info.Tiled = true
+			group[log.LogId] = info
+		}
+		result[op.Name] = group
+	}
 
-			if log.TemporalInterval != nil {
-				startInclusive, err := time.Parse(time.RFC3339, log.TemporalInterval.StartInclusive)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse log %q start timestamp: %w", log.Url, err)
-				}
+	return result, nil
+}
 
-				endExclusive, err := time.Parse(time.RFC3339, log.TemporalInterval.EndExclusive)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse log %q end timestamp: %w", log.Url, err)
-				}
+// newLog constructs a Log from the fields common to RFC 6962 and tiled logs in
+// the log list schema.
+func newLog(
+	description *string,
+	url string,
+	key string,
+	mmd float64,
+	logState *schema.LogListSchemaJsonOperatorsElemLogsElemState,
+	interval *schema.LogListSchemaJsonOperatorsElemLogsElemTemporalInterval,
+) (Log, error) {
+	var name string
+	if description != nil {
+		name = *description
+	}
 
-				info.StartInclusive = startInclusive
-				info.EndExclusive = endExclusive
-			}
+	info := Log{
+		Name:  name,
+		Url:   url,
+		Key:   key,
+		State: stateFromState(logState),
+		MMD:   time.Duration(mmd * float64(time.Second)),
+	}
 
-			group[log.LogId] = info
+	if interval != nil {
+		startInclusive, err := time.Parse(time.RFC3339, interval.StartInclusive)
+		if err != nil {
+			return Log{}, fmt.Errorf("failed to parse log %q start timestamp: %w", url, err)
 		}
-		result[op.Name] = group
+
+		endExclusive, err := time.Parse(time.RFC3339, interval.EndExclusive)
+		if err != nil {
+			return Log{}, fmt.Errorf("failed to parse log %q end timestamp: %w", url, err)
+		}
+
+		info.StartInclusive = startInclusive
+		info.EndExclusive = endExclusive
 	}
 
-	return result, nil
+	return info, nil
 }
 
 // SubsetForPurpose returns a new log list containing only those logs whose
@@ -206,6 +266,8 @@ func (ll List) subset(names []string) (List, error) {
 				State:          log.State,
 				StartInclusive: log.StartInclusive,
 				EndExclusive:   log.EndExclusive,
+				MMD:            log.MMD,
+				Tiled:          log.Tiled,
 			}
 
 			newGroup[id] = newLog
@@ -247,6 +309,8 @@ func (ll List) forPurpose(p purpose) (List, error) {
 				State:          log.State,
 				StartInclusive: log.StartInclusive,
 				EndExclusive:   log.EndExclusive,
+				MMD:            log.MMD,
+				Tiled:          log.Tiled,
 			}
 
 			newGroup[id] = newLog
@@ -274,6 +338,17 @@ func (ll List) OperatorForLogID(logID string) (string, error) {
 	return "", fmt.Errorf("no log with ID %q found", logID)
 }
 
+// LogForID returns the Log with the given ID, or an error if no such log can
+// be found.
+func (ll List) LogForID(logID string) (Log, error) {
+	for _, group := range ll {
+		if log, found := group[logID]; found {
+			return log, nil
+		}
+	}
+	return Log{}, fmt.Errorf("no log with ID %q found", logID)
+}
+
 // Permute returns the list of operator group names in a randomized order.
 func (ll List) Permute() []string {
 	keys := make([]string, 0, len(ll))
@@ -288,13 +363,13 @@ func (ll List) Permute() []string {
 	return result
 }
 
-// PickOne returns the URI and Public Key of a single randomly-selected log
-// which is run by the given operator and whose temporal interval includes the
-// given expiry time. It returns an error if no such log can be found.
-func (ll List) PickOne(operator string, expiry time.Time) (string, string, error) {
+// PickOne returns a single randomly-selected log which is run by the given
+// operator and whose temporal interval includes the given expiry time. It
+// returns an error if no such log can be found.
+func (ll List) PickOne(operator string, expiry time.Time) (Log, error) {
 	group, ok := ll[operator]
 	if !ok {
-		return "", "", fmt.Errorf("no log operator group named %q", operator)
+		return Log{}, fmt.Errorf("no log operator group named %q", operator)
 	}
 
 	candidates := make([]Log, 0)
@@ -311,9 +386,8 @@ func (ll List) PickOne(operator string, expiry time.Time) (string, string, error
 
 	// Ensure rand.Intn below won't panic.
 	if len(candidates) < 1 {
-		return "", "", fmt.Errorf("no log found for group %q and expiry %s", operator, expiry)
+		return Log{}, fmt.Errorf("no log found for group %q and expiry %s", operator, expiry)
 	}
 
-	log := candidates[rand.IntN(len(candidates))]
-	return log.Url, log.Key, nil
+	return candidates[rand.IntN(len(candidates))], nil
 }
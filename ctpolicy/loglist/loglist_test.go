@@ -11,6 +11,71 @@ func TestNew(t *testing.T) {
 
 }
 
+func TestNewMMD(t *testing.T) {
+	list, err := New("../../test/ct-test-srv/log_list.json")
+	test.AssertNotError(t, err, "loading log list")
+	for _, group := range list {
+		for _, log := range group {
+			// The test log list relies on the schema's default MMD.
+			test.AssertEquals(t, log.MMD, 24*time.Hour)
+		}
+	}
+}
+
+func TestNewTiled(t *testing.T) {
+	list, err := newHelper([]byte(`{
+		"operators": [{
+			"name": "Operator A",
+			"email": ["fake@example.org"],
+			"logs": [{
+				"description": "A1",
+				"log_id": "ID A1",
+				"key": "KA1",
+				"url": "https://a1.example.com/"
+			}],
+			"tiled_logs": [{
+				"description": "A2",
+				"log_id": "ID A2",
+				"key": "KA2",
+				"mmd": 60,
+				"submission_url": "https://a2.example.com/",
+				"monitoring_url": "https://mon.a2.example.com/",
+				"state": {"usable": {"timestamp": "2025-01-01T00:00:00Z"}},
+				"temporal_interval": {
+					"start_inclusive": "2025-01-01T00:00:00Z",
+					"end_exclusive": "2025-07-01T00:00:00Z"
+				}
+			}]
+		}]
+	}`))
+	test.AssertNotError(t, err, "parsing log list")
+
+	a1 := list["Operator A"]["ID A1"]
+	test.Assert(t, !a1.Tiled, "RFC 6962 log should not be tiled")
+	test.AssertEquals(t, a1.Url, "https://a1.example.com/")
+
+	a2 := list["Operator A"]["ID A2"]
+	test.Assert(t, a2.Tiled, "static-ct log should be tiled")
+	test.AssertEquals(t, a2.Url, "https://a2.example.com/")
+	test.AssertEquals(t, a2.MMD, time.Minute)
+	test.AssertEquals(t, a2.State, usable)
+	test.AssertEquals(t, a2.EndExclusive, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+
+	sub, err := list.SubsetForPurpose([]string{"A2"}, Informational)
+	test.AssertNotError(t, err, "subsetting log list")
+	test.Assert(t, sub["Operator A"]["ID A2"].Tiled, "subset should preserve tiled logs")
+
+	_, err = newHelper([]byte(`{
+		"operators": [{
+			"name": "Operator A",
+			"email": ["fake@example.org"],
+			"logs": [],
+			"tiled_logs": [{"log_id": "ID A2", "key": "KA2", "submission_url": "https://a2.example.com/"}]
+		}]
+	}`))
+	test.AssertError(t, err, "tiled log without a monitoring_url should be rejected")
+}
+
 func TestSubset(t *testing.T) {
 	input := List{
 		"Operator A": {
@@ -143,6 +208,24 @@ func TestOperatorForLogID(t *testing.T) {
 	test.AssertError(t, err, "should not have found log")
 }
 
+func TestLogForID(t *testing.T) {
+	input := List{
+		"Operator A": {
+			"ID A1": Log{Name: "Log A1", State: usable},
+		},
+		"Operator B": {
+			"ID B1": Log{Name: "Log B1", State: qualified},
+		},
+	}
+
+	actual, err := input.LogForID("ID B1")
+	test.AssertNotError(t, err, "should have found log")
+	test.AssertEquals(t, actual.Name, "Log B1")
+
+	_, err = input.LogForID("Other ID")
+	test.AssertError(t, err, "should not have found log")
+}
+
 func TestPermute(t *testing.T) {
 	input := List{
 		"Operator A": {
@@ -176,7 +259,7 @@ func TestPickOne(t *testing.T) {
 			"ID A1": Log{Name: "Log A1"},
 		},
 	}
-	_, _, err := input.PickOne("Operator B", date0)
+	_, err := input.PickOne("Operator B", date0)
 	test.AssertError(t, err, "should have failed to find operator")
 
 	input = List{
@@ -184,13 +267,13 @@ func TestPickOne(t *testing.T) {
 			"ID A1": Log{Name: "Log A1", StartInclusive: date0, EndExclusive: date1},
 		},
 	}
-	_, _, err = input.PickOne("Operator A", date2)
+	_, err = input.PickOne("Operator A", date2)
 	test.AssertError(t, err, "should have failed to find log")
-	_, _, err = input.PickOne("Operator A", date1)
+	_, err = input.PickOne("Operator A", date1)
 	test.AssertError(t, err, "should have failed to find log")
-	_, _, err = input.PickOne("Operator A", date0)
+	_, err = input.PickOne("Operator A", date0)
 	test.AssertNotError(t, err, "should have found a log")
-	_, _, err = input.PickOne("Operator A", date0.Add(time.Hour))
+	_, err = input.PickOne("Operator A", date0.Add(time.Hour))
 	test.AssertNotError(t, err, "should have found a log")
 
 	input = List{
@@ -201,8 +284,8 @@ func TestPickOne(t *testing.T) {
 			"ID B2": Log{Name: "Log B2", StartInclusive: date1, EndExclusive: date2, Key: "KB2", Url: "UB2"},
 		},
 	}
-	url, key, err := input.PickOne("Operator A", date0.Add(time.Hour))
+	log, err := input.PickOne("Operator A", date0.Add(time.Hour))
 	test.AssertNotError(t, err, "should have found a log")
-	test.AssertSliceContains(t, []string{"UA1", "UB1"}, url)
-	test.AssertSliceContains(t, []string{"KA1", "KB1"}, key)
+	test.AssertSliceContains(t, []string{"UA1", "UB1"}, log.Url)
+	test.AssertSliceContains(t, []string{"KA1", "KB1"}, log.Key)
 }
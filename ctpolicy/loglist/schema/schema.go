@@ -26,6 +26,10 @@ type LogListSchemaJsonOperatorsElem struct {
 
 	// Name corresponds to the JSON schema field "name".
 	Name string `json:"name"`
+
+	// Details of Certificate Transparency logs implementing the Static CT API,
+	// run by this operator.
+	TiledLogs []LogListSchemaJsonOperatorsElemTiledLogsElem `json:"tiled_logs,omitempty"`
 }
 
 type LogListSchemaJsonOperatorsElemLogsElem struct {
@@ -109,6 +113,86 @@ type LogListSchemaJsonOperatorsElemLogsElemTemporalInterval struct {
 	StartInclusive string `json:"start_inclusive"`
 }
 
+type LogListSchemaJsonOperatorsElemTiledLogsElem struct {
+	// A human-readable description that can be used to identify this log.
+	Description *string `json:"description,omitempty"`
+
+	// The log's public key as a DER-encoded ASN.1 SubjectPublicKeyInfo structure,
+	// then encoded as base64 (https://tools.ietf.org/html/rfc5280#section-4.1.2.7).
+	Key string `json:"key"`
+
+	// This is the LogID found in SCTs issued by this log
+	// (https://tools.ietf.org/html/rfc6962#section-3.2).
+	LogId string `json:"log_id"`
+
+	// The purpose of this log, e.g. test.
+	LogType *LogListSchemaJsonOperatorsElemTiledLogsElemLogType `json:"log_type,omitempty"`
+
+	// The CT log should not take longer than this to incorporate a certificate
+	// (https://tools.ietf.org/html/rfc6962#section-3).
+	Mmd float64 `json:"mmd"`
+
+	// The API endpoints are defined in https://c2sp.org/static-ct-api.
+	MonitoringUrl string `json:"monitoring_url"`
+
+	// If the log has changed operators, this will contain a list of the previous
+	// operators, along with the timestamp when they stopped operating the log.
+	PreviousOperators []LogListSchemaJsonOperatorsElemTiledLogsElemPreviousOperatorsElem `json:"previous_operators,omitempty"`
+
+	// State corresponds to the JSON schema field "state".
+	State *LogListSchemaJsonOperatorsElemTiledLogsElemState `json:"state,omitempty"`
+
+	// The API endpoints are defined in https://c2sp.org/static-ct-api.
+	SubmissionUrl string `json:"submission_url"`
+
+	// The log will only accept certificates that expire (have a NotAfter date)
+	// between these dates.
+	TemporalInterval *LogListSchemaJsonOperatorsElemTiledLogsElemTemporalInterval `json:"temporal_interval,omitempty"`
+}
+
+type LogListSchemaJsonOperatorsElemTiledLogsElemLogType string
+
+const LogListSchemaJsonOperatorsElemTiledLogsElemLogTypeProd LogListSchemaJsonOperatorsElemTiledLogsElemLogType = "prod"
+const LogListSchemaJsonOperatorsElemTiledLogsElemLogTypeTest LogListSchemaJsonOperatorsElemTiledLogsElemLogType = "test"
+
+type LogListSchemaJsonOperatorsElemTiledLogsElemPreviousOperatorsElem struct {
+	// The time at which this operator stopped operating this log.
+	EndTime string `json:"end_time"`
+
+	// Name corresponds to the JSON schema field "name".
+	Name string `json:"name"`
+}
+
+type LogListSchemaJsonOperatorsElemTiledLogsElemState struct {
+	// Pending corresponds to the JSON schema field "pending".
+	Pending *State `json:"pending,omitempty"`
+
+	// Qualified corresponds to the JSON schema field "qualified".
+	Qualified *State `json:"qualified,omitempty"`
+
+	// Readonly corresponds to the JSON schema field "readonly".
+	Readonly interface{} `json:"readonly,omitempty"`
+
+	// Rejected corresponds to the JSON schema field "rejected".
+	Rejected *State `json:"rejected,omitempty"`
+
+	// Retired corresponds to the JSON schema field "retired".
+	Retired *State `json:"retired,omitempty"`
+
+	// Usable corresponds to the JSON schema field "usable".
+	Usable *State `json:"usable,omitempty"`
+}
+
+// The log will only accept certificates that expire (have a NotAfter date) between
+// these dates.
+type LogListSchemaJsonOperatorsElemTiledLogsElemTemporalInterval struct {
+	// All certificates must expire before this date.
+	EndExclusive string `json:"end_exclusive"`
+
+	// All certificates must expire on this date or later.
+	StartInclusive string `json:"start_inclusive"`
+}
+
 type State struct {
 	// The time at which the log entered this state.
 	Timestamp string `json:"timestamp"`
@@ -203,6 +287,98 @@ func (j *LogListSchemaJsonOperatorsElemLogsElem) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *LogListSchemaJsonOperatorsElemTiledLogsElemPreviousOperatorsElem) UnmarshalJSON(b []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if v, ok := raw["end_time"]; !ok || v == nil {
+		return fmt.Errorf("field end_time: required")
+	}
+	if v, ok := raw["name"]; !ok || v == nil {
+		return fmt.Errorf("field name: required")
+	}
+	type Plain LogListSchemaJsonOperatorsElemTiledLogsElemPreviousOperatorsElem
+	var plain Plain
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return err
+	}
+	*j = LogListSchemaJsonOperatorsElemTiledLogsElemPreviousOperatorsElem(plain)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *LogListSchemaJsonOperatorsElemTiledLogsElemTemporalInterval) UnmarshalJSON(b []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if v, ok := raw["end_exclusive"]; !ok || v == nil {
+		return fmt.Errorf("field end_exclusive: required")
+	}
+	if v, ok := raw["start_inclusive"]; !ok || v == nil {
+		return fmt.Errorf("field start_inclusive: required")
+	}
+	type Plain LogListSchemaJsonOperatorsElemTiledLogsElemTemporalInterval
+	var plain Plain
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return err
+	}
+	*j = LogListSchemaJsonOperatorsElemTiledLogsElemTemporalInterval(plain)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *LogListSchemaJsonOperatorsElemTiledLogsElemLogType) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	var ok bool
+	for _, expected := range enumValues_LogListSchemaJsonOperatorsElemTiledLogsElemLogType {
+		if reflect.DeepEqual(v, expected) {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return fmt.Errorf("invalid value (expected one of %#v): %#v", enumValues_LogListSchemaJsonOperatorsElemTiledLogsElemLogType, v)
+	}
+	*j = LogListSchemaJsonOperatorsElemTiledLogsElemLogType(v)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *LogListSchemaJsonOperatorsElemTiledLogsElem) UnmarshalJSON(b []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if v, ok := raw["key"]; !ok || v == nil {
+		return fmt.Errorf("field key: required")
+	}
+	if v, ok := raw["log_id"]; !ok || v == nil {
+		return fmt.Errorf("field log_id: required")
+	}
+	if v, ok := raw["monitoring_url"]; !ok || v == nil {
+		return fmt.Errorf("field monitoring_url: required")
+	}
+	if v, ok := raw["submission_url"]; !ok || v == nil {
+		return fmt.Errorf("field submission_url: required")
+	}
+	type Plain LogListSchemaJsonOperatorsElemTiledLogsElem
+	var plain Plain
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return err
+	}
+	if v, ok := raw["mmd"]; !ok || v == nil {
+		plain.Mmd = 86400
+	}
+	*j = LogListSchemaJsonOperatorsElemTiledLogsElem(plain)
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (j *State) UnmarshalJSON(b []byte) error {
 	var raw map[string]interface{}
@@ -250,6 +426,11 @@ var enumValues_LogListSchemaJsonOperatorsElemLogsElemLogType = []interface{}{
 	"test",
 }
 
+var enumValues_LogListSchemaJsonOperatorsElemTiledLogsElemLogType = []interface{}{
+	"prod",
+	"test",
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (j *LogListSchemaJson) UnmarshalJSON(b []byte) error {
 	var raw map[string]interface{}
@@ -0,0 +1,270 @@
+package ctpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	berrors "github.com/letsencrypt/boulder/errors"
+	blog "github.com/letsencrypt/boulder/log"
+	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// Queue makes the best-effort CT submissions recorded in the SA's durable
+// queue, so that they survive restarts of the RA and outages of the publisher
+// or the logs. Failed submissions are retried with exponential backoff until
+// they succeed or the certificate expires. Any number of RAs may process the
+// same queue, because each submission is leased by one at a time, but only one
+// should report its depth and age.
+type Queue struct {
+	sa           sapb.StorageAuthorityClient
+	pub          pubpb.PublisherClient
+	pollInterval time.Duration
+	batchSize    int64
+	lease        time.Duration
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	reportStats  bool
+	clk          clock.Clock
+	log          blog.Logger
+
+	depth    prometheus.Gauge
+	age      prometheus.Gauge
+	attempts *prometheus.CounterVec
+}
+
+// NewQueue returns a Queue which leases submissions from the given SA and makes
+// them using the given publisher.
+func NewQueue(sa sapb.StorageAuthorityClient, pub pubpb.PublisherClient, c ctconfig.SubmissionQueueConfig, clk clock.Clock, log blog.Logger, stats prometheus.Registerer) *Queue {
+	depth := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ct_submission_queue_depth",
+		Help: "Number of CT submissions to informational and final logs which have not yet succeeded.",
+	})
+	age := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ct_submission_queue_oldest_age_seconds",
+		Help: "Age of the oldest CT submission to an informational or final log which has not yet succeeded.",
+	})
+	if c.ReportStats {
+		// Only the one RA which reports the queue's stats exports these, so
+		// that idle gauges on the others aren't mistaken for an empty queue.
+		stats.MustRegister(depth)
+		stats.MustRegister(age)
+	}
+
+	attempts := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ct_submission_queue_attempts",
+		Help: "Number of queued CT submissions attempted, by kind (info or final) and result (succeeded, failed, or expired).",
+	}, []string{"kind", "result"})
+	stats.MustRegister(attempts)
+
+	q := &Queue{
+		sa:           sa,
+		pub:          pub,
+		pollInterval: c.PollInterval.Duration,
+		batchSize:    c.BatchSize,
+		lease:        c.LeaseDuration.Duration,
+		baseBackoff:  c.BaseBackoff.Duration,
+		maxBackoff:   c.MaxBackoff.Duration,
+		reportStats:  c.ReportStats,
+		clk:          clk,
+		log:          log,
+		depth:        depth,
+		age:          age,
+		attempts:     attempts,
+	}
+	if q.pollInterval == 0 {
+		q.pollInterval = 10 * time.Second
+	}
+	if q.batchSize == 0 {
+		q.batchSize = 100
+	}
+	if q.lease == 0 {
+		q.lease = 5 * time.Minute
+	}
+	if q.baseBackoff == 0 {
+		q.baseBackoff = time.Minute
+	}
+	if q.maxBackoff == 0 {
+		q.maxBackoff = 4 * time.Hour
+	}
+	return q
+}
+
+// Enqueue durably records that the certificate or precertificate with the
+// given serial and expiry must be submitted to each of the given logs. The
+// submissions will be attempted the next time the queue is polled.
+func (q *Queue) Enqueue(ctx context.Context, serial string, kind pubpb.SubmissionType, expiry time.Time, logs []loglist.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	req := &sapb.CTSubmissions{}
+	for _, log := range logs {
+		req.Submissions = append(req.Submissions, &sapb.CTSubmission{
+			Serial:       serial,
+			Kind:         int64(kind),
+			LogURL:       log.Url,
+			LogPublicKey: log.Key,
+			StaticCT:     log.Tiled,
+			Expires:      timestamppb.New(expiry),
+		})
+	}
+	_, err := q.sa.AddCTSubmissions(ctx, req)
+	if err != nil {
+		return fmt.Errorf("queueing %d CT submission(s) of %s: %w", len(logs), serial, err)
+	}
+	return nil
+}
+
+// Run polls the queue for due submissions and attempts them, until the given
+// context is cancelled.
+func (q *Queue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if q.reportStats {
+			err := q.updateStats(ctx)
+			if err != nil {
+				q.log.Errf("Failed to get CT submission queue stats: %s", err)
+			}
+		}
+		for {
+			leased, err := q.processBatch(ctx)
+			if err != nil {
+				q.log.Errf("Failed to lease CT submissions: %s", err)
+				break
+			}
+			if leased < q.batchSize {
+				break
+			}
+		}
+	}
+}
+
+// updateStats sets the queue depth and age gauges.
+func (q *Queue) updateStats(ctx context.Context) error {
+	stats, err := q.sa.GetCTSubmissionQueueStats(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	q.depth.Set(float64(stats.Count))
+	if stats.Oldest == nil {
+		q.age.Set(0)
+	} else {
+		q.age.Set(q.clk.Since(stats.Oldest.AsTime()).Seconds())
+	}
+	return nil
+}
+
+// processBatch leases a batch of due submissions and attempts them all
+// concurrently. It returns the number of submissions leased.
+func (q *Queue) processBatch(ctx context.Context) (int64, error) {
+	resp, err := q.sa.LeaseCTSubmissions(ctx, &sapb.LeaseCTSubmissionsRequest{
+		Limit: q.batchSize,
+		Lease: durationpb.New(q.lease),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, sub := range resp.Submissions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.attempt(ctx, sub)
+		}()
+	}
+	wg.Wait()
+	return int64(len(resp.Submissions)), nil
+}
+
+// attempt makes a single leased submission, and then deletes it from the
+// queue if it succeeded or the certificate has expired, or reschedules it
+// otherwise.
+func (q *Queue) attempt(ctx context.Context, sub *sapb.CTSubmission) {
+	kind := pubpb.SubmissionType(sub.Kind)
+
+	result := expired
+	var err error
+	if q.clk.Now().Before(sub.Expires.AsTime()) {
+		err = q.submit(ctx, sub)
+		if err != nil {
+			result = failed
+		} else {
+			result = succeeded
+		}
+	} else {
+		q.log.Warningf("Abandoning CT submission of %s to log %q after %d attempt(s): certificate expired", sub.Serial, sub.LogURL, sub.Attempts-1)
+	}
+	q.attempts.WithLabelValues(kind.String(), result).Inc()
+
+	if result == failed {
+		backoff := core.RetryBackoff(int(sub.Attempts), q.baseBackoff, q.maxBackoff, 2)
+		q.log.Warningf("CT submission of %s to log %q failed on attempt %d, retrying in %s: %s", sub.Serial, sub.LogURL, sub.Attempts, backoff, err)
+		_, err = q.sa.RescheduleCTSubmission(ctx, &sapb.RescheduleCTSubmissionRequest{
+			Id:          sub.Id,
+			NextAttempt: timestamppb.New(q.clk.Now().Add(backoff)),
+			LeasedUntil: sub.NextAttempt,
+		})
+		if errors.Is(err, berrors.NotFound) {
+			q.log.Warningf("Lease on CT submission %d expired before it could be rescheduled", sub.Id)
+		} else if err != nil {
+			q.log.Errf("Failed to reschedule CT submission %d: %s", sub.Id, err)
+		}
+		return
+	}
+
+	_, err = q.sa.DeleteCTSubmission(ctx, &sapb.DeleteCTSubmissionRequest{Id: sub.Id, LeasedUntil: sub.NextAttempt})
+	if errors.Is(err, berrors.NotFound) {
+		q.log.Warningf("Lease on CT submission %d expired before it could be deleted", sub.Id)
+	} else if err != nil {
+		q.log.Errf("Failed to delete CT submission %d: %s", sub.Id, err)
+	}
+}
+
+// submit fetches the certificate or precertificate to be submitted from the
+// SA, and submits it to the log.
+func (q *Queue) submit(ctx context.Context, sub *sapb.CTSubmission) error {
+	kind := pubpb.SubmissionType(sub.Kind)
+	var cert *corepb.Certificate
+	var err error
+	switch kind {
+	case pubpb.SubmissionType_final:
+		cert, err = q.sa.GetCertificate(ctx, &sapb.Serial{Serial: sub.Serial})
+	case pubpb.SubmissionType_info:
+		cert, err = q.sa.GetLintPrecertificate(ctx, &sapb.Serial{Serial: sub.Serial})
+	default:
+		return fmt.Errorf("unexpected submission kind %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("getting certificate: %w", err)
+	}
+
+	_, err = q.pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{
+		LogURL:       sub.LogURL,
+		LogPublicKey: sub.LogPublicKey,
+		Der:          cert.Der,
+		Kind:         kind,
+		StaticCT:     sub.StaticCT,
+	})
+	return err
+}
@@ -0,0 +1,278 @@
+package ctpolicy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/ctpolicy/ctconfig"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	berrors "github.com/letsencrypt/boulder/errors"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	pubpb "github.com/letsencrypt/boulder/publisher/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeQueueSA is an in-memory implementation of the SA's CT submission queue.
+type fakeQueueSA struct {
+	sapb.StorageAuthorityClient
+	clk clock.Clock
+
+	sync.Mutex
+	nextID int64
+	subs   map[int64]*sapb.CTSubmission
+}
+
+func newFakeQueueSA(clk clock.Clock) *fakeQueueSA {
+	return &fakeQueueSA{clk: clk, subs: make(map[int64]*sapb.CTSubmission)}
+}
+
+func (sa *fakeQueueSA) AddCTSubmissions(_ context.Context, req *sapb.CTSubmissions, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.Lock()
+	defer sa.Unlock()
+	for _, sub := range req.Submissions {
+		sa.nextID++
+		sub = proto.Clone(sub).(*sapb.CTSubmission)
+		sub.Id = sa.nextID
+		sub.Created = timestamppb.New(sa.clk.Now())
+		sub.NextAttempt = timestamppb.New(sa.clk.Now())
+		sa.subs[sub.Id] = sub
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *fakeQueueSA) LeaseCTSubmissions(_ context.Context, req *sapb.LeaseCTSubmissionsRequest, _ ...grpc.CallOption) (*sapb.CTSubmissions, error) {
+	sa.Lock()
+	defer sa.Unlock()
+	resp := &sapb.CTSubmissions{}
+	for _, sub := range sa.subs {
+		if int64(len(resp.Submissions)) >= req.Limit {
+			break
+		}
+		if sub.NextAttempt.AsTime().After(sa.clk.Now()) {
+			continue
+		}
+		sub.Attempts++
+		sub.NextAttempt = timestamppb.New(sa.clk.Now().Add(req.Lease.AsDuration()))
+		resp.Submissions = append(resp.Submissions, proto.Clone(sub).(*sapb.CTSubmission))
+	}
+	return resp, nil
+}
+
+func (sa *fakeQueueSA) RescheduleCTSubmission(_ context.Context, req *sapb.RescheduleCTSubmissionRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.Lock()
+	defer sa.Unlock()
+	sub, ok := sa.subs[req.Id]
+	if !ok || !sub.NextAttempt.AsTime().Equal(req.LeasedUntil.AsTime()) {
+		return nil, berrors.NotFoundError("no CT submission found with id %d", req.Id)
+	}
+	sub.NextAttempt = req.NextAttempt
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *fakeQueueSA) DeleteCTSubmission(_ context.Context, req *sapb.DeleteCTSubmissionRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	sa.Lock()
+	defer sa.Unlock()
+	sub, ok := sa.subs[req.Id]
+	if !ok || !sub.NextAttempt.AsTime().Equal(req.LeasedUntil.AsTime()) {
+		return nil, berrors.NotFoundError("no CT submission found with id %d", req.Id)
+	}
+	delete(sa.subs, req.Id)
+	return &emptypb.Empty{}, nil
+}
+
+func (sa *fakeQueueSA) GetCTSubmissionQueueStats(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*sapb.CTSubmissionQueueStats, error) {
+	sa.Lock()
+	defer sa.Unlock()
+	resp := &sapb.CTSubmissionQueueStats{Count: int64(len(sa.subs))}
+	for _, sub := range sa.subs {
+		if resp.Oldest == nil || sub.Created.AsTime().Before(resp.Oldest.AsTime()) {
+			resp.Oldest = sub.Created
+		}
+	}
+	return resp, nil
+}
+
+func (sa *fakeQueueSA) GetCertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	return &corepb.Certificate{Serial: req.Serial, Der: []byte("final")}, nil
+}
+
+func (sa *fakeQueueSA) GetLintPrecertificate(_ context.Context, req *sapb.Serial, _ ...grpc.CallOption) (*corepb.Certificate, error) {
+	return &corepb.Certificate{Serial: req.Serial, Der: []byte("precert")}, nil
+}
+
+// recordingPub fails submissions to the logs in badURLs, and records the
+// requests it receives.
+type recordingPub struct {
+	badURLs map[string]bool
+
+	sync.Mutex
+	reqs []*pubpb.Request
+}
+
+func (pub *recordingPub) SubmitToSingleCTWithResult(_ context.Context, req *pubpb.Request, _ ...grpc.CallOption) (*pubpb.Result, error) {
+	pub.Lock()
+	defer pub.Unlock()
+	pub.reqs = append(pub.reqs, req)
+	if pub.badURLs[req.LogURL] {
+		return nil, errors.New("log unavailable")
+	}
+	return &pubpb.Result{Sct: []byte{0}}, nil
+}
+
+func TestQueue(t *testing.T) {
+	clk := clock.NewFake()
+	sa := newFakeQueueSA(clk)
+	pub := &recordingPub{badURLs: map[string]bool{"UrlB": true}}
+	q := NewQueue(sa, pub, ctconfig.SubmissionQueueConfig{ReportStats: true}, clk, blog.NewMock(), metrics.NoopRegisterer)
+
+	logs := []loglist.Log{
+		{Url: "UrlA", Key: "KeyA"},
+		{Url: "UrlB", Key: "KeyB", Tiled: true},
+	}
+	err := q.Enqueue(context.Background(), "00aa", pubpb.SubmissionType_final, clk.Now().Add(time.Hour), logs)
+	test.AssertNotError(t, err, "enqueueing final submissions")
+	err = q.Enqueue(context.Background(), "00bb", pubpb.SubmissionType_info, clk.Now().Add(time.Hour), logs[:1])
+	test.AssertNotError(t, err, "enqueueing info submissions")
+
+	clk.Add(time.Minute)
+	err = q.updateStats(context.Background())
+	test.AssertNotError(t, err, "updating stats")
+	test.AssertMetricWithLabelsEquals(t, q.depth, prometheus.Labels{}, 3)
+	test.AssertMetricWithLabelsEquals(t, q.age, prometheus.Labels{}, 60)
+
+	// The successful submissions are deleted, and the failed one is retried
+	// after a backoff.
+	leased, err := q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processing batch")
+	test.AssertEquals(t, leased, int64(3))
+	test.AssertEquals(t, len(pub.reqs), 3)
+	test.AssertMetricWithLabelsEquals(t, q.attempts, prometheus.Labels{"kind": "final", "result": succeeded}, 1)
+	test.AssertMetricWithLabelsEquals(t, q.attempts, prometheus.Labels{"kind": "final", "result": failed}, 1)
+	test.AssertMetricWithLabelsEquals(t, q.attempts, prometheus.Labels{"kind": "info", "result": succeeded}, 1)
+	test.AssertEquals(t, len(sa.subs), 1)
+	for _, req := range pub.reqs {
+		switch req.LogURL {
+		case "UrlA":
+			test.Assert(t, !req.StaticCT, "UrlA is not a static CT log")
+		case "UrlB":
+			test.Assert(t, req.StaticCT, "UrlB is a static CT log")
+			test.AssertByteEquals(t, req.Der, []byte("final"))
+			test.AssertEquals(t, req.Kind, pubpb.SubmissionType_final)
+		}
+	}
+
+	leased, err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processing batch")
+	test.AssertEquals(t, leased, int64(0))
+
+	// Once the log recovers, the retry succeeds.
+	clk.Add(2 * time.Minute)
+	pub.badURLs = nil
+	leased, err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processing batch")
+	test.AssertEquals(t, leased, int64(1))
+	test.AssertEquals(t, len(sa.subs), 0)
+	test.AssertMetricWithLabelsEquals(t, q.attempts, prometheus.Labels{"kind": "final", "result": succeeded}, 2)
+
+	err = q.updateStats(context.Background())
+	test.AssertNotError(t, err, "updating stats")
+	test.AssertMetricWithLabelsEquals(t, q.depth, prometheus.Labels{}, 0)
+	test.AssertMetricWithLabelsEquals(t, q.age, prometheus.Labels{}, 0)
+
+	// Submissions of expired certificates are abandoned without being made.
+	err = q.Enqueue(context.Background(), "00cc", pubpb.SubmissionType_final, clk.Now().Add(time.Minute), logs[:1])
+	test.AssertNotError(t, err, "enqueueing final submission")
+	clk.Add(time.Minute)
+	pub.reqs = nil
+	leased, err = q.processBatch(context.Background())
+	test.AssertNotError(t, err, "processing batch")
+	test.AssertEquals(t, leased, int64(1))
+	test.AssertEquals(t, len(pub.reqs), 0)
+	test.AssertEquals(t, len(sa.subs), 0)
+	test.AssertMetricWithLabelsEquals(t, q.attempts, prometheus.Labels{"kind": "final", "result": expired}, 1)
+
+	// A submission whose lease expired and was taken by another caller is
+	// left for the new lease holder.
+	err = q.Enqueue(context.Background(), "00dd", pubpb.SubmissionType_final, clk.Now().Add(time.Hour), logs[:1])
+	test.AssertNotError(t, err, "enqueueing final submission")
+	stale, err := sa.LeaseCTSubmissions(context.Background(), &sapb.LeaseCTSubmissionsRequest{Limit: 1, Lease: durationpb.New(q.lease)})
+	test.AssertNotError(t, err, "leasing submission")
+	test.AssertEquals(t, len(stale.Submissions), 1)
+	clk.Add(q.lease)
+	current, err := sa.LeaseCTSubmissions(context.Background(), &sapb.LeaseCTSubmissionsRequest{Limit: 1, Lease: durationpb.New(q.lease)})
+	test.AssertNotError(t, err, "leasing submission")
+	test.AssertEquals(t, len(current.Submissions), 1)
+	q.attempt(context.Background(), stale.Submissions[0])
+	test.AssertEquals(t, len(sa.subs), 1)
+	test.AssertEquals(t, sa.subs[stale.Submissions[0].Id].NextAttempt.AsTime(), current.Submissions[0].NextAttempt.AsTime())
+}
+
+func TestQueueStatsNotReported(t *testing.T) {
+	clk := clock.NewFake()
+	sa := newFakeQueueSA(clk)
+	reg := prometheus.NewRegistry()
+	NewQueue(sa, &recordingPub{}, ctconfig.SubmissionQueueConfig{}, clk, blog.NewMock(), reg)
+
+	// Only the RA configured to report the queue's stats exports them.
+	families, err := reg.Gather()
+	test.AssertNotError(t, err, "gathering metrics")
+	for _, family := range families {
+		test.Assert(t, family.GetName() != "ct_submission_queue_depth", "queue depth should not be exported")
+		test.Assert(t, family.GetName() != "ct_submission_queue_oldest_age_seconds", "queue age should not be exported")
+	}
+}
+
+func TestSubmitFinalCertQueued(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0xabcdef),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "creating certificate")
+
+	clk := clock.NewFake()
+	sa := newFakeQueueSA(clk)
+	pub := &recordingPub{}
+	q := NewQueue(sa, pub, ctconfig.SubmissionQueueConfig{}, clk, blog.NewMock(), metrics.NoopRegisterer)
+	end := time.Now().Add(24 * time.Hour)
+	ctp := New(pub, nil, nil, loglist.List{
+		"OperA": {"LogA1": {Url: "UrlA1", Key: "KeyA1", EndExclusive: end}},
+		"OperB": {"LogB1": {Url: "UrlB1", Key: "KeyB1", EndExclusive: end, Tiled: true}},
+	}, 0, q, blog.NewMock(), metrics.NoopRegisterer)
+
+	err = ctp.SubmitFinalCert(context.Background(), der, template.NotAfter)
+	test.AssertNotError(t, err, "submitting final cert")
+
+	// The submissions are queued rather than made.
+	test.AssertEquals(t, len(pub.reqs), 0)
+	test.AssertEquals(t, len(sa.subs), 2)
+	for _, sub := range sa.subs {
+		test.AssertEquals(t, sub.Serial, "000000000000000000000000000000abcdef")
+		test.AssertEquals(t, pubpb.SubmissionType(sub.Kind), pubpb.SubmissionType_final)
+		test.AssertEquals(t, sub.StaticCT, sub.LogURL == "UrlB1")
+	}
+
+	err = ctp.SubmitFinalCert(context.Background(), []byte("not a certificate"), template.NotAfter)
+	test.AssertError(t, err, "submitting a malformed cert should fail")
+}
@@ -126,6 +126,41 @@ type Config struct {
 	// This feature flag also causes CAA checks to happen after all remote VAs
 	// have passed DCV.
 	EnforceMPIC bool
+
+	// ExternalAccountBinding causes the SA to look up External Account Binding
+	// MAC keys in the externalAccountKeys table, and to read and write the
+	// externalAccountID column of the registrations table. Both are added by a
+	// migration which is only present in db-next. When disabled, no external
+	// account keys exist, so new accounts can't be bound to one.
+	ExternalAccountBinding bool
+
+	// CTSubmissionQueue causes the SA to serve the durable queue of CT
+	// submissions kept in the ctSubmissions table, which is added by a
+	// migration only present in db-next. When disabled, the SA's queue methods
+	// return an error, so RAs configured to use the queue can't enqueue
+	// submissions.
+	CTSubmissionQueue bool
+
+	// StoreAuthzPerspectiveResults causes the SA to record the per-perspective
+	// DCV and CAA results submitted with each finalized authorization, and to
+	// return them when requested, in the authzPerspectiveResults table. That
+	// table is added by a migration which is only present in db-next.
+	StoreAuthzPerspectiveResults bool
+
+	// StoreCRLShardNumbers causes the SA to record the CRL Number of each
+	// shard's most recent full CRL in the crlNumber column of the crlShards
+	// table, and to return it from GetCRLShard. That column is added by a
+	// migration which is only present in db-next. When disabled, no CRL Number
+	// is returned, so the crl-updater can't produce delta CRLs.
+	StoreCRLShardNumbers bool
+
+	// ExcludeSerialShardedCerts allows the SA to honor the ExcludeSerialSharded
+	// field of GetRevokedCerts requests, which looks up each revoked cert by the
+	// revokedCertificates serial index. That index is added by a migration which
+	// is only present in db-next. When disabled, such requests are refused, so
+	// the crl-updater can't run the "expiry" and "serial" sharding strategies
+	// side by side.
+	ExcludeSerialShardedCerts bool
 }
 
 var fMu = new(sync.RWMutex)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rsa"
 	"errors"
@@ -49,7 +50,7 @@ type Config struct {
 	FermatRounds int
 }
 
-// AllowedKeys is a map of six specific key algorithm and size combinations to
+// AllowedKeys is a map of specific key algorithm and size combinations to
 // booleans indicating whether keys of that type are considered good.
 type AllowedKeys struct {
 	// Baseline Requirements, Section 6.1.5 requires key size >= 2048 and a multiple
@@ -66,6 +67,18 @@ type AllowedKeys struct {
 	ECDSAP256 bool
 	ECDSAP384 bool
 	ECDSAP521 bool
+	// Ed25519 keys (RFC 8410) are not permitted by the Baseline Requirements,
+	// so this should only be enabled for private PKI deployments, whose
+	// certificate profiles must also ignore the BR lints
+	// e_algorithm_identifier_improper_encoding and
+	// e_public_key_type_not_allowed.
+	Ed25519 bool
+	// ML-DSA keys (FIPS 204) are likewise not permitted by the Baseline
+	// Requirements. They are only supported when Boulder is built with Go 1.27
+	// or later, and a certificate profile must also explicitly allow them.
+	MLDSA44 bool
+	MLDSA65 bool
+	MLDSA87 bool
 }
 
 // LetsEncryptCPS encodes the five key algorithms and sizes allowed by the Let's
@@ -137,16 +150,20 @@ func NewPolicy(config *Config, bkc BlockedKeyCheckFunc) (KeyPolicy, error) {
 
 // GoodKey returns true if the key is acceptable for both TLS use and account
 // key use (our requirements are the same for either one), according to basic
-// strength and algorithm checking. GoodKey only supports pointers: *rsa.PublicKey
-// and *ecdsa.PublicKey. It will reject non-pointer types.
+// strength and algorithm checking. GoodKey supports *rsa.PublicKey,
+// *ecdsa.PublicKey, ed25519.PublicKey, and *mldsa.PublicKey. It will reject
+// other types, including non-pointer RSA and ECDSA keys.
 // TODO: Support JSONWebKeys once go-jose migration is done.
 func (policy *KeyPolicy) GoodKey(ctx context.Context, key crypto.PublicKey) error {
 	// Early rejection of unacceptable key types to guard subsequent checks.
+	_, isMLDSA := mldsaParameterSet(key)
 	switch t := key.(type) {
-	case *rsa.PublicKey, *ecdsa.PublicKey:
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
 		break
 	default:
-		return badKey("unsupported key type %T", t)
+		if !isMLDSA {
+			return badKey("unsupported key type %T", t)
+		}
 	}
 	if policy.blockedCheck != nil {
 		digest, err := core.KeyDigest(key)
@@ -165,11 +182,37 @@ func (policy *KeyPolicy) GoodKey(ctx context.Context, key crypto.PublicKey) erro
 		return policy.goodKeyRSA(t)
 	case *ecdsa.PublicKey:
 		return policy.goodKeyECDSA(t)
+	case ed25519.PublicKey:
+		return policy.goodKeyEd25519(t)
 	default:
+		if isMLDSA {
+			return policy.goodKeyMLDSA(key)
+		}
 		return badKey("unsupported key type %T", key)
 	}
 }
 
+// goodKeyEd25519 determines if an Ed25519 pubkey meets our requirements.
+func (policy *KeyPolicy) goodKeyEd25519(key ed25519.PublicKey) error {
+	if !policy.allowedKeys.Ed25519 {
+		return badKey("Ed25519 keys not allowed")
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return badKey("wrong Ed25519 key size: %d bytes", len(key))
+	}
+	return nil
+}
+
+// goodKeyMLDSA determines if an ML-DSA pubkey meets our requirements. The
+// encoding of the key is validated when it is parsed, so only the parameter
+// set needs to be checked.
+func (policy *KeyPolicy) goodKeyMLDSA(key crypto.PublicKey) error {
+	if !policy.allowedKeys.Allows(key) {
+		return badKey("%s keys not allowed", Describe(key))
+	}
+	return nil
+}
+
 // GoodKeyECDSA determines if an ECDSA pubkey meets our requirements
 func (policy *KeyPolicy) goodKeyECDSA(key *ecdsa.PublicKey) (err error) {
 	// Check the curve.
@@ -269,17 +312,99 @@ func isPointAtInfinityNISTP(x, y *big.Int) bool {
 
 // GoodCurve determines if an elliptic curve meets our requirements.
 func (policy *KeyPolicy) goodCurve(c elliptic.Curve) (err error) {
+	if policy.allowedKeys.allowsCurve(c) {
+		return nil
+	}
+	return badKey("ECDSA curve %v not allowed", c.Params().Name)
+}
+
+// allowsCurve returns true if the given elliptic curve is allowed.
+func (ak AllowedKeys) allowsCurve(c elliptic.Curve) bool {
 	// Simply use a whitelist for now.
 	params := c.Params()
 	switch {
-	case policy.allowedKeys.ECDSAP256 && params == elliptic.P256().Params():
-		return nil
-	case policy.allowedKeys.ECDSAP384 && params == elliptic.P384().Params():
-		return nil
-	case policy.allowedKeys.ECDSAP521 && params == elliptic.P521().Params():
-		return nil
+	case ak.ECDSAP256 && params == elliptic.P256().Params():
+		return true
+	case ak.ECDSAP384 && params == elliptic.P384().Params():
+		return true
+	case ak.ECDSAP521 && params == elliptic.P521().Params():
+		return true
+	default:
+		return false
+	}
+}
+
+// allowsRSABitLen returns true if RSA keys with the given modulus length are
+// allowed.
+func (ak AllowedKeys) allowsRSABitLen(bitLen int) bool {
+	switch {
+	case bitLen == 2048 && ak.RSA2048:
+		return true
+	case bitLen == 3072 && ak.RSA3072:
+		return true
+	case bitLen == 4096 && ak.RSA4096:
+		return true
 	default:
-		return badKey("ECDSA curve %v not allowed", params.Name)
+		return false
+	}
+}
+
+// allowsMLDSA returns true if ML-DSA keys with the given parameter set are
+// allowed.
+func (ak AllowedKeys) allowsMLDSA(parameterSet string) bool {
+	switch {
+	case parameterSet == "ML-DSA-44" && ak.MLDSA44:
+		return true
+	case parameterSet == "ML-DSA-65" && ak.MLDSA65:
+		return true
+	case parameterSet == "ML-DSA-87" && ak.MLDSA87:
+		return true
+	default:
+		return false
+	}
+}
+
+// Allows returns true if the type and size (or curve) of the given key is
+// allowed. Unlike KeyPolicy.GoodKey, it does not check the key for any
+// weaknesses.
+func (ak AllowedKeys) Allows(key crypto.PublicKey) bool {
+	switch t := key.(type) {
+	case *rsa.PublicKey:
+		return ak.allowsRSABitLen(t.N.BitLen())
+	case *ecdsa.PublicKey:
+		return ak.allowsCurve(t.Curve)
+	case ed25519.PublicKey:
+		return ak.Ed25519
+	default:
+		parameterSet, ok := mldsaParameterSet(key)
+		return ok && ak.allowsMLDSA(parameterSet)
+	}
+}
+
+// IsMLDSA returns true if the given key is an ML-DSA key. It always returns
+// false when built with a version of Go which doesn't support ML-DSA.
+func IsMLDSA(key crypto.PublicKey) bool {
+	_, ok := mldsaParameterSet(key)
+	return ok
+}
+
+// Describe returns a short human-readable description of the type and size
+// (or curve) of the given key, such as "RSA 2048" or "ECDSA P-256", for use in
+// error messages.
+func Describe(key crypto.PublicKey) string {
+	switch t := key.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", t.N.BitLen())
+	case *ecdsa.PublicKey:
+		return fmt.Sprintf("ECDSA %s", t.Curve.Params().Name)
+	case ed25519.PublicKey:
+		return "Ed25519"
+	default:
+		parameterSet, ok := mldsaParameterSet(key)
+		if ok {
+			return parameterSet
+		}
+		return fmt.Sprintf("key type %T", key)
 	}
 }
 
@@ -333,16 +458,10 @@ func (policy *KeyPolicy) goodKeyRSA(key *rsa.PublicKey) error {
 func (policy *KeyPolicy) goodRSABitLen(key *rsa.PublicKey) error {
 	// See comment on AllowedKeys above.
 	modulusBitLen := key.N.BitLen()
-	switch {
-	case modulusBitLen == 2048 && policy.allowedKeys.RSA2048:
+	if policy.allowedKeys.allowsRSABitLen(modulusBitLen) {
 		return nil
-	case modulusBitLen == 3072 && policy.allowedKeys.RSA3072:
-		return nil
-	case modulusBitLen == 4096 && policy.allowedKeys.RSA4096:
-		return nil
-	default:
-		return badKey("key size not supported: %d", modulusBitLen)
 	}
+	return badKey("key size not supported: %d", modulusBitLen)
 }
 
 // Returns true iff integer i is divisible by any of the primes in smallPrimes.
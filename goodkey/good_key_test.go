@@ -3,6 +3,7 @@ package goodkey
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -365,3 +366,52 @@ func BenchmarkFermat10(b *testing.B)    { benchFermat(10, b) }
 func BenchmarkFermat100(b *testing.B)   { benchFermat(100, b) }
 func BenchmarkFermat1000(b *testing.B)  { benchFermat(1000, b) }
 func BenchmarkFermat10000(b *testing.B) { benchFermat(10000, b) }
+
+func TestAllowedKeysAllows(t *testing.T) {
+	t.Parallel()
+
+	ak := AllowedKeys{RSA2048: true, ECDSAP384: true}
+
+	rsa2048, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "generating RSA key")
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating P-256 key")
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	test.AssertNotError(t, err, "generating P-384 key")
+
+	test.Assert(t, ak.Allows(&rsa2048.PublicKey), "RSA 2048 should be allowed")
+	test.Assert(t, !ak.Allows(&p256.PublicKey), "ECDSA P-256 should not be allowed")
+	test.Assert(t, ak.Allows(&p384.PublicKey), "ECDSA P-384 should be allowed")
+	test.Assert(t, !ak.Allows(&rsa.PublicKey{N: big.NewInt(1).Lsh(big.NewInt(1), 3071)}), "RSA 3072 should not be allowed")
+	test.Assert(t, !ak.Allows(nil), "nil key should not be allowed")
+
+	test.AssertEquals(t, Describe(&rsa2048.PublicKey), "RSA 2048")
+	test.AssertEquals(t, Describe(&p256.PublicKey), "ECDSA P-256")
+}
+
+func TestEd25519(t *testing.T) {
+	t.Parallel()
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "generating Ed25519 key")
+
+	policy, err := NewPolicy(nil, nil)
+	test.AssertNotError(t, err, "NewPolicy failed")
+	err = policy.GoodKey(context.Background(), pub)
+	test.AssertError(t, err, "Ed25519 key should not be allowed by default")
+	test.AssertEquals(t, err.Error(), "Ed25519 keys not allowed")
+
+	policy, err = NewPolicy(&Config{AllowedKeys: &AllowedKeys{Ed25519: true}}, nil)
+	test.AssertNotError(t, err, "NewPolicy failed")
+	test.AssertNotError(t, policy.GoodKey(context.Background(), pub), "Ed25519 key should be allowed")
+	test.AssertError(t, policy.GoodKey(context.Background(), pub[:31]), "short Ed25519 key should be rejected")
+	test.Assert(t, (AllowedKeys{Ed25519: true}).Allows(pub), "Allows should accept Ed25519 key")
+	test.AssertEquals(t, Describe(pub), "Ed25519")
+	test.Assert(t, !IsMLDSA(pub), "Ed25519 key is not an ML-DSA key")
+
+	// Ed25519 keys can be blocked like any other key.
+	policy.blockedCheck = func(context.Context, []byte) (bool, error) { return true, nil }
+	err = policy.GoodKey(context.Background(), pub)
+	test.AssertError(t, err, "blocked Ed25519 key should be rejected")
+	test.AssertEquals(t, err.Error(), "public key is forbidden")
+}
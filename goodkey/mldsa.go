@@ -0,0 +1,28 @@
+//go:build go1.27
+
+package goodkey
+
+import (
+	"crypto"
+	"crypto/mldsa"
+)
+
+// mldsaParameterSet returns the name of the ML-DSA parameter set of the given
+// key, such as "ML-DSA-65", and true if the key is an ML-DSA key. ML-DSA is
+// only supported when built with Go 1.27 or later.
+func mldsaParameterSet(key crypto.PublicKey) (string, bool) {
+	k, ok := key.(*mldsa.PublicKey)
+	if !ok || k == nil {
+		return "", false
+	}
+	switch k.Parameters() {
+	case mldsa.MLDSA44():
+		return "ML-DSA-44", true
+	case mldsa.MLDSA65():
+		return "ML-DSA-65", true
+	case mldsa.MLDSA87():
+		return "ML-DSA-87", true
+	default:
+		return k.Parameters().String(), true
+	}
+}
@@ -0,0 +1,34 @@
+//go:build go1.27
+
+package goodkey
+
+import (
+	"context"
+	"crypto/mldsa"
+	"testing"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestMLDSA(t *testing.T) {
+	t.Parallel()
+
+	key, err := mldsa.GenerateKey(mldsa.MLDSA44())
+	test.AssertNotError(t, err, "generating ML-DSA key")
+	pub := key.PublicKey()
+
+	test.Assert(t, IsMLDSA(pub), "IsMLDSA should recognize ML-DSA key")
+	test.AssertEquals(t, Describe(pub), "ML-DSA-44")
+
+	err = testingPolicy.GoodKey(context.Background(), pub)
+	test.AssertError(t, err, "ML-DSA key should not be allowed by the testing policy")
+	test.AssertEquals(t, err.Error(), "ML-DSA-44 keys not allowed")
+
+	policy, err := NewPolicy(&Config{AllowedKeys: &AllowedKeys{MLDSA44: true}}, nil)
+	test.AssertNotError(t, err, "NewPolicy failed")
+	test.AssertNotError(t, policy.GoodKey(context.Background(), pub), "ML-DSA-44 key should be allowed")
+
+	policy, err = NewPolicy(&Config{AllowedKeys: &AllowedKeys{MLDSA65: true, MLDSA87: true}}, nil)
+	test.AssertNotError(t, err, "NewPolicy failed")
+	test.AssertError(t, policy.GoodKey(context.Background(), pub), "ML-DSA-44 key should not be allowed")
+}
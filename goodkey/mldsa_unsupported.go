@@ -0,0 +1,11 @@
+//go:build !go1.27
+
+package goodkey
+
+import "crypto"
+
+// mldsaParameterSet always returns false, because ML-DSA keys can't be
+// represented when built with a version of Go older than 1.27.
+func mldsaParameterSet(_ crypto.PublicKey) (string, bool) {
+	return "", false
+}
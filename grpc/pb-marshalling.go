@@ -306,6 +306,7 @@ func AuthzToPB(authz core.Authorization) (*corepb.Authorization, error) {
 	return &corepb.Authorization{
 		Id:             authz.ID,
 		DnsName:        authz.Identifier.Value,
+		Identifier:     authz.Identifier.AsProto(),
 		RegistrationID: authz.RegistrationID,
 		Status:         string(authz.Status),
 		Expires:        expires,
@@ -329,7 +330,7 @@ func PBToAuthz(pb *corepb.Authorization) (core.Authorization, error) {
 	}
 	authz := core.Authorization{
 		ID:             pb.Id,
-		Identifier:     identifier.NewDNS(pb.DnsName),
+		Identifier:     identifier.FromProtoWithDefault(pb.Identifier, pb.DnsName),
 		RegistrationID: pb.RegistrationID,
 		Status:         core.AcmeStatus(pb.Status),
 		Expires:        expires,
@@ -353,7 +354,7 @@ func orderValid(order *corepb.Order) bool {
 // `order.CertificateSerial` to be nil such that it can be used in places where
 // the order has not been finalized yet.
 func newOrderValid(order *corepb.Order) bool {
-	return !(order.RegistrationID == 0 || order.Expires == nil || len(order.DnsNames) == 0)
+	return !(order.RegistrationID == 0 || order.Expires == nil || (len(order.DnsNames) == 0 && len(order.Identifiers) == 0))
 }
 
 func CertToPB(cert core.Certificate) *corepb.Certificate {
@@ -0,0 +1,84 @@
+package iana
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+type reservedPrefix struct {
+	prefix  netip.Prefix
+	comment string
+}
+
+func mustParsePrefix(cidr string, comment string) reservedPrefix {
+	return reservedPrefix{
+		prefix:  netip.MustParsePrefix(cidr),
+		comment: comment,
+	}
+}
+
+var (
+	// reservedV4Prefixes are IPv4 ranges which are not globally reachable, or
+	// which are otherwise unsuitable for validation or issuance.
+	reservedV4Prefixes = []reservedPrefix{
+		mustParsePrefix("10.0.0.0/8", "RFC 1918: Private-Use"),
+		mustParsePrefix("172.16.0.0/12", "RFC 1918: Private-Use"),
+		mustParsePrefix("192.168.0.0/16", "RFC 1918: Private-Use"),
+		mustParsePrefix("127.0.0.0/8", "RFC 5735: Loopback"),
+		mustParsePrefix("0.0.0.0/8", "RFC 1122, Section 3.2.1.3: This host on this network"),
+		mustParsePrefix("169.254.0.0/16", "RFC 3927: Link Local"),
+		mustParsePrefix("192.0.0.0/24", "RFC 5736: IETF Protocol Assignments"),
+		mustParsePrefix("192.0.2.0/24", "RFC 5737: Documentation (TEST-NET-1)"),
+		mustParsePrefix("198.51.100.0/24", "RFC 5737: Documentation (TEST-NET-2)"),
+		mustParsePrefix("203.0.113.0/24", "RFC 5737: Documentation (TEST-NET-3)"),
+		mustParsePrefix("192.88.99.0/24", "RFC 3068: 6to4 Relay Anycast"),
+		mustParsePrefix("198.18.0.0/15", "RFC 2544, Errata 423: Benchmarking"),
+		mustParsePrefix("224.0.0.0/4", "RFC 3171: Multicast"),
+		mustParsePrefix("240.0.0.0/4", "RFC 1112: Reserved"),
+		mustParsePrefix("255.255.255.255/32", "RFC 919, Section 7: Limited Broadcast"),
+		mustParsePrefix("100.64.0.0/10", "RFC 6598: Shared Address Space"),
+	}
+
+	// reservedV6Prefixes are sourced from
+	// https://www.iana.org/assignments/iana-ipv6-special-registry/iana-ipv6-special-registry.xhtml
+	// where Global, Source, or Destination is False.
+	reservedV6Prefixes = []reservedPrefix{
+		mustParsePrefix("::/128", "RFC 4291: Unspecified Address"),
+		mustParsePrefix("::1/128", "RFC 4291: Loopback Address"),
+		mustParsePrefix("::ffff:0:0/96", "RFC 4291: IPv4-mapped Address"),
+		mustParsePrefix("100::/64", "RFC 6666: Discard Address Block"),
+		mustParsePrefix("2001::/23", "RFC 2928: IETF Protocol Assignments"),
+		mustParsePrefix("2001:2::/48", "RFC 5180: Benchmarking"),
+		mustParsePrefix("2001:db8::/32", "RFC 3849: Documentation"),
+		mustParsePrefix("2001::/32", "RFC 4380: TEREDO"),
+		mustParsePrefix("fc00::/7", "RFC 4193: Unique-Local"),
+		mustParsePrefix("fe80::/10", "RFC 4291: Section 2.5.6 Link-Scoped Unicast"),
+		mustParsePrefix("ff00::/8", "RFC 4291: Section 2.7"),
+		// We disable validations to IPs under the 6to4 anycast prefix because
+		// there's too much risk of a malicious actor advertising the prefix and
+		// answering validations for a 6to4 host they do not control.
+		// https://community.letsencrypt.org/t/problems-validating-ipv6-against-host-running-6to4/18312/9
+		mustParsePrefix("2002::/16", "RFC 7526: 6to4 anycast prefix deprecated"),
+	}
+)
+
+// IsReservedAddr returns an error if the given IP address falls within a range
+// which IANA lists as not globally reachable, or which is otherwise reserved.
+// IPv4-mapped IPv6 addresses are considered reserved; callers which want to
+// check the embedded IPv4 address must unmap it first.
+func IsReservedAddr(ip netip.Addr) error {
+	if !ip.IsValid() {
+		return fmt.Errorf("invalid IP address")
+	}
+	ip = ip.WithZone("")
+	prefixes := reservedV6Prefixes
+	if ip.Is4() {
+		prefixes = reservedV4Prefixes
+	}
+	for _, rp := range prefixes {
+		if rp.prefix.Contains(ip) {
+			return fmt.Errorf("IP address is in a reserved address block: %s", rp.comment)
+		}
+	}
+	return nil
+}
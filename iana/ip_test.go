@@ -0,0 +1,51 @@
+package iana
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIsReservedAddr(t *testing.T) {
+	testCases := []struct {
+		ip       string
+		reserved bool
+	}{
+		{"127.0.0.1", true},
+		{"10.255.0.3", true},
+		{"172.31.255.255", true},
+		{"192.168.254.254", true},
+		{"100.64.0.1", true},
+		{"255.255.255.255", true},
+		{"1.1.1.1", false},
+		{"64.112.117.122", false},
+		{"172.32.255.255", false},
+
+		{"::", true},
+		{"::1", true},
+		{"::ffff:1.1.1.1", true},
+		{"2001:db8::1", true},
+		{"fe80::1", true},
+		{"fe80::1%eth0", true},
+		{"2002::", true},
+		{"ff10::1", true},
+		{"2606:4700:4700::1111", false},
+		{"2602:80a:6000::1", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			t.Parallel()
+			err := IsReservedAddr(netip.MustParseAddr(tc.ip))
+			if err != nil && !tc.reserved {
+				t.Errorf("IsReservedAddr(%q) = %q, want nil", tc.ip, err)
+			}
+			if err == nil && tc.reserved {
+				t.Errorf("IsReservedAddr(%q) = nil, want error", tc.ip)
+			}
+		})
+	}
+
+	if IsReservedAddr(netip.Addr{}) == nil {
+		t.Errorf("IsReservedAddr(invalid) = nil, want error")
+	}
+}
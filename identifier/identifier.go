@@ -4,7 +4,11 @@
 package identifier
 
 import (
+	"crypto/x509"
+	"net"
 	"net/netip"
+	"slices"
+	"strings"
 
 	corepb "github.com/letsencrypt/boulder/core/proto"
 )
@@ -22,16 +26,21 @@ const (
 
 // ACMEIdentifier is a struct encoding an identifier that can be validated. The
 // protocol allows for different types of identifier to be supported (DNS
-// names, IP addresses, etc.), but currently we only support RFC 8555 DNS type
-// identifiers for domain names.
+// names, IP addresses, etc.). We support RFC 8555 DNS type identifiers for
+// domain names and RFC 8738 IP type identifiers for IP addresses.
 type ACMEIdentifier struct {
 	// Type is the registered IdentifierType of the identifier.
 	Type IdentifierType `json:"type"`
 	// Value is the value of the identifier. For a DNS type identifier it is
-	// a domain name.
+	// a domain name. For an IP type identifier it is the textual form of an
+	// IP address.
 	Value string `json:"value"`
 }
 
+// ACMEIdentifiers is a named type for a slice of ACME identifiers, so that
+// methods can be applied to these slices.
+type ACMEIdentifiers []ACMEIdentifier
+
 func (i ACMEIdentifier) AsProto() *corepb.Identifier {
 	return &corepb.Identifier{
 		Type:  string(i.Type),
@@ -39,6 +48,58 @@ func (i ACMEIdentifier) AsProto() *corepb.Identifier {
 	}
 }
 
+// FromProto returns the ACMEIdentifier represented by the provided protobuf.
+func FromProto(ident *corepb.Identifier) ACMEIdentifier {
+	return ACMEIdentifier{
+		Type:  IdentifierType(ident.Type),
+		Value: ident.Value,
+	}
+}
+
+// FromProtoWithDefault can be removed after DnsName fields in protobufs are
+// removed. It returns the ACMEIdentifier represented by the provided protobuf,
+// falling back to a DNS identifier for the legacy dnsName field when the
+// protobuf identifier is missing.
+//
+// TODO: Remove this function once all callers populate identifiers.
+func FromProtoWithDefault(ident *corepb.Identifier, dnsName string) ACMEIdentifier {
+	if ident != nil && ident.Value != "" {
+		return FromProto(ident)
+	}
+	return NewDNS(dnsName)
+}
+
+// FromProtoSlice returns the ACMEIdentifiers represented by the provided
+// protobufs.
+func FromProtoSlice(pbIdents []*corepb.Identifier) ACMEIdentifiers {
+	var idents ACMEIdentifiers
+	for _, pbIdent := range pbIdents {
+		idents = append(idents, FromProto(pbIdent))
+	}
+	return idents
+}
+
+// FromProtoSliceWithDefault returns the ACMEIdentifiers represented by the
+// provided protobufs. If none are present, it falls back to treating each of
+// the legacy dnsNames as a DNS identifier.
+//
+// TODO: Remove this function once all callers populate identifiers.
+func FromProtoSliceWithDefault(pbIdents []*corepb.Identifier, dnsNames []string) ACMEIdentifiers {
+	if len(pbIdents) > 0 {
+		return FromProtoSlice(pbIdents)
+	}
+	return NewDNSSlice(dnsNames)
+}
+
+// ToProtoSlice returns the protobuf representation of each identifier.
+func (idents ACMEIdentifiers) ToProtoSlice() []*corepb.Identifier {
+	var pbIdents []*corepb.Identifier
+	for _, ident := range idents {
+		pbIdents = append(pbIdents, ident.AsProto())
+	}
+	return pbIdents
+}
+
 // NewDNS is a convenience function for creating an ACMEIdentifier with Type
 // "dns" for a given domain name.
 func NewDNS(domain string) ACMEIdentifier {
@@ -48,11 +109,135 @@ func NewDNS(domain string) ACMEIdentifier {
 	}
 }
 
+// NewDNSSlice is a convenience function for creating a slice of ACMEIdentifiers
+// with Type "dns" for a given slice of domain names.
+func NewDNSSlice(input []string) ACMEIdentifiers {
+	var out ACMEIdentifiers
+	for _, in := range input {
+		out = append(out, NewDNS(in))
+	}
+	return out
+}
+
 // NewIP is a convenience function for creating an ACMEIdentifier with Type "ip"
 // for a given IP address.
 func NewIP(ip netip.Addr) ACMEIdentifier {
 	return ACMEIdentifier{
-		Type:  TypeIP,
-		Value: ip.StringExpanded(),
+		Type: TypeIP,
+		// RFC 8738, Sec. 3: The identifier value MUST contain the textual form
+		// of the address as defined in RFC 1123, Sec. 2.1 for IPv4 and in RFC
+		// 5952, Sec. 4 for IPv6.
+		Value: ip.WithZone("").String(),
+	}
+}
+
+// FromString returns an IP identifier if the value parses as an IP address,
+// and a DNS identifier otherwise. It exists for legacy storage formats and
+// rate limit bucket keys which record only identifier values. Because the
+// policy authority never permits a DNS name that is syntactically an IP
+// address, the mapping is unambiguous.
+func FromString(value string) ACMEIdentifier {
+	ip, err := netip.ParseAddr(value)
+	if err == nil {
+		return NewIP(ip)
+	}
+	return NewDNS(value)
+}
+
+// fromX509 extracts the Subject Alternative Names from a certificate or CSR's
+// fields, and returns a slice of ACMEIdentifiers.
+func fromX509(commonName string, dnsNames []string, ipAddresses []net.IP) ACMEIdentifiers {
+	var sans ACMEIdentifiers
+	for _, name := range dnsNames {
+		sans = append(sans, NewDNS(name))
+	}
+	if commonName != "" {
+		// Boulder won't generate certificates with a CN that's not also present
+		// in the SANs, but such a certificate is possible. If appended, this is
+		// deduplicated later with Normalize(). We assume the CN is a DNSName,
+		// because CNs are untyped strings without metadata, and we will never
+		// configure a Boulder profile to issue a certificate that contains both
+		// an IP address identifier and a CN.
+		sans = append(sans, NewDNS(commonName))
+	}
+	for _, ip := range ipAddresses {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok {
+			continue
+		}
+		sans = append(sans, NewIP(addr.Unmap()))
+	}
+	return Normalize(sans)
+}
+
+// FromCert extracts the Subject Common Name and Subject Alternative Names from
+// a certificate, and returns a slice of ACMEIdentifiers.
+func FromCert(cert *x509.Certificate) ACMEIdentifiers {
+	return fromX509(cert.Subject.CommonName, cert.DNSNames, cert.IPAddresses)
+}
+
+// FromCSR extracts the Subject Common Name and Subject Alternative Names from a
+// CSR, and returns a slice of ACMEIdentifiers.
+func FromCSR(csr *x509.CertificateRequest) ACMEIdentifiers {
+	return fromX509(csr.Subject.CommonName, csr.DNSNames, csr.IPAddresses)
+}
+
+// Normalize returns the set of all unique ACME identifiers in the input after
+// all of them are lowercased. The returned identifier values will be in their
+// lowercased form and sorted alphabetically by type and then value.
+func Normalize(idents ACMEIdentifiers) ACMEIdentifiers {
+	out := make(ACMEIdentifiers, len(idents))
+	for i, ident := range idents {
+		out[i] = ACMEIdentifier{
+			Type:  ident.Type,
+			Value: strings.ToLower(ident.Value),
+		}
+	}
+
+	slices.SortFunc(out, func(a, b ACMEIdentifier) int {
+		if a.Type == b.Type {
+			return strings.Compare(a.Value, b.Value)
+		}
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+
+	return slices.Compact(out)
+}
+
+// ToValues returns a slice of the values of the provided identifiers, in the
+// same order.
+func (idents ACMEIdentifiers) ToValues() []string {
+	values := make([]string, 0, len(idents))
+	for _, ident := range idents {
+		values = append(values, ident.Value)
+	}
+	return values
+}
+
+// DNSNames returns the values of only the DNS type identifiers.
+func (idents ACMEIdentifiers) DNSNames() []string {
+	var names []string
+	for _, ident := range idents {
+		if ident.Type == TypeDNS {
+			names = append(names, ident.Value)
+		}
+	}
+	return names
+}
+
+// IPAddresses returns the parsed values of only the IP type identifiers.
+// Identifiers whose value does not parse as an IP address are skipped.
+func (idents ACMEIdentifiers) IPAddresses() []net.IP {
+	var ips []net.IP
+	for _, ident := range idents {
+		if ident.Type != TypeIP {
+			continue
+		}
+		ip, err := netip.ParseAddr(ident.Value)
+		if err != nil {
+			continue
+		}
+		ips = append(ips, net.IP(ip.AsSlice()))
 	}
+	return ips
 }
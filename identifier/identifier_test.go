@@ -0,0 +1,138 @@
+package identifier
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/netip"
+	"reflect"
+	"slices"
+	"testing"
+
+	corepb "github.com/letsencrypt/boulder/core/proto"
+)
+
+func TestNormalize(t *testing.T) {
+	idents := ACMEIdentifiers{
+		{Type: "DNS", Value: "foobar.com"},
+		{Type: "DNS", Value: "fooBAR.com"},
+		{Type: "DNS", Value: "baz.com"},
+		{Type: "DNS", Value: "foobar.com"},
+		{Type: "DNS", Value: "bar.com"},
+		{Type: "DNS", Value: "bar.com"},
+		{Type: "DNS", Value: "a.com"},
+	}
+	expected := ACMEIdentifiers{
+		{Type: "DNS", Value: "a.com"},
+		{Type: "DNS", Value: "bar.com"},
+		{Type: "DNS", Value: "baz.com"},
+		{Type: "DNS", Value: "foobar.com"},
+	}
+	u := Normalize(idents)
+	if !reflect.DeepEqual(expected, u) {
+		t.Errorf("expected Normalize(%#v) to return %#v, got %#v", idents, expected, u)
+	}
+
+	mixed := ACMEIdentifiers{
+		NewIP(netip.MustParseAddr("9.9.9.9")),
+		NewDNS("example.com"),
+		NewIP(netip.MustParseAddr("2602:80a:6000::1")),
+		NewIP(netip.MustParseAddr("9.9.9.9")),
+	}
+	expected = ACMEIdentifiers{
+		NewDNS("example.com"),
+		NewIP(netip.MustParseAddr("2602:80a:6000::1")),
+		NewIP(netip.MustParseAddr("9.9.9.9")),
+	}
+	u = Normalize(mixed)
+	if !reflect.DeepEqual(expected, u) {
+		t.Errorf("expected Normalize(%#v) to return %#v, got %#v", mixed, expected, u)
+	}
+}
+
+func TestNewIP(t *testing.T) {
+	testCases := []struct {
+		ip   string
+		want string
+	}{
+		{"9.9.9.9", "9.9.9.9"},
+		{"2602:080a:6000:0000:0000:0000:0000:0001", "2602:80a:6000::1"},
+		{"fe80::1%eth0", "fe80::1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			t.Parallel()
+			got := NewIP(netip.MustParseAddr(tc.ip))
+			if got.Type != TypeIP || got.Value != tc.want {
+				t.Errorf("NewIP(%q) = %#v, want value %q", tc.ip, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromString(t *testing.T) {
+	testCases := []struct {
+		value string
+		want  ACMEIdentifier
+	}{
+		{"example.com", NewDNS("example.com")},
+		{"*.example.com", NewDNS("*.example.com")},
+		{"9.9.9.9", NewIP(netip.MustParseAddr("9.9.9.9"))},
+		{"2602:80a:6000::1", NewIP(netip.MustParseAddr("2602:80a:6000::1"))},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			t.Parallel()
+			got := FromString(tc.value)
+			if got != tc.want {
+				t.Errorf("FromString(%q) = %#v, want %#v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromProtoSliceWithDefault(t *testing.T) {
+	idents := FromProtoSliceWithDefault(nil, []string{"example.com"})
+	if !slices.Equal(idents, ACMEIdentifiers{NewDNS("example.com")}) {
+		t.Errorf("expected fallback to dnsNames, got %#v", idents)
+	}
+
+	pbIdents := []*corepb.Identifier{
+		{Type: "dns", Value: "example.com"},
+		{Type: "ip", Value: "9.9.9.9"},
+	}
+	idents = FromProtoSliceWithDefault(pbIdents, []string{"example.com"})
+	expected := ACMEIdentifiers{NewDNS("example.com"), NewIP(netip.MustParseAddr("9.9.9.9"))}
+	if !slices.Equal(idents, expected) {
+		t.Errorf("expected %#v, got %#v", expected, idents)
+	}
+	if !reflect.DeepEqual(idents.ToProtoSlice(), pbIdents) {
+		t.Errorf("expected ToProtoSlice to round trip, got %#v", idents.ToProtoSlice())
+	}
+}
+
+func TestFromCSR(t *testing.T) {
+	csr := &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "www.example.com"},
+		DNSNames:    []string{"example.com", "WWW.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("9.9.9.9"), net.ParseIP("::ffff:9.9.9.9"), net.ParseIP("2602:80a:6000::1")},
+	}
+	expected := ACMEIdentifiers{
+		NewDNS("example.com"),
+		NewDNS("www.example.com"),
+		NewIP(netip.MustParseAddr("2602:80a:6000::1")),
+		NewIP(netip.MustParseAddr("9.9.9.9")),
+	}
+	idents := FromCSR(csr)
+	if !slices.Equal(idents, expected) {
+		t.Errorf("expected FromCSR to return %#v, got %#v", expected, idents)
+	}
+
+	if !slices.Equal(idents.DNSNames(), []string{"example.com", "www.example.com"}) {
+		t.Errorf("unexpected DNSNames(): %#v", idents.DNSNames())
+	}
+	ips := idents.IPAddresses()
+	if len(ips) != 2 || !ips[0].Equal(net.ParseIP("2602:80a:6000::1")) || !ips[1].Equal(net.ParseIP("9.9.9.9")) {
+		t.Errorf("unexpected IPAddresses(): %#v", ips)
+	}
+}
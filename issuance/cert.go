@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
@@ -13,6 +15,10 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +30,9 @@ import (
 
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/linter"
 	"github.com/letsencrypt/boulder/precert"
 )
@@ -68,8 +77,31 @@ type ProfileConfig struct {
 	// profile, and which we know it is safe to ignore.
 	IgnoredLints []string
 
-	// Deprecated: we do not respect this field.
-	Policies []PolicyConfig `validate:"-"`
+	// AllowedKeys restricts the key types and sizes which may be certified
+	// under this profile. It can only narrow, not widen, the set of keys
+	// allowed by the goodkey policy. If nil, any key which passes the goodkey
+	// policy is allowed, except for ML-DSA keys, which must be explicitly
+	// allowed here.
+	AllowedKeys *goodkey.AllowedKeys
+	// MaxNames is the maximum number of identifiers which may be included in a
+	// certificate issued under this profile. If zero, only the global MaxNames
+	// limit applies.
+	MaxNames int `validate:"omitempty,min=1,max=100"`
+	// IdentifierTypes lists the types of identifier which may be included in a
+	// certificate issued under this profile. If empty, all identifier types
+	// are allowed.
+	IdentifierTypes []identifier.IdentifierType `validate:"omitempty,dive,oneof=dns ip"`
+	// ChallengeTypes lists the challenge types which may be used to validate
+	// the identifiers in an order requesting this profile. Existing
+	// authorizations which were validated using other challenge types are not
+	// reused for such orders. If empty, all enabled challenge types are
+	// allowed. This is enforced by the RA; the CA does not see challenges.
+	ChallengeTypes []core.AcmeChallenge `validate:"omitempty,dive,oneof=http-01 dns-01 tls-alpn-01 dns-account-01 dns-persist-01"`
+
+	// Policies lists certificate policy OIDs to include in certificates
+	// issued under this profile, in addition to the CA/Browser Forum
+	// domain-validated policy OID, which is always included.
+	Policies []PolicyConfig `validate:"omitempty,dive"`
 }
 
 // PolicyConfig describes a policy
@@ -77,6 +109,64 @@ type PolicyConfig struct {
 	OID string `validate:"required"`
 }
 
+// CheckIdentifiers returns an error if the given identifiers may not be
+// included, together, in a certificate issued under this profile.
+func (pc *ProfileConfig) CheckIdentifiers(idents identifier.ACMEIdentifiers) error {
+	types := make([]identifier.IdentifierType, 0, len(idents))
+	for _, ident := range idents {
+		types = append(types, ident.Type)
+	}
+	return checkIdentifiers(pc.MaxNames, pc.IdentifierTypes, types)
+}
+
+// CheckKey returns an error if the given public key may not be certified under
+// this profile. It does not check whether the key is otherwise acceptable;
+// that is the job of goodkey.KeyPolicy.
+func (pc *ProfileConfig) CheckKey(key crypto.PublicKey) error {
+	return checkKey(pc.AllowedKeys, key)
+}
+
+// AllowsChallengeType returns true if identifiers in an order requesting this
+// profile may be validated using the given challenge type.
+func (pc *ProfileConfig) AllowsChallengeType(challType core.AcmeChallenge) bool {
+	return len(pc.ChallengeTypes) == 0 || slices.Contains(pc.ChallengeTypes, challType)
+}
+
+// ErrTooManyIdentifiers is wrapped by the error returned when a request has
+// more identifiers than its profile's MaxNames allows.
+var ErrTooManyIdentifiers = errors.New("too many identifiers")
+
+// checkIdentifiers returns an error if there are more than maxNames
+// identifiers, or if any identifier's type is not among allowedTypes. A zero
+// maxNames or an empty allowedTypes imposes no limit.
+func checkIdentifiers(maxNames int, allowedTypes []identifier.IdentifierType, types []identifier.IdentifierType) error {
+	if maxNames > 0 && len(types) > maxNames {
+		return fmt.Errorf("%w: profile allows at most %d identifiers, but %d were requested", ErrTooManyIdentifiers, maxNames, len(types))
+	}
+	if len(allowedTypes) == 0 {
+		return nil
+	}
+	for _, t := range types {
+		if !slices.Contains(allowedTypes, t) {
+			return fmt.Errorf("profile does not allow identifiers of type %q", t)
+		}
+	}
+	return nil
+}
+
+// checkKey returns an error if allowed is non-nil and does not include the
+// type and size of the given key. ML-DSA keys are only allowed if they are
+// explicitly included in allowed.
+func checkKey(allowed *goodkey.AllowedKeys, key crypto.PublicKey) error {
+	if allowed == nil && !goodkey.IsMLDSA(key) {
+		return nil
+	}
+	if allowed != nil && allowed.Allows(key) {
+		return nil
+	}
+	return fmt.Errorf("profile does not allow %s", goodkey.Describe(key))
+}
+
 // Profile is the validated structure created by reading in ProfileConfigs and IssuerConfigs
 type Profile struct {
 	allowMustStaple     bool
@@ -88,6 +178,11 @@ type Profile struct {
 	maxBackdate time.Duration
 	maxValidity time.Duration
 
+	allowedKeys     *goodkey.AllowedKeys
+	maxNames        int
+	identifierTypes []identifier.IdentifierType
+	policies        []asn1.ObjectIdentifier
+
 	lints lint.Registry
 }
 
@@ -105,6 +200,20 @@ func NewProfile(profileConfig *ProfileConfig) (*Profile, error) {
 		return nil, fmt.Errorf("validity period %q is too large", profileConfig.MaxValidityPeriod.Duration)
 	}
 
+	if profileConfig.MaxNames < 0 {
+		return nil, fmt.Errorf("max names %d must not be negative", profileConfig.MaxNames)
+	}
+	for _, t := range profileConfig.IdentifierTypes {
+		if t != identifier.TypeDNS && t != identifier.TypeIP {
+			return nil, fmt.Errorf("unsupported identifier type %q", t)
+		}
+	}
+	for _, t := range profileConfig.ChallengeTypes {
+		if !t.IsValid() {
+			return nil, fmt.Errorf("unsupported challenge type %q", t)
+		}
+	}
+
 	// TODO(#7756): These lint names don't yet exist in our current zlint v3.6.0 but exist in v3.6.2.
 	// In order to upgrade without throwing errors, we need to add these to our ignored lints.
 	// However, v3.6.0 will error if it sees ignored lints it doesn't recognize. Solution: filter
@@ -124,6 +233,20 @@ func NewProfile(profileConfig *ProfileConfig) (*Profile, error) {
 		lints.SetConfiguration(lintconfig)
 	}
 
+	// The domain-validated policy OID is always included, so that it is
+	// never duplicated, regardless of whether it is configured.
+	var policies []asn1.ObjectIdentifier
+	for _, policy := range profileConfig.Policies {
+		oid, err := parseOID(policy.OID)
+		if err != nil {
+			return nil, fmt.Errorf("parsing policy OID %q: %w", policy.OID, err)
+		}
+		if oid.Equal(domainValidatedOID) || slices.ContainsFunc(policies, oid.Equal) {
+			continue
+		}
+		policies = append(policies, oid)
+	}
+
 	sp := &Profile{
 		allowMustStaple:     profileConfig.AllowMustStaple,
 		omitCommonName:      profileConfig.OmitCommonName,
@@ -132,6 +255,10 @@ func NewProfile(profileConfig *ProfileConfig) (*Profile, error) {
 		omitSKID:            profileConfig.OmitSKID,
 		maxBackdate:         profileConfig.MaxValidityBackdate.Duration,
 		maxValidity:         profileConfig.MaxValidityPeriod.Duration,
+		allowedKeys:         profileConfig.AllowedKeys,
+		maxNames:            profileConfig.MaxNames,
+		identifierTypes:     profileConfig.IdentifierTypes,
+		policies:            policies,
 		lints:               lints,
 	}
 
@@ -155,9 +282,11 @@ func (p *Profile) GenerateValidity(now time.Time) (time.Time, time.Time) {
 // request doesn't match the signing profile an error is returned.
 func (i *Issuer) requestValid(clk clock.Clock, prof *Profile, req *IssuanceRequest) error {
 	switch req.PublicKey.PublicKey.(type) {
-	case *rsa.PublicKey, *ecdsa.PublicKey:
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
 	default:
-		return errors.New("unsupported public key type")
+		if !goodkey.IsMLDSA(req.PublicKey.PublicKey) {
+			return errors.New("unsupported public key type")
+		}
 	}
 
 	if len(req.precertDER) == 0 && !i.active {
@@ -168,6 +297,23 @@ func (i *Issuer) requestValid(clk clock.Clock, prof *Profile, req *IssuanceReque
 		return errors.New("unexpected subject key ID length")
 	}
 
+	err := checkKey(prof.allowedKeys, req.PublicKey.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	types := make([]identifier.IdentifierType, 0, len(req.DNSNames)+len(req.IPAddresses))
+	for range req.DNSNames {
+		types = append(types, identifier.TypeDNS)
+	}
+	for range req.IPAddresses {
+		types = append(types, identifier.TypeIP)
+	}
+	err = checkIdentifiers(prof.maxNames, prof.identifierTypes, types)
+	if err != nil {
+		return err
+	}
+
 	if !prof.allowMustStaple && req.IncludeMustStaple {
 		return errors.New("must-staple extension cannot be included")
 	}
@@ -203,22 +349,49 @@ func (i *Issuer) requestValid(clk clock.Clock, prof *Profile, req *IssuanceReque
 	return nil
 }
 
+// domainValidatedOID is the CA/Browser Forum domain-validated certificate
+// policy OID. Baseline Requirements, Section 7.1.6.1.
+var domainValidatedOID = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1}
+
+// parseOID parses a dotted-decimal object identifier, such as "1.2.3".
+func parseOID(s string) (asn1.ObjectIdentifier, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 {
+		return nil, errors.New("must have at least two components")
+	}
+	oid := make(asn1.ObjectIdentifier, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid component %q", part)
+		}
+		oid[i] = n
+	}
+	return oid, nil
+}
+
 func (i *Issuer) generateTemplate() *x509.Certificate {
 	template := &x509.Certificate{
 		SignatureAlgorithm:    i.sigAlg,
 		OCSPServer:            []string{i.ocspURL},
 		IssuingCertificateURL: []string{i.issuerURL},
 		BasicConstraintsValid: true,
-		// Baseline Requirements, Section 7.1.6.1: domain-validated
-		PolicyIdentifiers: []asn1.ObjectIdentifier{{2, 23, 140, 1, 2, 1}},
+		PolicyIdentifiers:     []asn1.ObjectIdentifier{domainValidatedOID},
 	}
 
-	// TODO(#7294): Use i.crlURLBase and a shard calculation to create a
-	// crlDistributionPoint.
-
 	return template
 }
 
+// crlShardForSerial deterministically maps a serial number onto one of
+// numShards CRL shards, numbered 1 through numShards, using the SHA-256 hash
+// of the serial's bytes. Shard 0 is never returned, as the SA and crl-updater
+// use it to mean "not sharded by serial".
+func crlShardForSerial(serial *big.Int, numShards int) int64 {
+	digest := sha256.Sum256(serial.Bytes())
+	idx := new(big.Int).Mod(new(big.Int).SetBytes(digest[:]), big.NewInt(int64(numShards)))
+	return idx.Int64() + 1
+}
+
 var ctPoisonExt = pkix.Extension{
 	// OID for CT poison, RFC 6962 (was never assigned a proper id-pe- name)
 	Id:       asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3},
@@ -296,8 +469,9 @@ type IssuanceRequest struct {
 	NotBefore time.Time
 	NotAfter  time.Time
 
-	CommonName string
-	DNSNames   []string
+	CommonName  string
+	DNSNames    []string
+	IPAddresses []net.IP
 
 	IncludeMustStaple bool
 	IncludeCTPoison   bool
@@ -340,6 +514,7 @@ func (i *Issuer) Prepare(prof *Profile, req *IssuanceRequest) ([]byte, *issuance
 
 	// generate template from the issuer's data
 	template := i.generateTemplate()
+	template.PolicyIdentifiers = append(template.PolicyIdentifiers, prof.policies...)
 
 	ekus := []x509.ExtKeyUsage{
 		x509.ExtKeyUsageServerAuth,
@@ -355,10 +530,17 @@ func (i *Issuer) Prepare(prof *Profile, req *IssuanceRequest) ([]byte, *issuance
 	// populate template from the issuance request
 	template.NotBefore, template.NotAfter = req.NotBefore, req.NotAfter
 	template.SerialNumber = big.NewInt(0).SetBytes(req.Serial)
+	if i.crlShards > 0 {
+		// Because the shard is derived solely from the serial, the precert and
+		// final cert will always contain the same CRL Distribution Point.
+		shard := crlShardForSerial(template.SerialNumber, i.crlShards)
+		template.CRLDistributionPoints = []string{fmt.Sprintf("%s%d.crl", i.crlURLBase, shard)}
+	}
 	if req.CommonName != "" && !prof.omitCommonName {
 		template.Subject.CommonName = req.CommonName
 	}
 	template.DNSNames = req.DNSNames
+	template.IPAddresses = req.IPAddresses
 
 	switch req.PublicKey.PublicKey.(type) {
 	case *rsa.PublicKey:
@@ -367,8 +549,13 @@ func (i *Issuer) Prepare(prof *Profile, req *IssuanceRequest) ([]byte, *issuance
 		} else {
 			template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
 		}
-	case *ecdsa.PublicKey:
+	case *ecdsa.PublicKey, ed25519.PublicKey:
 		template.KeyUsage = x509.KeyUsageDigitalSignature
+	default:
+		// ML-DSA keys, like Ed25519 keys, can only be used for signatures.
+		if goodkey.IsMLDSA(req.PublicKey.PublicKey) {
+			template.KeyUsage = x509.KeyUsageDigitalSignature
+		}
 	}
 
 	if !prof.omitSKID {
@@ -473,6 +660,7 @@ func RequestFromPrecert(precert *x509.Certificate, scts []ct.SignedCertificateTi
 		NotAfter:          precert.NotAfter,
 		CommonName:        precert.Subject.CommonName,
 		DNSNames:          precert.DNSNames,
+		IPAddresses:       precert.IPAddresses,
 		IncludeMustStaple: ContainsMustStaple(precert.Extensions),
 		sctList:           scts,
 		precertDER:        precert.Raw,
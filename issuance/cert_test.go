@@ -4,6 +4,7 @@ import (
 	"crypto"
 	"crypto/dsa"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -11,13 +12,20 @@ import (
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
 	"testing"
 	"time"
 
 	ct "github.com/google/certificate-transparency-go"
 	"github.com/jmhodges/clock"
 
+	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/linter"
 	"github.com/letsencrypt/boulder/test"
 )
@@ -382,6 +390,49 @@ func TestIssue(t *testing.T) {
 	}
 }
 
+// privatePKILints are the Baseline Requirements lints which must be ignored by
+// a profile which allows Ed25519 or ML-DSA keys.
+var privatePKILints = []string{
+	"e_algorithm_identifier_improper_encoding",
+	"e_public_key_type_not_allowed",
+}
+
+func TestIssueEd25519(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+
+	pc := defaultProfileConfig()
+	pc.IgnoredLints = append(pc.IgnoredLints, privatePKILints...)
+	profile, err := NewProfile(pc)
+	test.AssertNotError(t, err, "NewProfile failed")
+	signer, err := newIssuer(defaultIssuerConfig(), issuerCert, issuerSigner, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	ir := &IssuanceRequest{
+		PublicKey:       MarshalablePublicKey{pub},
+		SubjectKeyId:    goodSKID,
+		Serial:          []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		DNSNames:        []string{"example.com"},
+		NotBefore:       fc.Now(),
+		NotAfter:        fc.Now().Add(time.Hour - time.Second),
+		IncludeCTPoison: true,
+	}
+
+	// The Baseline Requirements lints reject Ed25519 keys.
+	_, _, err = signer.Prepare(defaultProfile(), ir)
+	test.AssertError(t, err, "Prepare should fail BR lints for Ed25519 key")
+
+	_, issuanceToken, err := signer.Prepare(profile, ir)
+	test.AssertNotError(t, err, "Prepare failed")
+	certBytes, err := signer.Issue(issuanceToken)
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.PublicKey, pub)
+	test.AssertEquals(t, cert.KeyUsage, x509.KeyUsageDigitalSignature)
+}
+
 func TestIssueCommonName(t *testing.T) {
 	fc := clock.NewFake()
 	fc.Set(time.Now())
@@ -391,6 +442,7 @@ func TestIssueCommonName(t *testing.T) {
 		"w_subject_common_name_included",
 		"w_ct_sct_policy_count_unsatisfied",
 		"e_scts_from_same_operator",
+		"e_scts_insufficient_for_lifetime",
 	}
 	cnProfile, err := NewProfile(prof)
 	test.AssertNotError(t, err, "NewProfile failed")
@@ -440,6 +492,176 @@ func TestIssueCommonName(t *testing.T) {
 	test.AssertEquals(t, cert.Subject.CommonName, "")
 }
 
+func TestIssueIPAddresses(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+
+	prof := defaultProfileConfig()
+	prof.IgnoredLints = []string{
+		"w_ct_sct_policy_count_unsatisfied",
+		"e_scts_from_same_operator",
+		"e_scts_insufficient_for_lifetime",
+	}
+	profile, err := NewProfile(prof)
+	test.AssertNotError(t, err, "NewProfile failed")
+	signer, err := newIssuer(defaultIssuerConfig(), issuerCert, issuerSigner, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	ir := &IssuanceRequest{
+		PublicKey:       MarshalablePublicKey{pk.Public()},
+		SubjectKeyId:    goodSKID,
+		Serial:          []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		DNSNames:        []string{"example.com"},
+		IPAddresses:     []net.IP{net.ParseIP("64.112.117.122"), net.ParseIP("2602:80a:6000::1")},
+		NotBefore:       fc.Now(),
+		NotAfter:        fc.Now().Add(time.Hour - time.Second),
+		IncludeCTPoison: true,
+	}
+
+	_, issuanceToken, err := signer.Prepare(profile, ir)
+	test.AssertNotError(t, err, "Prepare failed")
+	certBytes, err := signer.Issue(issuanceToken)
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.DNSNames, []string{"example.com"})
+	test.AssertEquals(t, len(cert.IPAddresses), 2)
+	test.Assert(t, cert.IPAddresses[0].Equal(net.ParseIP("64.112.117.122")), "wrong first IP address")
+	test.Assert(t, cert.IPAddresses[1].Equal(net.ParseIP("2602:80a:6000::1")), "wrong second IP address")
+
+	// A final certificate request built from the precertificate must carry
+	// the same IP addresses.
+	finalReq, err := RequestFromPrecert(cert, nil)
+	test.AssertNotError(t, err, "RequestFromPrecert failed")
+	test.AssertDeepEquals(t, finalReq.IPAddresses, cert.IPAddresses)
+}
+
+func TestNewProfileRestrictions(t *testing.T) {
+	t.Parallel()
+
+	pc := defaultProfileConfig()
+	pc.MaxNames = -1
+	_, err := NewProfile(pc)
+	test.AssertError(t, err, "negative MaxNames should fail")
+
+	pc = defaultProfileConfig()
+	pc.IdentifierTypes = []identifier.IdentifierType{"email"}
+	_, err = NewProfile(pc)
+	test.AssertError(t, err, "unsupported identifier type should fail")
+
+	pc = defaultProfileConfig()
+	pc.ChallengeTypes = []core.AcmeChallenge{"dns-02"}
+	_, err = NewProfile(pc)
+	test.AssertError(t, err, "unsupported challenge type should fail")
+
+	pc = defaultProfileConfig()
+	pc.Policies = []PolicyConfig{{OID: "1.3.6.not.an.oid"}}
+	_, err = NewProfile(pc)
+	test.AssertError(t, err, "malformed policy OID should fail")
+
+	pc = defaultProfileConfig()
+	pc.Policies = []PolicyConfig{{OID: "2.23.140.1.2.1"}, {OID: "1.3.6.1.4.1.44947.1.1.1"}, {OID: "1.3.6.1.4.1.44947.1.1.1"}}
+	prof, err := NewProfile(pc)
+	test.AssertNotError(t, err, "NewProfile failed")
+	test.AssertDeepEquals(t, prof.policies, []asn1.ObjectIdentifier{{1, 3, 6, 1, 4, 1, 44947, 1, 1, 1}})
+}
+
+func TestProfileConfigChecks(t *testing.T) {
+	t.Parallel()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	idents := identifier.ACMEIdentifiers{identifier.NewDNS("example.com"), identifier.NewIP(netip.MustParseAddr("64.112.117.122"))}
+
+	// An unrestricted profile allows everything.
+	pc := defaultProfileConfig()
+	test.AssertNotError(t, pc.CheckIdentifiers(idents), "unrestricted profile rejected identifiers")
+	test.AssertNotError(t, pc.CheckKey(pk.Public()), "unrestricted profile rejected key")
+	test.Assert(t, pc.AllowsChallengeType(core.ChallengeTypeHTTP01), "unrestricted profile rejected http-01")
+
+	pc.MaxNames = 1
+	err = pc.CheckIdentifiers(idents)
+	test.AssertError(t, err, "too many identifiers should be rejected")
+	test.AssertErrorIs(t, err, ErrTooManyIdentifiers)
+	test.AssertContains(t, err.Error(), "at most 1 identifiers")
+
+	pc.MaxNames = 0
+	pc.IdentifierTypes = []identifier.IdentifierType{identifier.TypeDNS}
+	err = pc.CheckIdentifiers(idents)
+	test.AssertError(t, err, "IP identifier should be rejected")
+	test.AssertContains(t, err.Error(), `type "ip"`)
+	test.AssertNotError(t, pc.CheckIdentifiers(idents[:1]), "DNS identifier should be allowed")
+
+	pc.AllowedKeys = &goodkey.AllowedKeys{RSA2048: true}
+	err = pc.CheckKey(pk.Public())
+	test.AssertError(t, err, "P-256 key should be rejected")
+	test.AssertEquals(t, err.Error(), "profile does not allow ECDSA P-256")
+
+	pc.ChallengeTypes = []core.AcmeChallenge{core.ChallengeTypeDNS01}
+	test.Assert(t, pc.AllowsChallengeType(core.ChallengeTypeDNS01), "dns-01 should be allowed")
+	test.Assert(t, !pc.AllowsChallengeType(core.ChallengeTypeHTTP01), "http-01 should not be allowed")
+}
+
+func TestIssueRestrictedProfile(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+
+	pc := defaultProfileConfig()
+	pc.AllowedKeys = &goodkey.AllowedKeys{ECDSAP256: true}
+	pc.MaxNames = 2
+	pc.IdentifierTypes = []identifier.IdentifierType{identifier.TypeDNS}
+	pc.Policies = []PolicyConfig{{OID: "1.3.6.1.4.1.44947.1.1.1"}}
+	profile, err := NewProfile(pc)
+	test.AssertNotError(t, err, "NewProfile failed")
+	signer, err := newIssuer(defaultIssuerConfig(), issuerCert, issuerSigner, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	ir := &IssuanceRequest{
+		PublicKey:       MarshalablePublicKey{pk.Public()},
+		SubjectKeyId:    goodSKID,
+		Serial:          []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		DNSNames:        []string{"example.com"},
+		NotBefore:       fc.Now(),
+		NotAfter:        fc.Now().Add(time.Hour - time.Second),
+		IncludeCTPoison: true,
+	}
+
+	// A key which the profile does not allow is rejected.
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	badKey := *ir
+	badKey.PublicKey = MarshalablePublicKey{p384.Public()}
+	_, _, err = signer.Prepare(profile, &badKey)
+	test.AssertError(t, err, "Prepare should reject a disallowed key")
+	test.AssertContains(t, err.Error(), "ECDSA P-384")
+
+	// Too many names, or an identifier type which the profile does not allow,
+	// is rejected.
+	tooMany := *ir
+	tooMany.DNSNames = []string{"a.example.com", "b.example.com", "c.example.com"}
+	_, _, err = signer.Prepare(profile, &tooMany)
+	test.AssertError(t, err, "Prepare should reject too many names")
+	badType := *ir
+	badType.IPAddresses = []net.IP{net.ParseIP("64.112.117.122")}
+	_, _, err = signer.Prepare(profile, &badType)
+	test.AssertError(t, err, "Prepare should reject a disallowed identifier type")
+
+	// An allowed request carries the configured policy OID in addition to
+	// the domain-validated policy OID.
+	_, issuanceToken, err := signer.Prepare(profile, ir)
+	test.AssertNotError(t, err, "Prepare failed")
+	certBytes, err := signer.Issue(issuanceToken)
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.PolicyIdentifiers, []asn1.ObjectIdentifier{
+		{2, 23, 140, 1, 2, 1},
+		{1, 3, 6, 1, 4, 1, 44947, 1, 1, 1},
+	})
+}
+
 func TestIssueOmissions(t *testing.T) {
 	fc := clock.NewFake()
 	fc.Set(time.Now())
@@ -453,6 +675,7 @@ func TestIssueOmissions(t *testing.T) {
 		"w_ext_subject_key_identifier_missing_sub_cert",
 		"w_ct_sct_policy_count_unsatisfied",
 		"e_scts_from_same_operator",
+		"e_scts_insufficient_for_lifetime",
 	}
 	prof, err := NewProfile(pc)
 	test.AssertNotError(t, err, "building test profile")
@@ -623,6 +846,65 @@ func TestIssueMustStaple(t *testing.T) {
 	test.AssertDeepEquals(t, cert.Extensions[9], mustStapleExt)
 }
 
+func TestIssueCRLDistributionPoint(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+
+	config := defaultIssuerConfig()
+	config.CRLShards = 10
+	signer, err := newIssuer(config, issuerCert, issuerSigner, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+
+	serial := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	_, issuanceToken, err := signer.Prepare(defaultProfile(), &IssuanceRequest{
+		PublicKey:       MarshalablePublicKey{pk.Public()},
+		SubjectKeyId:    goodSKID,
+		Serial:          serial,
+		DNSNames:        []string{"example.com"},
+		NotBefore:       fc.Now(),
+		NotAfter:        fc.Now().Add(time.Hour - time.Second),
+		IncludeCTPoison: true,
+	})
+	test.AssertNotError(t, err, "Prepare failed")
+	precertBytes, err := signer.Issue(issuanceToken)
+	test.AssertNotError(t, err, "Issue failed")
+	precert, err := x509.ParseCertificate(precertBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+
+	shard := crlShardForSerial(big.NewInt(0).SetBytes(serial), 10)
+	expected := []string{fmt.Sprintf("http://crl-url.example.org/%d.crl", shard)}
+	test.AssertDeepEquals(t, precert.CRLDistributionPoints, expected)
+
+	// The final certificate must contain the same CRLDP as the precertificate.
+	finalReq, err := RequestFromPrecert(precert, []ct.SignedCertificateTimestamp{
+		{
+			SCTVersion: ct.V1,
+			LogID:      ct.LogID{KeyID: *(*[32]byte)(mustDecodeB64("OJiMlNA1mMOTLd/pI7q68npCDrlsQeFaqAwasPwEvQM="))},
+		},
+	})
+	test.AssertNotError(t, err, "generating request from precert")
+	_, issuanceToken, err = signer.Prepare(defaultProfile(), finalReq)
+	test.AssertNotError(t, err, "Prepare failed")
+	certBytes, err := signer.Issue(issuanceToken)
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.CRLDistributionPoints, expected)
+}
+
+func TestCRLShardForSerial(t *testing.T) {
+	seen := make(map[int64]bool)
+	for i := range 1000 {
+		shard := crlShardForSerial(big.NewInt(int64(i)), 8)
+		test.Assert(t, shard >= 1 && shard <= 8, fmt.Sprintf("shard %d out of range", shard))
+		test.AssertEquals(t, crlShardForSerial(big.NewInt(int64(i)), 8), shard)
+		seen[shard] = true
+	}
+	test.AssertEquals(t, len(seen), 8)
+}
+
 func TestIssueBadLint(t *testing.T) {
 	fc := clock.NewFake()
 	fc.Set(time.Now())
@@ -758,6 +1040,7 @@ func TestMismatchedProfiles(t *testing.T) {
 		"w_subject_common_name_included",
 		"w_ct_sct_policy_count_unsatisfied",
 		"e_scts_from_same_operator",
+		"e_scts_insufficient_for_lifetime",
 	}
 	cnProfile, err := NewProfile(pc)
 	test.AssertNotError(t, err, "NewProfile failed")
@@ -785,6 +1068,7 @@ func TestMismatchedProfiles(t *testing.T) {
 	pc.IgnoredLints = []string{
 		"w_ct_sct_policy_count_unsatisfied",
 		"e_scts_from_same_operator",
+		"e_scts_insufficient_for_lifetime",
 	}
 	test.AssertNotError(t, err, "building test lint registry")
 	noCNProfile, err := NewProfile(pc)
@@ -10,6 +10,7 @@ import (
 	"github.com/zmap/zlint/v3/lint"
 
 	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	"github.com/letsencrypt/boulder/linter"
 )
@@ -17,11 +18,18 @@ import (
 type CRLProfileConfig struct {
 	ValidityInterval config.Duration
 	MaxBackdate      config.Duration
+
+	// DeltaCRLs enables the issuance of delta CRLs, as defined in RFC 5280
+	// Section 5.2.4. When set, full CRLs carry a Freshest CRL extension pointing
+	// at the delta CRL for the same shard, and requests with a BaseNumber are
+	// issued as delta CRLs.
+	DeltaCRLs bool
 }
 
 type CRLProfile struct {
 	validityInterval time.Duration
 	maxBackdate      time.Duration
+	deltaCRLs        bool
 
 	lints lint.Registry
 }
@@ -38,7 +46,14 @@ func NewCRLProfile(config CRLProfileConfig) (*CRLProfile, error) {
 		return nil, fmt.Errorf("crl max backdate must be non-negative, got %q", config.MaxBackdate)
 	}
 
-	reg, err := linter.NewRegistry(nil)
+	var skipLints []string
+	if config.DeltaCRLs {
+		// This lint ensures we never issue anything which could be construed as
+		// a delta CRL, which is exactly what this profile is for.
+		skipLints = append(skipLints, "e_crl_is_not_delta")
+	}
+
+	reg, err := linter.NewRegistry(skipLints)
 	if err != nil {
 		return nil, fmt.Errorf("creating lint registry: %w", err)
 	}
@@ -46,6 +61,7 @@ func NewCRLProfile(config CRLProfileConfig) (*CRLProfile, error) {
 	return &CRLProfile{
 		validityInterval: config.ValidityInterval.Duration,
 		maxBackdate:      config.MaxBackdate.Duration,
+		deltaCRLs:        config.DeltaCRLs,
 		lints:            reg,
 	}, nil
 }
@@ -54,6 +70,11 @@ type CRLRequest struct {
 	Number *big.Int
 	Shard  int64
 
+	// BaseNumber is the CRL Number of the full CRL on which this CRL is based.
+	// If set, a delta CRL is issued, containing only the entries revoked since
+	// that full CRL.
+	BaseNumber *big.Int
+
 	ThisUpdate time.Time
 
 	Entries []x509.RevocationListEntry
@@ -89,6 +110,30 @@ func (i *Issuer) IssueCRL(prof *CRLProfile, req *CRLRequest) ([]byte, error) {
 	}
 	template.ExtraExtensions = append(template.ExtraExtensions, idp)
 
+	if req.BaseNumber != nil {
+		if !prof.deltaCRLs {
+			return nil, fmt.Errorf("CRL profile does not allow delta CRLs")
+		}
+		if req.BaseNumber.Cmp(req.Number) >= 0 {
+			return nil, fmt.Errorf("delta CRL number %d must be greater than base CRL number %d", req.Number, req.BaseNumber)
+		}
+		indicator, err := delta.MakeIndicatorExt(req.BaseNumber)
+		if err != nil {
+			return nil, fmt.Errorf("creating delta CRL indicator extension: %w", err)
+		}
+		template.ExtraExtensions = append(template.ExtraExtensions, indicator)
+	} else if prof.deltaCRLs {
+		// Per RFC 5280 Section 5.2.6, the Freshest CRL extension only appears
+		// in full CRLs, and points at their delta.
+		freshest, err := delta.MakeFreshestCRLExt([]string{
+			fmt.Sprintf("%s%d-delta.crl", i.crlURLBase, req.Shard),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating freshest CRL extension: %w", err)
+		}
+		template.ExtraExtensions = append(template.ExtraExtensions, freshest)
+	}
+
 	err = i.Linter.CheckCRL(template, prof.lints)
 	if err != nil {
 		return nil, err
@@ -13,6 +13,7 @@ import (
 	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
 
 	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/crl/delta"
 	"github.com/letsencrypt/boulder/crl/idp"
 	"github.com/letsencrypt/boulder/test"
 )
@@ -172,6 +173,93 @@ func TestIssueCRL(t *testing.T) {
 	test.Assert(t, !found, "Violation of RFC 5280 Section 5.1.2.6")
 }
 
+func TestIssueDeltaCRL(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+
+	issuer, err := newIssuer(defaultIssuerConfig(), issuerCert, issuerSigner, clk)
+	test.AssertNotError(t, err, "creating test issuer")
+
+	deltaProfile, err := NewCRLProfile(CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+		DeltaCRLs:        true,
+	})
+	test.AssertNotError(t, err, "creating delta CRL profile")
+
+	fullProfile, err := NewCRLProfile(CRLProfileConfig{
+		ValidityInterval: config.Duration{Duration: 7 * 24 * time.Hour},
+		MaxBackdate:      config.Duration{Duration: time.Hour},
+	})
+	test.AssertNotError(t, err, "creating full CRL profile")
+
+	defaultRequest := CRLRequest{
+		Number:     big.NewInt(456),
+		Shard:      100,
+		BaseNumber: big.NewInt(123),
+		ThisUpdate: clk.Now().Add(-time.Second),
+		Entries: []x509.RevocationListEntry{
+			{
+				SerialNumber:   big.NewInt(987),
+				RevocationTime: clk.Now().Add(-time.Hour),
+				ReasonCode:     1,
+			},
+		},
+	}
+
+	// A delta CRL carries the base CRL number, and the same IDP as its base.
+	req := defaultRequest
+	res, err := issuer.IssueCRL(deltaProfile, &req)
+	test.AssertNotError(t, err, "delta crl issuance should have succeeded")
+	parsedRes, err := x509.ParseRevocationList(res)
+	test.AssertNotError(t, err, "parsing test delta crl")
+	baseNumber, err := delta.GetBaseNumber(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting base number from test delta crl")
+	test.AssertDeepEquals(t, baseNumber, big.NewInt(123))
+	idps, err := idp.GetIDPURIs(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting IDP URIs from test delta crl")
+	test.AssertDeepEquals(t, idps, []string{"http://crl-url.example.org/100.crl"})
+	freshest, err := delta.GetFreshestCRLURIs(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting freshest CRL URIs from test delta crl")
+	test.AssertEquals(t, len(freshest), 0)
+
+	// A full CRL issued under a delta-enabled profile points at its delta.
+	req = defaultRequest
+	req.BaseNumber = nil
+	res, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertNotError(t, err, "full crl issuance should have succeeded")
+	parsedRes, err = x509.ParseRevocationList(res)
+	test.AssertNotError(t, err, "parsing test full crl")
+	baseNumber, err = delta.GetBaseNumber(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting base number from test full crl")
+	test.Assert(t, baseNumber == nil, "full crl should not have a delta CRL indicator")
+	freshest, err = delta.GetFreshestCRLURIs(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting freshest CRL URIs from test full crl")
+	test.AssertDeepEquals(t, freshest, []string{"http://crl-url.example.org/100-delta.crl"})
+
+	// A full CRL issued under a profile without deltas has no freshest CRL.
+	res, err = issuer.IssueCRL(fullProfile, &req)
+	test.AssertNotError(t, err, "full crl issuance should have succeeded")
+	parsedRes, err = x509.ParseRevocationList(res)
+	test.AssertNotError(t, err, "parsing test full crl")
+	freshest, err = delta.GetFreshestCRLURIs(parsedRes.Extensions)
+	test.AssertNotError(t, err, "getting freshest CRL URIs from test full crl")
+	test.AssertEquals(t, len(freshest), 0)
+
+	// Delta CRLs can't be issued under a profile without deltas.
+	req = defaultRequest
+	_, err = issuer.IssueCRL(fullProfile, &req)
+	test.AssertError(t, err, "delta crl issuance without delta profile should fail")
+	test.AssertContains(t, err.Error(), "does not allow delta CRLs")
+
+	// The delta CRL must be newer than its base.
+	req = defaultRequest
+	req.BaseNumber = big.NewInt(456)
+	_, err = issuer.IssueCRL(deltaProfile, &req)
+	test.AssertError(t, err, "delta crl issuance with stale number should fail")
+	test.AssertContains(t, err.Error(), "must be greater than base CRL number")
+}
+
 // revokedCertificatesFieldExists is a modified version of
 // x509.ParseRevocationList that takes a given sequence of bytes representing a
 // CRL and parses away layers until the optional `revokedCertificates` field of
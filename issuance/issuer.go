@@ -164,6 +164,14 @@ type IssuerConfig struct {
 	OCSPURL    string `validate:"required,url"`
 	CRLURLBase string `validate:"omitempty,url,startswith=http://,endswith=/"`
 
+	// CRLShards, if non-zero, causes each issued certificate to be assigned to
+	// one of this many CRL shards based on a hash of its serial number. The
+	// shard is embedded in the certificate as a CRL Distribution Point URL of
+	// the form CRLURLBase + "<shard>.crl". It must match the number of shards
+	// configured in the crl-updater. If zero, certificates do not contain a
+	// CRL Distribution Point and are sharded by expiration time instead.
+	CRLShards int `validate:"min=0"`
+
 	Location IssuerLoc
 }
 
@@ -204,8 +212,11 @@ type Issuer struct {
 	// certificates.
 	ocspURL string
 	// Used to set the Issuing Distribution Point extension in issued CRLs
-	// *and* (eventually) the CRL Distribution Point extension in issued certs.
+	// *and* the CRL Distribution Point extension in issued certs.
 	crlURLBase string
+	// The number of CRL shards to which certificates are assigned by serial
+	// hash. If zero, no CRL Distribution Point is included in certificates.
+	crlShards int
 
 	clk clock.Clock
 }
@@ -248,6 +259,9 @@ func newIssuer(config IssuerConfig, cert *Certificate, signer crypto.Signer, clk
 	if !strings.HasSuffix(config.CRLURLBase, "/") {
 		return nil, fmt.Errorf("crlURLBase must end with exactly one forward slash, got %q", config.CRLURLBase)
 	}
+	if config.CRLShards < 0 {
+		return nil, fmt.Errorf("crlShards must be non-negative, got %d", config.CRLShards)
+	}
 
 	// We require that all of our issuers be capable of both issuing certs and
 	// providing revocation information.
@@ -276,6 +290,7 @@ func newIssuer(config IssuerConfig, cert *Certificate, signer crypto.Signer, clk
 		issuerURL:  config.IssuerURL,
 		ocspURL:    config.OCSPURL,
 		crlURLBase: config.CRLURLBase,
+		crlShards:  config.CRLShards,
 		clk:        clk,
 	}
 	return i, nil
@@ -30,6 +30,7 @@ func defaultProfileConfig() *ProfileConfig {
 		IgnoredLints: []string{
 			"w_ct_sct_policy_count_unsatisfied",
 			"e_scts_from_same_operator",
+			"e_scts_insufficient_for_lifetime",
 		},
 	}
 }
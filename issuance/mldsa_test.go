@@ -0,0 +1,59 @@
+//go:build go1.27
+
+package issuance
+
+import (
+	"crypto/mldsa"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/goodkey"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestIssueMLDSA(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+
+	signer, err := newIssuer(defaultIssuerConfig(), issuerCert, issuerSigner, fc)
+	test.AssertNotError(t, err, "NewIssuer failed")
+	pk, err := mldsa.GenerateKey(mldsa.MLDSA65())
+	test.AssertNotError(t, err, "failed to generate test key")
+	ir := &IssuanceRequest{
+		PublicKey:       MarshalablePublicKey{pk.Public()},
+		SubjectKeyId:    goodSKID,
+		Serial:          []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		DNSNames:        []string{"example.com"},
+		NotBefore:       fc.Now(),
+		NotAfter:        fc.Now().Add(time.Hour - time.Second),
+		IncludeCTPoison: true,
+	}
+
+	// ML-DSA keys are only allowed by profiles which explicitly allow them.
+	_, _, err = signer.Prepare(defaultProfile(), ir)
+	test.AssertError(t, err, "Prepare should reject ML-DSA key for a profile without AllowedKeys")
+	test.AssertContains(t, err.Error(), "profile does not allow ML-DSA-65")
+
+	pc := defaultProfileConfig()
+	pc.IgnoredLints = append(pc.IgnoredLints, privatePKILints...)
+	pc.AllowedKeys = &goodkey.AllowedKeys{MLDSA44: true}
+	profile, err := NewProfile(pc)
+	test.AssertNotError(t, err, "NewProfile failed")
+	_, _, err = signer.Prepare(profile, ir)
+	test.AssertError(t, err, "Prepare should reject ML-DSA-65 key for a profile allowing only ML-DSA-44")
+
+	pc.AllowedKeys = &goodkey.AllowedKeys{MLDSA65: true}
+	profile, err = NewProfile(pc)
+	test.AssertNotError(t, err, "NewProfile failed")
+	_, issuanceToken, err := signer.Prepare(profile, ir)
+	test.AssertNotError(t, err, "Prepare failed")
+	certBytes, err := signer.Issue(issuanceToken)
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertEquals(t, cert.PublicKeyAlgorithm, x509.MLDSA)
+	test.AssertEquals(t, cert.KeyUsage, x509.KeyUsageDigitalSignature)
+}
@@ -0,0 +1,87 @@
+package chrome
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/ct"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/linter/lints"
+)
+
+type sctsInsufficientForLifetime struct {
+	logList loglist.List
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_scts_insufficient_for_lifetime",
+			Description:   "Let's Encrypt Subscriber Certificates have enough SCTs from distinct known logs, run by at least two operators, for their lifetime",
+			Citation:      "Chrome CT Policy; Apple CT Policy",
+			Source:        lints.ChromeCTPolicy,
+			EffectiveDate: time.Date(2022, time.April, 15, 0, 0, 0, 0, time.UTC),
+		},
+		Lint: NewSCTsInsufficientForLifetime,
+	})
+}
+
+func NewSCTsInsufficientForLifetime() lint.CertificateLintInterface {
+	return &sctsInsufficientForLifetime{logList: loglist.GetLintList()}
+}
+
+func (l *sctsInsufficientForLifetime) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) && !util.IsExtInCert(c, util.CtPoisonOID)
+}
+
+func (l *sctsInsufficientForLifetime) Execute(c *x509.Certificate) *lint.LintResult {
+	if len(l.logList) == 0 {
+		return &lint.LintResult{
+			Status:  lint.NE,
+			Details: "Failed to load log list, unable to check Certificate SCTs.",
+		}
+	}
+
+	// Both policies require two SCTs for certificates valid for up to 180
+	// days, and three for longer-lived certificates. RFC 5280 4.1.2.5: "The
+	// validity period for a certificate is the period of time from notBefore
+	// through notAfter, inclusive."
+	required := 2
+	if c.NotAfter.Add(time.Second).Sub(c.NotBefore) > 180*lints.BRDay {
+		required = 3
+	}
+
+	// Only SCTs from distinct logs in the log list count towards the policy.
+	logIDs := make(map[ct.SHA256Hash]struct{})
+	operatorNames := make(map[string]struct{})
+	for _, sct := range c.SignedCertificateTimestampList {
+		operator, err := l.logList.OperatorForLogID(sct.LogID.Base64String())
+		if err != nil {
+			continue
+		}
+		logIDs[sct.LogID] = struct{}{}
+		operatorNames[operator] = struct{}{}
+	}
+
+	if len(logIDs) < required {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("Certificate has SCTs from %d distinct known logs; browser policy requires %d for its lifetime.", len(logIDs), required),
+		}
+	}
+
+	if len(operatorNames) < 2 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "Certificate has SCTs from too few distinct known log operators; browser policy requires 2.",
+		}
+	}
+
+	return &lint.LintResult{
+		Status: lint.Pass,
+	}
+}
@@ -0,0 +1,128 @@
+package chrome
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/ct"
+	"github.com/zmap/zlint/v3/lint"
+
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+)
+
+func TestSCTsInsufficientForLifetime(t *testing.T) {
+	t.Parallel()
+
+	logA1 := ct.SHA256Hash{0xa1}
+	logA2 := ct.SHA256Hash{0xa2}
+	logB1 := ct.SHA256Hash{0xb1}
+	logC1 := ct.SHA256Hash{0xc1}
+	unknown := ct.SHA256Hash{0xff}
+	logList := loglist.List{
+		"Operator A": {
+			logA1.Base64String(): {Name: "Log A1"},
+			logA2.Base64String(): {Name: "Log A2"},
+		},
+		"Operator B": {
+			logB1.Base64String(): {Name: "Log B1"},
+		},
+		"Operator C": {
+			logC1.Base64String(): {Name: "Log C1"},
+		},
+	}
+
+	notBefore := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	shortLived := notBefore.Add(90*24*time.Hour - time.Second)
+	longLived := notBefore.Add(200*24*time.Hour - time.Second)
+
+	testCases := []struct {
+		name       string
+		notAfter   time.Time
+		logIDs     []ct.SHA256Hash
+		want       lint.LintStatus
+		wantSubStr string
+	}{
+		{
+			name:     "two operators, short lived",
+			notAfter: shortLived,
+			logIDs:   []ct.SHA256Hash{logA1, logB1},
+			want:     lint.Pass,
+		},
+		{
+			name:       "one SCT",
+			notAfter:   shortLived,
+			logIDs:     []ct.SHA256Hash{logA1},
+			want:       lint.Error,
+			wantSubStr: "from 1 distinct known logs; browser policy requires 2",
+		},
+		{
+			name:       "same log twice",
+			notAfter:   shortLived,
+			logIDs:     []ct.SHA256Hash{logA1, logA1},
+			want:       lint.Error,
+			wantSubStr: "from 1 distinct known logs",
+		},
+		{
+			name:       "unknown log",
+			notAfter:   shortLived,
+			logIDs:     []ct.SHA256Hash{logA1, unknown},
+			want:       lint.Error,
+			wantSubStr: "from 1 distinct known logs",
+		},
+		{
+			name:       "one operator",
+			notAfter:   shortLived,
+			logIDs:     []ct.SHA256Hash{logA1, logA2},
+			want:       lint.Error,
+			wantSubStr: "too few distinct known log operators",
+		},
+		{
+			name:       "two SCTs, long lived",
+			notAfter:   longLived,
+			logIDs:     []ct.SHA256Hash{logA1, logB1},
+			want:       lint.Error,
+			wantSubStr: "browser policy requires 3",
+		},
+		{
+			name:     "three SCTs, long lived",
+			notAfter: longLived,
+			logIDs:   []ct.SHA256Hash{logA1, logB1, logC1},
+			want:     lint.Pass,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := &x509.Certificate{
+				NotBefore: notBefore,
+				NotAfter:  tc.notAfter,
+			}
+			for _, id := range tc.logIDs {
+				c.SignedCertificateTimestampList = append(c.SignedCertificateTimestampList, &ct.SignedCertificateTimestamp{LogID: id})
+			}
+
+			l := &sctsInsufficientForLifetime{logList: logList}
+			r := l.Execute(c)
+
+			if r.Status != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, r.Status)
+			}
+			if !strings.Contains(r.Details, tc.wantSubStr) {
+				t.Errorf("expected %q, got %q", tc.wantSubStr, r.Details)
+			}
+		})
+	}
+}
+
+func TestSCTsInsufficientForLifetimeNoLogList(t *testing.T) {
+	t.Parallel()
+
+	l := &sctsInsufficientForLifetime{}
+	r := l.Execute(&x509.Certificate{})
+	if r.Status != lint.NE {
+		t.Errorf("expected %q, got %q", lint.NE, r.Status)
+	}
+}
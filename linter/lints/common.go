@@ -2,12 +2,15 @@ package lints
 
 import (
 	"bytes"
+	"fmt"
 	"net/url"
 	"time"
 
 	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zcrypto/x509/pkix"
 	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
 	"golang.org/x/crypto/cryptobyte"
 	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
 )
@@ -27,6 +30,8 @@ const (
 var (
 	CPSV33Date           = time.Date(2021, time.June, 8, 0, 0, 0, 0, time.UTC)
 	MozillaPolicy281Date = time.Date(2023, time.February, 15, 0, 0, 0, 0, time.UTC)
+	RFC8410Date          = time.Date(2018, time.August, 1, 0, 0, 0, 0, time.UTC)
+	RFC9881Date          = time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC)
 )
 
 // IssuingDistributionPoint stores the IA5STRING value(s) of the optional
@@ -80,6 +85,35 @@ func GetExtWithOID(exts []pkix.Extension, oid asn1.ObjectIdentifier) *pkix.Exten
 	return nil
 }
 
+// signatureKeyUsages are the key usages which can be asserted for a key which
+// can only be used to make signatures.
+const signatureKeyUsages = x509.KeyUsageDigitalSignature | x509.KeyUsageContentCommitment |
+	x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+
+// CheckSignatureOnlyKeyUsage is a helper for lints of certificates whose
+// subject public key can only be used to make signatures, such as Ed25519 and
+// ML-DSA keys. If the certificate has a key usage extension, it must assert at
+// least one of digitalSignature, nonRepudiation, keyCertSign, or cRLSign, and
+// nothing else. The keyType is only used in the details of the result.
+func CheckSignatureOnlyKeyUsage(c *x509.Certificate, keyType string) *lint.LintResult {
+	if !util.IsExtInCert(c, util.KeyUsageOID) {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	if c.KeyUsage&signatureKeyUsages == 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("%s certificates must assert a signature key usage", keyType),
+		}
+	}
+	if c.KeyUsage&^signatureKeyUsages != 0 {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("%s certificates must not assert key usages other than digitalSignature, nonRepudiation, keyCertSign, and cRLSign", keyType),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
 // ReadOptionalASN1BooleanWithTag attempts to read and advance incoming to
 // search for an optional DER-encoded ASN.1 element tagged with the given tag.
 // Unless out is nil, it stores whether an element with the tag was found in
@@ -20,7 +20,9 @@ we never issue a CRL which could be construed as a Delta CRL.
 RFC 5280: 5.2.6
 
 Similarly, Section 5.2.6 defines the Freshest CRL extension, which is only
-applicable in the case that the CRL is a Delta CRL.
+applicable in the case that delta CRLs are issued.
+
+CRL profiles which enable delta CRLs skip this lint.
 ************************************************/
 
 func init() {
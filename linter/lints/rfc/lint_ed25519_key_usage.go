@@ -0,0 +1,43 @@
+package rfc
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+
+	"github.com/letsencrypt/boulder/linter/lints"
+)
+
+type ed25519KeyUsage struct{}
+
+/************************************************
+RFC 8410: 5
+If the keyUsage extension is present in a certificate that indicates id-Ed25519
+or id-Ed448 in SubjectPublicKeyInfo, then the following MUST be present:
+digitalSignature; nonRepudiation; keyCertSign; cRLSign. One or more of the
+values MUST be present, and no other values are allowed.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ed25519_key_usage",
+			Description:   "Certificates with Ed25519 subject keys may only assert signature key usages",
+			Citation:      "RFC 8410: 5",
+			Source:        lint.RFC5280,
+			EffectiveDate: lints.RFC8410Date,
+		},
+		Lint: NewEd25519KeyUsage,
+	})
+}
+
+func NewEd25519KeyUsage() lint.CertificateLintInterface {
+	return &ed25519KeyUsage{}
+}
+
+func (l *ed25519KeyUsage) CheckApplies(c *x509.Certificate) bool {
+	return c.PublicKeyAlgorithm == x509.Ed25519
+}
+
+func (l *ed25519KeyUsage) Execute(c *x509.Certificate) *lint.LintResult {
+	return lints.CheckSignatureOnlyKeyUsage(c, "Ed25519")
+}
@@ -0,0 +1,93 @@
+package rfc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	zx509 "github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// makeCertWithKeyUsage returns a certificate for the given subject public key
+// with the given key usage, signed by a throwaway ECDSA key. If ku is zero,
+// the certificate has no key usage extension.
+func makeCertWithKeyUsage(t *testing.T, pub crypto.PublicKey, ku x509.KeyUsage) *zx509.Certificate {
+	t.Helper()
+	signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating signing key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"example.com"},
+		KeyUsage:     ku,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pub, signer)
+	test.AssertNotError(t, err, "creating certificate")
+	cert, err := zx509.ParseCertificate(der)
+	test.AssertNotError(t, err, "parsing certificate")
+	return cert
+}
+
+func TestEd25519KeyUsage(t *testing.T) {
+	t.Parallel()
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "generating Ed25519 key")
+
+	testCases := []struct {
+		name       string
+		ku         x509.KeyUsage
+		want       lint.LintStatus
+		wantSubStr string
+	}{
+		{
+			name: "no key usage",
+			want: lint.Pass,
+		},
+		{
+			name: "digital signature",
+			ku:   x509.KeyUsageDigitalSignature,
+			want: lint.Pass,
+		},
+		{
+			name:       "key encipherment",
+			ku:         x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			want:       lint.Error,
+			wantSubStr: "must not assert key usages other than",
+		},
+		{
+			name:       "key agreement only",
+			ku:         x509.KeyUsageKeyAgreement,
+			want:       lint.Error,
+			wantSubStr: "must assert a signature key usage",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := NewEd25519KeyUsage()
+			c := makeCertWithKeyUsage(t, pub, tc.ku)
+			test.Assert(t, l.CheckApplies(c), "lint should apply to Ed25519 certificate")
+			r := l.Execute(c)
+			test.AssertEquals(t, r.Status, tc.want)
+			test.Assert(t, strings.Contains(r.Details, tc.wantSubStr), "lint result details")
+		})
+	}
+
+	// The lint doesn't apply to certificates with other key types.
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+	c := makeCertWithKeyUsage(t, ecKey.Public(), x509.KeyUsageKeyAgreement)
+	test.Assert(t, !NewEd25519KeyUsage().CheckApplies(c), "lint should not apply to ECDSA certificate")
+}
@@ -0,0 +1,71 @@
+package rfc
+
+import (
+	"encoding/asn1"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"golang.org/x/crypto/cryptobyte"
+	cryptobyte_asn1 "golang.org/x/crypto/cryptobyte/asn1"
+
+	"github.com/letsencrypt/boulder/linter/lints"
+)
+
+type mldsaKeyUsage struct{}
+
+/************************************************
+RFC 9881: 5
+If the keyUsage extension is present in a certificate that indicates
+id-ml-dsa-44, id-ml-dsa-65, or id-ml-dsa-87 in SubjectPublicKeyInfo, then the
+subject public key can only be used for signatures, and at least one of
+digitalSignature, nonRepudiation, keyCertSign, or cRLSign MUST be present. The
+keyEncipherment, dataEncipherment, keyAgreement, encipherOnly, and
+decipherOnly values MUST NOT be present.
+************************************************/
+
+// mldsaOIDs are the SubjectPublicKeyInfo algorithm identifiers of the three
+// ML-DSA parameter sets. The zcrypto library used by zlint doesn't know about
+// ML-DSA, so we check for these OIDs in the raw SubjectPublicKeyInfo.
+var mldsaOIDs = []asn1.ObjectIdentifier{
+	{2, 16, 840, 1, 101, 3, 4, 3, 17},
+	{2, 16, 840, 1, 101, 3, 4, 3, 18},
+	{2, 16, 840, 1, 101, 3, 4, 3, 19},
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_mldsa_key_usage",
+			Description:   "Certificates with ML-DSA subject keys may only assert signature key usages",
+			Citation:      "RFC 9881: 5",
+			Source:        lint.RFC5280,
+			EffectiveDate: lints.RFC9881Date,
+		},
+		Lint: NewMLDSAKeyUsage,
+	})
+}
+
+func NewMLDSAKeyUsage() lint.CertificateLintInterface {
+	return &mldsaKeyUsage{}
+}
+
+func (l *mldsaKeyUsage) CheckApplies(c *x509.Certificate) bool {
+	spki := cryptobyte.String(c.RawSubjectPublicKeyInfo)
+	var algID cryptobyte.String
+	var oid asn1.ObjectIdentifier
+	if !spki.ReadASN1(&spki, cryptobyte_asn1.SEQUENCE) ||
+		!spki.ReadASN1(&algID, cryptobyte_asn1.SEQUENCE) ||
+		!algID.ReadASN1ObjectIdentifier(&oid) {
+		return false
+	}
+	for _, mldsaOID := range mldsaOIDs {
+		if oid.Equal(mldsaOID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *mldsaKeyUsage) Execute(c *x509.Certificate) *lint.LintResult {
+	return lints.CheckSignatureOnlyKeyUsage(c, "ML-DSA")
+}
@@ -0,0 +1,45 @@
+//go:build go1.27
+
+package rfc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/mldsa"
+	"crypto/rand"
+	"crypto/x509"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestMLDSAKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	for _, params := range []mldsa.Parameters{mldsa.MLDSA44(), mldsa.MLDSA65(), mldsa.MLDSA87()} {
+		t.Run(params.String(), func(t *testing.T) {
+			t.Parallel()
+			key, err := mldsa.GenerateKey(params)
+			test.AssertNotError(t, err, "generating ML-DSA key")
+			l := NewMLDSAKeyUsage()
+
+			c := makeCertWithKeyUsage(t, key.Public(), x509.KeyUsageDigitalSignature)
+			test.Assert(t, l.CheckApplies(c), "lint should apply to ML-DSA certificate")
+			test.AssertEquals(t, l.Execute(c).Status, lint.Pass)
+
+			c = makeCertWithKeyUsage(t, key.Public(), x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment)
+			r := l.Execute(c)
+			test.AssertEquals(t, r.Status, lint.Error)
+			test.Assert(t, strings.Contains(r.Details, "must not assert key usages other than"), "lint result details")
+		})
+	}
+
+	// The lint doesn't apply to certificates with other key types.
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating ECDSA key")
+	c := makeCertWithKeyUsage(t, ecKey.Public(), x509.KeyUsageKeyAgreement)
+	test.Assert(t, !NewMLDSAKeyUsage().CheckApplies(c), "lint should not apply to ECDSA certificate")
+}
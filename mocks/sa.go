@@ -261,6 +261,36 @@ func (sa *StorageAuthority) GetPausedIdentifiers(_ context.Context, _ *sapb.Regi
 	return nil, nil
 }
 
+// GetExternalAccountKey is a mock which knows about two external account keys:
+// "eab-key", which is valid, and "eab-revoked-key", which has been revoked.
+// Both have the HMAC key "this is an external account key."
+func (sa *StorageAuthorityReadOnly) GetExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKeyID, _ ...grpc.CallOption) (*sapb.ExternalAccountKey, error) {
+	eak := &sapb.ExternalAccountKey{
+		KeyID:     req.KeyID,
+		HmacKey:   []byte("this is an external account key."),
+		CreatedAt: timestamppb.New(sa.clk.Now().Add(-time.Hour)),
+	}
+	switch req.KeyID {
+	case "eab-key":
+		return eak, nil
+	case "eab-revoked-key":
+		eak.RevokedAt = timestamppb.New(sa.clk.Now())
+		return eak, nil
+	default:
+		return nil, berrors.NotFoundError("no external account key %q found", req.KeyID)
+	}
+}
+
+// GetRateLimitOverrides is a mock which returns no overrides
+func (sa *StorageAuthorityReadOnly) GetRateLimitOverrides(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (sapb.StorageAuthorityReadOnly_GetRateLimitOverridesClient, error) {
+	return &ServerStreamClient[sapb.RateLimitOverride]{}, nil
+}
+
+// GetCTSubmissionQueueStats is a mock which reports an empty queue
+func (sa *StorageAuthorityReadOnly) GetCTSubmissionQueueStats(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*sapb.CTSubmissionQueueStats, error) {
+	return &sapb.CTSubmissionQueueStats{}, nil
+}
+
 // GetRevokedCerts is a mock
 func (sa *StorageAuthorityReadOnly) GetRevokedCerts(ctx context.Context, _ *sapb.GetRevokedCertsRequest, _ ...grpc.CallOption) (sapb.StorageAuthorityReadOnly_GetRevokedCertsClient, error) {
 	return &ServerStreamClient[corepb.CRLEntry]{}, nil
@@ -276,6 +306,11 @@ func (sa *StorageAuthorityReadOnly) GetMaxExpiration(_ context.Context, req *emp
 	return nil, nil
 }
 
+// GetCRLShard is a mock
+func (sa *StorageAuthorityReadOnly) GetCRLShard(_ context.Context, _ *sapb.GetCRLShardRequest, _ ...grpc.CallOption) (*sapb.CRLShard, error) {
+	return nil, berrors.NotFoundError("no CRL shard")
+}
+
 // AddPrecertificate is a mock
 func (sa *StorageAuthority) AddPrecertificate(ctx context.Context, req *sapb.AddCertificateRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, nil
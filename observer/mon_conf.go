@@ -2,6 +2,7 @@ package observer
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -14,7 +15,7 @@ import (
 // MonConf is exported to receive YAML configuration in `ObsConf`.
 type MonConf struct {
 	Period   config.Duration  `yaml:"period"`
-	Kind     string           `yaml:"kind" validate:"required,oneof=DNS HTTP CRL TLS TCP"`
+	Kind     string           `yaml:"kind" validate:"required,oneof=DNS HTTP CRL TLS TCP ACME OCSP CT"`
 	Settings probers.Settings `yaml:"settings" validate:"min=1,dive"`
 }
 
@@ -43,6 +44,15 @@ func (c MonConf) unmarshalConfigurer() (probers.Configurer, error) {
 	return configurer, nil
 }
 
+// key returns a string which is identical for any two `MonConf` objects
+// that would construct identical monitors.
+func (c MonConf) key() string {
+	// Maps are marshaled with sorted keys, so equal settings always produce
+	// equal bytes.
+	settings, _ := yaml.Marshal(c.Settings)
+	return fmt.Sprintf("%s/%s/%s", c.Kind, c.Period.Duration, settings)
+}
+
 // makeMonitor constructs a `monitor` object from the contents of the
 // bound `MonConf`. If the `MonConf` cannot be validated, an error
 // appropriate for end-user consumption is returned instead.
@@ -59,5 +69,5 @@ func (c MonConf) makeMonitor(collectors map[string]prometheus.Collector) (*monit
 	if err != nil {
 		return nil, err
 	}
-	return &monitor{c.Period.Duration, prober}, nil
+	return &monitor{c.Period.Duration, prober, c.key(), make(chan struct{})}, nil
 }
@@ -11,12 +11,17 @@ import (
 type monitor struct {
 	period time.Duration
 	prober probers.Prober
+	// key identifies the configuration the monitor was constructed from, so
+	// that reloads can tell which monitors have changed.
+	key  string
+	stop chan struct{}
 }
 
 // start spins off a 'Prober' goroutine on an interval of `m.period`
-// with a timeout of half `m.period`
-func (m monitor) start(logger blog.Logger) {
+// with a timeout of half `m.period`, until `m.stop` is closed.
+func (m *monitor) start(logger blog.Logger) {
 	ticker := time.NewTicker(m.period)
+	defer ticker.Stop()
 	timeout := m.period / 2
 	for {
 		go func() {
@@ -33,6 +38,10 @@ func (m monitor) start(logger blog.Logger) {
 				"kind=[%s] success=[%v] duration=[%f] name=[%s]",
 				m.prober.Kind(), success, dur.Seconds(), m.prober.Name())
 		}()
-		<-ticker.C
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+		}
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/letsencrypt/boulder/cmd"
+	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/observer/probers"
 )
 
@@ -59,10 +60,13 @@ func (c *ObsConf) validateDebugAddr() error {
 	return nil
 }
 
-func (c *ObsConf) makeMonitors(metrics prometheus.Registerer) ([]*monitor, []error, error) {
+// makeMonitors constructs a `monitor` for each valid entry in `MonConfs`.
+// Collectors for each prober kind are only registered the first time that
+// kind is seen in `proberSpecificMetrics`, which is updated in place, so that
+// monitors constructed on reload share the collectors of earlier ones.
+func (c *ObsConf) makeMonitors(metrics prometheus.Registerer, proberSpecificMetrics map[string]map[string]prometheus.Collector) ([]*monitor, []error, error) {
 	var errs []error
 	var monitors []*monitor
-	proberSpecificMetrics := make(map[string]map[string]prometheus.Collector)
 	for e, m := range c.MonConfs {
 		entry := strconv.Itoa(e + 1)
 		proberConf, err := probers.GetConfigurer(m.Kind)
@@ -150,17 +154,30 @@ func (c *ObsConf) MakeObserver() (*Observer, error) {
 	logger.Infof("Initializing boulder-observer daemon")
 	logger.Debugf("Using config: %+v", c)
 
-	monitors, errs, err := c.makeMonitors(metrics)
+	proberSpecificMetrics := make(map[string]map[string]prometheus.Collector)
+	monitors, errs, err := c.makeMonitors(metrics, proberSpecificMetrics)
+	logValidation(logger, errs, len(c.MonConfs))
+	if err != nil {
+		return nil, err
+	}
+	return &Observer{
+		logger:        logger,
+		metrics:       metrics,
+		proberMetrics: proberSpecificMetrics,
+		conf:          c,
+		monitors:      monitors,
+		shutdown:      shutdown,
+	}, nil
+}
+
+// logValidation logs the errors returned when validating `total` monitors.
+func logValidation(logger blog.Logger, errs []error, total int) {
 	if len(errs) != 0 {
-		logger.Errf("%d of %d monitors failed validation", len(errs), len(c.MonConfs))
+		logger.Errf("%d of %d monitors failed validation", len(errs), total)
 		for _, err := range errs {
 			logger.Errf("%s", err)
 		}
 	} else {
 		logger.Info("all monitors passed validation")
 	}
-	if err != nil {
-		return nil, err
-	}
-	return &Observer{logger, monitors, shutdown}, nil
 }
@@ -2,29 +2,136 @@ package observer
 
 import (
 	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+
+	"github.com/prometheus/client_golang/prometheus"
 
-	"github.com/letsencrypt/boulder/cmd"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/observer/probers"
+	_ "github.com/letsencrypt/boulder/observer/probers/acme"
 	_ "github.com/letsencrypt/boulder/observer/probers/crl"
+	_ "github.com/letsencrypt/boulder/observer/probers/ct"
 	_ "github.com/letsencrypt/boulder/observer/probers/dns"
 	_ "github.com/letsencrypt/boulder/observer/probers/http"
+	_ "github.com/letsencrypt/boulder/observer/probers/ocsp"
 	_ "github.com/letsencrypt/boulder/observer/probers/tcp"
 	_ "github.com/letsencrypt/boulder/observer/probers/tls"
 )
 
 // Observer is the steward of goroutines started for each `monitor`.
 type Observer struct {
-	logger   blog.Logger
-	monitors []*monitor
-	shutdown func(ctx context.Context)
+	logger        blog.Logger
+	metrics       prometheus.Registerer
+	proberMetrics map[string]map[string]prometheus.Collector
+	conf          *ObsConf
+	monitors      []*monitor
+	shutdown      func(ctx context.Context)
 }
 
-// Start spins off a goroutine for each monitor, and waits for a signal to exit
-func (o Observer) Start() {
+// Start spins off a goroutine for each monitor, and waits for a signal to
+// exit. If `load` is non-nil, a SIGHUP calls it to re-read the configuration
+// and reloads the monitors from the result, rather than exiting.
+func (o *Observer) Start(load func() (*ObsConf, error)) {
 	for _, mon := range o.monitors {
 		go mon.start(o.logger)
 	}
 
 	defer o.shutdown(context.Background())
-	cmd.WaitForSignal()
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
+	for sig := range sigChan {
+		if sig != syscall.SIGHUP || load == nil {
+			return
+		}
+		o.logger.Info("received SIGHUP, reloading monitors")
+		c, err := load()
+		if err != nil {
+			o.logger.Errf("failed to load config, keeping current monitors: %s", err)
+			continue
+		}
+		err = o.Reload(c)
+		if err != nil {
+			o.logger.Errf("failed to reload monitors, keeping current monitors: %s", err)
+		}
+	}
+}
+
+// Reload replaces the running monitors with those configured by `c`.
+// Monitors whose configuration is unchanged keep running undisturbed, along
+// with their Prometheus series. Monitors no longer configured are stopped,
+// their series deleted, and new ones started. If none of the monitors in `c`
+// are valid, the running monitors are left in place and an error is returned.
+// Only `monitors` can be reloaded; changes to any other field require a
+// restart.
+func (o *Observer) Reload(c *ObsConf) error {
+	if len(c.MonConfs) == 0 {
+		return errors.New("no monitors provided")
+	}
+	if c.DebugAddr != o.conf.DebugAddr || !reflect.DeepEqual(c.Buckets, o.conf.Buckets) ||
+		c.Syslog != o.conf.Syslog || c.OpenTelemetry != o.conf.OpenTelemetry {
+		o.logger.Warning("only 'monitors' can be reloaded, ignoring changes to other fields")
+	}
+
+	monitors, errs, err := c.makeMonitors(o.metrics, o.proberMetrics)
+	logValidation(o.logger, errs, len(c.MonConfs))
+	if err != nil {
+		return err
+	}
+
+	// Index the running monitors by key. The same configuration may appear
+	// more than once, so each key maps to a list of monitors.
+	running := make(map[string][]*monitor)
+	for _, mon := range o.monitors {
+		running[mon.key] = append(running[mon.key], mon)
+	}
+
+	var next []*monitor
+	var started int
+	for _, mon := range monitors {
+		existing := running[mon.key]
+		if len(existing) > 0 {
+			// Keep the running monitor, and discard the new, identical one.
+			next = append(next, existing[0])
+			running[mon.key] = existing[1:]
+			continue
+		}
+		go mon.start(o.logger)
+		next = append(next, mon)
+		started++
+	}
+
+	// Stop whatever is left over, and delete the series it was reporting
+	// unless another monitor still reports them.
+	inUse := make(map[[2]string]bool)
+	remaining := make([]probers.Prober, 0, len(next))
+	for _, mon := range next {
+		inUse[[2]string{mon.prober.Name(), mon.prober.Kind()}] = true
+		remaining = append(remaining, mon.prober)
+	}
+	var stopped int
+	for _, mons := range running {
+		for _, mon := range mons {
+			close(mon.stop)
+			stopped++
+			if !inUse[[2]string{mon.prober.Name(), mon.prober.Kind()}] {
+				histObservations.DeletePartialMatch(prometheus.Labels{
+					"name": mon.prober.Name(), "kind": mon.prober.Kind(),
+				})
+				cleaner, ok := mon.prober.(probers.Cleaner)
+				if ok {
+					cleaner.Cleanup(remaining)
+				}
+			}
+		}
+	}
+
+	o.monitors = next
+	o.conf.MonConfs = c.MonConfs
+	o.logger.Infof("reloaded monitors: started=[%d] stopped=[%d] unchanged=[%d]",
+		started, stopped, len(next)-started)
+	return nil
 }
@@ -0,0 +1,133 @@
+package observer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/config"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func mockMonConf(name string, valid bool) *MonConf {
+	return &MonConf{
+		config.Duration{Duration: time.Hour},
+		mockConf,
+		probers.Settings{"valid": valid, "pname": name, "pkind": "bar"},
+	}
+}
+
+func isStopped(mon *monitor) bool {
+	select {
+	case <-mon.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestObserver_Reload(t *testing.T) {
+	if histObservations == nil {
+		histObservations = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: "obs_observations"}, []string{"name", "kind", "success"})
+	}
+	histObservations.Reset()
+
+	// The initial monitors are never started, so that the only observations
+	// of them are the ones made here.
+	conf := &ObsConf{MonConfs: []*MonConf{mockMonConf("foo", true), mockMonConf("bar", true)}}
+	o := &Observer{
+		logger:        blog.NewMock(),
+		metrics:       metrics.NoopRegisterer,
+		proberMetrics: make(map[string]map[string]prometheus.Collector),
+		conf:          conf,
+	}
+	monitors, _, err := conf.makeMonitors(o.metrics, o.proberMetrics)
+	test.AssertNotError(t, err, "making monitors")
+	o.monitors = monitors
+	foo, bar := monitors[0], monitors[1]
+	histObservations.WithLabelValues("foo", "bar", "true").Observe(1)
+	histObservations.WithLabelValues("bar", "bar", "true").Observe(1)
+	defer func() {
+		for _, mon := range o.monitors {
+			close(mon.stop)
+		}
+	}()
+
+	// Replace bar with baz, leaving foo unchanged.
+	err = o.Reload(&ObsConf{MonConfs: []*MonConf{mockMonConf("foo", true), mockMonConf("baz", true)}})
+	test.AssertNotError(t, err, "reloading")
+	test.AssertEquals(t, len(o.monitors), 2)
+	test.AssertEquals(t, o.monitors[0], foo)
+	test.Assert(t, !isStopped(foo), "unchanged monitor should not be stopped")
+	test.Assert(t, isStopped(bar), "removed monitor should be stopped")
+	test.AssertEquals(t, o.monitors[1].prober.Name(), "baz")
+	test.AssertMetricWithLabelsEquals(t, histObservations, prometheus.Labels{"name": "foo"}, 1)
+	test.AssertMetricWithLabelsEquals(t, histObservations, prometheus.Labels{"name": "bar"}, 0)
+
+	// A config with no valid monitors leaves the running monitors in place.
+	err = o.Reload(&ObsConf{MonConfs: []*MonConf{mockMonConf("qux", false)}})
+	test.AssertError(t, err, "reloading with no valid monitors should fail")
+	test.AssertEquals(t, len(o.monitors), 2)
+	test.AssertEquals(t, o.monitors[0], foo)
+
+	// A duplicated entry keeps the running monitor and starts one more.
+	baz := o.monitors[1]
+	err = o.Reload(&ObsConf{MonConfs: []*MonConf{mockMonConf("foo", true), mockMonConf("foo", true)}})
+	test.AssertNotError(t, err, "reloading")
+	test.AssertEquals(t, len(o.monitors), 2)
+	test.AssertEquals(t, o.monitors[0], foo)
+	test.Assert(t, o.monitors[1] != foo, "duplicate monitor should be new")
+	test.Assert(t, isStopped(baz), "removed monitor should be stopped")
+}
+
+func TestObserver_ReloadDeletesProberSeries(t *testing.T) {
+	if histObservations == nil {
+		histObservations = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: "obs_observations"}, []string{"name", "kind", "success"})
+	}
+
+	crlMonConf := func(url string) *MonConf {
+		return &MonConf{config.Duration{Duration: time.Hour}, "CRL", probers.Settings{"url": url}}
+	}
+	conf := &ObsConf{MonConfs: []*MonConf{crlMonConf("http://a.example/crl"), crlMonConf("http://b.example/crl")}}
+	o := &Observer{
+		logger:        blog.NewMock(),
+		metrics:       metrics.NoopRegisterer,
+		proberMetrics: make(map[string]map[string]prometheus.Collector),
+		conf:          conf,
+	}
+	monitors, _, err := conf.makeMonitors(o.metrics, o.proberMetrics)
+	test.AssertNotError(t, err, "making monitors")
+	o.monitors = monitors
+	defer func() {
+		for _, mon := range o.monitors {
+			close(mon.stop)
+		}
+	}()
+
+	thisUpdate := o.proberMetrics["CRL"]["obs_crl_this_update"].(*prometheus.GaugeVec)
+	thisUpdate.WithLabelValues("http://a.example/crl").Set(1)
+	thisUpdate.WithLabelValues("http://b.example/crl").Set(1)
+
+	err = o.Reload(&ObsConf{MonConfs: []*MonConf{crlMonConf("http://a.example/crl")}})
+	test.AssertNotError(t, err, "reloading")
+	test.AssertMetricWithLabelsEquals(t, thisUpdate, prometheus.Labels{"url": "http://a.example/crl"}, 1)
+	test.AssertMetricWithLabelsEquals(t, thisUpdate, prometheus.Labels{"url": "http://b.example/crl"}, 0)
+}
+
+func TestMonConf_key(t *testing.T) {
+	a := mockMonConf("foo", true)
+	b := &MonConf{a.Period, a.Kind, probers.Settings{"pkind": "bar", "pname": "foo", "valid": true}}
+	test.AssertEquals(t, a.key(), b.key())
+
+	b.Period = config.Duration{Duration: time.Minute}
+	test.AssertNotEquals(t, a.key(), b.key())
+
+	b = mockMonConf("baz", true)
+	test.AssertNotEquals(t, a.key(), b.key())
+}
@@ -0,0 +1,298 @@
+package probers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/eggsampler/acme/v3"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	http01 = "http-01"
+	dns01  = "dns-01"
+)
+
+// The steps of an issuance probe, in the order they're performed.
+const (
+	stepDirectory = "directory"
+	stepAccount   = "account"
+	stepOrder     = "order"
+	stepAuthorize = "authorize"
+	stepFinalize  = "finalize"
+	stepDownload  = "download"
+	stepRevoke    = "revoke"
+)
+
+var steps = []string{stepDirectory, stepAccount, stepOrder, stepAuthorize, stepFinalize, stepDownload, stepRevoke}
+
+// ACMEProbe is the exported `Prober` object for monitors configured to perform
+// a full ACME issuance: register an account, create an order, solve its
+// challenges using a built-in responder, finalize, download the certificate
+// chain and revoke the certificate.
+type ACMEProbe struct {
+	directoryURL    string
+	domain          string
+	randomSubdomain bool
+	challenge       string
+	responder       *responder
+	account         *accountCache
+	stepLatency     *prometheus.HistogramVec
+	stepOutcome     *prometheus.CounterVec
+}
+
+// accountCache holds the account a prober registered, so that each probe
+// doesn't register a new one.
+type accountCache struct {
+	sync.Mutex
+	// key is the configured account key, or nil if a key should be generated
+	// whenever an account is registered.
+	key     crypto.Signer
+	account *acme.Account
+}
+
+// get returns the cached account, registering one if there isn't one yet.
+func (a *accountCache) get(client acme.Client) (acme.Account, error) {
+	a.Lock()
+	defer a.Unlock()
+	if a.account != nil {
+		return *a.account, nil
+	}
+
+	key := a.key
+	if key == nil {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return acme.Account{}, err
+		}
+	}
+	account, err := client.NewAccountOptions(key, acme.NewAcctOptAgreeTOS())
+	if err != nil {
+		return acme.Account{}, err
+	}
+	a.account = &account
+	return account, nil
+}
+
+// reset drops the cached account, so the next probe registers it again.
+func (a *accountCache) reset() {
+	a.Lock()
+	defer a.Unlock()
+	a.account = nil
+}
+
+// isAccountError returns true if err is an ACME problem indicating that the
+// account used for the request does not exist or may no longer be used.
+func isAccountError(err error) bool {
+	var prob acme.Problem
+	if !errors.As(err, &prob) {
+		return false
+	}
+	switch prob.Type {
+	case "urn:ietf:params:acme:error:accountDoesNotExist", "urn:ietf:params:acme:error:unauthorized":
+		return true
+	}
+	return false
+}
+
+// deadlineTransport is an http.RoundTripper which binds every request to ctx,
+// so that no request outlives the probe it was made for.
+type deadlineTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t deadlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.base.RoundTrip(req.WithContext(t.ctx))
+}
+
+// Name returns a string that uniquely identifies the monitor.
+func (p ACMEProbe) Name() string {
+	return fmt.Sprintf("%s-%s-%s", p.directoryURL, p.domain, p.challenge)
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Prober`.
+func (p ACMEProbe) Kind() string {
+	return "ACME"
+}
+
+// Probe performs a full issuance against the configured ACME server. The whole
+// probe, including each wait for a challenge or order to become valid, is
+// bounded by the given timeout.
+func (p ACMEProbe) Probe(timeout time.Duration) (bool, time.Duration) {
+	start := time.Now()
+	err := p.issueAndRevoke(timeout)
+	return err == nil, time.Since(start)
+}
+
+// step runs f as the named step of the probe, recording its latency and
+// outcome.
+func (p ACMEProbe) step(name string, f func() error) error {
+	start := time.Now()
+	err := f()
+	p.stepLatency.WithLabelValues(p.directoryURL, p.challenge, name).Observe(time.Since(start).Seconds())
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	p.stepOutcome.WithLabelValues(p.directoryURL, p.challenge, name, outcome).Inc()
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+func (p ACMEProbe) issueAndRevoke(timeout time.Duration) error {
+	domain := p.domain
+	if p.randomSubdomain {
+		var label [4]byte
+		_, err := rand.Read(label[:])
+		if err != nil {
+			return err
+		}
+		domain = hex.EncodeToString(label[:]) + "." + domain
+	}
+
+	err := p.responder.start()
+	if err != nil {
+		return fmt.Errorf("starting %s responder: %w", p.challenge, err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	httpClient := &http.Client{
+		Transport: deadlineTransport{ctx: ctx, base: http.DefaultTransport},
+	}
+
+	var client acme.Client
+	err = p.step(stepDirectory, func() error {
+		client, err = acme.NewClient(p.directoryURL, acme.WithHTTPClient(httpClient))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var account acme.Account
+	err = p.step(stepAccount, func() error {
+		account, err = p.account.get(client)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var order acme.Order
+	err = p.step(stepOrder, func() error {
+		order, err = client.NewOrder(account, []acme.Identifier{{Type: "dns", Value: domain}})
+		return err
+	})
+	if err != nil {
+		if isAccountError(err) {
+			// The cached account has been deactivated or otherwise become
+			// unusable; register it again on the next probe.
+			p.account.reset()
+		}
+		return err
+	}
+
+	// The client polls for challenges and orders to become valid, ignoring
+	// request errors, until its PollTimeout elapses, so bound that by the
+	// probe's deadline too.
+	client.PollTimeout = time.Until(deadline)
+	err = p.step(stepAuthorize, func() error {
+		for _, authzURL := range order.Authorizations {
+			err := p.authorize(client, account, authzURL)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = p.step(stepFinalize, func() error {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return err
+		}
+		der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{domain}}, key)
+		if err != nil {
+			return err
+		}
+		csr, err := x509.ParseCertificateRequest(der)
+		if err != nil {
+			return err
+		}
+		client.PollTimeout = time.Until(deadline)
+		order, err = client.FinalizeOrder(account, order, csr)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var certs []*x509.Certificate
+	err = p.step(stepDownload, func() error {
+		certs, err = client.FetchCertificates(account, order.Certificate)
+		if err != nil {
+			return err
+		}
+		if len(certs) < 2 {
+			return fmt.Errorf("expected a certificate and at least one issuer, got %d certificates", len(certs))
+		}
+		return certs[0].VerifyHostname(domain)
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.step(stepRevoke, func() error {
+		return client.RevokeCertificate(account, certs[0], account.PrivateKey, 0)
+	})
+}
+
+// authorize provisions the configured challenge of the authorization at
+// authzURL with the built-in responder and waits for it to be validated.
+func (p ACMEProbe) authorize(client acme.Client, account acme.Account, authzURL string) error {
+	authz, err := client.FetchAuthorization(account, authzURL)
+	if err != nil {
+		return err
+	}
+	if authz.Status == "valid" {
+		return nil
+	}
+
+	chal, ok := authz.ChallengeMap[p.challenge]
+	if !ok {
+		return errors.New("authorization has no " + p.challenge + " challenge")
+	}
+
+	switch p.challenge {
+	case http01:
+		p.responder.addHTTP01(chal.Token, chal.KeyAuthorization)
+		defer p.responder.removeHTTP01(chal.Token)
+	case dns01:
+		name := "_acme-challenge." + authz.Identifier.Value
+		value := acme.EncodeDNS01KeyAuthorization(chal.KeyAuthorization)
+		p.responder.addDNS01(name, value)
+		defer p.responder.removeDNS01(name, value)
+	}
+
+	_, err = client.UpdateChallenge(account, chal)
+	return err
+}
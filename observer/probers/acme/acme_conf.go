@@ -0,0 +1,194 @@
+package probers
+
+import (
+	"crypto"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/privatekey"
+	"github.com/letsencrypt/boulder/strictyaml"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	stepLatencyName = "obs_acme_step_latency"
+	stepOutcomeName = "obs_acme_step_outcome"
+)
+
+// ACMEConf is exported to receive YAML configuration.
+type ACMEConf struct {
+	DirectoryURL    string `yaml:"directoryURL"`
+	Domain          string `yaml:"domain"`
+	RandomSubdomain bool   `yaml:"randomSubdomain"`
+	Challenge       string `yaml:"challenge"`
+	ResponderAddr   string `yaml:"responderAddr"`
+	AccountKeyFile  string `yaml:"accountKeyFile"`
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Configurer`.
+func (c ACMEConf) Kind() string {
+	return "ACME"
+}
+
+// UnmarshalSettings takes YAML as bytes and unmarshals it to the to an ACMEConf
+// object.
+func (c ACMEConf) UnmarshalSettings(settings []byte) (probers.Configurer, error) {
+	var conf ACMEConf
+	err := strictyaml.Unmarshal(settings, &conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+func (c ACMEConf) validateDirectoryURL() error {
+	url, err := url.Parse(c.DirectoryURL)
+	if err != nil {
+		return fmt.Errorf(
+			"invalid 'directoryURL', got: %q, expected a valid url", c.DirectoryURL)
+	}
+	if url.Scheme != "http" && url.Scheme != "https" {
+		return fmt.Errorf(
+			"invalid 'directoryURL', got: %q, scheme must be http or https", c.DirectoryURL)
+	}
+	return nil
+}
+
+func (c ACMEConf) validateDomain() error {
+	if c.Domain == "" || strings.ContainsAny(c.Domain, "*/: ") {
+		return fmt.Errorf(
+			"invalid 'domain', got: %q, expected a non-wildcard hostname", c.Domain)
+	}
+	return nil
+}
+
+func (c ACMEConf) validateChallenge() error {
+	switch strings.ToLower(c.Challenge) {
+	case http01, dns01:
+		return nil
+	}
+	return fmt.Errorf(
+		"invalid 'challenge', got: %q, must be one of %s", c.Challenge, []string{http01, dns01})
+}
+
+func (c ACMEConf) validateResponderAddr() error {
+	if c.ResponderAddr == "" {
+		return nil
+	}
+	_, _, err := net.SplitHostPort(c.ResponderAddr)
+	if err != nil {
+		return fmt.Errorf(
+			"invalid 'responderAddr', got: %q, expected host:port: %s", c.ResponderAddr, err)
+	}
+	return nil
+}
+
+// MakeProber constructs an `ACMEProbe` object from the contents of the bound
+// `ACMEConf` object. If the `ACMEConf` cannot be validated, an error
+// appropriate for end-user consumption is returned instead.
+func (c ACMEConf) MakeProber(collectors map[string]prometheus.Collector) (probers.Prober, error) {
+	// Validate `directoryURL`
+	err := c.validateDirectoryURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate `domain`
+	err = c.validateDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate `challenge`
+	err = c.validateChallenge()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate `responderAddr`
+	err = c.validateResponderAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load `accountKeyFile`
+	var accountKey crypto.Signer
+	if c.AccountKeyFile != "" {
+		accountKey, _, err = privatekey.Load(c.AccountKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'accountKeyFile', got: %q: %s", c.AccountKeyFile, err)
+		}
+	}
+
+	// Validate the Prometheus collectors that were passed in
+	coll, ok := collectors[stepLatencyName]
+	if !ok {
+		return nil, fmt.Errorf("acme prober did not receive collector %q", stepLatencyName)
+	}
+
+	stepLatencyColl, ok := coll.(*prometheus.HistogramVec)
+	if !ok {
+		return nil, fmt.Errorf("acme prober received collector %q of wrong type, got: %T, expected *prometheus.HistogramVec", stepLatencyName, coll)
+	}
+
+	coll, ok = collectors[stepOutcomeName]
+	if !ok {
+		return nil, fmt.Errorf("acme prober did not receive collector %q", stepOutcomeName)
+	}
+
+	stepOutcomeColl, ok := coll.(*prometheus.CounterVec)
+	if !ok {
+		return nil, fmt.Errorf("acme prober received collector %q of wrong type, got: %T, expected *prometheus.CounterVec", stepOutcomeName, coll)
+	}
+
+	challenge := strings.ToLower(c.Challenge)
+	addr := c.ResponderAddr
+	if addr == "" {
+		addr = defaultResponderAddrs[challenge]
+	}
+
+	return ACMEProbe{
+		directoryURL:    c.DirectoryURL,
+		domain:          strings.ToLower(c.Domain),
+		randomSubdomain: c.RandomSubdomain,
+		challenge:       challenge,
+		responder:       getResponder(challenge, addr),
+		account:         &accountCache{key: accountKey},
+		stepLatency:     stepLatencyColl,
+		stepOutcome:     stepOutcomeColl,
+	}, nil
+}
+
+// Instrument constructs any `prometheus.Collector` objects the `ACMEProbe` will
+// need to report its own metrics. A map is returned containing the constructed
+// objects, indexed by the name of the Prometheus metric. If no objects were
+// constructed, nil is returned.
+func (c ACMEConf) Instrument() map[string]prometheus.Collector {
+	stepLatency := prometheus.Collector(prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    stepLatencyName,
+			Help:    "Latency of each step of an ACME issuance probe, in seconds",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"directory", "challenge", "step"},
+	))
+	stepOutcome := prometheus.Collector(prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: stepOutcomeName,
+			Help: fmt.Sprintf("Outcome of each step of an ACME issuance probe. Steps are %s", steps),
+		}, []string{"directory", "challenge", "step", "outcome"},
+	))
+	return map[string]prometheus.Collector{
+		stepLatencyName: stepLatency,
+		stepOutcomeName: stepOutcome,
+	}
+}
+
+// init is called at runtime and registers `ACMEConf`, a `Prober` `Configurer`
+// type, as "ACME".
+func init() {
+	probers.Register(ACMEConf{})
+}
@@ -0,0 +1,136 @@
+package probers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/yaml.v3"
+)
+
+func TestACMEConf_MakeProber(t *testing.T) {
+	goodDirectory, goodDomain, goodChallenge := "http://boulder.service.consul:4001/directory", "example.com", "http-01"
+	colls := ACMEConf{}.Instrument()
+	badColl := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "obs_acme_foo",
+			Help: "Hmmm, this shouldn't be here...",
+		},
+		[]string{},
+	))
+	type fields struct {
+		DirectoryURL   string
+		Domain         string
+		Challenge      string
+		ResponderAddr  string
+		AccountKeyFile string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		colls   map[string]prometheus.Collector
+		wantErr bool
+	}{
+		// valid
+		{"valid http-01", fields{goodDirectory, goodDomain, goodChallenge, "", ""}, colls, false},
+		{"valid dns-01", fields{"https://acme-staging-v02.api.letsencrypt.org/directory", goodDomain, "DNS-01", "127.0.0.1:8053", ""}, colls, false},
+		{"valid accountKeyFile", fields{goodDirectory, goodDomain, goodChallenge, "", "../../../test/hierarchy/ee-e1.key.pem"}, colls, false},
+
+		// invalid directoryURL
+		{"bad directoryURL", fields{":::::", goodDomain, goodChallenge, "", ""}, colls, true},
+		{"directoryURL without scheme", fields{"example.com/directory", goodDomain, goodChallenge, "", ""}, colls, true},
+
+		// invalid domain
+		{"empty domain", fields{goodDirectory, "", goodChallenge, "", ""}, colls, true},
+		{"wildcard domain", fields{goodDirectory, "*.example.com", goodChallenge, "", ""}, colls, true},
+		{"domain with scheme", fields{goodDirectory, "https://example.com", goodChallenge, "", ""}, colls, true},
+
+		// invalid challenge
+		{"empty challenge", fields{goodDirectory, goodDomain, "", "", ""}, colls, true},
+		{"unsupported challenge", fields{goodDirectory, goodDomain, "tls-alpn-01", "", ""}, colls, true},
+
+		// invalid responderAddr
+		{"responderAddr without port", fields{goodDirectory, goodDomain, goodChallenge, "127.0.0.1", ""}, colls, true},
+
+		// invalid accountKeyFile
+		{"missing accountKeyFile", fields{goodDirectory, goodDomain, goodChallenge, "", "does-not-exist.pem"}, colls, true},
+
+		// invalid collector
+		{
+			"unexpected collector",
+			fields{goodDirectory, goodDomain, goodChallenge, "", ""},
+			map[string]prometheus.Collector{"obs_acme_foo": badColl},
+			true,
+		},
+		{
+			"missing collectors",
+			fields{goodDirectory, goodDomain, goodChallenge, "", ""},
+			map[string]prometheus.Collector{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ACMEConf{
+				DirectoryURL:   tt.fields.DirectoryURL,
+				Domain:         tt.fields.Domain,
+				Challenge:      tt.fields.Challenge,
+				ResponderAddr:  tt.fields.ResponderAddr,
+				AccountKeyFile: tt.fields.AccountKeyFile,
+			}
+			if _, err := c.MakeProber(tt.colls); (err != nil) != tt.wantErr {
+				t.Errorf("ACMEConf.MakeProber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestACMEConf_UnmarshalSettings(t *testing.T) {
+	type fields struct {
+		directoryURL    interface{}
+		domain          interface{}
+		randomSubdomain interface{}
+		challenge       interface{}
+		responderAddr   interface{}
+		accountKeyFile  interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    probers.Configurer
+		wantErr bool
+	}{
+		{
+			"valid",
+			fields{"http://boulder.service.consul:4001/directory", "example.com", true, "dns-01", ":8053", "account.key"},
+			ACMEConf{"http://boulder.service.consul:4001/directory", "example.com", true, "dns-01", ":8053", "account.key"},
+			false,
+		},
+		{"invalid directoryURL (map)", fields{make(map[string]interface{}), "example.com", false, "http-01", "", ""}, nil, true},
+		{"invalid randomSubdomain (list)", fields{"http://example.com", "example.com", make([]string, 0), "http-01", "", ""}, nil, true},
+		{"invalid challenge (list)", fields{"http://example.com", "example.com", false, make([]string, 0), "", ""}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := probers.Settings{
+				"directoryURL":    tt.fields.directoryURL,
+				"domain":          tt.fields.domain,
+				"randomSubdomain": tt.fields.randomSubdomain,
+				"challenge":       tt.fields.challenge,
+				"responderAddr":   tt.fields.responderAddr,
+				"accountKeyFile":  tt.fields.accountKeyFile,
+			}
+			settingsBytes, _ := yaml.Marshal(settings)
+			c := ACMEConf{}
+			got, err := c.UnmarshalSettings(settingsBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ACMEConf.UnmarshalSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ACMEConf.UnmarshalSettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package probers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eggsampler/acme/v3"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeACME is a minimal ACME server which validates http-01 challenges
+// against the prober's built-in responder and issues certificates from a
+// throwaway issuer.
+type fakeACME struct {
+	t         *testing.T
+	srv       *httptest.Server
+	responder *responder
+	// validate is false if challenges should stay pending forever.
+	validate bool
+
+	issuerKey  *ecdsa.PrivateKey
+	issuerCert *x509.Certificate
+
+	sync.Mutex
+	accounts   int
+	thumbprint string
+	domain     string
+	leaf       []byte
+	revoked    []*big.Int
+}
+
+func newFakeACME(t *testing.T, validate bool) *fakeACME {
+	t.Helper()
+	issuerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating issuer key")
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake issuer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, issuerKey.Public(), issuerKey)
+	test.AssertNotError(t, err, "creating issuer certificate")
+	issuerCert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "parsing issuer certificate")
+
+	f := &fakeACME{t: t, validate: validate, issuerKey: issuerKey, issuerCert: issuerCert}
+	f.srv = httptest.NewServer(f)
+	t.Cleanup(f.srv.Close)
+	return f
+}
+
+// readJWS returns the payload and protected header JWK, if any, of the JWS
+// in the request body. Signatures aren't checked.
+func (f *fakeACME) readJWS(r *http.Request) ([]byte, *jose.JSONWebKey) {
+	body, err := io.ReadAll(r.Body)
+	test.AssertNotError(f.t, err, "reading request body")
+	jws, err := jose.ParseSigned(string(body), []jose.SignatureAlgorithm{jose.ES256, jose.RS256})
+	test.AssertNotError(f.t, err, "parsing JWS")
+	return jws.UnsafePayloadWithoutVerification(), jws.Signatures[0].Protected.JSONWebKey
+}
+
+func (f *fakeACME) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	test.AssertNotError(f.t, err, "writing response")
+}
+
+func (f *fakeACME) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Replay-Nonce", "nonce")
+	url := f.srv.URL
+	f.Lock()
+	defer f.Unlock()
+
+	switch r.URL.Path {
+	case "/directory":
+		f.writeJSON(w, http.StatusOK, map[string]string{
+			"newNonce":   url + "/nonce",
+			"newAccount": url + "/account",
+			"newOrder":   url + "/order",
+			"revokeCert": url + "/revoke",
+		})
+	case "/nonce":
+		w.WriteHeader(http.StatusOK)
+	case "/account":
+		_, jwk := f.readJWS(r)
+		thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+		test.AssertNotError(f.t, err, "computing thumbprint")
+		f.thumbprint = base64.RawURLEncoding.EncodeToString(thumbprint)
+		f.accounts++
+		w.Header().Set("Location", url+"/account/1")
+		f.writeJSON(w, http.StatusCreated, map[string]string{"status": "valid"})
+	case "/order":
+		payload, _ := f.readJWS(r)
+		var req struct {
+			Identifiers []struct{ Value string }
+		}
+		err := json.Unmarshal(payload, &req)
+		test.AssertNotError(f.t, err, "parsing new order")
+		f.domain = req.Identifiers[0].Value
+		w.Header().Set("Location", url+"/order/1")
+		f.writeJSON(w, http.StatusCreated, map[string]any{
+			"status":         "pending",
+			"authorizations": []string{url + "/authz/1"},
+			"finalize":       url + "/finalize/1",
+		})
+	case "/authz/1":
+		f.readJWS(r)
+		f.writeJSON(w, http.StatusOK, map[string]any{
+			"status":     "pending",
+			"identifier": map[string]string{"type": "dns", "value": f.domain},
+			"challenges": []map[string]string{
+				{"type": "http-01", "url": url + "/chall/1", "token": "token", "status": "pending"},
+			},
+		})
+	case "/chall/1":
+		f.readJWS(r)
+		status := "pending"
+		if f.validate {
+			f.responder.Lock()
+			addr := f.responder.boundAddr
+			f.responder.Unlock()
+			resp, err := http.Get("http://" + addr + http01Prefix + "token")
+			test.AssertNotError(f.t, err, "fetching http-01 response")
+			keyAuthorization, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			test.AssertNotError(f.t, err, "reading http-01 response")
+			test.AssertEquals(f.t, string(keyAuthorization), "token."+f.thumbprint)
+			status = "valid"
+		}
+		f.writeJSON(w, http.StatusOK, map[string]string{"type": "http-01", "token": "token", "status": status})
+	case "/finalize/1":
+		payload, _ := f.readJWS(r)
+		var req struct{ CSR string }
+		err := json.Unmarshal(payload, &req)
+		test.AssertNotError(f.t, err, "parsing finalize request")
+		der, err := base64.RawURLEncoding.DecodeString(req.CSR)
+		test.AssertNotError(f.t, err, "decoding CSR")
+		csr, err := x509.ParseCertificateRequest(der)
+		test.AssertNotError(f.t, err, "parsing CSR")
+		test.AssertDeepEquals(f.t, csr.DNSNames, []string{f.domain})
+		f.leaf, err = x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(time.Now().UnixNano()),
+			DNSNames:     csr.DNSNames,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}, f.issuerCert, csr.PublicKey, f.issuerKey)
+		test.AssertNotError(f.t, err, "issuing certificate")
+		w.Header().Set("Location", url+"/order/1")
+		f.writeJSON(w, http.StatusOK, map[string]string{"status": "valid", "certificate": url + "/cert/1"})
+	case "/cert/1":
+		f.readJWS(r)
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		_ = pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: f.leaf})
+		_ = pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: f.issuerCert.Raw})
+	case "/revoke":
+		payload, _ := f.readJWS(r)
+		var req struct{ Certificate string }
+		err := json.Unmarshal(payload, &req)
+		test.AssertNotError(f.t, err, "parsing revocation request")
+		der, err := base64.RawURLEncoding.DecodeString(req.Certificate)
+		test.AssertNotError(f.t, err, "decoding certificate")
+		cert, err := x509.ParseCertificate(der)
+		test.AssertNotError(f.t, err, "parsing certificate")
+		f.revoked = append(f.revoked, cert.SerialNumber)
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestProbeIssuesAndRevokes(t *testing.T) {
+	f := newFakeACME(t, true)
+	colls := ACMEConf{}.Instrument()
+	conf := ACMEConf{
+		DirectoryURL:    f.srv.URL + "/directory",
+		Domain:          "example.com",
+		RandomSubdomain: true,
+		Challenge:       "http-01",
+		ResponderAddr:   "127.0.0.1:0",
+	}
+	prober, err := conf.MakeProber(colls)
+	test.AssertNotError(t, err, "making prober")
+	f.responder = prober.(ACMEProbe).responder
+
+	for range 2 {
+		ok, _ := prober.Probe(10 * time.Second)
+		test.Assert(t, ok, "probe against a working server should succeed")
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	test.AssertEquals(t, f.accounts, 1)
+	test.AssertEquals(t, len(f.revoked), 2)
+
+	outcomes := colls[stepOutcomeName].(*prometheus.CounterVec)
+	for _, step := range steps {
+		test.AssertMetricWithLabelsEquals(t, outcomes, prometheus.Labels{"step": step, "outcome": "success"}, 2)
+	}
+	test.AssertMetricWithLabelsEquals(t, outcomes, prometheus.Labels{"outcome": "failure"}, 0)
+}
+
+func TestProbeBoundedByTimeout(t *testing.T) {
+	f := newFakeACME(t, false)
+	colls := ACMEConf{}.Instrument()
+	conf := ACMEConf{
+		DirectoryURL:  f.srv.URL + "/directory",
+		Domain:        "example.com",
+		Challenge:     "http-01",
+		ResponderAddr: "127.0.0.1:0",
+	}
+	prober, err := conf.MakeProber(colls)
+	test.AssertNotError(t, err, "making prober")
+
+	ok, dur := prober.Probe(time.Second)
+	test.Assert(t, !ok, "probe whose challenge never validates should fail")
+	test.Assert(t, dur < 3*time.Second, "probe should be bounded by its timeout")
+
+	outcomes := colls[stepOutcomeName].(*prometheus.CounterVec)
+	test.AssertMetricWithLabelsEquals(t, outcomes, prometheus.Labels{"step": stepAuthorize, "outcome": "failure"}, 1)
+}
+
+func TestProbeRecordsFailedStep(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	colls := ACMEConf{}.Instrument()
+	conf := ACMEConf{
+		DirectoryURL:  srv.URL + "/directory",
+		Domain:        "example.com",
+		Challenge:     "http-01",
+		ResponderAddr: "127.0.0.1:0",
+	}
+	prober, err := conf.MakeProber(colls)
+	test.AssertNotError(t, err, "making prober")
+
+	ok, _ := prober.Probe(time.Second)
+	test.Assert(t, !ok, "probe against a server without a directory should fail")
+
+	outcomes := colls[stepOutcomeName].(*prometheus.CounterVec)
+	test.AssertMetricWithLabelsEquals(t, outcomes, prometheus.Labels{
+		"directory": conf.DirectoryURL, "challenge": "http-01", "step": stepDirectory, "outcome": "failure",
+	}, 1)
+	test.AssertMetricWithLabelsEquals(t, outcomes, prometheus.Labels{"step": stepAccount}, 0)
+}
+
+func TestIsAccountError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{acme.Problem{Type: "urn:ietf:params:acme:error:accountDoesNotExist"}, true},
+		{fmt.Errorf("wrapped: %w", acme.Problem{Type: "urn:ietf:params:acme:error:unauthorized"}), true},
+		{acme.Problem{Type: "urn:ietf:params:acme:error:rateLimited"}, false},
+		{acme.Problem{Type: "urn:ietf:params:acme:error:serverInternal"}, false},
+		{errors.New("connection refused"), false},
+	} {
+		test.AssertEquals(t, isAccountError(tc.err), tc.want)
+	}
+}
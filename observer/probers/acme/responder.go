@@ -0,0 +1,171 @@
+package probers
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const http01Prefix = "/.well-known/acme-challenge/"
+
+// defaultResponderAddrs are the addresses the built-in responders listen on if
+// no `responderAddr` is configured.
+var defaultResponderAddrs = map[string]string{
+	http01: ":80",
+	dns01:  ":53",
+}
+
+var (
+	respondersMu sync.Mutex
+	// responders holds the built-in responders, indexed by challenge type and
+	// listen address, so that monitors configured with the same responder
+	// share a single listener.
+	responders = make(map[string]*responder)
+)
+
+// getResponder returns the responder for the given challenge type and listen
+// address, creating it if necessary. The responder doesn't listen until its
+// start method is called.
+func getResponder(challenge, addr string) *responder {
+	respondersMu.Lock()
+	defer respondersMu.Unlock()
+	key := challenge + " " + addr
+	r, ok := responders[key]
+	if !ok {
+		r = &responder{
+			challenge: challenge,
+			addr:      addr,
+			http01:    make(map[string]string),
+			dns01:     make(map[string][]string),
+		}
+		responders[key] = r
+	}
+	return r
+}
+
+// responder answers http-01 requests over HTTP, or dns-01 TXT queries over
+// UDP, for the challenges of in-flight probes.
+type responder struct {
+	challenge string
+	addr      string
+
+	sync.Mutex
+	// boundAddr is the address the responder is listening on, or empty if it
+	// has not been started.
+	boundAddr string
+	// http01 maps tokens to key authorizations.
+	http01 map[string]string
+	// dns01 maps lowercased names, without a trailing dot, to TXT values.
+	dns01 map[string][]string
+}
+
+// start begins listening, if the responder isn't already.
+func (r *responder) start() error {
+	r.Lock()
+	defer r.Unlock()
+	if r.boundAddr != "" {
+		return nil
+	}
+
+	switch r.challenge {
+	case http01:
+		ln, err := net.Listen("tcp", r.addr)
+		if err != nil {
+			return err
+		}
+		srv := &http.Server{
+			Handler:           r,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+		go func() { _ = srv.Serve(ln) }()
+		r.boundAddr = ln.Addr().String()
+	case dns01:
+		pc, err := net.ListenPacket("udp", r.addr)
+		if err != nil {
+			return err
+		}
+		srv := &dns.Server{PacketConn: pc, Handler: r}
+		go func() { _ = srv.ActivateAndServe() }()
+		r.boundAddr = pc.LocalAddr().String()
+	default:
+		return fmt.Errorf("unsupported challenge type %q", r.challenge)
+	}
+	return nil
+}
+
+func (r *responder) addHTTP01(token, keyAuthorization string) {
+	r.Lock()
+	defer r.Unlock()
+	r.http01[token] = keyAuthorization
+}
+
+func (r *responder) removeHTTP01(token string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.http01, token)
+}
+
+func (r *responder) addDNS01(name, value string) {
+	r.Lock()
+	defer r.Unlock()
+	name = strings.ToLower(name)
+	r.dns01[name] = append(r.dns01[name], value)
+}
+
+func (r *responder) removeDNS01(name, value string) {
+	r.Lock()
+	defer r.Unlock()
+	name = strings.ToLower(name)
+	r.dns01[name] = slices.DeleteFunc(r.dns01[name], func(v string) bool { return v == value })
+	if len(r.dns01[name]) == 0 {
+		delete(r.dns01, name)
+	}
+}
+
+// ServeHTTP answers http-01 challenge requests.
+func (r *responder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	token, ok := strings.CutPrefix(req.URL.Path, http01Prefix)
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	r.Lock()
+	keyAuthorization, ok := r.http01[token]
+	r.Unlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	fmt.Fprint(w, keyAuthorization)
+}
+
+// ServeDNS answers dns-01 TXT queries. All other queries, including the CAA
+// queries made before issuance, get an empty authoritative answer.
+func (r *responder) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.Authoritative = true
+
+	r.Lock()
+	for _, q := range req.Question {
+		if q.Qtype != dns.TypeTXT {
+			continue
+		}
+		for _, value := range r.dns01[strings.ToLower(strings.TrimSuffix(q.Name, "."))] {
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET},
+				Txt: []string{value},
+			})
+		}
+	}
+	r.Unlock()
+
+	_ = w.WriteMsg(m)
+}
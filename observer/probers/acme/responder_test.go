@@ -0,0 +1,76 @@
+package probers
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/miekg/dns"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestHTTP01Responder(t *testing.T) {
+	r := getResponder(http01, "127.0.0.1:0")
+	test.AssertEquals(t, getResponder(http01, "127.0.0.1:0"), r)
+	err := r.start()
+	test.AssertNotError(t, err, "starting responder")
+	err = r.start()
+	test.AssertNotError(t, err, "starting responder twice")
+
+	get := func(path string) (int, string) {
+		t.Helper()
+		resp, err := http.Get("http://" + r.boundAddr + path)
+		test.AssertNotError(t, err, "requesting challenge")
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		test.AssertNotError(t, err, "reading challenge response")
+		return resp.StatusCode, string(body)
+	}
+
+	r.addHTTP01("token", "token.thumbprint")
+	code, body := get(http01Prefix + "token")
+	test.AssertEquals(t, code, http.StatusOK)
+	test.AssertEquals(t, body, "token.thumbprint")
+
+	code, _ = get(http01Prefix + "other")
+	test.AssertEquals(t, code, http.StatusNotFound)
+	code, _ = get("/token")
+	test.AssertEquals(t, code, http.StatusNotFound)
+
+	r.removeHTTP01("token")
+	code, _ = get(http01Prefix + "token")
+	test.AssertEquals(t, code, http.StatusNotFound)
+}
+
+func TestDNS01Responder(t *testing.T) {
+	r := getResponder(dns01, "127.0.0.1:0")
+	err := r.start()
+	test.AssertNotError(t, err, "starting responder")
+
+	query := func(name string, qtype uint16) []dns.RR {
+		t.Helper()
+		m := new(dns.Msg)
+		m.SetQuestion(name, qtype)
+		resp, err := dns.Exchange(m, r.boundAddr)
+		test.AssertNotError(t, err, "querying responder")
+		test.AssertEquals(t, resp.Rcode, dns.RcodeSuccess)
+		return resp.Answer
+	}
+
+	r.addDNS01("_acme-challenge.Example.com", "one")
+	r.addDNS01("_acme-challenge.example.com", "two")
+	answers := query("_acme-challenge.EXAMPLE.com.", dns.TypeTXT)
+	test.AssertEquals(t, len(answers), 2)
+	test.AssertDeepEquals(t, answers[0].(*dns.TXT).Txt, []string{"one"})
+	test.AssertDeepEquals(t, answers[1].(*dns.TXT).Txt, []string{"two"})
+
+	test.AssertEquals(t, len(query("example.com.", dns.TypeCAA)), 0)
+
+	r.removeDNS01("_acme-challenge.example.com", "one")
+	answers = query("_acme-challenge.example.com.", dns.TypeTXT)
+	test.AssertEquals(t, len(answers), 1)
+	r.removeDNS01("_acme-challenge.example.com", "two")
+	test.AssertEquals(t, len(query("_acme-challenge.example.com.", dns.TypeTXT)), 0)
+	test.AssertEquals(t, len(r.dns01), 0)
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/observer/probers"
 )
 
 // CRLProbe is the exported 'Prober' object for monitors configured to
@@ -28,6 +30,13 @@ func (p CRLProbe) Kind() string {
 	return "CRL"
 }
 
+// Cleanup deletes the series reported for the configured CRL.
+func (p CRLProbe) Cleanup(_ []probers.Prober) {
+	p.cThisUpdate.DeleteLabelValues(p.url)
+	p.cNextUpdate.DeleteLabelValues(p.url)
+	p.cCertCount.DeleteLabelValues(p.url)
+}
+
 // Probe requests the configured CRL and publishes metrics about it if found.
 func (p CRLProbe) Probe(timeout time.Duration) (bool, time.Duration) {
 	start := time.Now()
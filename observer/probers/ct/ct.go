@@ -0,0 +1,298 @@
+package probers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	ctClient "github.com/google/certificate-transparency-go/client"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/observer/probers"
+)
+
+type reason int
+
+const (
+	none reason = iota
+	sthUnavailable
+	sthTooOld
+	inclusionProofFailed
+)
+
+var reasonToString = map[reason]string{
+	none:                 "nil",
+	sthUnavailable:       "sthUnavailable",
+	sthTooOld:            "sthTooOld",
+	inclusionProofFailed: "inclusionProofFailed",
+}
+
+func getReasons() []string {
+	var allReasons []string
+	for _, v := range reasonToString {
+		allReasons = append(allReasons, v)
+	}
+	return allReasons
+}
+
+// ctLog is a single log checked by a `CTProbe`.
+type ctLog struct {
+	name   string
+	id     []byte
+	mmd    time.Duration
+	client *ctClient.LogClient
+}
+
+// CTProbe is the exported `Prober` object for monitors configured to check
+// the health of the CT logs in a log list.
+type CTProbe struct {
+	logListFile  string
+	logs         []ctLog
+	recentChains string
+	sthAge       *prometheus.GaugeVec
+	sthTreeSize  *prometheus.GaugeVec
+	reason       *prometheus.CounterVec
+}
+
+// Name returns a string that uniquely identifies the monitor.
+func (p CTProbe) Name() string {
+	names := make([]string, 0, len(p.logs))
+	for _, log := range p.logs {
+		names = append(names, log.name)
+	}
+	return fmt.Sprintf("%s-%s", p.logListFile, strings.Join(names, ","))
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Prober`.
+func (p CTProbe) Kind() string {
+	return "CT"
+}
+
+// Cleanup deletes the series reported for each configured log, unless one of
+// the `remaining` CT probes also checks that log.
+func (p CTProbe) Cleanup(remaining []probers.Prober) {
+	inUse := make(map[string]bool)
+	for _, prober := range remaining {
+		other, ok := prober.(CTProbe)
+		if !ok {
+			continue
+		}
+		for _, log := range other.logs {
+			inUse[log.name] = true
+		}
+	}
+	for _, log := range p.logs {
+		if inUse[log.name] {
+			continue
+		}
+		p.sthAge.DeleteLabelValues(log.name)
+		p.sthTreeSize.DeleteLabelValues(log.name)
+		p.reason.DeletePartialMatch(prometheus.Labels{"log": log.name})
+	}
+}
+
+// Probe concurrently fetches the latest STH of each configured log and checks
+// that it is no older than the log's MMD. If `recentChains` is configured, it
+// also verifies that each log has incorporated the SCTs embedded in those
+// certificates whose MMD has passed. It succeeds only if every log passes.
+func (p CTProbe) Probe(timeout time.Duration) (bool, time.Duration) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// Chains which can't be read are ignored, rather than failing every log:
+	// they are usually being written by another process.
+	chains := p.loadChains()
+
+	var wg sync.WaitGroup
+	results := make([]reason, len(p.logs))
+	for i, log := range p.logs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = p.check(ctx, log, chains)
+			p.reason.WithLabelValues(log.name, reasonToString[results[i]]).Inc()
+		}()
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		if r != none {
+			return false, time.Since(start)
+		}
+	}
+	return true, time.Since(start)
+}
+
+// check fetches the latest STH from a single log, reports its age and tree
+// size, and returns the reason the log is unhealthy, if any.
+func (p CTProbe) check(ctx context.Context, log ctLog, chains [][]*ctx509.Certificate) reason {
+	// GetSTH verifies the STH signature against the log's public key.
+	sth, err := log.client.GetSTH(ctx)
+	if err != nil {
+		return sthUnavailable
+	}
+	sthTime := ct.TimestampToTime(sth.Timestamp)
+	age := time.Since(sthTime)
+	p.sthAge.WithLabelValues(log.name).Set(age.Seconds())
+	p.sthTreeSize.WithLabelValues(log.name).Set(float64(sth.TreeSize))
+	if age > log.mmd {
+		return sthTooOld
+	}
+
+	for _, chain := range chains {
+		for _, sct := range embeddedSCTs(chain[0]) {
+			if !bytes.Equal(sct.LogID.KeyID[:], log.id) {
+				continue
+			}
+			// The log has until the MMD has passed to incorporate the
+			// certificate, so only check SCTs which the latest STH must cover.
+			if ct.TimestampToTime(sct.Timestamp).Add(log.mmd).After(sthTime) {
+				continue
+			}
+			err := checkInclusion(ctx, log.client, chain, sct.Timestamp, sth)
+			if err != nil {
+				return inclusionProofFailed
+			}
+		}
+	}
+	return none
+}
+
+// loadChains parses the leaf and issuer from each PEM file matching
+// `recentChains`.
+func (p CTProbe) loadChains() [][]*ctx509.Certificate {
+	if p.recentChains == "" {
+		return nil
+	}
+	paths, err := filepath.Glob(p.recentChains)
+	if err != nil {
+		return nil
+	}
+	var chains [][]*ctx509.Certificate
+	for _, path := range paths {
+		chain, err := loadChain(path)
+		if err != nil {
+			continue
+		}
+		chains = append(chains, chain)
+	}
+	return chains
+}
+
+// loadChain returns the first two certificates, a leaf and its issuer, from a
+// PEM file.
+func loadChain(path string) ([]*ctx509.Certificate, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var chain []*ctx509.Certificate
+	for len(chain) < 2 {
+		var block *pem.Block
+		block, contents = pem.Decode(contents)
+		if block == nil {
+			return nil, fmt.Errorf("%q does not contain a leaf and issuer", path)
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := ctx509.ParseCertificate(block.Bytes)
+		if ctx509.IsFatal(err) {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	return chain, nil
+}
+
+// embeddedSCTs returns the SCTs embedded in cert, skipping any which can't be
+// parsed.
+func embeddedSCTs(cert *ctx509.Certificate) []ct.SignedCertificateTimestamp {
+	var scts []ct.SignedCertificateTimestamp
+	for _, serialized := range cert.SCTList.SCTList {
+		var sct ct.SignedCertificateTimestamp
+		rest, err := cttls.Unmarshal(serialized.Val, &sct)
+		if err != nil || len(rest) != 0 {
+			continue
+		}
+		scts = append(scts, sct)
+	}
+	return scts
+}
+
+// checkInclusion fetches and verifies a proof that the precertificate entry
+// for chain, logged at timestamp, is included in the tree described by sth.
+func checkInclusion(ctx context.Context, client *ctClient.LogClient, chain []*ctx509.Certificate, timestamp uint64, sth *ct.SignedTreeHead) error {
+	leaf, err := ct.MerkleTreeLeafForEmbeddedSCT(chain, timestamp)
+	if err != nil {
+		return err
+	}
+	leafHash, err := ct.LeafHashForLeaf(leaf)
+	if err != nil {
+		return err
+	}
+	proof, err := client.GetProofByHash(ctx, leafHash[:], sth.TreeSize)
+	if err != nil {
+		return err
+	}
+	if proof.LeafIndex < 0 {
+		return fmt.Errorf("negative leaf index %d", proof.LeafIndex)
+	}
+	return verifyInclusion(uint64(proof.LeafIndex), sth.TreeSize, leafHash[:], proof.AuditPath, sth.SHA256RootHash[:])
+}
+
+// hashChildren returns the hash of an interior node of a Merkle tree, as
+// defined by RFC 9162, Section 2.1.1.
+func hashChildren(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{1})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// verifyInclusion verifies that proof is a valid inclusion proof for the leaf
+// at index in a tree of the given size with the given root, following the
+// algorithm in RFC 9162, Section 2.1.3.2.
+func verifyInclusion(index, size uint64, leafHash []byte, proof [][]byte, root []byte) error {
+	if index >= size {
+		return fmt.Errorf("leaf index %d is not less than tree size %d", index, size)
+	}
+	fn, sn := index, size-1
+	r := leafHash
+	for _, p := range proof {
+		if sn == 0 {
+			return errors.New("inclusion proof is too long")
+		}
+		if fn&1 == 1 || fn == sn {
+			r = hashChildren(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = hashChildren(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 {
+		return errors.New("inclusion proof is too short")
+	}
+	if !bytes.Equal(r, root) {
+		return errors.New("inclusion proof does not match root hash")
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package probers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+
+	ctClient "github.com/google/certificate-transparency-go/client"
+	"github.com/google/certificate-transparency-go/jsonclient"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ctpolicy/loglist"
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/strictyaml"
+)
+
+const (
+	sthAgeName      = "obs_ct_sth_age"
+	sthTreeSizeName = "obs_ct_sth_tree_size"
+	ctReasonName    = "obs_ct_reason"
+)
+
+// CTConf is exported to receive YAML configuration.
+type CTConf struct {
+	LogListFile  string   `yaml:"logListFile"`
+	Logs         []string `yaml:"logs"`
+	RecentChains string   `yaml:"recentChains"`
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Configurer`.
+func (c CTConf) Kind() string {
+	return "CT"
+}
+
+// UnmarshalSettings takes YAML as bytes and unmarshals it to the to a CTConf
+// object.
+func (c CTConf) UnmarshalSettings(settings []byte) (probers.Configurer, error) {
+	var conf CTConf
+	err := strictyaml.Unmarshal(settings, &conf)
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// loadLogs returns the logs named by `logs`, or every RFC 6962 log in
+// `logListFile` which accepts submissions if `logs` is empty. Static CT API
+// logs, which publish checkpoints rather than serving get-sth, are not
+// supported.
+func (c CTConf) loadLogs() ([]ctLog, error) {
+	if c.LogListFile == "" {
+		return nil, fmt.Errorf("invalid 'logListFile', must be provided")
+	}
+	list, err := loglist.New(c.LogListFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'logListFile', got: %q: %s", c.LogListFile, err)
+	}
+
+	names := c.Logs
+	if len(names) == 0 {
+		for _, group := range list {
+			for _, log := range group {
+				if log.Tiled {
+					continue
+				}
+				names = append(names, log.Name)
+			}
+		}
+	}
+	list, err = list.SubsetForPurpose(names, loglist.Informational)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'logs', got: %q: %s", c.Logs, err)
+	}
+
+	var logs []ctLog
+	for _, group := range list {
+		for id, log := range group {
+			if log.Tiled {
+				return nil, fmt.Errorf("invalid 'logs', log %q implements the Static CT API, which is not supported", log.Name)
+			}
+			logID, err := base64.StdEncoding.DecodeString(id)
+			if err != nil {
+				return nil, fmt.Errorf("log %q has invalid id %q: %s", log.Name, id, err)
+			}
+			key, err := base64.StdEncoding.DecodeString(log.Key)
+			if err != nil {
+				return nil, fmt.Errorf("log %q has invalid key: %s", log.Name, err)
+			}
+			client, err := ctClient.New(log.Url, &http.Client{}, jsonclient.Options{
+				PublicKeyDER: key,
+				UserAgent:    "boulder-observer/" + core.GetBuildID(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("making CT client for log %q: %s", log.Name, err)
+			}
+			logs = append(logs, ctLog{
+				name:   log.Name,
+				id:     logID,
+				mmd:    log.MMD,
+				client: client,
+			})
+		}
+	}
+	if len(logs) == 0 {
+		return nil, fmt.Errorf("invalid 'logListFile', got: %q, contains no logs accepting submissions", c.LogListFile)
+	}
+	sort.Slice(logs, func(i, j int) bool { return logs[i].name < logs[j].name })
+	return logs, nil
+}
+
+// MakeProber constructs a `CTProbe` object from the contents of the bound
+// `CTConf` object. If the `CTConf` cannot be validated, an error appropriate
+// for end-user consumption is returned instead.
+func (c CTConf) MakeProber(collectors map[string]prometheus.Collector) (probers.Prober, error) {
+	// Validate `logListFile` and `logs`
+	logs, err := c.loadLogs()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate `recentChains`
+	if c.RecentChains != "" {
+		_, err := filepath.Glob(c.RecentChains)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'recentChains', got: %q: %s", c.RecentChains, err)
+		}
+	}
+
+	// Validate the Prometheus collectors that were passed in
+	coll, ok := collectors[sthAgeName]
+	if !ok {
+		return nil, fmt.Errorf("ct prober did not receive collector %q", sthAgeName)
+	}
+
+	sthAgeColl, ok := coll.(*prometheus.GaugeVec)
+	if !ok {
+		return nil, fmt.Errorf("ct prober received collector %q of wrong type, got: %T, expected *prometheus.GaugeVec", sthAgeName, coll)
+	}
+
+	coll, ok = collectors[sthTreeSizeName]
+	if !ok {
+		return nil, fmt.Errorf("ct prober did not receive collector %q", sthTreeSizeName)
+	}
+
+	sthTreeSizeColl, ok := coll.(*prometheus.GaugeVec)
+	if !ok {
+		return nil, fmt.Errorf("ct prober received collector %q of wrong type, got: %T, expected *prometheus.GaugeVec", sthTreeSizeName, coll)
+	}
+
+	coll, ok = collectors[ctReasonName]
+	if !ok {
+		return nil, fmt.Errorf("ct prober did not receive collector %q", ctReasonName)
+	}
+
+	reasonColl, ok := coll.(*prometheus.CounterVec)
+	if !ok {
+		return nil, fmt.Errorf("ct prober received collector %q of wrong type, got: %T, expected *prometheus.CounterVec", ctReasonName, coll)
+	}
+
+	return CTProbe{
+		logListFile:  c.LogListFile,
+		logs:         logs,
+		recentChains: c.RecentChains,
+		sthAge:       sthAgeColl,
+		sthTreeSize:  sthTreeSizeColl,
+		reason:       reasonColl,
+	}, nil
+}
+
+// Instrument constructs any `prometheus.Collector` objects the `CTProbe` will
+// need to report its own metrics. A map is returned containing the constructed
+// objects, indexed by the name of the Prometheus metric. If no objects were
+// constructed, nil is returned.
+func (c CTConf) Instrument() map[string]prometheus.Collector {
+	sthAge := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: sthAgeName,
+			Help: "Seconds since the timestamp of the log's latest STH",
+		}, []string{"log"},
+	))
+	sthTreeSize := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: sthTreeSizeName,
+			Help: "Tree size of the log's latest STH",
+		}, []string{"log"},
+	))
+	reason := prometheus.Collector(prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: ctReasonName,
+			Help: fmt.Sprintf("Reason for CT Prober check failure. Can be one of %s", getReasons()),
+		}, []string{"log", "reason"},
+	))
+	return map[string]prometheus.Collector{
+		sthAgeName:      sthAge,
+		sthTreeSizeName: sthTreeSize,
+		ctReasonName:    reason,
+	}
+}
+
+// init is called at runtime and registers `CTConf`, a `Prober` `Configurer`
+// type, as "CT".
+func init() {
+	probers.Register(CTConf{})
+}
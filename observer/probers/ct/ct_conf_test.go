@@ -0,0 +1,127 @@
+package probers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/yaml.v3"
+)
+
+const testLogList = "../../../test/ct-test-srv/log_list.json"
+
+func TestCTConf_MakeProber(t *testing.T) {
+	colls := CTConf{}.Instrument()
+	badColl := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "obs_ct_foo",
+			Help: "Hmmm, this shouldn't be here...",
+		},
+		[]string{},
+	))
+	type fields struct {
+		LogListFile  string
+		Logs         []string
+		RecentChains string
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		colls    map[string]prometheus.Collector
+		wantLogs int
+		wantErr  bool
+	}{
+		// valid
+		{"all logs", fields{testLogList, nil, ""}, colls, 9, false},
+		{"some logs", fields{testLogList, []string{"A1 Current", "B1"}, ""}, colls, 2, false},
+		{"pending log", fields{testLogList, []string{"F1"}, ""}, colls, 1, false},
+		{"recent chains", fields{testLogList, nil, "/tmp/chains/*.pem"}, colls, 9, false},
+
+		// invalid logListFile
+		{"no logListFile", fields{"", nil, ""}, colls, 0, true},
+		{"missing logListFile", fields{"/does/not/exist.json", nil, ""}, colls, 0, true},
+
+		// invalid logs
+		{"unknown log", fields{testLogList, []string{"Z1"}, ""}, colls, 0, true},
+		{"only retired log", fields{testLogList, []string{"E1"}, ""}, colls, 0, true},
+
+		// invalid recentChains
+		{"bad recentChains", fields{testLogList, nil, "[-]"}, colls, 0, true},
+
+		// invalid collector
+		{
+			"unexpected collector",
+			fields{testLogList, nil, ""},
+			map[string]prometheus.Collector{"obs_ct_foo": badColl},
+			0,
+			true,
+		},
+		{
+			"missing collectors",
+			fields{testLogList, nil, ""},
+			map[string]prometheus.Collector{},
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := CTConf{
+				LogListFile:  tt.fields.LogListFile,
+				Logs:         tt.fields.Logs,
+				RecentChains: tt.fields.RecentChains,
+			}
+			p, err := c.MakeProber(tt.colls)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CTConf.MakeProber() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && len(p.(CTProbe).logs) != tt.wantLogs {
+				t.Errorf("CTConf.MakeProber() got %d logs, want %d", len(p.(CTProbe).logs), tt.wantLogs)
+			}
+		})
+	}
+}
+
+func TestCTConf_UnmarshalSettings(t *testing.T) {
+	type fields struct {
+		logListFile  interface{}
+		logs         interface{}
+		recentChains interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    probers.Configurer
+		wantErr bool
+	}{
+		{
+			"valid",
+			fields{testLogList, []string{"A1 Current"}, "/tmp/chains/*.pem"},
+			CTConf{testLogList, []string{"A1 Current"}, "/tmp/chains/*.pem"},
+			false,
+		},
+		{"invalid logListFile (map)", fields{make(map[string]interface{}), nil, ""}, nil, true},
+		{"invalid logs (string)", fields{testLogList, "A1 Current", ""}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := probers.Settings{
+				"logListFile":  tt.fields.logListFile,
+				"logs":         tt.fields.logs,
+				"recentChains": tt.fields.recentChains,
+			}
+			settingsBytes, _ := yaml.Marshal(settings)
+			c := CTConf{}
+			got, err := c.UnmarshalSettings(settingsBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CTConf.UnmarshalSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CTConf.UnmarshalSettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
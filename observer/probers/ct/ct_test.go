@@ -0,0 +1,327 @@
+package probers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// leafHash returns the RFC 9162 hash of a leaf containing data.
+func leafHash(data []byte) []byte {
+	h := sha256.Sum256(append([]byte{0}, data...))
+	return h[:]
+}
+
+// referenceRoot and referencePath implement MTH and PATH from RFC 6962,
+// Section 2.1, directly from their recursive definitions.
+func referenceRoot(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	k := largestPowerOfTwoBelow(len(leaves))
+	return hashChildren(referenceRoot(leaves[:k]), referenceRoot(leaves[k:]))
+}
+
+func referencePath(m int, leaves [][]byte) [][]byte {
+	if len(leaves) == 1 {
+		return nil
+	}
+	k := largestPowerOfTwoBelow(len(leaves))
+	if m < k {
+		return append(referencePath(m, leaves[:k]), referenceRoot(leaves[k:]))
+	}
+	return append(referencePath(m-k, leaves[k:]), referenceRoot(leaves[:k]))
+}
+
+func largestPowerOfTwoBelow(n int) int {
+	k := 1
+	for k*2 < n {
+		k *= 2
+	}
+	return k
+}
+
+func TestVerifyInclusion(t *testing.T) {
+	var leaves [][]byte
+	for size := 1; size <= 17; size++ {
+		leaves = append(leaves, leafHash([]byte{byte(size)}))
+		root := referenceRoot(leaves)
+		for index := range leaves {
+			proof := referencePath(index, leaves)
+			err := verifyInclusion(uint64(index), uint64(size), leaves[index], proof, root)
+			test.AssertNotError(t, err, fmt.Sprintf("verifying leaf %d of %d", index, size))
+
+			err = verifyInclusion(uint64(index), uint64(size), leafHash([]byte("other")), proof, root)
+			test.AssertError(t, err, "proof for the wrong leaf should not verify")
+			err = verifyInclusion(uint64(index), uint64(size), leaves[index], append(proof, root), root)
+			test.AssertError(t, err, "proof with extra hashes should not verify")
+		}
+		err := verifyInclusion(uint64(size), uint64(size), leaves[0], nil, root)
+		test.AssertError(t, err, "index beyond the tree should not verify")
+	}
+}
+
+// testLog is a fake CT log serving a three-entry tree, whose middle entry is
+// the precertificate for a leaf certificate with an SCT embedded.
+type testLog struct {
+	key      *ecdsa.PrivateKey
+	chain    []*ctx509.Certificate
+	chainPEM []byte
+	leaves   [][]byte
+}
+
+func newTestLog(t *testing.T, sctTime time.Time) *testLog {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating log key")
+	spki, err := x509.MarshalPKIXPublicKey(key.Public())
+	test.AssertNotError(t, err, "marshaling log key")
+
+	issuerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating issuer key")
+	issuerTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test issuer"},
+		NotBefore:             sctTime.Add(-time.Hour),
+		NotAfter:              sctTime.Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	issuerDER, err := x509.CreateCertificate(rand.Reader, issuerTmpl, issuerTmpl, issuerKey.Public(), issuerKey)
+	test.AssertNotError(t, err, "creating issuer")
+
+	sct := ct.SignedCertificateTimestamp{
+		SCTVersion: ct.V1,
+		LogID:      ct.LogID{KeyID: sha256.Sum256(spki)},
+		Timestamp:  uint64(sctTime.UnixMilli()),
+		Signature: ct.DigitallySigned{
+			Algorithm: cttls.SignatureAndHashAlgorithm{Hash: cttls.SHA256, Signature: cttls.ECDSA},
+			Signature: []byte{0},
+		},
+	}
+	sctBytes, err := cttls.Marshal(sct)
+	test.AssertNotError(t, err, "marshaling SCT")
+	sctList, err := cttls.Marshal(ctx509.SignedCertificateTimestampList{SCTList: []ctx509.SerializedSCT{{Val: sctBytes}}})
+	test.AssertNotError(t, err, "marshaling SCT list")
+	sctExt, err := asn1.Marshal(sctList)
+	test.AssertNotError(t, err, "marshaling SCT list extension")
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating leaf key")
+	leafTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		DNSNames:     []string{"example.com"},
+		NotBefore:    sctTime.Add(-time.Hour),
+		NotAfter:     sctTime.Add(24 * time.Hour),
+		ExtraExtensions: []pkix.Extension{
+			{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}, Value: sctExt},
+		},
+	}
+	issuer, err := x509.ParseCertificate(issuerDER)
+	test.AssertNotError(t, err, "parsing issuer")
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTmpl, issuer, leafKey.Public(), issuerKey)
+	test.AssertNotError(t, err, "creating leaf")
+
+	l := &testLog{key: key}
+	for _, der := range [][]byte{leafDER, issuerDER} {
+		cert, err := ctx509.ParseCertificate(der)
+		test.AssertNotError(t, err, "parsing certificate")
+		l.chain = append(l.chain, cert)
+		l.chainPEM = append(l.chainPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	entry, err := ct.MerkleTreeLeafForEmbeddedSCT(l.chain, sct.Timestamp)
+	test.AssertNotError(t, err, "building Merkle tree leaf")
+	entryHash, err := ct.LeafHashForLeaf(entry)
+	test.AssertNotError(t, err, "hashing Merkle tree leaf")
+	l.leaves = [][]byte{leafHash([]byte("first")), entryHash[:], leafHash([]byte("last"))}
+	return l
+}
+
+// server returns a server implementing get-sth, with an STH signed at sthTime,
+// and get-proof-by-hash. If badProof is true, the returned proof is invalid.
+func (l *testLog) server(t *testing.T, sthTime time.Time, badProof bool) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ct/v1/get-sth", func(w http.ResponseWriter, r *http.Request) {
+		sth := ct.SignedTreeHead{
+			Version:   ct.V1,
+			TreeSize:  uint64(len(l.leaves)),
+			Timestamp: uint64(sthTime.UnixMilli()),
+		}
+		copy(sth.SHA256RootHash[:], referenceRoot(l.leaves))
+		input, err := ct.SerializeSTHSignatureInput(sth)
+		test.AssertNotError(t, err, "serializing STH")
+		digest := sha256.Sum256(input)
+		sig, err := l.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+		test.AssertNotError(t, err, "signing STH")
+		signature, err := cttls.Marshal(cttls.DigitallySigned{
+			Algorithm: cttls.SignatureAndHashAlgorithm{Hash: cttls.SHA256, Signature: cttls.ECDSA},
+			Signature: sig,
+		})
+		test.AssertNotError(t, err, "marshaling STH signature")
+		_ = json.NewEncoder(w).Encode(ct.GetSTHResponse{
+			TreeSize:          sth.TreeSize,
+			Timestamp:         sth.Timestamp,
+			SHA256RootHash:    sth.SHA256RootHash[:],
+			TreeHeadSignature: signature,
+		})
+	})
+	mux.HandleFunc("/ct/v1/get-proof-by-hash", func(w http.ResponseWriter, r *http.Request) {
+		hash, err := base64.StdEncoding.DecodeString(r.URL.Query().Get("hash"))
+		test.AssertNotError(t, err, "decoding hash")
+		test.AssertByteEquals(t, hash, l.leaves[1])
+		proof := referencePath(1, l.leaves)
+		if badProof {
+			proof = proof[:1]
+		}
+		_ = json.NewEncoder(w).Encode(ct.GetProofByHashResponse{LeafIndex: 1, AuditPath: proof})
+	})
+	return httptest.NewServer(mux)
+}
+
+// writeLogList writes a log list containing a single log, served at url, to a
+// temporary directory and returns its path.
+func (l *testLog) writeLogList(t *testing.T, url string) string {
+	t.Helper()
+	spki, err := x509.MarshalPKIXPublicKey(l.key.Public())
+	test.AssertNotError(t, err, "marshaling log key")
+	id := sha256.Sum256(spki)
+	list := map[string]interface{}{
+		"version":            "1.0",
+		"log_list_timestamp": "1970-01-01T00:00:01Z",
+		"operators": []interface{}{
+			map[string]interface{}{
+				"name":  "Operator",
+				"email": []string{"fake@example.org"},
+				"logs": []interface{}{
+					map[string]interface{}{
+						"description": "Test Log",
+						"log_id":      base64.StdEncoding.EncodeToString(id[:]),
+						"key":         base64.StdEncoding.EncodeToString(spki),
+						"url":         url,
+						"mmd":         86400,
+						"state": map[string]interface{}{
+							"usable": map[string]interface{}{"timestamp": "2000-01-01T00:00:00Z"},
+						},
+					},
+				},
+			},
+		},
+	}
+	contents, err := json.Marshal(list)
+	test.AssertNotError(t, err, "marshaling log list")
+	path := filepath.Join(t.TempDir(), "log_list.json")
+	err = os.WriteFile(path, contents, 0644)
+	test.AssertNotError(t, err, "writing log list")
+	return path
+}
+
+func TestCTProbe(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name       string
+		sthTime    time.Time
+		sctTime    time.Time
+		badProof   bool
+		wantReason reason
+	}{
+		{"healthy", now.Add(-time.Minute), now.Add(-48 * time.Hour), false, none},
+		{"stale STH", now.Add(-25 * time.Hour), now.Add(-72 * time.Hour), false, sthTooOld},
+		{"bad inclusion proof", now.Add(-time.Minute), now.Add(-48 * time.Hour), true, inclusionProofFailed},
+		{"SCT within MMD", now.Add(-time.Minute), now.Add(-time.Hour), true, none},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			log := newTestLog(t, tc.sctTime)
+			srv := log.server(t, tc.sthTime, tc.badProof)
+			defer srv.Close()
+
+			chains := t.TempDir()
+			err := os.WriteFile(filepath.Join(chains, "chain.pem"), log.chainPEM, 0644)
+			test.AssertNotError(t, err, "writing chain")
+
+			colls := CTConf{}.Instrument()
+			prober, err := CTConf{
+				LogListFile:  log.writeLogList(t, srv.URL),
+				RecentChains: filepath.Join(chains, "*.pem"),
+			}.MakeProber(colls)
+			test.AssertNotError(t, err, "making prober")
+
+			ok, _ := prober.Probe(5 * time.Second)
+			test.AssertEquals(t, ok, tc.wantReason == none)
+			test.AssertMetricWithLabelsEquals(t, colls[ctReasonName].(*prometheus.CounterVec), prometheus.Labels{
+				"log": "Test Log", "reason": reasonToString[tc.wantReason],
+			}, 1)
+			test.AssertMetricWithLabelsEquals(t, colls[sthTreeSizeName].(*prometheus.GaugeVec), prometheus.Labels{"log": "Test Log"}, 3)
+		})
+	}
+}
+
+func TestCTProbeUnavailable(t *testing.T) {
+	log := newTestLog(t, time.Now())
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	colls := CTConf{}.Instrument()
+	prober, err := CTConf{LogListFile: log.writeLogList(t, srv.URL)}.MakeProber(colls)
+	test.AssertNotError(t, err, "making prober")
+
+	ok, _ := prober.Probe(time.Second)
+	test.Assert(t, !ok, "probe of a log returning 404s should fail")
+	test.AssertMetricWithLabelsEquals(t, colls[ctReasonName].(*prometheus.CounterVec), prometheus.Labels{
+		"log": "Test Log", "reason": "sthUnavailable",
+	}, 1)
+}
+
+func TestCTProbeCleanup(t *testing.T) {
+	colls := CTConf{}.Instrument()
+	treeSize := colls[sthTreeSizeName].(*prometheus.GaugeVec)
+	newProbe := func(names ...string) CTProbe {
+		p := CTProbe{
+			sthAge:      colls[sthAgeName].(*prometheus.GaugeVec),
+			sthTreeSize: treeSize,
+			reason:      colls[ctReasonName].(*prometheus.CounterVec),
+		}
+		for _, name := range names {
+			p.logs = append(p.logs, ctLog{name: name})
+		}
+		return p
+	}
+	removed := newProbe("A", "B")
+	remaining := newProbe("B")
+	treeSize.WithLabelValues("A").Set(3)
+	treeSize.WithLabelValues("B").Set(3)
+
+	// Only the series of the log which no remaining probe checks are deleted.
+	removed.Cleanup([]probers.Prober{remaining})
+	test.AssertMetricWithLabelsEquals(t, treeSize, prometheus.Labels{"log": "A"}, 0)
+	test.AssertMetricWithLabelsEquals(t, treeSize, prometheus.Labels{"log": "B"}, 3)
+
+	remaining.Cleanup(nil)
+	test.AssertMetricWithLabelsEquals(t, treeSize, prometheus.Labels{"log": "B"}, 0)
+}
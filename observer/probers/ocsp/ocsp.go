@@ -0,0 +1,150 @@
+package probers
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/ocsp"
+)
+
+type reason int
+
+const (
+	none reason = iota
+	requestFailed
+	invalidResponse
+	serialDidNotMatch
+	statusDidNotMatch
+	stale
+)
+
+var reasonToString = map[reason]string{
+	none:              "nil",
+	requestFailed:     "requestFailed",
+	invalidResponse:   "invalidResponse",
+	serialDidNotMatch: "serialDidNotMatch",
+	statusDidNotMatch: "statusDidNotMatch",
+	stale:             "stale",
+}
+
+func getReasons() []string {
+	var allReasons []string
+	for _, v := range reasonToString {
+		allReasons = append(allReasons, v)
+	}
+	return allReasons
+}
+
+// OCSPProbe is the exported `Prober` object for monitors configured to check
+// the responses of an OCSP responder.
+type OCSPProbe struct {
+	url          string
+	serial       string
+	request      []byte
+	issuer       *x509.Certificate
+	expectStatus int
+	maxAge       time.Duration
+	responseAge  *prometheus.GaugeVec
+	timeToExpiry *prometheus.GaugeVec
+	reason       *prometheus.CounterVec
+}
+
+// Name returns a string that uniquely identifies the monitor.
+func (p OCSPProbe) Name() string {
+	return fmt.Sprintf("%s-%s", p.url, p.serial)
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Prober`.
+func (p OCSPProbe) Kind() string {
+	return "OCSP"
+}
+
+// Cleanup deletes the series reported for the configured certificate.
+func (p OCSPProbe) Cleanup(_ []probers.Prober) {
+	labels := prometheus.Labels{"url": p.url, "serial": p.serial}
+	p.responseAge.DeletePartialMatch(labels)
+	p.timeToExpiry.DeletePartialMatch(labels)
+	p.reason.DeletePartialMatch(labels)
+}
+
+// Probe requests the OCSP status of the configured certificate using both GET
+// and POST. It succeeds only if both responses are correctly signed, fresh,
+// and have the expected status.
+func (p OCSPProbe) Probe(timeout time.Duration) (bool, time.Duration) {
+	start := time.Now()
+	client := http.Client{Timeout: timeout}
+
+	ok := true
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		r := p.check(client, method)
+		p.reason.WithLabelValues(p.url, p.serial, method, reasonToString[r]).Inc()
+		if r != none {
+			ok = false
+		}
+	}
+	return ok, time.Since(start)
+}
+
+// check makes a single OCSP request using the given method, reports the age
+// and time to expiry of the response, and returns the reason it is
+// unacceptable, if any.
+func (p OCSPProbe) check(client http.Client, method string) reason {
+	var resp *http.Response
+	var err error
+	switch method {
+	case http.MethodGet:
+		resp, err = client.Get(p.url + "/" + url.PathEscape(base64.StdEncoding.EncodeToString(p.request)))
+	case http.MethodPost:
+		resp, err = client.Post(p.url, "application/ocsp-request", bytes.NewReader(p.request))
+	}
+	if err != nil {
+		return requestFailed
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return requestFailed
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return requestFailed
+	}
+
+	// ParseResponse verifies the signature of the response against the
+	// issuer, or against a delegated responder certificate issued by it.
+	parsed, err := ocsp.ParseResponse(body, p.issuer)
+	if err != nil {
+		return invalidResponse
+	}
+	return p.checkResponse(parsed, method, time.Now())
+}
+
+// checkResponse reports the age and time to expiry of a parsed response, and
+// returns the reason it is unacceptable, if any.
+func (p OCSPProbe) checkResponse(resp *ocsp.Response, method string, now time.Time) reason {
+	age := now.Sub(resp.ThisUpdate)
+	p.responseAge.WithLabelValues(p.url, p.serial, method).Set(age.Seconds())
+	p.timeToExpiry.WithLabelValues(p.url, p.serial, method).Set(resp.NextUpdate.Sub(now).Seconds())
+
+	if resp.SerialNumber == nil || core.SerialToString(resp.SerialNumber) != p.serial {
+		return serialDidNotMatch
+	}
+	if resp.Status != p.expectStatus {
+		return statusDidNotMatch
+	}
+	if resp.ThisUpdate.After(now) || resp.NextUpdate.IsZero() || !resp.NextUpdate.After(now) {
+		return stale
+	}
+	if p.maxAge > 0 && age > p.maxAge {
+		return stale
+	}
+	return none
+}
@@ -0,0 +1,227 @@
+package probers
+
+import (
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/letsencrypt/boulder/strictyaml"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	responseAgeName  = "obs_ocsp_response_age"
+	timeToExpiryName = "obs_ocsp_time_to_expiry"
+	ocspReasonName   = "obs_ocsp_reason"
+)
+
+// OCSPConf is exported to receive YAML configuration.
+type OCSPConf struct {
+	URL          string          `yaml:"url"`
+	CertFile     string          `yaml:"certFile"`
+	Serial       string          `yaml:"serial"`
+	IssuerFile   string          `yaml:"issuerFile"`
+	ExpectStatus string          `yaml:"expectStatus"`
+	MaxAge       config.Duration `yaml:"maxAge"`
+}
+
+// Kind returns a name that uniquely identifies the `Kind` of `Configurer`.
+func (c OCSPConf) Kind() string {
+	return "OCSP"
+}
+
+// UnmarshalSettings takes YAML as bytes and unmarshals it to the to an
+// OCSPConf object.
+func (c OCSPConf) UnmarshalSettings(settings []byte) (probers.Configurer, error) {
+	var conf OCSPConf
+	err := strictyaml.Unmarshal(settings, &conf)
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// loadCerts returns the certificate (or a stand-in holding only its serial)
+// and its issuer.
+func (c OCSPConf) loadCerts() (*x509.Certificate, *x509.Certificate, error) {
+	if c.IssuerFile == "" {
+		return nil, nil, fmt.Errorf("invalid 'issuerFile', must be provided")
+	}
+	issuer, err := core.LoadCert(c.IssuerFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid 'issuerFile', got: %q: %s", c.IssuerFile, err)
+	}
+
+	if (c.CertFile == "") == (c.Serial == "") {
+		return nil, nil, fmt.Errorf("exactly one of 'certFile' or 'serial' must be provided")
+	}
+
+	if c.CertFile != "" {
+		cert, err := core.LoadCert(c.CertFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid 'certFile', got: %q: %s", c.CertFile, err)
+		}
+		err = cert.CheckSignatureFrom(issuer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid 'certFile', got: %q, not issued by 'issuerFile': %s", c.CertFile, err)
+		}
+		return cert, issuer, nil
+	}
+
+	serial, ok := new(big.Int).SetString(strings.ReplaceAll(c.Serial, ":", ""), 16)
+	if !ok || serial.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("invalid 'serial', got: %q, expected a positive hex-encoded integer", c.Serial)
+	}
+	return &x509.Certificate{SerialNumber: serial}, issuer, nil
+}
+
+// responderURL returns the configured `url`, falling back to the OCSP URL in
+// the certificate's AIA extension.
+func (c OCSPConf) responderURL(cert *x509.Certificate) (string, error) {
+	responder := c.URL
+	if responder == "" {
+		if len(cert.OCSPServer) == 0 {
+			return "", fmt.Errorf("invalid 'url', must be provided if the certificate has no OCSP URL")
+		}
+		responder = cert.OCSPServer[0]
+	}
+	url, err := url.Parse(responder)
+	if err != nil {
+		return "", fmt.Errorf(
+			"invalid 'url', got: %q, expected a valid url", responder)
+	}
+	if url.Scheme != "http" && url.Scheme != "https" {
+		return "", fmt.Errorf(
+			"invalid 'url', got: %q, scheme must be http or https", responder)
+	}
+	return strings.TrimSuffix(responder, "/"), nil
+}
+
+func (c OCSPConf) validateExpectStatus() (int, error) {
+	switch strings.ToLower(c.ExpectStatus) {
+	case "good":
+		return ocsp.Good, nil
+	case "revoked":
+		return ocsp.Revoked, nil
+	}
+	return 0, fmt.Errorf(
+		"invalid 'expectStatus', got %q. Must be one of %s", c.ExpectStatus, []string{"good", "revoked"})
+}
+
+// MakeProber constructs an `OCSPProbe` object from the contents of the bound
+// `OCSPConf` object. If the `OCSPConf` cannot be validated, an error
+// appropriate for end-user consumption is returned instead.
+func (c OCSPConf) MakeProber(collectors map[string]prometheus.Collector) (probers.Prober, error) {
+	// Validate `certFile`, `serial` and `issuerFile`
+	cert, issuer, err := c.loadCerts()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate `url`
+	responder, err := c.responderURL(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate `expectStatus`
+	expectStatus, err := c.validateExpectStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate `maxAge`
+	if c.MaxAge.Duration < 0 {
+		return nil, fmt.Errorf("invalid 'maxAge', got: %s, must not be negative", c.MaxAge.Duration)
+	}
+
+	req, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating OCSP request: %s", err)
+	}
+
+	// Validate the Prometheus collectors that were passed in
+	coll, ok := collectors[responseAgeName]
+	if !ok {
+		return nil, fmt.Errorf("ocsp prober did not receive collector %q", responseAgeName)
+	}
+
+	responseAgeColl, ok := coll.(*prometheus.GaugeVec)
+	if !ok {
+		return nil, fmt.Errorf("ocsp prober received collector %q of wrong type, got: %T, expected *prometheus.GaugeVec", responseAgeName, coll)
+	}
+
+	coll, ok = collectors[timeToExpiryName]
+	if !ok {
+		return nil, fmt.Errorf("ocsp prober did not receive collector %q", timeToExpiryName)
+	}
+
+	timeToExpiryColl, ok := coll.(*prometheus.GaugeVec)
+	if !ok {
+		return nil, fmt.Errorf("ocsp prober received collector %q of wrong type, got: %T, expected *prometheus.GaugeVec", timeToExpiryName, coll)
+	}
+
+	coll, ok = collectors[ocspReasonName]
+	if !ok {
+		return nil, fmt.Errorf("ocsp prober did not receive collector %q", ocspReasonName)
+	}
+
+	reasonColl, ok := coll.(*prometheus.CounterVec)
+	if !ok {
+		return nil, fmt.Errorf("ocsp prober received collector %q of wrong type, got: %T, expected *prometheus.CounterVec", ocspReasonName, coll)
+	}
+
+	return OCSPProbe{
+		url:          responder,
+		serial:       core.SerialToString(cert.SerialNumber),
+		request:      req,
+		issuer:       issuer,
+		expectStatus: expectStatus,
+		maxAge:       c.MaxAge.Duration,
+		responseAge:  responseAgeColl,
+		timeToExpiry: timeToExpiryColl,
+		reason:       reasonColl,
+	}, nil
+}
+
+// Instrument constructs any `prometheus.Collector` objects the `OCSPProbe` will
+// need to report its own metrics. A map is returned containing the constructed
+// objects, indexed by the name of the Prometheus metric. If no objects were
+// constructed, nil is returned.
+func (c OCSPConf) Instrument() map[string]prometheus.Collector {
+	responseAge := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: responseAgeName,
+			Help: "Seconds since the thisUpdate of the OCSP response",
+		}, []string{"url", "serial", "method"},
+	))
+	timeToExpiry := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: timeToExpiryName,
+			Help: "Seconds until the nextUpdate of the OCSP response",
+		}, []string{"url", "serial", "method"},
+	))
+	reason := prometheus.Collector(prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: ocspReasonName,
+			Help: fmt.Sprintf("Reason for OCSP Prober check failure. Can be one of %s", getReasons()),
+		}, []string{"url", "serial", "method", "reason"},
+	))
+	return map[string]prometheus.Collector{
+		responseAgeName:  responseAge,
+		timeToExpiryName: timeToExpiry,
+		ocspReasonName:   reason,
+	}
+}
+
+// init is called at runtime and registers `OCSPConf`, a `Prober` `Configurer`
+// type, as "OCSP".
+func init() {
+	probers.Register(OCSPConf{})
+}
@@ -0,0 +1,148 @@
+package probers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/observer/probers"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	goodCert   = "../../../test/hierarchy/ee-r3.cert.pem"
+	goodIssuer = "../../../test/hierarchy/int-r3.cert.pem"
+	badIssuer  = "../../../test/hierarchy/int-e1.cert.pem"
+)
+
+func TestOCSPConf_MakeProber(t *testing.T) {
+	goodURL, goodStatus := "http://ocsp.example.com", "good"
+	colls := OCSPConf{}.Instrument()
+	badColl := prometheus.Collector(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "obs_ocsp_foo",
+			Help: "Hmmm, this shouldn't be here...",
+		},
+		[]string{},
+	))
+	type fields struct {
+		URL          string
+		CertFile     string
+		Serial       string
+		IssuerFile   string
+		ExpectStatus string
+		MaxAge       time.Duration
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		colls   map[string]prometheus.Collector
+		wantErr bool
+	}{
+		// valid
+		{"valid cert", fields{goodURL, goodCert, "", goodIssuer, goodStatus, 0}, colls, false},
+		{"valid serial", fields{goodURL, "", "1d72443db5189821", goodIssuer, "Revoked", time.Hour}, colls, false},
+		{"valid serial with colons", fields{goodURL, "", "1d:72:44:3d:b5:18:98:21", goodIssuer, goodStatus, 0}, colls, false},
+
+		// invalid url
+		{"bad url", fields{":::::", goodCert, "", goodIssuer, goodStatus, 0}, colls, true},
+		{"url without scheme", fields{"ocsp.example.com", goodCert, "", goodIssuer, goodStatus, 0}, colls, true},
+		{"no url and no AIA", fields{"", goodCert, "", goodIssuer, goodStatus, 0}, colls, true},
+
+		// invalid certificate
+		{"neither cert nor serial", fields{goodURL, "", "", goodIssuer, goodStatus, 0}, colls, true},
+		{"both cert and serial", fields{goodURL, goodCert, "1d72443db5189821", goodIssuer, goodStatus, 0}, colls, true},
+		{"missing cert", fields{goodURL, "/does/not/exist", "", goodIssuer, goodStatus, 0}, colls, true},
+		{"bad serial", fields{goodURL, "", "zzz", goodIssuer, goodStatus, 0}, colls, true},
+		{"zero serial", fields{goodURL, "", "00", goodIssuer, goodStatus, 0}, colls, true},
+
+		// invalid issuer
+		{"no issuer", fields{goodURL, goodCert, "", "", goodStatus, 0}, colls, true},
+		{"wrong issuer", fields{goodURL, goodCert, "", badIssuer, goodStatus, 0}, colls, true},
+
+		// invalid expectStatus
+		{"empty expectStatus", fields{goodURL, goodCert, "", goodIssuer, "", 0}, colls, true},
+		{"unknown expectStatus", fields{goodURL, goodCert, "", goodIssuer, "unknown", 0}, colls, true},
+
+		// invalid maxAge
+		{"negative maxAge", fields{goodURL, goodCert, "", goodIssuer, goodStatus, -time.Hour}, colls, true},
+
+		// invalid collector
+		{
+			"unexpected collector",
+			fields{goodURL, goodCert, "", goodIssuer, goodStatus, 0},
+			map[string]prometheus.Collector{"obs_ocsp_foo": badColl},
+			true,
+		},
+		{
+			"missing collectors",
+			fields{goodURL, goodCert, "", goodIssuer, goodStatus, 0},
+			map[string]prometheus.Collector{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := OCSPConf{
+				URL:          tt.fields.URL,
+				CertFile:     tt.fields.CertFile,
+				Serial:       tt.fields.Serial,
+				IssuerFile:   tt.fields.IssuerFile,
+				ExpectStatus: tt.fields.ExpectStatus,
+				MaxAge:       config.Duration{Duration: tt.fields.MaxAge},
+			}
+			if _, err := c.MakeProber(tt.colls); (err != nil) != tt.wantErr {
+				t.Errorf("OCSPConf.MakeProber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOCSPConf_UnmarshalSettings(t *testing.T) {
+	type fields struct {
+		url          interface{}
+		certFile     interface{}
+		issuerFile   interface{}
+		expectStatus interface{}
+		maxAge       interface{}
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    probers.Configurer
+		wantErr bool
+	}{
+		{
+			"valid",
+			fields{"http://ocsp.example.com", goodCert, goodIssuer, "good", "12h"},
+			OCSPConf{"http://ocsp.example.com", goodCert, "", goodIssuer, "good", config.Duration{Duration: 12 * time.Hour}},
+			false,
+		},
+		{"invalid url (map)", fields{make(map[string]interface{}), goodCert, goodIssuer, "good", "12h"}, nil, true},
+		{"invalid expectStatus (list)", fields{"http://ocsp.example.com", goodCert, goodIssuer, make([]string, 0), "12h"}, nil, true},
+		{"invalid maxAge", fields{"http://ocsp.example.com", goodCert, goodIssuer, "good", "forever"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := probers.Settings{
+				"url":          tt.fields.url,
+				"certFile":     tt.fields.certFile,
+				"issuerFile":   tt.fields.issuerFile,
+				"expectStatus": tt.fields.expectStatus,
+				"maxAge":       tt.fields.maxAge,
+			}
+			settingsBytes, _ := yaml.Marshal(settings)
+			c := OCSPConf{}
+			got, err := c.UnmarshalSettings(settingsBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OCSPConf.UnmarshalSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OCSPConf.UnmarshalSettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
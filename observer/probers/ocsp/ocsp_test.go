@@ -0,0 +1,176 @@
+package probers
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// ocspServer answers OCSP requests, made using either GET or POST, with a
+// response signed by signer and built from template.
+func ocspServer(t *testing.T, issuer *x509.Certificate, signer crypto.Signer, template *ocsp.Response) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var der []byte
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+			var encoded string
+			encoded, err = url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/"))
+			if err == nil {
+				der, err = base64.StdEncoding.DecodeString(encoded)
+			}
+		case http.MethodPost:
+			test.AssertEquals(t, r.Header.Get("Content-Type"), "application/ocsp-request")
+			der, err = io.ReadAll(r.Body)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		req, err := ocsp.ParseRequest(der)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		tmpl := *template
+		tmpl.SerialNumber = req.SerialNumber
+		resp, err := ocsp.CreateResponse(issuer, issuer, tmpl, signer)
+		test.AssertNotError(t, err, "creating OCSP response")
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(resp)
+	}))
+}
+
+func TestOCSPProbe(t *testing.T) {
+	issuer, err := core.LoadCert(goodIssuer)
+	test.AssertNotError(t, err, "loading issuer")
+	issuerKey, err := test.LoadSigner("../../../test/hierarchy/int-r3.key.pem")
+	test.AssertNotError(t, err, "loading issuer key")
+	otherKey, err := test.LoadSigner("../../../test/hierarchy/int-e1.key.pem")
+	test.AssertNotError(t, err, "loading other key")
+
+	now := time.Now()
+	fresh := &ocsp.Response{Status: ocsp.Good, ThisUpdate: now.Add(-time.Hour), NextUpdate: now.Add(time.Hour)}
+	expired := &ocsp.Response{Status: ocsp.Good, ThisUpdate: now.Add(-2 * time.Hour), NextUpdate: now.Add(-time.Hour)}
+	revoked := &ocsp.Response{Status: ocsp.Revoked, RevokedAt: now.Add(-time.Hour), ThisUpdate: now.Add(-time.Hour), NextUpdate: now.Add(time.Hour)}
+
+	testCases := []struct {
+		name         string
+		signer       crypto.Signer
+		template     *ocsp.Response
+		expectStatus string
+		maxAge       time.Duration
+		wantReason   reason
+	}{
+		{"good", issuerKey, fresh, "good", 0, none},
+		{"revoked", issuerKey, revoked, "revoked", 0, none},
+		{"unexpected status", issuerKey, fresh, "revoked", 0, statusDidNotMatch},
+		{"expired", issuerKey, expired, "good", 0, stale},
+		{"older than maxAge", issuerKey, fresh, "good", 30 * time.Minute, stale},
+		{"bad signature", otherKey, fresh, "good", 0, invalidResponse},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := ocspServer(t, issuer, tc.signer, tc.template)
+			defer srv.Close()
+
+			colls := OCSPConf{}.Instrument()
+			prober, err := OCSPConf{
+				URL:          srv.URL,
+				CertFile:     goodCert,
+				IssuerFile:   goodIssuer,
+				ExpectStatus: tc.expectStatus,
+				MaxAge:       config.Duration{Duration: tc.maxAge},
+			}.MakeProber(colls)
+			test.AssertNotError(t, err, "making prober")
+
+			ok, _ := prober.Probe(time.Second)
+			test.AssertEquals(t, ok, tc.wantReason == none)
+
+			reasons := colls[ocspReasonName].(*prometheus.CounterVec)
+			for _, method := range []string{http.MethodGet, http.MethodPost} {
+				test.AssertMetricWithLabelsEquals(t, reasons, prometheus.Labels{"method": method, "reason": reasonToString[tc.wantReason]}, 1)
+			}
+		})
+	}
+}
+
+func TestOCSPProbeUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	colls := OCSPConf{}.Instrument()
+	prober, err := OCSPConf{
+		URL:          srv.URL,
+		Serial:       "1d72443db5189821",
+		IssuerFile:   goodIssuer,
+		ExpectStatus: "good",
+	}.MakeProber(colls)
+	test.AssertNotError(t, err, "making prober")
+
+	ok, _ := prober.Probe(time.Second)
+	test.Assert(t, !ok, "probe of a responder returning 404s should fail")
+	test.AssertMetricWithLabelsEquals(t, colls[ocspReasonName].(*prometheus.CounterVec), prometheus.Labels{"reason": "requestFailed"}, 2)
+}
+
+func TestOCSPProbeMetrics(t *testing.T) {
+	colls := OCSPConf{}.Instrument()
+	prober, err := OCSPConf{
+		URL:          "http://ocsp.example.com",
+		CertFile:     goodCert,
+		IssuerFile:   goodIssuer,
+		ExpectStatus: "good",
+	}.MakeProber(colls)
+	test.AssertNotError(t, err, "making prober")
+	p := prober.(OCSPProbe)
+
+	cert, err := core.LoadCert(goodCert)
+	test.AssertNotError(t, err, "loading certificate")
+	now := time.Now()
+	r := p.checkResponse(&ocsp.Response{
+		SerialNumber: cert.SerialNumber,
+		Status:       ocsp.Good,
+		ThisUpdate:   now.Add(-time.Hour),
+		NextUpdate:   now.Add(2 * time.Hour),
+	}, http.MethodGet, now)
+	test.AssertEquals(t, r, none)
+	labels := prometheus.Labels{"url": p.url, "serial": p.serial, "method": http.MethodGet}
+	test.AssertMetricWithLabelsEquals(t, colls[responseAgeName].(*prometheus.GaugeVec), labels, time.Hour.Seconds())
+	test.AssertMetricWithLabelsEquals(t, colls[timeToExpiryName].(*prometheus.GaugeVec), labels, (2 * time.Hour).Seconds())
+
+	r = p.checkResponse(&ocsp.Response{
+		SerialNumber: cert.SerialNumber,
+		Status:       ocsp.Good,
+		ThisUpdate:   now.Add(time.Hour),
+		NextUpdate:   now.Add(2 * time.Hour),
+	}, http.MethodGet, now)
+	test.AssertEquals(t, r, stale)
+
+	r = p.checkResponse(&ocsp.Response{
+		SerialNumber: big.NewInt(1),
+		Status:       ocsp.Good,
+		ThisUpdate:   now.Add(-time.Hour),
+		NextUpdate:   now.Add(2 * time.Hour),
+	}, http.MethodGet, now)
+	test.AssertEquals(t, r, serialDidNotMatch)
+
+	p.Cleanup(nil)
+	test.AssertMetricWithLabelsEquals(t, colls[responseAgeName].(*prometheus.GaugeVec), labels, 0)
+	test.AssertMetricWithLabelsEquals(t, colls[timeToExpiryName].(*prometheus.GaugeVec), labels, 0)
+}
@@ -31,6 +31,17 @@ type Prober interface {
 	Probe(time.Duration) (bool, time.Duration)
 }
 
+// Cleaner is an optional interface for `Prober` types which report
+// Prometheus series of their own.
+type Cleaner interface {
+	// Cleanup deletes the series reported by this `Prober`. It's called
+	// when the monitor that configured the `Prober` is removed by a
+	// reload, so that the series don't linger at their last values.
+	// `remaining` are the `Prober`s of the monitors still running, whose
+	// series must be left in place.
+	Cleanup(remaining []Prober)
+}
+
 // Configurer is the interface for `Configurer` types.
 type Configurer interface {
 	// Kind returns a name that uniquely identifies the `Kind` of
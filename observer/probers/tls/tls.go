@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/letsencrypt/boulder/observer/obsdialer"
+	"github.com/letsencrypt/boulder/observer/probers"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/crypto/ocsp"
 )
@@ -64,6 +65,13 @@ func (p TLSProbe) Kind() string {
 	return "TLS"
 }
 
+// Cleanup deletes the series reported for the configured hostname.
+func (p TLSProbe) Cleanup(_ []probers.Prober) {
+	p.notAfter.DeleteLabelValues(p.hostname)
+	p.notBefore.DeleteLabelValues(p.hostname)
+	p.reason.DeletePartialMatch(prometheus.Labels{"hostname": p.hostname})
+}
+
 // Get OCSP status (good, revoked or unknown) of certificate
 func checkOCSP(cert, issuer *x509.Certificate, want int) (bool, error) {
 	req, err := ocsp.CreateRequest(cert, issuer, nil)
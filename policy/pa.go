@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"net/mail"
+	"net/netip"
 	"os"
 	"regexp"
 	"slices"
@@ -31,16 +32,23 @@ type AuthorityImpl struct {
 	blocklist              map[string]bool
 	exactBlocklist         map[string]bool
 	wildcardExactBlocklist map[string]bool
+	ipBlocklist            []netip.Prefix
 	blocklistMu            sync.RWMutex
 
-	enabledChallenges map[core.AcmeChallenge]bool
+	enabledChallenges  map[core.AcmeChallenge]bool
+	enabledIdentifiers map[identifier.IdentifierType]bool
 }
 
-// New constructs a Policy Authority.
-func New(challengeTypes map[core.AcmeChallenge]bool, log blog.Logger) (*AuthorityImpl, error) {
+// New constructs a Policy Authority. If identifierTypes is empty, only DNS
+// identifiers are enabled.
+func New(identifierTypes map[identifier.IdentifierType]bool, challengeTypes map[core.AcmeChallenge]bool, log blog.Logger) (*AuthorityImpl, error) {
+	if len(identifierTypes) == 0 {
+		identifierTypes = map[identifier.IdentifierType]bool{identifier.TypeDNS: true}
+	}
 	return &AuthorityImpl{
-		log:               log,
-		enabledChallenges: challengeTypes,
+		log:                log,
+		enabledChallenges:  challengeTypes,
+		enabledIdentifiers: identifierTypes,
 	}, nil
 }
 
@@ -64,6 +72,12 @@ type blockedNamesPolicy struct {
 	// time above and beyond the high-risk domains. Managing these entries separately
 	// from HighRiskBlockedNames makes it easier to vet changes accurately.
 	AdminBlockedNames []string `yaml:"AdminBlockedNames"`
+
+	// AdminBlockedPrefixes is a list of IP address prefixes in CIDR notation.
+	// Issuance for IP identifiers contained in any of these prefixes will be
+	// forbidden. (e.g. `AdminBlockedPrefixes` containing `192.0.2.0/24` will
+	// block `192.0.2.53`).
+	AdminBlockedPrefixes []string `yaml:"AdminBlockedPrefixes"`
 }
 
 // LoadHostnamePolicyFile will load the given policy file, returning an error if
@@ -123,10 +137,20 @@ func (pa *AuthorityImpl) processHostnamePolicy(policy blockedNamesPolicy) error
 		// wildcardNameMap to block issuance for `*.`+parts[1]
 		wildcardNameMap[parts[1]] = true
 	}
+	var prefixes []netip.Prefix
+	for _, v := range policy.AdminBlockedPrefixes {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return fmt.Errorf(
+				"Malformed AdminBlockedPrefixes entry, not a prefix: %q", v)
+		}
+		prefixes = append(prefixes, prefix)
+	}
 	pa.blocklistMu.Lock()
 	pa.blocklist = nameMap
 	pa.exactBlocklist = exactNameMap
 	pa.wildcardExactBlocklist = wildcardNameMap
+	pa.ipBlocklist = prefixes
 	pa.blocklistMu.Unlock()
 	return nil
 }
@@ -180,6 +204,11 @@ var (
 	errMalformedWildcard    = berrors.MalformedError("Domain name contains an invalid wildcard. A wildcard is only permitted before the first dot in a domain name")
 	errICANNTLDWildcard     = berrors.MalformedError("Domain name is a wildcard for an ICANN TLD")
 	errWildcardNotSupported = berrors.MalformedError("Wildcard domain names are not supported")
+	errUnsupportedIdent     = berrors.MalformedError("Invalid identifier type")
+	errIPInvalid            = berrors.MalformedError("IP address is invalid")
+	errIPNotCanonical       = berrors.MalformedError("IP address is not in its canonical textual form")
+	errIPReserved           = berrors.RejectedIdentifierError("IP address is in a reserved address block")
+	errIPPolicyForbidden    = berrors.RejectedIdentifierError("The ACME server refuses to issue a certificate for this IP address, because it is forbidden by policy")
 )
 
 // validNonWildcardDomain checks that a domain isn't:
@@ -318,6 +347,29 @@ func ValidDomain(domain string) error {
 	return validNonWildcardDomain(baseDomain)
 }
 
+// ValidIP checks that an IP address:
+//   - parses as an IPv4 or IPv6 address
+//   - is written in its canonical textual form (RFC 8738, Section 3)
+//   - isn't in an IANA special-purpose address block
+//
+// It does NOT ensure that the IP address is absent from any PA blocked lists.
+func ValidIP(ip string) error {
+	parsedIP, err := netip.ParseAddr(ip)
+	if err != nil {
+		return errIPInvalid
+	}
+	if parsedIP.Zone() != "" || parsedIP.Is4In6() {
+		return errIPInvalid
+	}
+	if parsedIP.String() != ip {
+		return errIPNotCanonical
+	}
+	if iana.IsReservedAddr(parsedIP) != nil {
+		return errIPReserved
+	}
+	return nil
+}
+
 // forbiddenMailDomains is a map of domain names we do not allow after the
 // @ symbol in contact mailto addresses. These are frequently used when
 // copy-pasting example configurations and would not result in expiration
@@ -353,16 +405,16 @@ func ValidEmail(address string) error {
 }
 
 // subError returns an appropriately typed error based on the input error
-func subError(name string, err error) berrors.SubBoulderError {
+func subError(ident identifier.ACMEIdentifier, err error) berrors.SubBoulderError {
 	var bErr *berrors.BoulderError
 	if errors.As(err, &bErr) {
 		return berrors.SubBoulderError{
-			Identifier:   identifier.NewDNS(name),
+			Identifier:   ident,
 			BoulderError: bErr,
 		}
 	} else {
 		return berrors.SubBoulderError{
-			Identifier: identifier.NewDNS(name),
+			Identifier: ident,
 			BoulderError: &berrors.BoulderError{
 				Type:   berrors.RejectedIdentifier,
 				Detail: err.Error(),
@@ -372,23 +424,38 @@ func subError(name string, err error) berrors.SubBoulderError {
 }
 
 // WillingToIssue determines whether the CA is willing to issue for the provided
-// domain names.
+// identifiers.
 //
-// It checks the criteria checked by `WellFormedDomainNames`, and additionally checks
-// whether any domain is on a blocklist.
+// It checks the criteria checked by `WellFormedIdentifiers`, and additionally
+// checks whether any identifier type is disabled, and whether any identifier
+// is on a blocklist.
 //
-// If multiple domains are invalid, the error will contain suberrors specific to
-// each domain.
+// If multiple identifiers are invalid, the error will contain suberrors
+// specific to each identifier.
 //
-// Precondition: all input domain names must be in lowercase.
-func (pa *AuthorityImpl) WillingToIssue(domains []string) error {
-	err := WellFormedDomainNames(domains)
+// Precondition: all input DNS identifier values must be in lowercase.
+func (pa *AuthorityImpl) WillingToIssue(idents identifier.ACMEIdentifiers) error {
+	err := WellFormedIdentifiers(idents)
 	if err != nil {
 		return err
 	}
 
 	var subErrors []berrors.SubBoulderError
-	for _, domain := range domains {
+	for _, ident := range idents {
+		if !pa.IdentifierTypeEnabled(ident.Type) {
+			subErrors = append(subErrors, subError(ident, berrors.RejectedIdentifierError("The ACME server has disabled this identifier type")))
+			continue
+		}
+
+		if ident.Type == identifier.TypeIP {
+			err := pa.checkIPLists(ident.Value)
+			if err != nil {
+				subErrors = append(subErrors, subError(ident, err))
+			}
+			continue
+		}
+
+		domain := ident.Value
 		if strings.Count(domain, "*") > 0 {
 			// The base domain is the wildcard request with the `*.` prefix removed
 			baseDomain := strings.TrimPrefix(domain, "*.")
@@ -396,7 +463,7 @@ func (pa *AuthorityImpl) WillingToIssue(domains []string) error {
 			// The base domain can't be in the wildcard exact blocklist
 			err = pa.checkWildcardHostList(baseDomain)
 			if err != nil {
-				subErrors = append(subErrors, subError(domain, err))
+				subErrors = append(subErrors, subError(ident, err))
 				continue
 			}
 		}
@@ -405,20 +472,22 @@ func (pa *AuthorityImpl) WillingToIssue(domains []string) error {
 		// name is on the regular blocklist.
 		err := pa.checkHostLists(domain)
 		if err != nil {
-			subErrors = append(subErrors, subError(domain, err))
+			subErrors = append(subErrors, subError(ident, err))
 			continue
 		}
 	}
 	return combineSubErrors(subErrors)
 }
 
-// WellFormedDomainNames returns an error if any of the provided domains do not meet these criteria:
+// WellFormedIdentifiers returns an error if any of the provided identifiers do
+// not meet these criteria:
 //
+// For DNS identifiers:
 //   - MUST contains only lowercase characters, numbers, hyphens, and dots
 //   - MUST NOT have more than maxLabels labels
 //   - MUST follow the DNS hostname syntax rules in RFC 1035 and RFC 2181
 //
-// In particular, it:
+// In particular, DNS identifiers:
 //   - MUST NOT contain underscores
 //   - MUST NOT match the syntax of an IP address
 //   - MUST end in a public suffix
@@ -426,25 +495,46 @@ func (pa *AuthorityImpl) WillingToIssue(domains []string) error {
 //   - MUST NOT be a label-wise suffix match for a name on the block list,
 //     where comparison is case-independent (normalized to lower case)
 //
-// If a domain contains a *, we additionally require:
+// If a DNS identifier contains a *, we additionally require:
 //   - There is at most one `*` wildcard character
 //   - That the wildcard character is the leftmost label
 //   - That the wildcard label is not immediately adjacent to a top level ICANN
 //     TLD
 //
-// If multiple domains are invalid, the error will contain suberrors specific to
-// each domain.
-func WellFormedDomainNames(domains []string) error {
+// For IP identifiers:
+//   - MUST be a valid IPv4 or IPv6 address in its canonical textual form
+//   - MUST NOT be in an IANA special-purpose address block
+//
+// If multiple identifiers are invalid, the error will contain suberrors
+// specific to each identifier.
+func WellFormedIdentifiers(idents identifier.ACMEIdentifiers) error {
 	var subErrors []berrors.SubBoulderError
-	for _, domain := range domains {
-		err := ValidDomain(domain)
+	for _, ident := range idents {
+		var err error
+		switch ident.Type {
+		case identifier.TypeDNS:
+			err = ValidDomain(ident.Value)
+		case identifier.TypeIP:
+			err = ValidIP(ident.Value)
+		default:
+			err = errUnsupportedIdent
+		}
 		if err != nil {
-			subErrors = append(subErrors, subError(domain, err))
+			subErrors = append(subErrors, subError(ident, err))
 		}
 	}
 	return combineSubErrors(subErrors)
 }
 
+// WellFormedDomainNames returns an error if any of the provided domains do not
+// meet the criteria for DNS identifiers checked by WellFormedIdentifiers.
+//
+// If multiple domains are invalid, the error will contain suberrors specific to
+// each domain.
+func WellFormedDomainNames(domains []string) error {
+	return WellFormedIdentifiers(identifier.NewDNSSlice(domains))
+}
+
 func combineSubErrors(subErrors []berrors.SubBoulderError) error {
 	if len(subErrors) > 0 {
 		// If there was only one error, then use it as the top level error that is
@@ -489,6 +579,29 @@ func (pa *AuthorityImpl) checkWildcardHostList(domain string) error {
 	return nil
 }
 
+// checkIPLists checks the ipBlocklist for a given IP address. If the address is
+// not contained in any blocked prefix nil is returned, otherwise
+// errIPPolicyForbidden is returned.
+func (pa *AuthorityImpl) checkIPLists(ip string) error {
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+
+	if pa.blocklist == nil {
+		return fmt.Errorf("Hostname policy not yet loaded.")
+	}
+
+	parsedIP, err := netip.ParseAddr(ip)
+	if err != nil {
+		return errIPInvalid
+	}
+	for _, prefix := range pa.ipBlocklist {
+		if prefix.Contains(parsedIP) {
+			return errIPPolicyForbidden
+		}
+	}
+	return nil
+}
+
 func (pa *AuthorityImpl) checkHostLists(domain string) error {
 	pa.blocklistMu.RLock()
 	defer pa.blocklistMu.RUnlock()
@@ -517,12 +630,16 @@ func (pa *AuthorityImpl) checkHostLists(domain string) error {
 // filtering can happen dynamically at request rather than being set in stone
 // at creation time.
 func (pa *AuthorityImpl) ChallengeTypesFor(ident identifier.ACMEIdentifier) ([]core.AcmeChallenge, error) {
-	// If the identifier is for a DNS wildcard name we only provide a DNS-01
-	// challenge, to comply with the BRs Sections 3.2.2.4.19 and 3.2.2.4.20
-	// stating that ACME HTTP-01 and TLS-ALPN-01 are not suitable for validating
-	// Wildcard Domains.
+	// If the identifier is for a DNS wildcard name we only provide the DNS-01,
+	// DNS-ACCOUNT-01, and DNS-PERSIST-01 challenges, to comply with the BRs
+	// Sections 3.2.2.4.19 and 3.2.2.4.20 stating that ACME HTTP-01 and
+	// TLS-ALPN-01 are not suitable for validating Wildcard Domains.
 	if ident.Type == identifier.TypeDNS && strings.HasPrefix(ident.Value, "*.") {
-		return []core.AcmeChallenge{core.ChallengeTypeDNS01}, nil
+		return []core.AcmeChallenge{
+			core.ChallengeTypeDNS01,
+			core.ChallengeTypeDNSAccount01,
+			core.ChallengeTypeDNSPersist01,
+		}, nil
 	}
 
 	// Return all challenge types we support for non-wildcard DNS identifiers.
@@ -531,6 +648,18 @@ func (pa *AuthorityImpl) ChallengeTypesFor(ident identifier.ACMEIdentifier) ([]c
 			core.ChallengeTypeHTTP01,
 			core.ChallengeTypeDNS01,
 			core.ChallengeTypeTLSALPN01,
+			core.ChallengeTypeDNSAccount01,
+			core.ChallengeTypeDNSPersist01,
+		}, nil
+	}
+
+	// RFC 8738, Section 7: IP identifiers can be validated with the HTTP-01 and
+	// TLS-ALPN-01 challenges, but there is no DNS name at which to look up a
+	// DNS-01, DNS-ACCOUNT-01, or DNS-PERSIST-01 TXT record.
+	if ident.Type == identifier.TypeIP {
+		return []core.AcmeChallenge{
+			core.ChallengeTypeHTTP01,
+			core.ChallengeTypeTLSALPN01,
 		}, nil
 	}
 
@@ -539,6 +668,13 @@ func (pa *AuthorityImpl) ChallengeTypesFor(ident identifier.ACMEIdentifier) ([]c
 	return nil, fmt.Errorf("unrecognized identifier type %q", ident.Type)
 }
 
+// IdentifierTypeEnabled returns whether the specified identifier type is enabled
+func (pa *AuthorityImpl) IdentifierTypeEnabled(t identifier.IdentifierType) bool {
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+	return pa.enabledIdentifiers[t]
+}
+
 // ChallengeTypeEnabled returns whether the specified challenge type is enabled
 func (pa *AuthorityImpl) ChallengeTypeEnabled(t core.AcmeChallenge) bool {
 	pa.blocklistMu.RLock()
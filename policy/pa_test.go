@@ -23,7 +23,7 @@ func paImpl(t *testing.T) *AuthorityImpl {
 		core.ChallengeTypeTLSALPN01: true,
 	}
 
-	pa, err := New(enabledChallenges, blog.NewMock())
+	pa, err := New(nil, enabledChallenges, blog.NewMock())
 	if err != nil {
 		t.Fatalf("Couldn't create policy implementation: %s", err)
 	}
@@ -177,19 +177,19 @@ func TestWillingToIssue(t *testing.T) {
 	test.AssertNotError(t, err, "Couldn't load rules")
 
 	// Invalid encoding
-	err = pa.WillingToIssue([]string{"www.xn--m.com"})
+	err = pa.WillingToIssue(identifier.NewDNSSlice([]string{"www.xn--m.com"}))
 	test.AssertError(t, err, "WillingToIssue didn't fail on a malformed IDN")
 	// Valid encoding
-	err = pa.WillingToIssue([]string{"www.xn--mnich-kva.com"})
+	err = pa.WillingToIssue(identifier.NewDNSSlice([]string{"www.xn--mnich-kva.com"}))
 	test.AssertNotError(t, err, "WillingToIssue failed on a properly formed IDN")
 	// IDN TLD
-	err = pa.WillingToIssue([]string{"xn--example--3bhk5a.xn--p1ai"})
+	err = pa.WillingToIssue(identifier.NewDNSSlice([]string{"xn--example--3bhk5a.xn--p1ai"}))
 	test.AssertNotError(t, err, "WillingToIssue failed on a properly formed domain with IDN TLD")
 	features.Reset()
 
 	// Test expected blocked domains
 	for _, domain := range shouldBeBlocked {
-		err := pa.WillingToIssue([]string{domain})
+		err := pa.WillingToIssue(identifier.NewDNSSlice([]string{domain}))
 		test.AssertError(t, err, "domain was not correctly forbidden")
 		var berr *berrors.BoulderError
 		test.AssertErrorWraps(t, err, &berr)
@@ -198,7 +198,7 @@ func TestWillingToIssue(t *testing.T) {
 
 	// Test acceptance of good names
 	for _, domain := range shouldBeAccepted {
-		err := pa.WillingToIssue([]string{domain})
+		err := pa.WillingToIssue(identifier.NewDNSSlice([]string{domain}))
 		test.AssertNotError(t, err, "domain was incorrectly forbidden")
 	}
 }
@@ -284,7 +284,7 @@ func TestWillingToIssue_Wildcards(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			err := pa.WillingToIssue([]string{tc.Domain})
+			err := pa.WillingToIssue(identifier.NewDNSSlice([]string{tc.Domain}))
 			if tc.ExpectedErr == nil {
 				test.AssertNil(t, err, fmt.Sprintf("Unexpected error for domain %q, got %s", tc.Domain, err))
 			} else {
@@ -319,13 +319,13 @@ func TestWillingToIssue_SubErrors(t *testing.T) {
 	test.AssertNotError(t, err, "Couldn't load policy contents from file")
 
 	// Test multiple malformed domains and one banned domain; only the malformed ones will generate errors
-	err = pa.WillingToIssue([]string{
+	err = pa.WillingToIssue(identifier.NewDNSSlice([]string{
 		"perfectly-fine.com",      // fine
 		"letsdecrypt_org",         // malformed
 		"example.comm",            // malformed
 		"letsdecrypt.org",         // banned
 		"also-perfectly-fine.com", // fine
-	})
+	}))
 	test.AssertDeepEquals(t, err,
 		&berrors.BoulderError{
 			Type:   berrors.RejectedIdentifier,
@@ -349,12 +349,12 @@ func TestWillingToIssue_SubErrors(t *testing.T) {
 		})
 
 	// Test multiple banned domains.
-	err = pa.WillingToIssue([]string{
+	err = pa.WillingToIssue(identifier.NewDNSSlice([]string{
 		"perfectly-fine.com",      // fine
 		"letsdecrypt.org",         // banned
 		"example.com",             // banned
 		"also-perfectly-fine.com", // fine
-	})
+	}))
 	test.AssertError(t, err, "Expected err from WillingToIssueWildcards")
 
 	test.AssertDeepEquals(t, err,
@@ -380,7 +380,7 @@ func TestWillingToIssue_SubErrors(t *testing.T) {
 		})
 
 	// Test willing to issue with only *one* bad identifier.
-	err = pa.WillingToIssue([]string{"letsdecrypt.org"})
+	err = pa.WillingToIssue(identifier.NewDNSSlice([]string{"letsdecrypt.org"}))
 	test.AssertDeepEquals(t, err,
 		&berrors.BoulderError{
 			Type:   berrors.RejectedIdentifier,
@@ -388,6 +388,94 @@ func TestWillingToIssue_SubErrors(t *testing.T) {
 		})
 }
 
+func TestWellFormedIdentifiers(t *testing.T) {
+	testCases := []struct {
+		ident identifier.ACMEIdentifier
+		err   error
+	}{
+		{identifier.NewDNS("example.com"), nil},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "9.9.9.9"}, nil},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "2606:4700:4700::1111"}, nil},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "2606:4700:4700:0:0:0:0:1111"}, errIPNotCanonical},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "2606:4700:4700::1111%eth0"}, errIPInvalid},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "::ffff:9.9.9.9"}, errIPInvalid},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "009.9.9.9"}, errIPInvalid},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "example.com"}, errIPInvalid},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "10.0.0.1"}, errIPReserved},
+		{identifier.ACMEIdentifier{Type: identifier.TypeIP, Value: "fe80::1"}, errIPReserved},
+		{identifier.ACMEIdentifier{Type: identifier.TypeDNS, Value: "9.9.9.9"}, errIPAddress},
+		{identifier.ACMEIdentifier{Type: "email", Value: "someone@example.com"}, errUnsupportedIdent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.ident.Type)+"/"+tc.ident.Value, func(t *testing.T) {
+			err := WellFormedIdentifiers(identifier.ACMEIdentifiers{tc.ident})
+			if tc.err == nil {
+				test.AssertNotError(t, err, "expected identifier to be well-formed")
+				return
+			}
+			test.AssertError(t, err, "expected identifier to be malformed")
+			var berr *berrors.BoulderError
+			test.AssertErrorWraps(t, err, &berr)
+			test.AssertContains(t, berr.Detail, tc.err.(*berrors.BoulderError).Detail)
+		})
+	}
+}
+
+func TestWillingToIssue_IPs(t *testing.T) {
+	blocked := blockedNamesPolicy{
+		HighRiskBlockedNames: []string{"example.com"},
+		ExactBlockedNames:    []string{"www.example.org"},
+		AdminBlockedPrefixes: []string{"64.112.117.0/24", "2602:80a:6000:666::/64"},
+	}
+
+	pa := paImpl(t)
+	err := pa.processHostnamePolicy(blocked)
+	test.AssertNotError(t, err, "Couldn't load policy contents")
+
+	// IP identifiers are disabled by default.
+	err = pa.WillingToIssue(identifier.ACMEIdentifiers{identifier.NewIP(netip.MustParseAddr("9.9.9.9"))})
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+	test.AssertContains(t, err.Error(), "disabled this identifier type")
+
+	pa, err = New(map[identifier.IdentifierType]bool{
+		identifier.TypeDNS: true,
+		identifier.TypeIP:  true,
+	}, map[core.AcmeChallenge]bool{core.ChallengeTypeHTTP01: true}, blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create policy implementation")
+	err = pa.processHostnamePolicy(blocked)
+	test.AssertNotError(t, err, "Couldn't load policy contents")
+
+	testCases := []struct {
+		ip      string
+		wantErr error
+	}{
+		{"9.9.9.9", nil},
+		{"2606:4700:4700::1111", nil},
+		{"64.112.117.122", errIPPolicyForbidden},
+		{"2602:80a:6000:666::1", errIPPolicyForbidden},
+		{"2602:80a:6000:667::1", nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			err := pa.WillingToIssue(identifier.ACMEIdentifiers{identifier.NewIP(netip.MustParseAddr(tc.ip))})
+			if tc.wantErr == nil {
+				test.AssertNotError(t, err, "expected IP to be allowed")
+				return
+			}
+			test.AssertError(t, err, "expected IP to be blocked")
+			test.AssertContains(t, err.Error(), tc.wantErr.(*berrors.BoulderError).Detail)
+		})
+	}
+
+	err = pa.processHostnamePolicy(blockedNamesPolicy{
+		HighRiskBlockedNames: []string{"example.com"},
+		ExactBlockedNames:    []string{"www.example.org"},
+		AdminBlockedPrefixes: []string{"64.112.117.122"},
+	})
+	test.AssertError(t, err, "Loaded a policy with a malformed prefix")
+}
+
 func TestChallengeTypesFor(t *testing.T) {
 	t.Parallel()
 	pa := paImpl(t)
@@ -402,19 +490,26 @@ func TestChallengeTypesFor(t *testing.T) {
 			name:  "dns",
 			ident: identifier.NewDNS("example.com"),
 			wantChalls: []core.AcmeChallenge{
-				core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01, core.ChallengeTypeTLSALPN01,
+				core.ChallengeTypeHTTP01, core.ChallengeTypeDNS01, core.ChallengeTypeTLSALPN01, core.ChallengeTypeDNSAccount01, core.ChallengeTypeDNSPersist01,
 			},
 		},
 		{
 			name:  "wildcard",
 			ident: identifier.NewDNS("*.example.com"),
 			wantChalls: []core.AcmeChallenge{
-				core.ChallengeTypeDNS01,
+				core.ChallengeTypeDNS01, core.ChallengeTypeDNSAccount01, core.ChallengeTypeDNSPersist01,
+			},
+		},
+		{
+			name:  "ip",
+			ident: identifier.NewIP(netip.MustParseAddr("1.2.3.4")),
+			wantChalls: []core.AcmeChallenge{
+				core.ChallengeTypeHTTP01, core.ChallengeTypeTLSALPN01,
 			},
 		},
 		{
 			name:    "other",
-			ident:   identifier.NewIP(netip.MustParseAddr("1.2.3.4")),
+			ident:   identifier.ACMEIdentifier{Type: "email", Value: "someone@example.com"},
 			wantErr: "unrecognized identifier type",
 		},
 	}
@@ -10,8 +10,8 @@ import (
 const (
 	// Error types that can be used in ACME payloads. These are sorted in the
 	// same order as they are defined in RFC8555 Section 6.7. We do not implement
-	// the `compound`, `externalAccountRequired`, or `userActionRequired` errors,
-	// because we have no path that would return them.
+	// the `compound` or `userActionRequired` errors, because we have no path that
+	// would return them.
 	AccountDoesNotExistProblem   = ProblemType("accountDoesNotExist")
 	AlreadyRevokedProblem        = ProblemType("alreadyRevoked")
 	BadCSRProblem                = ProblemType("badCSR")
@@ -21,20 +21,21 @@ const (
 	BadSignatureAlgorithmProblem = ProblemType("badSignatureAlgorithm")
 	CAAProblem                   = ProblemType("caa")
 	// ConflictProblem is a problem type that is not defined in RFC8555.
-	ConflictProblem              = ProblemType("conflict")
-	ConnectionProblem            = ProblemType("connection")
-	DNSProblem                   = ProblemType("dns")
-	InvalidContactProblem        = ProblemType("invalidContact")
-	MalformedProblem             = ProblemType("malformed")
-	OrderNotReadyProblem         = ProblemType("orderNotReady")
-	PausedProblem                = ProblemType("rateLimited")
-	RateLimitedProblem           = ProblemType("rateLimited")
-	RejectedIdentifierProblem    = ProblemType("rejectedIdentifier")
-	ServerInternalProblem        = ProblemType("serverInternal")
-	TLSProblem                   = ProblemType("tls")
-	UnauthorizedProblem          = ProblemType("unauthorized")
-	UnsupportedContactProblem    = ProblemType("unsupportedContact")
-	UnsupportedIdentifierProblem = ProblemType("unsupportedIdentifier")
+	ConflictProblem                = ProblemType("conflict")
+	ConnectionProblem              = ProblemType("connection")
+	DNSProblem                     = ProblemType("dns")
+	ExternalAccountRequiredProblem = ProblemType("externalAccountRequired")
+	InvalidContactProblem          = ProblemType("invalidContact")
+	MalformedProblem               = ProblemType("malformed")
+	OrderNotReadyProblem           = ProblemType("orderNotReady")
+	PausedProblem                  = ProblemType("rateLimited")
+	RateLimitedProblem             = ProblemType("rateLimited")
+	RejectedIdentifierProblem      = ProblemType("rejectedIdentifier")
+	ServerInternalProblem          = ProblemType("serverInternal")
+	TLSProblem                     = ProblemType("tls")
+	UnauthorizedProblem            = ProblemType("unauthorized")
+	UnsupportedContactProblem      = ProblemType("unsupportedContact")
+	UnsupportedIdentifierProblem   = ProblemType("unsupportedIdentifier")
 
 	ErrorNS = "urn:ietf:params:acme:error:"
 )
@@ -178,6 +179,17 @@ func DNS(detail string) *ProblemDetails {
 	}
 }
 
+// ExternalAccountRequired returns a ProblemDetails representing an
+// ExternalAccountRequiredProblem, used when the server requires new accounts
+// to be bound to an external account and the request did not include one.
+func ExternalAccountRequired(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       ExternalAccountRequiredProblem,
+		Detail:     detail,
+		HTTPStatus: http.StatusForbidden,
+	}
+}
+
 // InvalidContact returns a ProblemDetails representing an InvalidContactProblem.
 func InvalidContact(detail string) *ProblemDetails {
 	return &ProblemDetails{
@@ -33,6 +33,7 @@ func TestProblemDetailsConvenience(t *testing.T) {
 		{RateLimited("rate limited detail"), RateLimitedProblem, http.StatusTooManyRequests, "rate limited detail"},
 		{BadNonce("bad nonce detail"), BadNonceProblem, http.StatusBadRequest, "bad nonce detail"},
 		{TLS("TLS error detail"), TLSProblem, http.StatusBadRequest, "TLS error detail"},
+		{ExternalAccountRequired("eab required detail"), ExternalAccountRequiredProblem, http.StatusForbidden, "eab required detail"},
 		{RejectedIdentifier("rejected identifier detail"), RejectedIdentifierProblem, http.StatusBadRequest, "rejected identifier detail"},
 		{AccountDoesNotExist("no account detail"), AccountDoesNotExistProblem, http.StatusBadRequest, "no account detail"},
 		{BadRevocationReason("only reason xxx is supported"), BadRevocationReasonProblem, http.StatusBadRequest, "only reason xxx is supported"},
@@ -37,6 +37,10 @@ type Log struct {
 	logID  string
 	uri    string
 	client *ctClient.LogClient
+	// staticCT is true if the log implements the Static CT API rather than
+	// RFC 6962. Such logs accept submissions at the same endpoints, but must
+	// return a leaf_index extension in their SCTs.
+	staticCT bool
 }
 
 // logCache contains a cache of *Log's that are constructed as required by
@@ -48,7 +52,7 @@ type logCache struct {
 
 // AddLog adds a *Log to the cache by constructing the statName, client and
 // verifier for the given uri & base64 public key.
-func (c *logCache) AddLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
+func (c *logCache) AddLog(uri, b64PK, userAgent string, staticCT bool, logger blog.Logger) (*Log, error) {
 	// Lock the mutex for reading to check the cache
 	c.RLock()
 	log, present := c.logs[b64PK]
@@ -64,7 +68,7 @@ func (c *logCache) AddLog(uri, b64PK, userAgent string, logger blog.Logger) (*Lo
 	defer c.Unlock()
 
 	// Construct a Log, add it to the cache, and return it to the caller
-	log, err := NewLog(uri, b64PK, userAgent, logger)
+	log, err := NewLog(uri, b64PK, userAgent, staticCT, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -87,8 +91,9 @@ func (la logAdaptor) Printf(s string, args ...interface{}) {
 	la.Logger.Infof(s, args...)
 }
 
-// NewLog returns an initialized Log struct
-func NewLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
+// NewLog returns an initialized Log struct. If staticCT is true, uri is the
+// submission prefix of a log implementing the Static CT API.
+func NewLog(uri, b64PK, userAgent string, staticCT bool, logger blog.Logger) (*Log, error) {
 	url, err := url.Parse(uri)
 	if err != nil {
 		return nil, err
@@ -145,9 +150,10 @@ func NewLog(uri, b64PK, userAgent string, logger blog.Logger) (*Log, error) {
 	}
 
 	return &Log{
-		logID:  b64PK,
-		uri:    url.String(),
-		client: client,
+		logID:    b64PK,
+		uri:      url.String(),
+		client:   client,
+		staticCT: staticCT,
 	}, nil
 }
 
@@ -252,7 +258,7 @@ func (pub *Impl) SubmitToSingleCTWithResult(ctx context.Context, req *pubpb.Requ
 	// Add a log URL/pubkey to the cache, if already present the
 	// existing *Log will be returned, otherwise one will be constructed, added
 	// and returned.
-	ctLog, err := pub.ctLogsCache.AddLog(req.LogURL, req.LogPublicKey, pub.userAgent, pub.log)
+	ctLog, err := pub.ctLogsCache.AddLog(req.LogURL, req.LogPublicKey, pub.userAgent, req.StaticCT, pub.log)
 	if err != nil {
 		pub.log.AuditErrf("Making Log: %s", err)
 		return nil, err
@@ -334,12 +340,20 @@ func (pub *Impl) singleLogSubmit(
 		return nil, fmt.Errorf("SCT Timestamp was too far in the past (%s)", timestamp)
 	}
 
+	if ctLog.staticCT {
+		_, err := parseLeafIndex(sct.Extensions)
+		if err != nil {
+			return nil, fmt.Errorf("SCT from Static CT API log has invalid extensions: %w", err)
+		}
+	}
+
 	return sct, nil
 }
 
 // CreateTestingSignedSCT is used by both the publisher tests and ct-test-serv, which is
-// why it is exported. It creates a signed SCT based on the provided chain.
-func CreateTestingSignedSCT(req []string, k *ecdsa.PrivateKey, precert bool, timestamp time.Time) []byte {
+// why it is exported. It creates a signed SCT, with the given extensions, based
+// on the provided chain.
+func CreateTestingSignedSCT(req []string, k *ecdsa.PrivateKey, precert bool, timestamp time.Time, extensions ct.CTExtensions) []byte {
 	chain := make([]ct.ASN1Cert, len(req))
 	for i, str := range req {
 		b, err := base64.StdEncoding.DecodeString(str)
@@ -367,6 +381,7 @@ func CreateTestingSignedSCT(req []string, k *ecdsa.PrivateKey, precert bool, tim
 		SCTVersion: ct.V1,
 		LogID:      ct.LogID{KeyID: logID},
 		Timestamp:  timestampMillis,
+		Extensions: extensions,
 	}, ct.LogEntry{Leaf: *leaf})
 	hashed := sha256.Sum256(serialized)
 	var ecdsaSig struct {
@@ -388,6 +403,7 @@ func CreateTestingSignedSCT(req []string, k *ecdsa.PrivateKey, precert bool, tim
 	jsonSCTObj.SCTVersion = ct.V1
 	jsonSCTObj.ID = base64.StdEncoding.EncodeToString(logID[:])
 	jsonSCTObj.Timestamp = timestampMillis
+	jsonSCTObj.Extensions = base64.StdEncoding.EncodeToString(extensions)
 	ds := ct.DigitallySigned{
 		Algorithm: cttls.SignatureAndHashAlgorithm{
 			Hash:      cttls.SHA256,
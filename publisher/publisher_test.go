@@ -23,6 +23,7 @@ import (
 	"time"
 
 	ct "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/letsencrypt/boulder/core"
@@ -71,7 +72,7 @@ func logSrv(k *ecdsa.PrivateKey) *testLogSrv {
 		if r.URL.Path == "/ct/v1/add-pre-chain" {
 			precert = true
 		}
-		sct := CreateTestingSignedSCT(jsonReq.Chain, k, precert, time.Now())
+		sct := CreateTestingSignedSCT(jsonReq.Chain, k, precert, time.Now(), nil)
 		fmt.Fprint(w, string(sct))
 		atomic.AddInt64(&testLog.submissions, 1)
 	})
@@ -96,7 +97,30 @@ func lyingLogSrv(k *ecdsa.PrivateKey, timestamp time.Time) *testLogSrv {
 		if r.URL.Path == "/ct/v1/add-pre-chain" {
 			precert = true
 		}
-		sct := CreateTestingSignedSCT(jsonReq.Chain, k, precert, timestamp)
+		sct := CreateTestingSignedSCT(jsonReq.Chain, k, precert, timestamp, nil)
+		fmt.Fprint(w, string(sct))
+		atomic.AddInt64(&testLog.submissions, 1)
+	})
+
+	testLog.Server = httptest.NewUnstartedServer(m)
+	testLog.Server.Start()
+	return testLog
+}
+
+// staticCTLogSrv signs SCTs with the given extensions, like a log implementing
+// the Static CT API.
+func staticCTLogSrv(k *ecdsa.PrivateKey, extensions ct.CTExtensions) *testLogSrv {
+	testLog := &testLogSrv{}
+	m := http.NewServeMux()
+	m.HandleFunc("/ct/", func(w http.ResponseWriter, r *http.Request) {
+		decoder := json.NewDecoder(r.Body)
+		var jsonReq ctSubmissionRequest
+		err := decoder.Decode(&jsonReq)
+		if err != nil {
+			return
+		}
+		precert := r.URL.Path == "/ct/v1/add-pre-chain"
+		sct := CreateTestingSignedSCT(jsonReq.Chain, k, precert, time.Now(), extensions)
 		fmt.Fprint(w, string(sct))
 		atomic.AddInt64(&testLog.submissions, 1)
 	})
@@ -166,7 +190,7 @@ func addLog(t *testing.T, port int, pubKey *ecdsa.PublicKey) *Log {
 	uri := fmt.Sprintf("http://localhost:%d", port)
 	der, err := x509.MarshalPKIXPublicKey(pubKey)
 	test.AssertNotError(t, err, "Failed to marshal key")
-	newLog, err := NewLog(uri, base64.StdEncoding.EncodeToString(der), "test-user-agent/1.0", log)
+	newLog, err := NewLog(uri, base64.StdEncoding.EncodeToString(der), "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "Couldn't create log")
 	test.AssertEquals(t, newLog.uri, fmt.Sprintf("http://localhost:%d", port))
 	return newLog
@@ -267,17 +291,63 @@ func TestTimestampVerificationPast(t *testing.T) {
 	}
 }
 
+func TestStaticCTSubmission(t *testing.T) {
+	testCases := []struct {
+		name       string
+		extensions ct.CTExtensions
+		staticCT   bool
+		wantErr    bool
+	}{
+		{"static-ct log with leaf index", MarshalLeafIndexExtension(1234), true, false},
+		{"static-ct log without leaf index", nil, true, true},
+		{"static-ct log with malformed leaf index", ct.CTExtensions{0, 0, 1, 7}, true, true},
+		{"RFC 6962 log without leaf index", nil, false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pub, _, k := setup(t)
+
+			server := staticCTLogSrv(k, tc.extensions)
+			defer server.Close()
+			pkDER, err := x509.MarshalPKIXPublicKey(&k.PublicKey)
+			test.AssertNotError(t, err, "Failed to marshal key")
+
+			issuerBundles, precert, err := makePrecert(k)
+			test.AssertNotError(t, err, "Failed to create test leaf")
+			pub.issuerBundles = issuerBundles
+
+			res, err := pub.SubmitToSingleCTWithResult(ctx, &pubpb.Request{
+				LogURL:       server.URL,
+				LogPublicKey: base64.StdEncoding.EncodeToString(pkDER),
+				Der:          precert,
+				Kind:         pubpb.SubmissionType_sct,
+				StaticCT:     tc.staticCT,
+			})
+			if tc.wantErr {
+				test.AssertError(t, err, "submission should have failed")
+				return
+			}
+			test.AssertNotError(t, err, "submission failed")
+
+			var sct ct.SignedCertificateTimestamp
+			_, err = cttls.Unmarshal(res.Sct, &sct)
+			test.AssertNotError(t, err, "Failed to unmarshal SCT")
+			test.AssertByteEquals(t, sct.Extensions, tc.extensions)
+		})
+	}
+}
+
 func TestLogCache(t *testing.T) {
 	cache := logCache{
 		logs: make(map[string]*Log),
 	}
 
 	// Adding a log with an invalid base64 public key should error
-	_, err := cache.AddLog("www.test.com", "1234", "test-user-agent/1.0", log)
+	_, err := cache.AddLog("www.test.com", "1234", "test-user-agent/1.0", false, log)
 	test.AssertError(t, err, "AddLog() with invalid base64 pk didn't error")
 
 	// Adding a log with an invalid URI should error
-	_, err = cache.AddLog(":", "", "test-user-agent/1.0", log)
+	_, err = cache.AddLog(":", "", "test-user-agent/1.0", false, log)
 	test.AssertError(t, err, "AddLog() with an invalid log URI didn't error")
 
 	// Create one keypair & base 64 public key
@@ -295,21 +365,21 @@ func TestLogCache(t *testing.T) {
 	k2b64 := base64.StdEncoding.EncodeToString(der2)
 
 	// Adding the first log should not produce an error
-	l1, err := cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", log)
+	l1, err := cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "cache.AddLog() failed for log 1")
 	test.AssertEquals(t, cache.Len(), 1)
 	test.AssertEquals(t, l1.uri, "http://log.one.example.com")
 	test.AssertEquals(t, l1.logID, k1b64)
 
 	// Adding it again should not produce any errors, or increase the Len()
-	l1, err = cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", log)
+	l1, err = cache.AddLog("http://log.one.example.com", k1b64, "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "cache.AddLog() failed for second add of log 1")
 	test.AssertEquals(t, cache.Len(), 1)
 	test.AssertEquals(t, l1.uri, "http://log.one.example.com")
 	test.AssertEquals(t, l1.logID, k1b64)
 
 	// Adding a second log should not error and should increase the Len()
-	l2, err := cache.AddLog("http://log.two.example.com", k2b64, "test-user-agent/1.0", log)
+	l2, err := cache.AddLog("http://log.two.example.com", k2b64, "test-user-agent/1.0", false, log)
 	test.AssertNotError(t, err, "cache.AddLog() failed for log 2")
 	test.AssertEquals(t, cache.Len(), 2)
 	test.AssertEquals(t, l2.uri, "http://log.two.example.com")
@@ -0,0 +1,61 @@
+package publisher
+
+import (
+	"errors"
+	"fmt"
+
+	ct "github.com/google/certificate-transparency-go"
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// leafIndexExtensionType is the type of the SCT extension in which logs
+// implementing the Static CT API (https://c2sp.org/static-ct-api) return the
+// index at which they have sequenced the submitted entry.
+const leafIndexExtensionType = 0
+
+// parseLeafIndex returns the index carried by the single leaf_index extension
+// among the given SCT extensions. The extensions field is a sequence of
+// Extension structs, each a uint8 type followed by opaque data with a uint16
+// length prefix. A leaf_index extension's data is a uint40.
+func parseLeafIndex(extensions ct.CTExtensions) (uint64, error) {
+	var index uint64
+	var found bool
+	s := cryptobyte.String(extensions)
+	for !s.Empty() {
+		var extType uint8
+		var data cryptobyte.String
+		if !s.ReadUint8(&extType) || !s.ReadUint16LengthPrefixed(&data) {
+			return 0, errors.New("malformed SCT extensions")
+		}
+		if extType != leafIndexExtensionType {
+			continue
+		}
+		if found {
+			return 0, errors.New("multiple leaf_index extensions")
+		}
+		var hi uint8
+		var lo uint32
+		if !data.ReadUint8(&hi) || !data.ReadUint32(&lo) || !data.Empty() {
+			return 0, fmt.Errorf("malformed leaf_index extension of %d bytes", len(data))
+		}
+		index = uint64(hi)<<32 | uint64(lo)
+		found = true
+	}
+	if !found {
+		return 0, errors.New("missing leaf_index extension")
+	}
+	return index, nil
+}
+
+// MarshalLeafIndexExtension returns SCT extensions containing only a
+// leaf_index extension for the given index. It is used by the publisher tests
+// and ct-test-srv to emulate a Static CT API log.
+func MarshalLeafIndexExtension(index uint64) ct.CTExtensions {
+	var b cryptobyte.Builder
+	b.AddUint8(leafIndexExtensionType)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint8(uint8(index >> 32))
+		b.AddUint32(uint32(index))
+	})
+	return b.BytesOrPanic()
+}
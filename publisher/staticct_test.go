@@ -0,0 +1,42 @@
+package publisher
+
+import (
+	"testing"
+
+	ct "github.com/google/certificate-transparency-go"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestParseLeafIndex(t *testing.T) {
+	for _, index := range []uint64{0, 1, 1 << 32, 1<<40 - 1} {
+		got, err := parseLeafIndex(MarshalLeafIndexExtension(index))
+		test.AssertNotError(t, err, "parsing leaf_index extension")
+		test.AssertEquals(t, got, index)
+	}
+
+	// Unknown extensions are skipped.
+	extensions := append(ct.CTExtensions{7, 0, 2, 0xff, 0xff}, MarshalLeafIndexExtension(42)...)
+	got, err := parseLeafIndex(extensions)
+	test.AssertNotError(t, err, "parsing leaf_index after unknown extension")
+	test.AssertEquals(t, got, uint64(42))
+
+	testCases := []struct {
+		name       string
+		extensions ct.CTExtensions
+	}{
+		{"empty", nil},
+		{"only unknown extension", ct.CTExtensions{7, 0, 1, 0}},
+		{"truncated header", ct.CTExtensions{0, 0}},
+		{"truncated data", ct.CTExtensions{0, 0, 5, 0, 0}},
+		{"short leaf_index", ct.CTExtensions{0, 0, 4, 0, 0, 0, 1}},
+		{"long leaf_index", ct.CTExtensions{0, 0, 6, 0, 0, 0, 0, 0, 1}},
+		{"duplicate leaf_index", append(MarshalLeafIndexExtension(1), MarshalLeafIndexExtension(2)...)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseLeafIndex(tc.extensions)
+			test.AssertError(t, err, "parsing invalid extensions should fail")
+		})
+	}
+}
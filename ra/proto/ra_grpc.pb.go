@@ -37,6 +37,7 @@ const (
 	RegistrationAuthority_FinalizeOrder_FullMethodName                     = "/ra.RegistrationAuthority/FinalizeOrder"
 	RegistrationAuthority_GenerateOCSP_FullMethodName                      = "/ra.RegistrationAuthority/GenerateOCSP"
 	RegistrationAuthority_UnpauseAccount_FullMethodName                    = "/ra.RegistrationAuthority/UnpauseAccount"
+	RegistrationAuthority_ResubmitToCT_FullMethodName                      = "/ra.RegistrationAuthority/ResubmitToCT"
 )
 
 // RegistrationAuthorityClient is the client API for RegistrationAuthority service.
@@ -59,6 +60,8 @@ type RegistrationAuthorityClient interface {
 	// Generate an OCSP response based on the DB's current status and reason code.
 	GenerateOCSP(ctx context.Context, in *GenerateOCSPRequest, opts ...grpc.CallOption) (*proto1.OCSPResponse, error)
 	UnpauseAccount(ctx context.Context, in *UnpauseAccountRequest, opts ...grpc.CallOption) (*UnpauseAccountResponse, error)
+	// Submit a final certificate to the configured final CT logs again.
+	ResubmitToCT(ctx context.Context, in *ResubmitToCTRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type registrationAuthorityClient struct {
@@ -219,6 +222,16 @@ func (c *registrationAuthorityClient) UnpauseAccount(ctx context.Context, in *Un
 	return out, nil
 }
 
+func (c *registrationAuthorityClient) ResubmitToCT(ctx context.Context, in *ResubmitToCTRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RegistrationAuthority_ResubmitToCT_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistrationAuthorityServer is the server API for RegistrationAuthority service.
 // All implementations must embed UnimplementedRegistrationAuthorityServer
 // for forward compatibility
@@ -239,6 +252,8 @@ type RegistrationAuthorityServer interface {
 	// Generate an OCSP response based on the DB's current status and reason code.
 	GenerateOCSP(context.Context, *GenerateOCSPRequest) (*proto1.OCSPResponse, error)
 	UnpauseAccount(context.Context, *UnpauseAccountRequest) (*UnpauseAccountResponse, error)
+	// Submit a final certificate to the configured final CT logs again.
+	ResubmitToCT(context.Context, *ResubmitToCTRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedRegistrationAuthorityServer()
 }
 
@@ -291,6 +306,9 @@ func (UnimplementedRegistrationAuthorityServer) GenerateOCSP(context.Context, *G
 func (UnimplementedRegistrationAuthorityServer) UnpauseAccount(context.Context, *UnpauseAccountRequest) (*UnpauseAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnpauseAccount not implemented")
 }
+func (UnimplementedRegistrationAuthorityServer) ResubmitToCT(context.Context, *ResubmitToCTRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitToCT not implemented")
+}
 func (UnimplementedRegistrationAuthorityServer) mustEmbedUnimplementedRegistrationAuthorityServer() {}
 
 // UnsafeRegistrationAuthorityServer may be embedded to opt out of forward compatibility for this service.
@@ -574,6 +592,24 @@ func _RegistrationAuthority_UnpauseAccount_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RegistrationAuthority_ResubmitToCT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitToCTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationAuthorityServer).ResubmitToCT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RegistrationAuthority_ResubmitToCT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationAuthorityServer).ResubmitToCT(ctx, req.(*ResubmitToCTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RegistrationAuthority_ServiceDesc is the grpc.ServiceDesc for RegistrationAuthority service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -641,6 +677,139 @@ var RegistrationAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnpauseAccount",
 			Handler:    _RegistrationAuthority_UnpauseAccount_Handler,
 		},
+		{
+			MethodName: "ResubmitToCT",
+			Handler:    _RegistrationAuthority_ResubmitToCT_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ra.proto",
+}
+
+const (
+	RateLimits_InspectRateLimit_FullMethodName = "/ra.RateLimits/InspectRateLimit"
+	RateLimits_ResetRateLimit_FullMethodName   = "/ra.RateLimits/ResetRateLimit"
+)
+
+// RateLimitsClient is the client API for RateLimits service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RateLimitsClient interface {
+	InspectRateLimit(ctx context.Context, in *RateLimitBucketRequest, opts ...grpc.CallOption) (*RateLimitBucketState, error)
+	ResetRateLimit(ctx context.Context, in *RateLimitBucketRequest, opts ...grpc.CallOption) (*RateLimitBucketState, error)
+}
+
+type rateLimitsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRateLimitsClient(cc grpc.ClientConnInterface) RateLimitsClient {
+	return &rateLimitsClient{cc}
+}
+
+func (c *rateLimitsClient) InspectRateLimit(ctx context.Context, in *RateLimitBucketRequest, opts ...grpc.CallOption) (*RateLimitBucketState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateLimitBucketState)
+	err := c.cc.Invoke(ctx, RateLimits_InspectRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateLimitsClient) ResetRateLimit(ctx context.Context, in *RateLimitBucketRequest, opts ...grpc.CallOption) (*RateLimitBucketState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateLimitBucketState)
+	err := c.cc.Invoke(ctx, RateLimits_ResetRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RateLimitsServer is the server API for RateLimits service.
+// All implementations must embed UnimplementedRateLimitsServer
+// for forward compatibility
+type RateLimitsServer interface {
+	InspectRateLimit(context.Context, *RateLimitBucketRequest) (*RateLimitBucketState, error)
+	ResetRateLimit(context.Context, *RateLimitBucketRequest) (*RateLimitBucketState, error)
+	mustEmbedUnimplementedRateLimitsServer()
+}
+
+// UnimplementedRateLimitsServer must be embedded to have forward compatible implementations.
+type UnimplementedRateLimitsServer struct {
+}
+
+func (UnimplementedRateLimitsServer) InspectRateLimit(context.Context, *RateLimitBucketRequest) (*RateLimitBucketState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectRateLimit not implemented")
+}
+func (UnimplementedRateLimitsServer) ResetRateLimit(context.Context, *RateLimitBucketRequest) (*RateLimitBucketState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetRateLimit not implemented")
+}
+func (UnimplementedRateLimitsServer) mustEmbedUnimplementedRateLimitsServer() {}
+
+// UnsafeRateLimitsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RateLimitsServer will
+// result in compilation errors.
+type UnsafeRateLimitsServer interface {
+	mustEmbedUnimplementedRateLimitsServer()
+}
+
+func RegisterRateLimitsServer(s grpc.ServiceRegistrar, srv RateLimitsServer) {
+	s.RegisterService(&RateLimits_ServiceDesc, srv)
+}
+
+func _RateLimits_InspectRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitsServer).InspectRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimits_InspectRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitsServer).InspectRateLimit(ctx, req.(*RateLimitBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateLimits_ResetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitsServer).ResetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimits_ResetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitsServer).ResetRateLimit(ctx, req.(*RateLimitBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RateLimits_ServiceDesc is the grpc.ServiceDesc for RateLimits service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RateLimits_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ra.RateLimits",
+	HandlerType: (*RateLimitsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InspectRateLimit",
+			Handler:    _RateLimits_InspectRateLimit_Handler,
+		},
+		{
+			MethodName: "ResetRateLimit",
+			Handler:    _RateLimits_ResetRateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ra.proto",
@@ -66,6 +66,12 @@ var (
 	// recheck CAA records within 8 hours of issuance. We set this to 7 hours to
 	// stay on the safe side.
 	caaRecheckDuration = -7 * time.Hour
+
+	// dnsPersistReuseLookback is how far back the RA looks for a valid
+	// authorization, satisfied using a DNS-PERSIST-01 record, as evidence that
+	// the same record is likely to satisfy a new authorization for the same
+	// identifier and account.
+	dnsPersistReuseLookback = 90 * 24 * time.Hour
 )
 
 // RegistrationAuthorityImpl defines an RA.
@@ -74,6 +80,7 @@ var (
 // populated, or there is a risk of panic.
 type RegistrationAuthorityImpl struct {
 	rapb.UnsafeRegistrationAuthorityServer
+	rapb.UnsafeRateLimitsServer
 	CA        capb.CertificateAuthorityClient
 	OCSP      capb.OCSPGeneratorClient
 	VA        va.RemoteClients
@@ -99,6 +106,12 @@ type RegistrationAuthorityImpl struct {
 	issuersByNameID map[issuance.NameID]*issuance.Certificate
 	purger          akamaipb.AkamaiPurgerClient
 
+	// certProfiles mirrors the CA's certificate profiles, so that the RA can
+	// reject orders and finalization requests which the CA would refuse, and
+	// restrict the challenges offered for orders requesting a profile.
+	certProfiles           map[string]*issuance.ProfileConfig
+	defaultCertProfileName string
+
 	ctpolicy *ctpolicy.CTPolicy
 
 	ctpolicyResults         *prometheus.HistogramVec
@@ -117,6 +130,7 @@ type RegistrationAuthorityImpl struct {
 }
 
 var _ rapb.RegistrationAuthorityServer = (*RegistrationAuthorityImpl)(nil)
+var _ rapb.RateLimitsServer = (*RegistrationAuthorityImpl)(nil)
 
 // NewRegistrationAuthorityImpl constructs a new RA object.
 func NewRegistrationAuthorityImpl(
@@ -136,6 +150,8 @@ func NewRegistrationAuthorityImpl(
 	ctp *ctpolicy.CTPolicy,
 	purger akamaipb.AkamaiPurgerClient,
 	issuers []*issuance.Certificate,
+	certProfiles map[string]*issuance.ProfileConfig,
+	defaultCertProfileName string,
 ) *RegistrationAuthorityImpl {
 	ctpolicyResults := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -261,6 +277,8 @@ func NewRegistrationAuthorityImpl(
 		ctpolicyResults:              ctpolicyResults,
 		purger:                       purger,
 		issuersByNameID:              issuersByNameID,
+		certProfiles:                 certProfiles,
+		defaultCertProfileName:       defaultCertProfileName,
 		namesPerCert:                 namesPerCert,
 		rlCheckLatency:               rlCheckLatency,
 		rlOverrideUsageGauge:         overrideUsageGauge,
@@ -318,6 +336,8 @@ type certificateRequestEvent struct {
 	CommonName string `json:",omitempty"`
 	// Names are the DNS SAN entries from the issued cert
 	Names []string `json:",omitempty"`
+	// IPAddresses are the IP SAN entries from the issued cert
+	IPAddresses []string `json:",omitempty"`
 	// NotBefore is the starting timestamp of the issued cert's validity period
 	NotBefore time.Time `json:",omitempty"`
 	// NotAfter is the ending timestamp of the issued cert's validity period
@@ -405,11 +425,12 @@ func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, reques
 
 	// Don't populate ID or CreatedAt because those will be set by the SA.
 	req := &corepb.Registration{
-		Key:             request.Key,
-		Contact:         request.Contact,
-		ContactsPresent: request.ContactsPresent,
-		Agreement:       request.Agreement,
-		Status:          string(core.StatusValid),
+		Key:               request.Key,
+		Contact:           request.Contact,
+		ContactsPresent:   request.ContactsPresent,
+		Agreement:         request.Agreement,
+		Status:            string(core.StatusValid),
+		ExternalAccountID: request.ExternalAccountID,
 	}
 
 	// Store the registration object, then return the version that got stored.
@@ -618,7 +639,7 @@ func (ra *RegistrationAuthorityImpl) matchesCSR(parsedCertificate *x509.Certific
 		return berrors.InternalServerError("generated certificate DNSNames don't match CSR DNSNames")
 	}
 
-	if !slices.EqualFunc(parsedCertificate.IPAddresses, csr.IPAddresses, func(l, r net.IP) bool { return l.Equal(r) }) {
+	if !slices.EqualFunc(parsedCertificate.IPAddresses, identifier.FromCSR(csr).IPAddresses(), func(l, r net.IP) bool { return l.Equal(r) }) {
 		return berrors.InternalServerError("generated certificate IPAddresses don't match CSR IPAddresses")
 	}
 	if !slices.Equal(parsedCertificate.EmailAddresses, csr.EmailAddresses) {
@@ -653,16 +674,16 @@ func (ra *RegistrationAuthorityImpl) matchesCSR(parsedCertificate *x509.Certific
 	return nil
 }
 
-// checkOrderAuthorizations verifies that a provided set of names associated
-// with a specific order and account has all of the required valid, unexpired
-// authorizations to proceed with issuance. It returns the authorizations that
-// satisfied the set of names or it returns an error. If it returns an error, it
-// will be of type BoulderError.
+// checkOrderAuthorizations verifies that a provided set of identifiers
+// associated with a specific order and account has all of the required valid,
+// unexpired authorizations to proceed with issuance. It returns the
+// authorizations that satisfied the set of identifiers or it returns an error.
+// If it returns an error, it will be of type BoulderError.
 func (ra *RegistrationAuthorityImpl) checkOrderAuthorizations(
 	ctx context.Context,
 	orderID orderID,
 	acctID accountID,
-	names []string,
+	idents identifier.ACMEIdentifiers,
 	now time.Time) (map[identifier.ACMEIdentifier]*core.Authorization, error) {
 	// Get all of the valid authorizations for this account/order
 	req := &sapb.GetValidOrderAuthorizationsRequest{
@@ -682,11 +703,7 @@ func (ra *RegistrationAuthorityImpl) checkOrderAuthorizations(
 	var missing []string
 	var invalid []string
 	var expired []string
-	for _, name := range names {
-		// TODO(#7647): Iterate directly over identifiers here, once the rest of the
-		// finalization flow supports non-dnsName identifiers.
-		ident := identifier.NewDNS(name)
-
+	for _, ident := range idents {
 		authz, ok := authzs[ident]
 		if !ok || authz == nil {
 			missing = append(missing, ident.Value)
@@ -729,8 +746,8 @@ func (ra *RegistrationAuthorityImpl) checkOrderAuthorizations(
 
 	// Even though this check is cheap, we do it after the more specific checks
 	// so that we can return more specific error messages.
-	if len(names) != len(authzs) {
-		return nil, berrors.UnauthorizedError("incorrect number of names requested for finalization")
+	if len(idents) != len(authzs) {
+		return nil, berrors.UnauthorizedError("incorrect number of identifiers requested for finalization")
 	}
 
 	// Check that the authzs either don't need CAA rechecking, or do the
@@ -778,6 +795,10 @@ func (ra *RegistrationAuthorityImpl) checkAuthorizationsCAA(
 	caaRecheckAfter := now.Add(caaRecheckDuration)
 
 	for _, authz := range authzs {
+		// CAA does not apply to IP address identifiers, see RFC 8738, Sec. 7.
+		if authz.Identifier.Type == identifier.TypeIP {
+			continue
+		}
 		if staleCAA, err := validatedBefore(authz, caaRecheckAfter); err != nil {
 			return err
 		} else if staleCAA {
@@ -1047,8 +1068,9 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 	// There should never be an order with 0 names at the stage, but we check to
 	// be on the safe side, throwing an internal server error if this assumption
 	// is ever violated.
-	if len(req.Order.DnsNames) == 0 {
-		return nil, berrors.InternalServerError("Order has no associated names")
+	orderIdents := identifier.Normalize(identifier.FromProtoSliceWithDefault(req.Order.Identifiers, req.Order.DnsNames))
+	if len(orderIdents) == 0 {
+		return nil, berrors.InternalServerError("Order has no associated identifiers")
 	}
 
 	// Parse the CSR from the request
@@ -1064,13 +1086,21 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 		return nil, err
 	}
 
-	// Dedupe, lowercase and sort both the names from the CSR and the names in the
-	// order.
-	csrNames := csrlib.NamesFromCSR(csr).SANs
-	orderNames := core.UniqueLowerNames(req.Order.DnsNames)
+	profile := ra.certProfile(req.Order.CertificateProfileName)
+	if profile != nil {
+		err = profile.CheckKey(csr.PublicKey)
+		if err != nil {
+			return nil, berrors.BadCSRError("invalid public key in CSR: %s", err)
+		}
+	}
+
+	// Dedupe, lowercase and sort both the identifiers from the CSR and the
+	// identifiers in the order.
+	csrIdents := identifier.FromCSR(csr)
 
-	// Check that the order names and the CSR names are an exact match
-	if !slices.Equal(csrNames, orderNames) {
+	// Check that the order identifiers and the CSR identifiers are an exact
+	// match
+	if !slices.Equal(csrIdents, orderIdents) {
 		return nil, berrors.UnauthorizedError(("CSR does not specify same identifiers as Order"))
 	}
 
@@ -1093,7 +1123,7 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 	// Double-check that all authorizations on this order are valid, are also
 	// associated with the same account as the order itself, and have recent CAA.
 	authzs, err := ra.checkOrderAuthorizations(
-		ctx, orderID(req.Order.Id), accountID(req.Order.RegistrationID), csrNames, ra.clk.Now())
+		ctx, orderID(req.Order.Id), accountID(req.Order.RegistrationID), csrIdents, ra.clk.Now())
 	if err != nil {
 		// Pass through the error without wrapping it because the called functions
 		// return BoulderError and we don't want to lose the type.
@@ -1102,7 +1132,7 @@ func (ra *RegistrationAuthorityImpl) validateFinalizeRequest(
 
 	// Collect up a certificateRequestAuthz that stores the ID and challenge type
 	// of each of the valid authorizations we used for this issuance.
-	logEventAuthzs := make(map[string]certificateRequestAuthz, len(csrNames))
+	logEventAuthzs := make(map[string]certificateRequestAuthz, len(csrIdents))
 	for _, authz := range authzs {
 		// No need to check for error here because we know this same call just
 		// succeeded inside ra.checkOrderAuthorizations
@@ -1159,7 +1189,7 @@ func (ra *RegistrationAuthorityImpl) issueCertificateOuter(
 
 		ra.namesPerCert.With(
 			prometheus.Labels{"type": "issued"},
-		).Observe(float64(len(order.DnsNames)))
+		).Observe(float64(len(identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames))))
 
 		ra.newCertCounter.With(
 			prometheus.Labels{
@@ -1170,6 +1200,9 @@ func (ra *RegistrationAuthorityImpl) issueCertificateOuter(
 		logEvent.SerialNumber = core.SerialToString(cert.SerialNumber)
 		logEvent.CommonName = cert.Subject.CommonName
 		logEvent.Names = cert.DNSNames
+		for _, ip := range cert.IPAddresses {
+			logEvent.IPAddresses = append(logEvent.IPAddresses, ip.String())
+		}
 		logEvent.NotBefore = cert.NotBefore
 		logEvent.NotAfter = cert.NotAfter
 		logEvent.CertProfileName = cpId.name
@@ -1290,7 +1323,10 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		return nil, nil, wrapError(err, "getting SCTs")
 	}
 
-	exists, err := ra.SA.FQDNSetExists(ctx, &sapb.FQDNSetExistsRequest{DnsNames: parsedPrecert.DNSNames})
+	exists, err := ra.SA.FQDNSetExists(ctx, &sapb.FQDNSetExistsRequest{
+		DnsNames:    parsedPrecert.DNSNames,
+		Identifiers: identifier.FromCert(parsedPrecert).ToProtoSlice(),
+	})
 	if err != nil {
 		return nil, nil, wrapError(err, "checking if certificate is a renewal")
 	}
@@ -1312,10 +1348,15 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		return nil, nil, wrapError(err, "parsing final certificate")
 	}
 
-	ra.countCertificateIssued(ctx, int64(acctID), slices.Clone(parsedCertificate.DNSNames), isRenewal)
+	ra.countCertificateIssued(ctx, int64(acctID), identifier.FromCert(parsedCertificate).ToValues(), isRenewal)
 
-	// Asynchronously submit the final certificate to any configured logs
-	go ra.ctpolicy.SubmitFinalCert(cert.Der, parsedCertificate.NotAfter)
+	// Submit the final certificate to any configured logs. This only waits
+	// for the submissions to be queued, not made. Failing to queue them
+	// doesn't invalidate the certificate, so it doesn't fail the order.
+	err = ra.ctpolicy.SubmitFinalCert(ctx, cert.Der, parsedCertificate.NotAfter)
+	if err != nil {
+		ra.log.Errf("Failed to submit final certificate %s to CT logs: %s", core.SerialToString(parsedCertificate.SerialNumber), err)
+	}
 
 	err = ra.matchesCSR(parsedCertificate, csr)
 	if err != nil {
@@ -1515,6 +1556,12 @@ func (ra *RegistrationAuthorityImpl) checkNewOrderLimits(ctx context.Context, na
 		ra.rlCheckLatency.WithLabelValues(ratelimit.NewOrdersPerAccount, ratelimits.Allowed).Observe(elapsed.Seconds())
 	}
 
+	// The remaining limits are keyed by DNS name, and do not apply to orders
+	// which contain only IP address identifiers.
+	if len(names) == 0 {
+		return nil
+	}
+
 	certNameLimits := ra.rlPolicies.CertificatesPerName()
 	if certNameLimits.Enabled() && !isRenewal {
 		started := ra.clk.Now()
@@ -1732,9 +1779,10 @@ func mergeUpdate(base *corepb.Registration, update *corepb.Registration) (*corep
 	return res, changed
 }
 
-// recordValidation records an authorization validation event,
-// it should only be used on v2 style authorizations.
-func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authID string, authExpires *time.Time, challenge *core.Challenge) error {
+// recordValidation records an authorization validation event, along with the
+// results of the DCV and CAA checks from each perspective, if any. It should
+// only be used on v2 style authorizations.
+func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authID string, authExpires *time.Time, challenge *core.Challenge, perspectives []*corepb.PerspectiveResult) error {
 	authzID, err := strconv.ParseInt(authID, 10, 64)
 	if err != nil {
 		return err
@@ -1754,13 +1802,14 @@ func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authI
 		validated = timestamppb.New(*challenge.Validated)
 	}
 	_, err = ra.SA.FinalizeAuthorization2(ctx, &sapb.FinalizeAuthorizationRequest{
-		Id:                authzID,
-		Status:            string(challenge.Status),
-		Expires:           timestamppb.New(expires),
-		Attempted:         string(challenge.Type),
-		AttemptedAt:       validated,
-		ValidationRecords: vr.Records,
-		ValidationError:   vr.Problem,
+		Id:                 authzID,
+		Status:             string(challenge.Status),
+		Expires:            timestamppb.New(expires),
+		Attempted:          string(challenge.Type),
+		AttemptedAt:        validated,
+		ValidationRecords:  vr.Records,
+		ValidationError:    vr.Problem,
+		PerspectiveResults: perspectives,
 	})
 	return err
 }
@@ -1833,29 +1882,41 @@ func (ra *RegistrationAuthorityImpl) resetAccountPausingLimit(ctx context.Contex
 // doDCVAndCAA performs DCV and CAA checks. When EnforceMPIC is enabled, the
 // checks are executed sequentially: DCV is performed first and CAA is only
 // checked if DCV is successful. Validation records from the DCV check are
-// returned even if the CAA check fails. When EnforceMPIC is disabled, DCV and
-// CAA checks are performed in the same request.
-func (ra *RegistrationAuthorityImpl) checkDCVAndCAA(ctx context.Context, dcvReq *vapb.PerformValidationRequest, caaReq *vapb.IsCAAValidRequest) (*corepb.ProblemDetails, []*corepb.ValidationRecord, error) {
+// returned even if the CAA check fails, as are the per-perspective results of
+// the DCV check and, if EnforceMultiCAA is enabled, of the CAA check. When
+// EnforceMPIC is disabled, DCV and CAA checks are performed in the same
+// request, and no per-perspective results are returned. If caaReq is nil, no
+// CAA check is performed, as for IP address identifiers.
+func (ra *RegistrationAuthorityImpl) checkDCVAndCAA(ctx context.Context, dcvReq *vapb.PerformValidationRequest, caaReq *vapb.IsCAAValidRequest) (*corepb.ProblemDetails, []*corepb.ValidationRecord, []*corepb.PerspectiveResult, error) {
 	if !features.Get().EnforceMPIC {
 		performValidationRes, err := ra.VA.PerformValidation(ctx, dcvReq)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
-		return performValidationRes.Problem, performValidationRes.Records, nil
+		return performValidationRes.Problem, performValidationRes.Records, nil, nil
 	} else {
 		doDCVRes, err := ra.VA.DoDCV(ctx, dcvReq)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
-		if doDCVRes.Problem != nil {
-			return doDCVRes.Problem, doDCVRes.Records, nil
+		if doDCVRes.Problem != nil || caaReq == nil {
+			return doDCVRes.Problem, doDCVRes.Records, doDCVRes.PerspectiveResults, nil
 		}
 
-		doCAAResp, err := ra.VA.IsCAAValid(ctx, caaReq)
+		// Only DoCAA, the MPIC counterpart of DoDCV, returns per-perspective
+		// results, but it always corroborates CAA with the remote VAs. Keep
+		// using IsCAAValid until that is enabled by EnforceMultiCAA, in which
+		// case no CAA perspective results are recorded.
+		var doCAAResp *vapb.IsCAAValidResponse
+		if features.Get().EnforceMultiCAA {
+			doCAAResp, err = ra.VA.DoCAA(ctx, caaReq)
+		} else {
+			doCAAResp, err = ra.VA.IsCAAValid(ctx, caaReq)
+		}
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, doDCVRes.PerspectiveResults, err
 		}
-		return doCAAResp.Problem, doDCVRes.Records, nil
+		return doCAAResp.Problem, doDCVRes.Records, append(doDCVRes.PerspectiveResults, doCAAResp.PerspectiveResults...), nil
 	}
 }
 
@@ -1870,7 +1931,7 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 	vStart := ra.clk.Now()
 
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
-	if req.Authz == nil || req.Authz.Id == "" || req.Authz.DnsName == "" || req.Authz.Status == "" || core.IsAnyNilOrZero(req.Authz.Expires) {
+	if req.Authz == nil || req.Authz.Id == "" || identifier.FromProtoWithDefault(req.Authz.Identifier, req.Authz.DnsName).Value == "" || req.Authz.Status == "" || core.IsAnyNilOrZero(req.Authz.Expires) {
 		return nil, errIncompleteGRPCRequest
 	}
 
@@ -1943,21 +2004,8 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 		copy(challenges, authz.Challenges)
 		authz.Challenges = challenges
 		chall, _ := bgrpc.ChallengeToPB(authz.Challenges[challIndex])
-		checkProb, checkRecords, err := ra.checkDCVAndCAA(
-			vaCtx,
-			&vapb.PerformValidationRequest{
-				DnsName:                  authz.Identifier.Value,
-				Challenge:                chall,
-				Authz:                    &vapb.AuthzMeta{Id: authz.ID, RegID: authz.RegistrationID},
-				ExpectedKeyAuthorization: expectedKeyAuthorization,
-			},
-			&vapb.IsCAAValidRequest{
-				Domain:           authz.Identifier.Value,
-				ValidationMethod: chall.Type,
-				AccountURIID:     authz.RegistrationID,
-				AuthzID:          authz.ID,
-			},
-		)
+		dcvReq, caaReq := validationRequests(authz, chall, expectedKeyAuthorization)
+		checkProb, checkRecords, perspectives, err := ra.checkDCVAndCAA(vaCtx, dcvReq, caaReq)
 		challenge := &authz.Challenges[challIndex]
 		var prob *probs.ProblemDetails
 		if err != nil {
@@ -2001,7 +2049,7 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 		challenge.Validated = &vStart
 		authz.Challenges[challIndex] = *challenge
 
-		err = ra.recordValidation(vaCtx, authz.ID, authz.Expires, challenge)
+		err = ra.recordValidation(vaCtx, authz.ID, authz.Expires, challenge, perspectives)
 		if err != nil {
 			if errors.Is(err, berrors.AlreadyRevoked) {
 				ra.log.Infof("Didn't record already-finalized validation: regID=[%d] authzID=[%s] err=[%s]",
@@ -2015,9 +2063,193 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 	return bgrpc.AuthzToPB(authz)
 }
 
+// validationRequests builds the VA requests used to validate the given
+// challenge of the given authorization, and to check CAA for its identifier.
+// The CAA request is nil if CAA does not apply to the identifier.
+func validationRequests(authz core.Authorization, chall *corepb.Challenge, expectedKeyAuthorization string) (*vapb.PerformValidationRequest, *vapb.IsCAAValidRequest) {
+	dcvReq := &vapb.PerformValidationRequest{
+		Identifier:               authz.Identifier.AsProto(),
+		Challenge:                chall,
+		Authz:                    &vapb.AuthzMeta{Id: authz.ID, RegID: authz.RegistrationID},
+		ExpectedKeyAuthorization: expectedKeyAuthorization,
+	}
+	var caaReq *vapb.IsCAAValidRequest
+	// CAA does not apply to IP address identifiers, see RFC 8738, Sec. 7.
+	if authz.Identifier.Type == identifier.TypeDNS {
+		// TODO: Remove DnsName once all VAs read Identifier.
+		dcvReq.DnsName = authz.Identifier.Value
+		caaReq = &vapb.IsCAAValidRequest{
+			Domain:           authz.Identifier.Value,
+			ValidationMethod: chall.Type,
+			AccountURIID:     authz.RegistrationID,
+			AuthzID:          authz.ID,
+		}
+	}
+	return dcvReq, caaReq
+}
+
+// reusePersistentProofs attempts to validate each of the given newly created
+// pending authorizations using a DNS-PERSIST-01 record, if the account has
+// previously validated the same identifier that way within
+// dnsPersistReuseLookback. Because the record is long-lived it is likely to
+// still be published, so the authorization can be satisfied without the
+// client responding to a challenge. The record is always checked afresh,
+// including CAA and (if enforced) MPIC, exactly as for a client-initiated
+// validation. If any step fails the authorization is simply left pending, and
+// no failed validation is counted, so the client can proceed as normal.
+//
+// This runs in the background so as not to delay the NewOrder response; the
+// client will observe the authorization becoming valid when it next polls.
+func (ra *RegistrationAuthorityImpl) reusePersistentProofs(regID int64, authzIDs []int64, idents []identifier.ACMEIdentifier) {
+	ra.drainWG.Add(1)
+	go func() {
+		defer ra.drainWG.Done()
+		ctx := context.Background()
+
+		prior, err := ra.SA.GetValidAuthorizations2(ctx, &sapb.GetValidAuthorizationsRequest{
+			RegistrationID: regID,
+			Identifiers:    identifier.ACMEIdentifiers(idents).ToProtoSlice(),
+			ValidUntil:     timestamppb.New(ra.clk.Now().Add(-dnsPersistReuseLookback)),
+		})
+		if err != nil {
+			ra.log.Warningf("Looking up prior DNS-PERSIST-01 validations for regID=[%d]: %s", regID, err)
+			return
+		}
+		persisted := make(map[identifier.ACMEIdentifier]bool)
+		for _, authz := range prior.Authzs {
+			if len(authz.Challenges) == 1 && authz.Challenges[0].Type == string(core.ChallengeTypeDNSPersist01) {
+				persisted[identifier.FromProto(authz.Identifier)] = true
+			}
+		}
+		if len(persisted) == 0 {
+			return
+		}
+
+		regPB, err := ra.SA.GetRegistration(ctx, &sapb.RegistrationID{Id: regID})
+		if err != nil {
+			ra.log.Warningf("Getting account for DNS-PERSIST-01 reuse regID=[%d]: %s", regID, err)
+			return
+		}
+		reg, err := bgrpc.PbToRegistration(regPB)
+		if err != nil {
+			ra.log.Warningf("Getting account for DNS-PERSIST-01 reuse regID=[%d]: %s", regID, err)
+			return
+		}
+
+		for _, authzID := range authzIDs {
+			authzPB, err := ra.SA.GetAuthorization2(ctx, &sapb.AuthorizationID2{Id: authzID})
+			if err != nil {
+				ra.log.Warningf("Getting authz for DNS-PERSIST-01 reuse authzID=[%d]: %s", authzID, err)
+				continue
+			}
+			authz, err := bgrpc.PBToAuthz(authzPB)
+			if err != nil {
+				ra.log.Warningf("Getting authz for DNS-PERSIST-01 reuse authzID=[%d]: %s", authzID, err)
+				continue
+			}
+			if authz.Status != core.StatusPending || !persisted[authz.Identifier] {
+				continue
+			}
+			challIndex := slices.IndexFunc(authz.Challenges, func(ch core.Challenge) bool {
+				return ch.Type == core.ChallengeTypeDNSPersist01
+			})
+			if challIndex < 0 {
+				continue
+			}
+			ra.reusePersistentProof(ctx, authz, challIndex, &reg)
+		}
+	}()
+}
+
+// reusePersistentProof makes a single attempt to validate the given challenge,
+// which must be a DNS-PERSIST-01 challenge of the given pending authorization,
+// and records the authorization as valid if it succeeds.
+func (ra *RegistrationAuthorityImpl) reusePersistentProof(ctx context.Context, authz core.Authorization, challIndex int, reg *core.Registration) {
+	vStart := ra.clk.Now()
+	challenge := authz.Challenges[challIndex]
+
+	// DNS-PERSIST-01 does not use the key authorization, but the VA requires
+	// one to be present.
+	expectedKeyAuthorization, err := challenge.ExpectedKeyAuthorization(reg.Key)
+	if err != nil {
+		ra.log.Warningf("Computing key authorization for DNS-PERSIST-01 reuse authzID=[%s]: %s", authz.ID, err)
+		return
+	}
+
+	chall, _ := bgrpc.ChallengeToPB(challenge)
+	dcvReq, caaReq := validationRequests(authz, chall, expectedKeyAuthorization)
+	checkProb, checkRecords, perspectives, err := ra.checkDCVAndCAA(ctx, dcvReq, caaReq)
+	if err != nil {
+		ra.log.Warningf("Could not communicate with VA for DNS-PERSIST-01 reuse authzID=[%s]: %s", authz.ID, err)
+		return
+	}
+	if checkProb != nil {
+		ra.log.Infof("DNS-PERSIST-01 record could not be reused: regID=[%d] authzID=[%s] problem=[%s]",
+			authz.RegistrationID, authz.ID, checkProb.Detail)
+		return
+	}
+
+	records := make([]core.ValidationRecord, len(checkRecords))
+	for i, r := range checkRecords {
+		records[i], err = bgrpc.PBToValidationRecord(r)
+		if err != nil {
+			ra.log.Warningf("Records for DNS-PERSIST-01 reuse corrupt authzID=[%s]: %s", authz.ID, err)
+			return
+		}
+	}
+	challenge.ValidationRecord = records
+	if !challenge.RecordsSane() {
+		ra.log.Warningf("Records for DNS-PERSIST-01 reuse failed sanity check authzID=[%s]", authz.ID)
+		return
+	}
+
+	challenge.Status = core.StatusValid
+	challenge.Validated = &vStart
+	if features.Get().AutomaticallyPauseZombieClients {
+		ra.resetAccountPausingLimit(ctx, authz.RegistrationID, authz.Identifier)
+	}
+
+	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &challenge, perspectives)
+	if err != nil {
+		ra.log.AuditErrf("Failed to record DNS-PERSIST-01 reuse: regID=[%d] authzID=[%s] err=[%s]",
+			authz.RegistrationID, authz.ID, err)
+		return
+	}
+	ra.log.Infof("Reused DNS-PERSIST-01 record: regID=[%d] authzID=[%s]", authz.RegistrationID, authz.ID)
+}
+
+// crlShard extracts the CRL shard index from the certificate's CRL
+// Distribution Point, if it has one. Certificates issued with serial-hash
+// sharding contain exactly one CRLDP URL whose final path component is
+// "<shard>.crl". Certificates without a CRLDP are sharded by expiration time
+// instead, and get a shard index of 0.
+func crlShard(cert *x509.Certificate) (int64, error) {
+	if len(cert.CRLDistributionPoints) == 0 {
+		return 0, nil
+	}
+	if len(cert.CRLDistributionPoints) > 1 {
+		return 0, errors.New("too many crlDistributionPoints in certificate")
+	}
+
+	url := strings.TrimSuffix(cert.CRLDistributionPoints[0], ".crl")
+	if url == cert.CRLDistributionPoints[0] {
+		return 0, fmt.Errorf("crlDistributionPoint %q does not end in .crl", cert.CRLDistributionPoints[0])
+	}
+	shardStr := url[strings.LastIndex(url, "/")+1:]
+	shardIdx, err := strconv.ParseInt(shardStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing CRL shard from %q: %w", cert.CRLDistributionPoints[0], err)
+	}
+	if shardIdx <= 0 {
+		return 0, fmt.Errorf("invalid CRL shard %d in %q", shardIdx, cert.CRLDistributionPoints[0])
+	}
+	return shardIdx, nil
+}
+
 // revokeCertificate updates the database to mark the certificate as revoked,
-// with the given reason and current timestamp.
-func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, serial *big.Int, issuerID issuance.NameID, reason revocation.Reason) error {
+// with the given reason and current timestamp. If shardIdx is non-zero, the
+// revocation is also recorded in the given serial-hash CRL shard.
+func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, serial *big.Int, issuerID issuance.NameID, shardIdx int64, reason revocation.Reason) error {
 	serialString := core.SerialToString(serial)
 
 	_, err := ra.SA.RevokeCertificate(ctx, &sapb.RevokeCertificateRequest{
@@ -2025,6 +2257,7 @@ func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, seri
 		Reason:   int64(reason),
 		Date:     timestamppb.New(ra.clk.Now()),
 		IssuerID: int64(issuerID),
+		ShardIdx: shardIdx,
 	})
 	if err != nil {
 		return err
@@ -2038,7 +2271,7 @@ func (ra *RegistrationAuthorityImpl) revokeCertificate(ctx context.Context, seri
 // as revoked, with the given reason and current timestamp. This only works for
 // certificates that were previously revoked for a reason other than
 // keyCompromise, and which are now being updated to keyCompromise instead.
-func (ra *RegistrationAuthorityImpl) updateRevocationForKeyCompromise(ctx context.Context, serial *big.Int, issuerID issuance.NameID) error {
+func (ra *RegistrationAuthorityImpl) updateRevocationForKeyCompromise(ctx context.Context, serial *big.Int, issuerID issuance.NameID, shardIdx int64) error {
 	serialString := core.SerialToString(serial)
 
 	status, err := ra.SA.GetCertificateStatus(ctx, &sapb.Serial{Serial: serialString})
@@ -2061,6 +2294,7 @@ func (ra *RegistrationAuthorityImpl) updateRevocationForKeyCompromise(ctx contex
 		Date:     timestamppb.New(ra.clk.Now()),
 		Backdate: status.RevokedDate,
 		IssuerID: int64(issuerID),
+		ShardIdx: shardIdx,
 	})
 	if err != nil {
 		return err
@@ -2147,13 +2381,15 @@ func (ra *RegistrationAuthorityImpl) RevokeCertByApplicant(ctx context.Context,
 		logEvent.Method = "subscriber"
 	} else {
 		// The requester is a different account. We need to confirm that they have
-		// authorizations for all names in the cert.
+		// authorizations for all identifiers in the cert.
 		logEvent.Method = "control"
 
+		idents := identifier.FromCert(cert)
 		var authzPB *sapb.Authorizations
 		authzPB, err = ra.SA.GetValidAuthorizations2(ctx, &sapb.GetValidAuthorizationsRequest{
 			RegistrationID: req.RegID,
-			DnsNames:       cert.DNSNames,
+			DnsNames:       idents.DNSNames(),
+			Identifiers:    idents.ToProtoSlice(),
 			ValidUntil:     timestamppb.New(ra.clk.Now()),
 		})
 		if err != nil {
@@ -2166,9 +2402,8 @@ func (ra *RegistrationAuthorityImpl) RevokeCertByApplicant(ctx context.Context,
 			return nil, err
 		}
 
-		// TODO(#7647): Support other kinds of SANs/identifiers here.
-		for _, name := range cert.DNSNames {
-			if _, present := authzMap[identifier.NewDNS(name)]; !present {
+		for _, ident := range idents {
+			if _, present := authzMap[ident]; !present {
 				return nil, berrors.UnauthorizedError("requester does not control all names in cert with serial %q", serialString)
 			}
 		}
@@ -2182,10 +2417,16 @@ func (ra *RegistrationAuthorityImpl) RevokeCertByApplicant(ctx context.Context,
 	}
 
 	issuerID := issuance.IssuerNameID(cert)
+	shardIdx, err := crlShard(cert)
+	if err != nil {
+		return nil, err
+	}
+
 	err = ra.revokeCertificate(
 		ctx,
 		cert.SerialNumber,
 		issuerID,
+		shardIdx,
 		revocation.Reason(req.Code),
 	)
 	if err != nil {
@@ -2238,6 +2479,10 @@ func (ra *RegistrationAuthorityImpl) RevokeCertByKey(ctx context.Context, req *r
 	}
 
 	issuerID := issuance.IssuerNameID(cert)
+	shardIdx, err := crlShard(cert)
+	if err != nil {
+		return nil, err
+	}
 
 	logEvent := certificateRevocationEvent{
 		ID:           core.NewToken(),
@@ -2265,6 +2510,7 @@ func (ra *RegistrationAuthorityImpl) RevokeCertByKey(ctx context.Context, req *r
 		ctx,
 		cert.SerialNumber,
 		issuerID,
+		shardIdx,
 		revocation.Reason(ocsp.KeyCompromise),
 	)
 
@@ -2287,7 +2533,7 @@ func (ra *RegistrationAuthorityImpl) RevokeCertByKey(ctx context.Context, req *r
 	} else if errors.Is(err, berrors.AlreadyRevoked) {
 		// If it was an AlreadyRevoked error, try to re-revoke the cert in case
 		// it was revoked for a reason other than keyCompromise.
-		err = ra.updateRevocationForKeyCompromise(ctx, cert.SerialNumber, issuerID)
+		err = ra.updateRevocationForKeyCompromise(ctx, cert.SerialNumber, issuerID, shardIdx)
 
 		// Perform an Akamai cache purge to handle occurrences of a client
 		// previously successfully revoking a certificate, but the cache purge had
@@ -2394,7 +2640,19 @@ func (ra *RegistrationAuthorityImpl) AdministrativelyRevokeCertificate(ctx conte
 		return nil, err
 	}
 
-	err = ra.revokeCertificate(ctx, serialInt, issuerID, revocation.Reason(req.Code))
+	// Malformed certificates have no parsed body from which to extract a CRL
+	// shard, so they are recorded without one. The crl-updater includes them in
+	// its expiry-sharded CRLs or, once it shards only by serial, in the
+	// highest-numbered shard.
+	var shardIdx int64
+	if cert != nil {
+		shardIdx, err = crlShard(cert)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = ra.revokeCertificate(ctx, serialInt, issuerID, shardIdx, revocation.Reason(req.Code))
 	// Perform an Akamai cache purge to handle occurrences of a client
 	// successfully revoking a certificate, but the initial cache purge failing.
 	if errors.Is(err, berrors.AlreadyRevoked) {
@@ -2408,7 +2666,7 @@ func (ra *RegistrationAuthorityImpl) AdministrativelyRevokeCertificate(ctx conte
 	}
 	if err != nil {
 		if req.Code == ocsp.KeyCompromise && errors.Is(err, berrors.AlreadyRevoked) {
-			err = ra.updateRevocationForKeyCompromise(ctx, serialInt, issuerID)
+			err = ra.updateRevocationForKeyCompromise(ctx, serialInt, issuerID, shardIdx)
 			if err != nil {
 				return nil, err
 			}
@@ -2517,31 +2775,99 @@ func (ra *RegistrationAuthorityImpl) GenerateOCSP(ctx context.Context, req *rapb
 	})
 }
 
+// ResubmitToCT submits the final certificate with the given serial to the
+// configured final CT logs again, as if it had just been issued. It is used by
+// operators to recover from submissions which were lost or abandoned.
+func (ra *RegistrationAuthorityImpl) ResubmitToCT(ctx context.Context, req *rapb.ResubmitToCTRequest) (*emptypb.Empty, error) {
+	if core.IsAnyNilOrZero(req.Serial) {
+		return nil, errIncompleteGRPCRequest
+	}
+
+	cert, err := ra.SA.GetCertificate(ctx, &sapb.Serial{Serial: req.Serial})
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCertificate, err := x509.ParseCertificate(cert.Der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate %s: %w", req.Serial, err)
+	}
+	if !ra.clk.Now().Before(parsedCertificate.NotAfter) {
+		return nil, berrors.NotFoundError("certificate is expired")
+	}
+
+	err = ra.ctpolicy.SubmitFinalCert(ctx, cert.Der, parsedCertificate.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+	ra.log.AuditInfof("Resubmitted final certificate %s to CT logs", req.Serial)
+	return &emptypb.Empty{}, nil
+}
+
+// wildcardChallengeAllowed returns true if the PA permits the given challenge
+// type to be used to validate the given wildcard identifier.
+func (ra *RegistrationAuthorityImpl) wildcardChallengeAllowed(ident identifier.ACMEIdentifier, challType core.AcmeChallenge) bool {
+	challTypes, err := ra.PA.ChallengeTypesFor(ident)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(challTypes, challType)
+}
+
+// wildcardAuthzAllowed returns true if the given authz for a wildcard
+// identifier has at least one challenge, and the PA permits every one of its
+// challenge types to be used to validate that identifier.
+func (ra *RegistrationAuthorityImpl) wildcardAuthzAllowed(ident identifier.ACMEIdentifier, authz *core.Authorization) bool {
+	if len(authz.Challenges) == 0 {
+		return false
+	}
+	for _, chall := range authz.Challenges {
+		if !ra.wildcardChallengeAllowed(ident, chall.Type) {
+			return false
+		}
+	}
+	return true
+}
+
 // NewOrder creates a new order object
 func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.NewOrderRequest) (*corepb.Order, error) {
 	if req == nil || req.RegistrationID == 0 {
 		return nil, errIncompleteGRPCRequest
 	}
 
+	idents := identifier.Normalize(identifier.FromProtoSliceWithDefault(req.Identifiers, req.DnsNames))
+
 	newOrder := &sapb.NewOrderRequest{
 		RegistrationID:         req.RegistrationID,
-		DnsNames:               core.UniqueLowerNames(req.DnsNames),
+		DnsNames:               idents.DNSNames(),
+		Identifiers:            idents.ToProtoSlice(),
 		CertificateProfileName: req.CertificateProfileName,
 		ReplacesSerial:         req.ReplacesSerial,
 	}
 
-	if len(newOrder.DnsNames) > ra.maxNames {
+	if len(idents) > ra.maxNames {
 		return nil, berrors.MalformedError(
-			"Order cannot contain more than %d DNS names", ra.maxNames)
+			"Order cannot contain more than %d identifiers", ra.maxNames)
+	}
+
+	profile := ra.certProfile(req.CertificateProfileName)
+	if profile != nil {
+		err := profile.CheckIdentifiers(idents)
+		if errors.Is(err, issuance.ErrTooManyIdentifiers) {
+			return nil, berrors.MalformedError("%s", err)
+		} else if err != nil {
+			return nil, berrors.RejectedIdentifierError("%s", err)
+		}
 	}
 
-	// Validate that our policy allows issuing for each of the names in the order
-	err := ra.PA.WillingToIssue(newOrder.DnsNames)
+	// Validate that our policy allows issuing for each of the identifiers in
+	// the order
+	err := ra.PA.WillingToIssue(idents)
 	if err != nil {
 		return nil, err
 	}
 
-	err = wildcardOverlap(newOrder.DnsNames)
+	err = wildcardOverlap(idents.DNSNames())
 	if err != nil {
 		return nil, err
 	}
@@ -2549,8 +2875,9 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	// See if there is an existing unexpired pending (or ready) order that can be reused
 	// for this account
 	existingOrder, err := ra.SA.GetOrderForNames(ctx, &sapb.GetOrderForNamesRequest{
-		AcctID:   newOrder.RegistrationID,
-		DnsNames: newOrder.DnsNames,
+		AcctID:      newOrder.RegistrationID,
+		DnsNames:    newOrder.DnsNames,
+		Identifiers: newOrder.Identifiers,
 	})
 	// If there was an error and it wasn't an acceptable "NotFound" error, return
 	// immediately
@@ -2563,7 +2890,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	if existingOrder != nil {
 		// Check to see if the expected fields of the existing order are set.
 		// TODO(#7153): Check each value via core.IsAnyNilOrZero
-		if existingOrder.Id == 0 || existingOrder.Status == "" || existingOrder.RegistrationID == 0 || len(existingOrder.DnsNames) == 0 || core.IsAnyNilOrZero(existingOrder.Created, existingOrder.Expires) {
+		if existingOrder.Id == 0 || existingOrder.Status == "" || existingOrder.RegistrationID == 0 || len(identifier.FromProtoSliceWithDefault(existingOrder.Identifiers, existingOrder.DnsNames)) == 0 || core.IsAnyNilOrZero(existingOrder.Created, existingOrder.Expires) {
 			return nil, errIncompleteGRPCResponse
 		}
 
@@ -2578,7 +2905,8 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 
 	// Renewal orders, indicated by ARI, are exempt from NewOrder rate limits.
 	if !req.IsARIRenewal && !features.Get().UseKvLimitsForNewOrder {
-		// Check if there is rate limit space for issuing a certificate.
+		// Check if there is rate limit space for issuing a certificate. The
+		// legacy rate limits only apply to DNS names.
 		err = ra.checkNewOrderLimits(ctx, newOrder.DnsNames, newOrder.RegistrationID, req.IsRenewal)
 		if err != nil {
 			return nil, err
@@ -2600,6 +2928,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 			RegistrationID: newOrder.RegistrationID,
 			ValidUntil:     timestamppb.New(authzExpiryCutoff),
 			DnsNames:       newOrder.DnsNames,
+			Identifiers:    newOrder.Identifiers,
 		}
 		existingAuthz, err = ra.SA.GetValidAuthorizations2(ctx, getAuthReq)
 	} else {
@@ -2607,6 +2936,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 			RegistrationID: newOrder.RegistrationID,
 			ValidUntil:     timestamppb.New(authzExpiryCutoff),
 			DnsNames:       newOrder.DnsNames,
+			Identifiers:    newOrder.Identifiers,
 		}
 		existingAuthz, err = ra.SA.GetAuthorizations2(ctx, getAuthReq)
 	}
@@ -2619,27 +2949,32 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		return nil, err
 	}
 
-	// For each of the names in the order, if there is an acceptable
+	// For each of the identifiers in the order, if there is an acceptable
 	// existing authz, append it to the order to reuse it. Otherwise track
-	// that there is a missing authz for that name.
-	// TODO(#7647): Support non-dnsName identifier types here.
+	// that there is a missing authz for that identifier.
 	var missingAuthzIdents []identifier.ACMEIdentifier
-	for _, name := range newOrder.DnsNames {
-		ident := identifier.NewDNS(name)
+	for _, ident := range idents {
 		// If there isn't an existing authz, note that its missing and continue
 		authz, exists := identToExistingAuthz[ident]
 		if !exists {
 			missingAuthzIdents = append(missingAuthzIdents, ident)
 			continue
 		}
+		// If the profile restricts challenge types, an authz which was (or
+		// could be) validated using a disallowed challenge can't be reused.
+		if !profileAllowsAuthz(profile, authz) {
+			delete(identToExistingAuthz, ident)
+			missingAuthzIdents = append(missingAuthzIdents, ident)
+			continue
+		}
 		authzAge := (ra.authorizationLifetime - authz.Expires.Sub(ra.clk.Now())).Seconds()
-		// If the identifier is a wildcard and the existing authz only has one
-		// DNS-01 type challenge we can reuse it. In theory we will
+		// If the identifier is a wildcard and every challenge of the existing
+		// authz is of a type which the PA permits for wildcards (e.g. DNS-01,
+		// DNS-ACCOUNT-01, or DNS-PERSIST-01), we can reuse it. In theory we will
 		// never get back an authorization for a domain with a wildcard prefix
 		// that doesn't meet this criteria from SA.GetAuthorizations but we verify
 		// again to be safe.
-		if strings.HasPrefix(name, "*.") &&
-			len(authz.Challenges) == 1 && authz.Challenges[0].Type == core.ChallengeTypeDNS01 {
+		if strings.HasPrefix(ident.Value, "*.") && ra.wildcardAuthzAllowed(ident, authz) {
 			authzID, err := strconv.ParseInt(authz.ID, 10, 64)
 			if err != nil {
 				return nil, err
@@ -2647,7 +2982,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 			newOrder.V2Authorizations = append(newOrder.V2Authorizations, authzID)
 			ra.authzAges.WithLabelValues("NewOrder", string(authz.Status)).Observe(authzAge)
 			continue
-		} else if !strings.HasPrefix(name, "*.") {
+		} else if !strings.HasPrefix(ident.Value, "*.") {
 			// If the identifier isn't a wildcard, we can reuse any authz
 			authzID, err := strconv.ParseInt(authz.ID, 10, 64)
 			if err != nil {
@@ -2688,7 +3023,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	// authorization for each.
 	var newAuthzs []*sapb.NewAuthzRequest
 	for _, ident := range missingAuthzIdents {
-		pb, err := ra.createPendingAuthz(newOrder.RegistrationID, ident)
+		pb, err := ra.createPendingAuthz(newOrder.RegistrationID, ident, profile)
 		if err != nil {
 			return nil, err
 		}
@@ -2736,25 +3071,42 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		return nil, err
 	}
 
-	if core.IsAnyNilOrZero(storedOrder.Id, storedOrder.Status, storedOrder.RegistrationID, storedOrder.DnsNames, storedOrder.Created, storedOrder.Expires) {
+	storedIdents := identifier.FromProtoSliceWithDefault(storedOrder.Identifiers, storedOrder.DnsNames)
+	if core.IsAnyNilOrZero(storedOrder.Id, storedOrder.Status, storedOrder.RegistrationID, storedIdents, storedOrder.Created, storedOrder.Expires) {
 		return nil, errIncompleteGRPCResponse
 	}
 	ra.orderAges.WithLabelValues("NewOrder").Observe(0)
 
-	// Note how many names are being requested in this certificate order.
-	ra.namesPerCert.With(prometheus.Labels{"type": "requested"}).Observe(float64(len(storedOrder.DnsNames)))
+	// Note how many identifiers are being requested in this certificate order.
+	ra.namesPerCert.With(prometheus.Labels{"type": "requested"}).Observe(float64(len(storedIdents)))
+
+	// If the client previously satisfied any of the new authorizations'
+	// identifiers using a standing DNS-PERSIST-01 record, try to reuse it.
+	if len(newAuthzs) > 0 && ra.PA.ChallengeTypeEnabled(core.ChallengeTypeDNSPersist01) {
+		ra.reusePersistentProofs(storedOrder.RegistrationID, storedOrder.V2Authorizations, missingAuthzIdents)
+	}
 
 	return storedOrder, nil
 }
 
 // createPendingAuthz checks that a name is allowed for issuance and creates the
 // necessary challenges for it and puts this and all of the relevant information
-// into a corepb.Authorization for transmission to the SA to be stored
-func (ra *RegistrationAuthorityImpl) createPendingAuthz(reg int64, ident identifier.ACMEIdentifier) (*sapb.NewAuthzRequest, error) {
+// into a corepb.Authorization for transmission to the SA to be stored. If
+// profile is non-nil, only the challenge types it allows are offered.
+func (ra *RegistrationAuthorityImpl) createPendingAuthz(reg int64, ident identifier.ACMEIdentifier, profile *issuance.ProfileConfig) (*sapb.NewAuthzRequest, error) {
 	challTypes, err := ra.PA.ChallengeTypesFor(ident)
 	if err != nil {
 		return nil, err
 	}
+	if profile != nil {
+		challTypes = slices.DeleteFunc(challTypes, func(t core.AcmeChallenge) bool {
+			return !profile.AllowsChallengeType(t)
+		})
+		if len(challTypes) == 0 {
+			return nil, berrors.RejectedIdentifierError(
+				"no challenge types allowed by the requested profile can be used to validate %q", ident.Value)
+		}
+	}
 
 	challStrs := make([]string, len(challTypes))
 	for i, t := range challTypes {
@@ -2772,6 +3124,38 @@ func (ra *RegistrationAuthorityImpl) createPendingAuthz(reg int64, ident identif
 	return authz, nil
 }
 
+// certProfile returns the configuration of the named certificate profile, or
+// of the default profile if name is empty. It returns nil if the RA has no
+// configuration for the profile, in which case no profile-specific
+// restrictions are applied; the WFE and CA remain responsible for rejecting
+// unknown profile names.
+func (ra *RegistrationAuthorityImpl) certProfile(name string) *issuance.ProfileConfig {
+	if name == "" {
+		name = ra.defaultCertProfileName
+	}
+	return ra.certProfiles[name]
+}
+
+// profileAllowsAuthz returns false if profile restricts challenge types and
+// the given authz cannot be reused for an order requesting that profile. A
+// valid authz is only reusable if it was validated using an allowed challenge
+// type. A pending authz is only reusable if it offers no disallowed challenge
+// types, since the client could otherwise fulfill any of them.
+func profileAllowsAuthz(profile *issuance.ProfileConfig, authz *core.Authorization) bool {
+	if profile == nil {
+		return true
+	}
+	for _, chall := range authz.Challenges {
+		if authz.Status == core.StatusValid && chall.Status != core.StatusValid {
+			continue
+		}
+		if !profile.AllowsChallengeType(chall.Type) {
+			return false
+		}
+	}
+	return true
+}
+
 // wildcardOverlap takes a slice of domain names and returns an error if any of
 // them is a non-wildcard FQDN that overlaps with a wildcard domain in the map.
 func wildcardOverlap(dnsNames []string) error {
@@ -2825,6 +3209,83 @@ func (ra *RegistrationAuthorityImpl) UnpauseAccount(ctx context.Context, request
 	return &rapb.UnpauseAccountResponse{Count: count.Count}, nil
 }
 
+// inspectRateLimit returns the current state of the rate limit bucket
+// identified by the request's limit name and bucket id.
+func (ra *RegistrationAuthorityImpl) inspectRateLimit(ctx context.Context, req *rapb.RateLimitBucketRequest) (*ratelimits.BucketState, error) {
+	if core.IsAnyNilOrZero(req, req.Name, req.Id) {
+		return nil, errIncompleteGRPCRequest
+	}
+	if ra.limiter == nil || ra.txnBuilder == nil {
+		return nil, berrors.InternalServerError("key-value rate limits are not configured")
+	}
+
+	name, err := ratelimits.NameFromString(req.Name)
+	if err != nil {
+		return nil, berrors.MalformedError("%s", err)
+	}
+	txn, err := ra.txnBuilder.InspectTransaction(name, req.Id)
+	if err != nil {
+		return nil, berrors.MalformedError("%s", err)
+	}
+	state, err := ra.limiter.Inspect(ctx, txn)
+	if err != nil {
+		return nil, fmt.Errorf("inspecting rate limit bucket: %w", err)
+	}
+	return state, nil
+}
+
+// bucketStateToPB converts a *ratelimits.BucketState to its protobuf
+// representation.
+func bucketStateToPB(state *ratelimits.BucketState) *rapb.RateLimitBucketState {
+	pb := &rapb.RateLimitBucketState{
+		Name:      state.Name.String(),
+		BucketKey: state.BucketKey,
+		Burst:     state.Burst,
+		Count:     state.Count,
+		Period:    durationpb.New(state.Period),
+		Override:  state.Override,
+		Remaining: state.Remaining,
+		ResetAt:   timestamppb.New(state.ResetAt),
+	}
+	if !state.TAT.IsZero() {
+		pb.Tat = timestamppb.New(state.TAT)
+	}
+	return pb
+}
+
+// InspectRateLimit returns the limit which applies to, and the current state
+// of, the rate limit bucket identified by the request's limit name and bucket
+// id. It does not modify the bucket.
+func (ra *RegistrationAuthorityImpl) InspectRateLimit(ctx context.Context, req *rapb.RateLimitBucketRequest) (*rapb.RateLimitBucketState, error) {
+	state, err := ra.inspectRateLimit(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return bucketStateToPB(state), nil
+}
+
+// ResetRateLimit resets the rate limit bucket identified by the request's
+// limit name and bucket id to its maximum capacity, and returns its new state.
+func (ra *RegistrationAuthorityImpl) ResetRateLimit(ctx context.Context, req *rapb.RateLimitBucketRequest) (*rapb.RateLimitBucketState, error) {
+	before, err := ra.inspectRateLimit(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ra.limiter.Reset(ctx, before.BucketKey)
+	if err != nil {
+		return nil, fmt.Errorf("resetting rate limit bucket %q: %w", before.BucketKey, err)
+	}
+	ra.log.AuditInfof("Reset rate limit bucket %q: previously %d of %d remaining, full at %s",
+		before.BucketKey, before.Remaining, before.Burst, before.ResetAt.Format(time.RFC3339))
+
+	after, err := ra.inspectRateLimit(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return bucketStateToPB(after), nil
+}
+
 func (ra *RegistrationAuthorityImpl) GetAuthorization(ctx context.Context, req *rapb.GetAuthorizationRequest) (*corepb.Authorization, error) {
 	if core.IsAnyNilOrZero(req, req.Id) {
 		return nil, errIncompleteGRPCRequest
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"math/big"
 	mrand "math/rand/v2"
+	"net/netip"
 	"os"
 	"regexp"
 	"strconv"
@@ -35,6 +36,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -354,7 +356,7 @@ func initAuthorities(t *testing.T) (*DummyValidationAuthority, sapb.StorageAutho
 	}
 	va := va.RemoteClients{VAClient: dummyVA, CAAClient: dummyVA}
 
-	pa, err := policy.New(map[core.AcmeChallenge]bool{
+	pa, err := policy.New(nil, map[core.AcmeChallenge]bool{
 		core.ChallengeTypeHTTP01: true,
 		core.ChallengeTypeDNS01:  true,
 	}, blog.NewMock())
@@ -387,7 +389,7 @@ func initAuthorities(t *testing.T) (*DummyValidationAuthority, sapb.StorageAutho
 		"OperB": {
 			"LogB1": {Url: "UrlB1", Key: "KeyB1"},
 		},
-	}, nil, nil, 0, log, metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, log, metrics.NoopRegisterer)
 
 	rlSource := ratelimits.NewInmemSource()
 	limiter, err := ratelimits.NewLimiter(fc, rlSource, stats)
@@ -404,7 +406,7 @@ func initAuthorities(t *testing.T) (*DummyValidationAuthority, sapb.StorageAutho
 		300*24*time.Hour, 7*24*time.Hour,
 		nil,
 		7*24*time.Hour, 5*time.Minute,
-		ctp, nil, nil)
+		ctp, nil, nil, nil, "")
 	ra.SA = sa
 	ra.VA = va
 	ra.CA = ca
@@ -815,6 +817,79 @@ func TestPerformValidationSuccess(t *testing.T) {
 	test.Assert(t, *challenge.Validated == expectedValidated, "Validated timestamp incorrect or missing")
 }
 
+func TestPerformValidationRecordsPerspectiveResults(t *testing.T) {
+	if !strings.Contains(os.Getenv("BOULDER_CONFIG_DIR"), "test/config-next") {
+		t.Skip("this test requires the authzPerspectiveResults table")
+	}
+
+	va, sa, ra, _, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	features.Set(features.Config{EnforceMPIC: true, EnforceMultiCAA: true, StoreAuthzPerspectiveResults: true})
+	defer features.Reset()
+
+	authzPB := createPendingAuthorization(t, sa, Identifier, fc.Now().Add(12*time.Hour))
+
+	va.doDCVResult = &vapb.ValidationResult{
+		Records: []*corepb.ValidationRecord{
+			{
+				AddressUsed:   []byte("192.168.0.1"),
+				Hostname:      "example.com",
+				Port:          "8080",
+				Url:           "http://example.com/",
+				ResolverAddrs: []string{"rebound"},
+			},
+		},
+		PerspectiveResults: []*corepb.PerspectiveResult{
+			{Operation: "dcv", Perspective: "primary", Passed: true, ResolverAddrs: []string{"rebound"}, Latency: durationpb.New(time.Second)},
+			{Operation: "dcv", Perspective: "dadaist", Rir: "ARIN", Passed: true, Latency: durationpb.New(2 * time.Second)},
+		},
+	}
+	va.doCAAResponse = &vapb.IsCAAValidResponse{
+		PerspectiveResults: []*corepb.PerspectiveResult{
+			{Operation: "caa", Perspective: "primary", Passed: true},
+			{Operation: "caa", Perspective: "dadaist", Rir: "ARIN", Passed: false, Problem: &corepb.ProblemDetails{ProblemType: "caa", Detail: "nope"}},
+		},
+	}
+
+	challIdx := dnsChallIdx(t, authzPB.Challenges)
+	_, err := ra.PerformValidation(ctx, &rapb.PerformValidationRequest{
+		Authz:          authzPB,
+		ChallengeIndex: challIdx,
+	})
+	test.AssertNotError(t, err, "PerformValidation failed")
+
+	select {
+	case <-va.doDCVRequest:
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for DummyValidationAuthority.DoDCV to complete")
+	}
+	select {
+	case <-va.doCAARequest:
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for DummyValidationAuthority.DoCAA to complete")
+	}
+
+	// Sleep so the RA has a chance to write to the SA
+	time.Sleep(100 * time.Millisecond)
+
+	authzID, err := strconv.ParseInt(authzPB.Id, 10, 64)
+	test.AssertNotError(t, err, "strconv.ParseInt failed")
+	dbAuthzPB, err := sa.GetAuthorization2(ctx, &sapb.AuthorizationID2{Id: authzID, IncludePerspectiveResults: true})
+	test.AssertNotError(t, err, "Could not fetch authorization from database")
+	test.AssertEquals(t, len(dbAuthzPB.PerspectiveResults), 4)
+	for i, res := range append(va.doDCVResult.PerspectiveResults, va.doCAAResponse.PerspectiveResults...) {
+		got := dbAuthzPB.PerspectiveResults[i]
+		test.AssertEquals(t, got.Operation, res.Operation)
+		test.AssertEquals(t, got.Perspective, res.Perspective)
+		test.AssertEquals(t, got.Rir, res.Rir)
+		test.AssertEquals(t, got.Passed, res.Passed)
+		test.AssertDeepEquals(t, got.ResolverAddrs, res.ResolverAddrs)
+		test.AssertEquals(t, got.Latency.AsDuration(), res.Latency.AsDuration())
+		test.AssertEquals(t, got.Problem.GetDetail(), res.Problem.GetDetail())
+	}
+}
+
 // mockSAWithSyncPause is a mock sapb.StorageAuthorityClient that forwards all
 // method calls to an inner SA, but also performs a blocking write to a channel
 // when PauseIdentifiers is called to allow the tests to synchronize.
@@ -2093,6 +2168,94 @@ func TestNewOrder(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "Cannot issue for \"a\": Domain name needs at least one dot")
 }
 
+// TestNewOrderReusesPersistentProof tests that when an account has previously
+// validated an identifier using a DNS-PERSIST-01 record, new authorizations for
+// that identifier are validated using the same record without client action,
+// and are left pending if the record no longer validates.
+func TestNewOrderReusesPersistentProof(t *testing.T) {
+	va, sa, ra, _, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	pa, err := policy.New(nil, map[core.AcmeChallenge]bool{
+		core.ChallengeTypeDNS01:        true,
+		core.ChallengeTypeDNSPersist01: true,
+	}, blog.NewMock())
+	test.AssertNotError(t, err, "Couldn't create PA")
+	err = pa.LoadHostnamePolicyFile("../test/hostname-policy.yaml")
+	test.AssertNotError(t, err, "Couldn't set hostname policy")
+	ra.PA = pa
+
+	// Create an authorization for each name, validated using DNS-PERSIST-01,
+	// which will have expired by the time the new orders are created.
+	for _, name := range []string{"persist.example.com", "gone.example.com"} {
+		exp := fc.Now().Add(time.Hour)
+		prior, err := sa.NewOrderAndAuthzs(ctx, &sapb.NewOrderAndAuthzsRequest{
+			NewOrder: &sapb.NewOrderRequest{
+				RegistrationID: Registration.Id,
+				Expires:        timestamppb.New(exp),
+				DnsNames:       []string{name},
+			},
+			NewAuthzs: []*sapb.NewAuthzRequest{{
+				Identifier:     identifier.NewDNS(name).AsProto(),
+				RegistrationID: Registration.Id,
+				Expires:        timestamppb.New(exp),
+				ChallengeTypes: []string{string(core.ChallengeTypeDNS01), string(core.ChallengeTypeDNSPersist01)},
+				Token:          core.NewToken(),
+			}},
+		})
+		test.AssertNotError(t, err, "sa.NewOrderAndAuthzs failed")
+		_, err = sa.FinalizeAuthorization2(ctx, &sapb.FinalizeAuthorizationRequest{
+			Id:          prior.V2Authorizations[0],
+			Status:      string(core.StatusValid),
+			Expires:     timestamppb.New(exp),
+			Attempted:   string(core.ChallengeTypeDNSPersist01),
+			AttemptedAt: timestamppb.New(fc.Now()),
+		})
+		test.AssertNotError(t, err, "sa.FinalizeAuthorization2 failed")
+	}
+	fc.Add(2 * time.Hour)
+
+	va.doDCVResult = &vapb.ValidationResult{
+		Records: []*corepb.ValidationRecord{{Hostname: "persist.example.com", ResolverAddrs: []string{"rebound"}}},
+	}
+	va.doCAAResponse = &vapb.IsCAAValidResponse{}
+
+	order, err := ra.NewOrder(ctx, &rapb.NewOrderRequest{
+		RegistrationID: Registration.Id,
+		DnsNames:       []string{"persist.example.com"},
+	})
+	test.AssertNotError(t, err, "ra.NewOrder failed")
+	test.AssertEquals(t, numAuthorizations(order), 1)
+
+	dcvReq := <-va.doDCVRequest
+	<-va.doCAARequest
+	ra.drainWG.Wait()
+	test.AssertEquals(t, dcvReq.Challenge.Type, string(core.ChallengeTypeDNSPersist01))
+
+	authzPB := getAuthorization(t, fmt.Sprint(order.V2Authorizations[0]), sa)
+	test.AssertEquals(t, authzPB.Status, string(core.StatusValid))
+	test.AssertEquals(t, len(authzPB.Challenges), 1)
+	test.AssertEquals(t, authzPB.Challenges[0].Type, string(core.ChallengeTypeDNSPersist01))
+
+	// If the record no longer validates, the new authorization must be left
+	// pending for the client to complete, not marked invalid.
+	va.doDCVResult = &vapb.ValidationResult{
+		Problem: &corepb.ProblemDetails{ProblemType: "unauthorized", Detail: "No TXT record found"},
+	}
+	order, err = ra.NewOrder(ctx, &rapb.NewOrderRequest{
+		RegistrationID: Registration.Id,
+		DnsNames:       []string{"gone.example.com"},
+	})
+	test.AssertNotError(t, err, "ra.NewOrder failed")
+
+	<-va.doDCVRequest
+	ra.drainWG.Wait()
+
+	authzPB = getAuthorization(t, fmt.Sprint(order.V2Authorizations[0]), sa)
+	test.AssertEquals(t, authzPB.Status, string(core.StatusPending))
+	test.AssertEquals(t, len(authzPB.Challenges), 2)
+}
+
 // TestNewOrderReuse tests that subsequent requests by an ACME account to create
 // an identical order results in only one order being created & subsequently
 // reused.
@@ -2663,6 +2826,40 @@ func TestNewOrderAuthzReuseSafety(t *testing.T) {
 	test.AssertNotEquals(t, order.V2Authorizations[0], int64(1))
 }
 
+func TestNewOrderReusesPendingWildcardAuthz(t *testing.T) {
+	_, _, ra, _, clk, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	// Use a mock SA that returns a pending authz for "*.zombo.com" with all of
+	// the challenge types permitted for wildcards.
+	expires := clk.Now().Add(24 * time.Hour)
+	ra.SA = &mockSAWithAuthzs{
+		authzs: []*core.Authorization{
+			{
+				ID:             "1",
+				Identifier:     identifier.NewDNS("*.zombo.com"),
+				RegistrationID: Registration.Id,
+				Status:         core.StatusPending,
+				Expires:        &expires,
+				Challenges: []core.Challenge{
+					{Type: core.ChallengeTypeDNS01, Status: core.StatusPending, Token: core.NewToken()},
+					{Type: core.ChallengeTypeDNSAccount01, Status: core.StatusPending, Token: core.NewToken()},
+					{Type: core.ChallengeTypeDNSPersist01, Status: core.StatusPending, Token: core.NewToken()},
+				},
+			},
+		},
+	}
+
+	order, err := ra.NewOrder(ctx, &rapb.NewOrderRequest{
+		RegistrationID: Registration.Id,
+		DnsNames:       []string{"*.zombo.com"},
+	})
+	test.AssertNotError(t, err, "NewOrder failed for a wildcard order request")
+	test.AssertEquals(t, numAuthorizations(order), 1)
+	// It should be the pending authz, despite its several challenges.
+	test.AssertEquals(t, order.V2Authorizations[0], int64(1))
+}
+
 func TestNewOrderWildcard(t *testing.T) {
 	_, _, ra, _, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3015,7 +3212,7 @@ func TestFinalizeOrder(t *testing.T) {
 				},
 				Csr: oneDomainCSR,
 			},
-			ExpectedErrMsg: "Order has no associated names",
+			ExpectedErrMsg: "Order has no associated identifiers",
 		},
 		{
 			Name: "Wrong order state (valid)",
@@ -3371,7 +3568,7 @@ func TestFinalizeOrderDisabledChallenge(t *testing.T) {
 	test.AssertNotError(t, err, "Error creating policy forbid CSR")
 
 	// Replace the Policy Authority with one which has this challenge type disabled
-	pa, err := policy.New(map[core.AcmeChallenge]bool{
+	pa, err := policy.New(nil, map[core.AcmeChallenge]bool{
 		core.ChallengeTypeDNS01:     true,
 		core.ChallengeTypeTLSALPN01: true,
 	}, ra.log)
@@ -3633,11 +3830,11 @@ func TestUpdateMissingAuthorization(t *testing.T) {
 
 	// Twiddle the authz to pretend its been validated by the VA
 	authz.Challenges[0].Status = "valid"
-	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0])
+	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0], nil)
 	test.AssertNotError(t, err, "ra.recordValidation failed")
 
 	// Try to record the same validation a second time.
-	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0])
+	err = ra.recordValidation(ctx, authz.ID, authz.Expires, &authz.Challenges[0], nil)
 	test.AssertError(t, err, "ra.recordValidation didn't fail")
 	test.AssertErrorIs(t, err, berrors.NotFound)
 }
@@ -3645,7 +3842,7 @@ func TestUpdateMissingAuthorization(t *testing.T) {
 func TestPerformValidationBadChallengeType(t *testing.T) {
 	_, _, ra, _, fc, cleanUp := initAuthorities(t)
 	defer cleanUp()
-	pa, err := policy.New(map[core.AcmeChallenge]bool{}, blog.NewMock())
+	pa, err := policy.New(nil, map[core.AcmeChallenge]bool{}, blog.NewMock())
 	test.AssertNotError(t, err, "Couldn't create PA")
 	ra.PA = pa
 
@@ -3693,7 +3890,7 @@ func TestCTPolicyMeasurements(t *testing.T) {
 		"OperB": {
 			"LogB1": {Url: "UrlB1", Key: "KeyB1"},
 		},
-	}, nil, nil, 0, log, metrics.NoopRegisterer)
+	}, nil, nil, 0, nil, log, metrics.NoopRegisterer)
 
 	// Create valid authorizations for not-example.com and www.not-example.com
 	exp := ra.clk.Now().Add(365 * 24 * time.Hour)
@@ -4057,6 +4254,98 @@ func TestNewOrderMaxNames(t *testing.T) {
 	test.AssertErrorIs(t, err, berrors.Malformed)
 }
 
+func TestNewOrderCertProfileRestrictions(t *testing.T) {
+	_, sa, ra, _, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.certProfiles = map[string]*issuance.ProfileConfig{
+		"restricted": {
+			MaxNames:        1,
+			IdentifierTypes: []identifier.IdentifierType{identifier.TypeDNS},
+			ChallengeTypes:  []core.AcmeChallenge{core.ChallengeTypeDNS01},
+		},
+	}
+
+	_, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         Registration.Id,
+		CertificateProfileName: "restricted",
+		DnsNames:               []string{"a.example.com", "b.example.com"},
+	})
+	test.AssertError(t, err, "NewOrder didn't fail with too many names for profile")
+	test.AssertErrorIs(t, err, berrors.Malformed)
+	test.AssertContains(t, err.Error(), "profile allows at most 1 identifiers")
+
+	_, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         Registration.Id,
+		CertificateProfileName: "restricted",
+		Identifiers:            []*corepb.Identifier{identifier.NewIP(netip.MustParseAddr("64.112.117.122")).AsProto()},
+	})
+	test.AssertError(t, err, "NewOrder didn't fail with an IP identifier for profile")
+	test.AssertErrorIs(t, err, berrors.RejectedIdentifier)
+
+	// An existing valid authz which was validated using a challenge type the
+	// profile doesn't allow is not reused, and the new authz only offers the
+	// allowed challenge type.
+	domain := randomDomain()
+	authzID := createFinalizedAuthorization(
+		t, sa, domain, fc.Now().Add(24*time.Hour), core.ChallengeTypeHTTP01, fc.Now().Add(-1*time.Hour))
+
+	order, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         Registration.Id,
+		CertificateProfileName: "restricted",
+		DnsNames:               []string{domain},
+	})
+	test.AssertNotError(t, err, "creating order for restricted profile")
+	test.AssertNotEquals(t, order.V2Authorizations[0], authzID)
+	authz, err := sa.GetAuthorization2(context.Background(), &sapb.AuthorizationID2{Id: order.V2Authorizations[0]})
+	test.AssertNotError(t, err, "getting new authz")
+	test.AssertEquals(t, len(authz.Challenges), 1)
+	test.AssertEquals(t, authz.Challenges[0].Type, string(core.ChallengeTypeDNS01))
+
+	// An order which doesn't request a restricted profile still reuses it.
+	order, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID: Registration.Id,
+		DnsNames:       []string{domain},
+	})
+	test.AssertNotError(t, err, "creating order for default profile")
+	test.AssertEquals(t, order.V2Authorizations[0], authzID)
+}
+
+func TestFinalizeOrderCertProfileKey(t *testing.T) {
+	_, sa, ra, _, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.certProfiles = map[string]*issuance.ProfileConfig{
+		"rsaonly": {AllowedKeys: &goodkey.AllowedKeys{RSA2048: true}},
+	}
+
+	domain := randomDomain()
+	createFinalizedAuthorization(
+		t, sa, domain, fc.Now().Add(24*time.Hour), core.ChallengeTypeDNS01, fc.Now().Add(-1*time.Hour))
+	order, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID:         Registration.Id,
+		CertificateProfileName: "rsaonly",
+		DnsNames:               []string{domain},
+	})
+	test.AssertNotError(t, err, "creating test order")
+
+	testKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating test key")
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		PublicKey: testKey.PublicKey,
+		DNSNames:  []string{domain},
+	}, testKey)
+	test.AssertNotError(t, err, "creating test CSR")
+
+	_, err = ra.FinalizeOrder(context.Background(), &rapb.FinalizeOrderRequest{
+		Order: order,
+		Csr:   csr,
+	})
+	test.AssertError(t, err, "finalization with a key the profile doesn't allow should fail")
+	test.AssertErrorIs(t, err, berrors.BadCSR)
+	test.AssertContains(t, err.Error(), "profile does not allow ECDSA P-256")
+}
+
 // CSR generated by Go:
 // * Random public key
 // * CN = not-example.com
@@ -4320,6 +4609,38 @@ func TestGenerateOCSPUnknownSerial(t *testing.T) {
 	}
 }
 
+func TestCRLShard(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		crldps      []string
+		expected    int64
+		expectedErr string
+	}{
+		{name: "no CRLDP", crldps: nil, expected: 0},
+		{name: "valid CRLDP", crldps: []string{"http://c.example.org/123/17.crl"}, expected: 17},
+		{name: "too many CRLDPs", crldps: []string{"http://c.example.org/1.crl", "http://c.example.org/2.crl"}, expectedErr: "too many"},
+		{name: "wrong suffix", crldps: []string{"http://c.example.org/1.der"}, expectedErr: "does not end in .crl"},
+		{name: "non-numeric shard", crldps: []string{"http://c.example.org/abc.crl"}, expectedErr: "parsing CRL shard"},
+		{name: "zero shard", crldps: []string{"http://c.example.org/0.crl"}, expectedErr: "invalid CRL shard"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			shard, err := crlShard(&x509.Certificate{CRLDistributionPoints: tc.crldps})
+			if tc.expectedErr != "" {
+				test.AssertError(t, err, "crlShard should have failed")
+				test.AssertContains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			test.AssertNotError(t, err, "crlShard failed")
+			test.AssertEquals(t, shard, tc.expected)
+		})
+	}
+}
+
 func TestRevokeCertByApplicant_Subscriber(t *testing.T) {
 	_, _, ra, _, clk, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -4788,6 +5109,46 @@ func TestUnpauseAccount(t *testing.T) {
 	test.AssertEquals(t, res.Count, int64(50001))
 }
 
+func TestInspectAndResetRateLimit(t *testing.T) {
+	_, _, ra, rlSource, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	_, err := ra.InspectRateLimit(context.Background(), &rapb.RateLimitBucketRequest{Name: "NewOrdersPerAccount"})
+	test.AssertErrorIs(t, err, errIncompleteGRPCRequest)
+
+	_, err = ra.InspectRateLimit(context.Background(), &rapb.RateLimitBucketRequest{Name: "NoSuchLimit", Id: "1"})
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	_, err = ra.InspectRateLimit(context.Background(), &rapb.RateLimitBucketRequest{Name: "NewOrdersPerAccount", Id: "not-a-regid"})
+	test.AssertErrorIs(t, err, berrors.Malformed)
+
+	// The NewOrdersPerAccount default is 1500 per 3h, so each order takes 7.2s
+	// to be replenished. Simulate 10 recent orders.
+	tat := fc.Now().Add(72 * time.Second)
+	err = rlSource.BatchSet(context.Background(), map[string]time.Time{"3:1": tat})
+	test.AssertNotError(t, err, "setting bucket")
+
+	state, err := ra.InspectRateLimit(context.Background(), &rapb.RateLimitBucketRequest{Name: "NewOrdersPerAccount", Id: "1"})
+	test.AssertNotError(t, err, "inspecting bucket")
+	test.AssertEquals(t, state.Name, "NewOrdersPerAccount")
+	test.AssertEquals(t, state.BucketKey, "3:1")
+	test.AssertEquals(t, state.Burst, int64(1500))
+	test.AssertEquals(t, state.Count, int64(1500))
+	test.AssertEquals(t, state.Period.AsDuration(), 3*time.Hour)
+	test.Assert(t, !state.Override, "should not be an override")
+	test.AssertEquals(t, state.Remaining, int64(1490))
+	test.AssertEquals(t, state.Tat.AsTime(), tat)
+	test.AssertEquals(t, state.ResetAt.AsTime(), tat)
+
+	state, err = ra.ResetRateLimit(context.Background(), &rapb.RateLimitBucketRequest{Name: "NewOrdersPerAccount", Id: "1"})
+	test.AssertNotError(t, err, "resetting bucket")
+	test.AssertEquals(t, state.Remaining, int64(1500))
+	test.AssertBoxedNil(t, state.Tat, "TAT should be unset after a reset")
+
+	_, err = rlSource.Get(context.Background(), "3:1")
+	test.AssertErrorIs(t, err, ratelimits.ErrBucketNotFound)
+}
+
 func TestGetAuthorization(t *testing.T) {
 	_, _, ra, _, _, cleanup := initAuthorities(t)
 	defer cleanup()
@@ -4809,7 +5170,7 @@ func TestGetAuthorization(t *testing.T) {
 	}
 
 	// With HTTP01 enabled, GetAuthorization should pass the mock challenge through.
-	pa, err := policy.New(map[core.AcmeChallenge]bool{
+	pa, err := policy.New(nil, map[core.AcmeChallenge]bool{
 		core.ChallengeTypeHTTP01: true,
 		core.ChallengeTypeDNS01:  true,
 	}, blog.NewMock())
@@ -4821,7 +5182,7 @@ func TestGetAuthorization(t *testing.T) {
 	test.AssertEquals(t, authz.Challenges[0].Type, string(core.ChallengeTypeHTTP01))
 
 	// With HTTP01 disabled, GetAuthorization should filter out the mock challenge.
-	pa, err = policy.New(map[core.AcmeChallenge]bool{
+	pa, err = policy.New(nil, map[core.AcmeChallenge]bool{
 		core.ChallengeTypeDNS01: true,
 	}, blog.NewMock())
 	test.AssertNotError(t, err, "Couldn't create PA")
@@ -1,13 +1,19 @@
 package ratelimits
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/letsencrypt/boulder/config"
 	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/strictyaml"
 )
 
@@ -15,6 +21,10 @@ import (
 // currently configured.
 var errLimitDisabled = errors.New("limit disabled")
 
+// errOverridesSource indicates that the overrides could not be loaded from, or
+// parsed after loading from, the configured OverridesSource.
+var errOverridesSource = errors.New("loading overrides from source")
+
 // limit defines the configuration for a rate limit or a rate limit override.
 //
 // The zero value of this struct is invalid, because some of the fields must
@@ -214,34 +224,164 @@ func loadAndParseDefaultLimits(path string) (limits, error) {
 	return parsed, nil
 }
 
+// Override is a rate limit override which is stored outside of the overrides
+// file, such as in the SA's rateLimitOverrides table. The Id is formatted as in
+// the overrides file.
+type Override struct {
+	Name   Name
+	Id     string
+	Burst  int64
+	Count  int64
+	Period time.Duration
+}
+
+// parse validates the override and returns it as a limit, along with the
+// 'name:id' key used to look it up in the overrides map.
+func (o Override) parse() (string, *limit, error) {
+	if !o.Name.isValid() {
+		return "", nil, fmt.Errorf("unrecognized name enum %d in override limit, must be one of %v", o.Name, limitNames)
+	}
+	l := &limit{
+		Burst:  o.Burst,
+		Count:  o.Count,
+		Period: config.Duration{Duration: o.Period},
+		name:   o.Name,
+	}
+	err := validateLimit(l)
+	if err != nil {
+		return "", nil, fmt.Errorf("validating override limit %s:%s: %w", o.Name, o.Id, err)
+	}
+	err = validateIdForName(o.Name, o.Id)
+	if err != nil {
+		return "", nil, fmt.Errorf("validating name %s and id %q for override limit: %w", o.Name, o.Id, err)
+	}
+	l.overrideKey = joinWithColon(o.Name.EnumString(), o.Id)
+	id := o.Id
+	if o.Name == CertificatesPerFQDNSet {
+		id = fmt.Sprintf("%x", core.HashNames(strings.Split(id, ",")))
+	}
+	l.precompute()
+	return joinWithColon(o.Name.EnumString(), id), l, nil
+}
+
+// Validate returns an error if the override's limit or id is invalid.
+func (o Override) Validate() error {
+	_, _, err := o.parse()
+	return err
+}
+
+// OverridesSource returns the current set of overrides stored outside of the
+// overrides file. It is called each time the limits are reloaded.
+type OverridesSource func(ctx context.Context) ([]Override, error)
+
 type limitRegistry struct {
+	// defaultsPath and overridesPath are the paths to the YAML files holding
+	// the default and override limits. overridesPath is optional.
+	defaultsPath  string
+	overridesPath string
+
+	// overridesSource, if non-nil, provides overrides in addition to those in
+	// the overrides file. They take precedence over the overrides file.
+	overridesSource OverridesSource
+
+	// mu protects defaults and overrides, which are replaced wholesale on each
+	// successful reload.
+	mu sync.RWMutex
+
 	// defaults stores default limits by 'name'.
 	defaults limits
 
 	// overrides stores override limits by 'name:id'.
 	overrides limits
+
+	reloads       *prometheus.CounterVec
+	overridesSize prometheus.Gauge
 }
 
-func newLimitRegistry(defaults, overrides string) (*limitRegistry, error) {
-	var err error
-	registry := &limitRegistry{}
-	registry.defaults, err = loadAndParseDefaultLimits(defaults)
+func newLimitRegistry(defaults, overrides string, source OverridesSource, stats prometheus.Registerer, logger blog.Logger) (*limitRegistry, error) {
+	reloads := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ratelimits_limits_reloads",
+		Help: "Number of times the rate limit defaults and overrides were reloaded, labeled by result=[success|failure]",
+	}, []string{"result"})
+	stats.MustRegister(reloads)
+
+	overridesSize := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ratelimits_overrides",
+		Help: "Number of rate limit overrides currently in effect",
+	})
+	stats.MustRegister(overridesSize)
+
+	registry := &limitRegistry{
+		defaultsPath:    defaults,
+		overridesPath:   overrides,
+		overridesSource: source,
+		reloads:         reloads,
+		overridesSize:   overridesSize,
+	}
+	err := registry.reload(context.Background())
+	if errors.Is(err, errOverridesSource) {
+		// An unavailable overrides source shouldn't prevent startup. Start with
+		// the overrides file alone, and pick up the source's overrides at the
+		// next reload.
+		logger.Errf("Failed to load rate limit overrides from source, using overrides file only until the next reload: %s", err)
+		registry.overridesSource = nil
+		err = registry.reload(context.Background())
+		registry.overridesSource = source
+	}
 	if err != nil {
 		return nil, err
 	}
+	return registry, nil
+}
 
-	if overrides == "" {
-		// No overrides specified, initialize an empty map.
-		registry.overrides = make(limits)
-		return registry, nil
+// reload loads, parses and validates the defaults file, the overrides file and
+// the overrides source, then swaps in the resulting limits. If anything fails
+// to load or validate, the current limits are left in place.
+func (l *limitRegistry) reload(ctx context.Context) error {
+	defaults, overrides, err := l.load(ctx)
+	if err != nil {
+		l.reloads.WithLabelValues("failure").Inc()
+		return err
 	}
 
-	registry.overrides, err = loadAndParseOverrideLimits(overrides)
+	l.mu.Lock()
+	l.defaults = defaults
+	l.overrides = overrides
+	l.mu.Unlock()
+
+	l.reloads.WithLabelValues("success").Inc()
+	l.overridesSize.Set(float64(len(overrides)))
+	return nil
+}
+
+func (l *limitRegistry) load(ctx context.Context) (limits, limits, error) {
+	defaults, err := loadAndParseDefaultLimits(l.defaultsPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return registry, nil
+	overrides := make(limits)
+	if l.overridesPath != "" {
+		overrides, err = loadAndParseOverrideLimits(l.overridesPath)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if l.overridesSource != nil {
+		fromSource, err := l.overridesSource(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errOverridesSource, err)
+		}
+		for _, o := range fromSource {
+			key, limit, err := o.parse()
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: %w", errOverridesSource, err)
+			}
+			overrides[key] = limit
+		}
+	}
+	return defaults, overrides, nil
 }
 
 // getLimit returns the limit for the specified by name and bucketKey, name is
@@ -254,6 +394,8 @@ func (l *limitRegistry) getLimit(name Name, bucketKey string) (*limit, error) {
 		// Name enums defined in this package.
 		return nil, fmt.Errorf("specified name enum %q, is invalid", name)
 	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
 	if bucketKey != "" {
 		// Check for override.
 		ol, ok := l.overrides[bucketKey]
@@ -1,11 +1,18 @@
 package ratelimits
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/letsencrypt/boulder/config"
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/test"
 )
 
@@ -196,3 +203,144 @@ func TestLoadAndParseDefaultLimits(t *testing.T) {
 	test.AssertError(t, err, "multiple default limits, one is bad")
 	test.Assert(t, !os.IsNotExist(err), "test file should exist")
 }
+
+func TestOverrideValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := Override{Name: NewRegistrationsPerIPAddress, Id: "10.0.0.2", Burst: 40, Count: 40, Period: time.Second}
+	test.AssertNotError(t, valid.Validate(), "valid override")
+
+	invalid := valid
+	invalid.Name = Unknown
+	test.AssertError(t, invalid.Validate(), "override with unknown name")
+
+	invalid = valid
+	invalid.Burst = 0
+	test.AssertError(t, invalid.Validate(), "override with zero burst")
+
+	invalid = valid
+	invalid.Id = "not-an-ip"
+	test.AssertError(t, invalid.Validate(), "override with invalid id")
+
+	fqdnSet := Override{Name: CertificatesPerFQDNSet, Id: "example.com,www.example.com", Burst: 1, Count: 1, Period: time.Hour}
+	key, l, err := fqdnSet.parse()
+	test.AssertNotError(t, err, "valid FQDNSet override")
+	test.AssertEquals(t, key, joinWithColon(CertificatesPerFQDNSet.EnumString(), fmt.Sprintf("%x", core.HashNames([]string{"example.com", "www.example.com"}))))
+	test.AssertEquals(t, l.overrideKey, joinWithColon(CertificatesPerFQDNSet.EnumString(), "example.com,www.example.com"))
+}
+
+func TestLimitRegistryReload(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	defaultsPath := filepath.Join(dir, "defaults.yml")
+	overridesPath := filepath.Join(dir, "overrides.yml")
+	for src, dst := range map[string]string{
+		"testdata/working_default.yml":  defaultsPath,
+		"testdata/working_override.yml": overridesPath,
+	} {
+		data, err := os.ReadFile(src)
+		test.AssertNotError(t, err, "reading testdata")
+		err = os.WriteFile(dst, data, 0644)
+		test.AssertNotError(t, err, "writing limits file")
+	}
+
+	var fromSource []Override
+	var sourceErr error
+	source := func(context.Context) ([]Override, error) {
+		return fromSource, sourceErr
+	}
+
+	registry, err := newLimitRegistry(defaultsPath, overridesPath, source, metrics.NoopRegisterer, blog.NewMock())
+	test.AssertNotError(t, err, "creating limit registry")
+
+	fileKey := joinWithColon(NewRegistrationsPerIPAddress.EnumString(), "10.0.0.2")
+	sourceKey := joinWithColon(NewRegistrationsPerIPAddress.EnumString(), "10.0.0.3")
+	l, err := registry.getLimit(NewRegistrationsPerIPAddress, fileKey)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(40))
+	l, err = registry.getLimit(NewRegistrationsPerIPAddress, sourceKey)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(20))
+
+	// Overrides from the source are picked up on reload, and take precedence
+	// over those in the overrides file.
+	fromSource = []Override{
+		{Name: NewRegistrationsPerIPAddress, Id: "10.0.0.2", Burst: 50, Count: 50, Period: time.Second},
+		{Name: NewRegistrationsPerIPAddress, Id: "10.0.0.3", Burst: 60, Count: 60, Period: time.Second},
+	}
+	err = registry.reload(context.Background())
+	test.AssertNotError(t, err, "reloading limits")
+	l, err = registry.getLimit(NewRegistrationsPerIPAddress, fileKey)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(50))
+	l, err = registry.getLimit(NewRegistrationsPerIPAddress, sourceKey)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(60))
+
+	// A failing or invalid source leaves the current limits in place.
+	sourceErr = errors.New("oops")
+	err = registry.reload(context.Background())
+	test.AssertError(t, err, "reloading limits with a failing source")
+	sourceErr = nil
+	fromSource = append(fromSource, Override{Name: NewRegistrationsPerIPAddress, Id: "10.0.0.4"})
+	err = registry.reload(context.Background())
+	test.AssertError(t, err, "reloading limits with an invalid override")
+	l, err = registry.getLimit(NewRegistrationsPerIPAddress, sourceKey)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(60))
+
+	// So does an invalid defaults file.
+	fromSource = nil
+	err = os.WriteFile(defaultsPath, []byte("NewRegistrationsPerIPAddress:\n  burst: 0\n"), 0644)
+	test.AssertNotError(t, err, "writing defaults file")
+	err = registry.reload(context.Background())
+	test.AssertError(t, err, "reloading limits with invalid defaults")
+	l, err = registry.getLimit(NewRegistrationsPerIPAddress, "")
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(20))
+
+	// Changes to the files are picked up on reload.
+	err = os.WriteFile(defaultsPath, []byte("NewRegistrationsPerIPAddress:\n  burst: 30\n  count: 30\n  period: 1s\n"), 0644)
+	test.AssertNotError(t, err, "writing defaults file")
+	err = registry.reload(context.Background())
+	test.AssertNotError(t, err, "reloading limits")
+	l, err = registry.getLimit(NewRegistrationsPerIPAddress, sourceKey)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(30))
+}
+
+func TestLimitRegistryStartsWithoutSource(t *testing.T) {
+	t.Parallel()
+
+	fromSource := []Override{
+		{Name: NewRegistrationsPerIPAddress, Id: "10.0.0.2", Burst: 50, Count: 50, Period: time.Second},
+	}
+	sourceErr := errors.New("oops")
+	source := func(context.Context) ([]Override, error) {
+		return fromSource, sourceErr
+	}
+
+	// A failing source at startup is logged, and the overrides file is used
+	// alone.
+	log := blog.NewMock()
+	registry, err := newLimitRegistry("testdata/working_default.yml", "testdata/working_override.yml", source, metrics.NoopRegisterer, log)
+	test.AssertNotError(t, err, "creating limit registry with a failing source")
+	test.AssertEquals(t, len(log.GetAllMatching("Failed to load rate limit overrides from source")), 1)
+	key := joinWithColon(NewRegistrationsPerIPAddress.EnumString(), "10.0.0.2")
+	l, err := registry.getLimit(NewRegistrationsPerIPAddress, key)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(40))
+
+	// The source's overrides are picked up at the next reload.
+	sourceErr = nil
+	err = registry.reload(context.Background())
+	test.AssertNotError(t, err, "reloading limits")
+	l, err = registry.getLimit(NewRegistrationsPerIPAddress, key)
+	test.AssertNotError(t, err, "getting limit")
+	test.AssertEquals(t, l.Burst, int64(50))
+
+	// A broken overrides file still prevents startup.
+	_, err = newLimitRegistry("testdata/working_default.yml", "testdata/busted_override_burst_0.yml", source, metrics.NoopRegisterer, log)
+	test.AssertError(t, err, "creating limit registry with a broken overrides file")
+}
@@ -416,6 +416,76 @@ func (l *Limiter) BatchRefund(ctx context.Context, txns []Transaction) (*Decisio
 	return batchDecision, nil
 }
 
+// BucketState describes the current state of a single bucket and the limit
+// which applies to it. It is intended for operators investigating why a
+// Subscriber is (or is not) being rate limited.
+type BucketState struct {
+	// Name is the name of the limit.
+	Name Name
+
+	// BucketKey is the key of the bucket in the underlying datastore, formatted
+	// as 'enum:id'.
+	BucketKey string
+
+	// Burst, Count and Period are the parameters of the limit which applies
+	// to the bucket, whether the default or an override.
+	Burst  int64
+	Count  int64
+	Period time.Duration
+
+	// Override is true if Burst, Count and Period come from an override rather
+	// than the default limit.
+	Override bool
+
+	// TAT is the theoretical arrival time stored for the bucket. It is the zero
+	// value if no bucket exists, which is equivalent to a full bucket.
+	TAT time.Time
+
+	// Remaining is the number of requests which would currently be allowed.
+	Remaining int64
+
+	// ResetAt is the time at which the bucket will be full again, assuming no
+	// further requests are made.
+	ResetAt time.Time
+}
+
+// Inspect returns the current state of the bucket referenced by the provided
+// Transaction, which should be obtained from
+// TransactionBuilder.InspectTransaction. No state is persisted to the
+// underlying datastore.
+func (l *Limiter) Inspect(ctx context.Context, txn Transaction) (*BucketState, error) {
+	if txn.allowOnly() {
+		return nil, errors.New("cannot inspect an allow-only transaction")
+	}
+	// Remove cancellation from the request context so that transactions are not
+	// interrupted by a client disconnect.
+	ctx = context.WithoutCancel(ctx)
+	tat, err := l.source.Get(ctx, txn.bucketKey)
+	if err != nil && !errors.Is(err, ErrBucketNotFound) {
+		return nil, err
+	}
+
+	// A zero-cost check reports the capacity of the bucket as it stands. A TAT
+	// of "now" is equivalent to a full bucket.
+	start := tat
+	if start.IsZero() {
+		start = l.clk.Now()
+	}
+	d := maybeSpend(l.clk, txn, start)
+
+	return &BucketState{
+		Name:      txn.limit.name,
+		BucketKey: txn.bucketKey,
+		Burst:     txn.limit.Burst,
+		Count:     txn.limit.Count,
+		Period:    txn.limit.Period.Duration,
+		Override:  txn.limit.isOverride(),
+		TAT:       tat,
+		Remaining: d.remaining,
+		ResetAt:   l.clk.Now().Add(d.resetIn),
+	}, nil
+}
+
 // Reset resets the specified bucket to its maximum capacity. The new bucket
 // state is persisted to the underlying datastore before returning.
 func (l *Limiter) Reset(ctx context.Context, bucketKey string) error {
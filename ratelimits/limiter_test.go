@@ -52,6 +52,7 @@ func setup(t *testing.T) (context.Context, map[string]*Limiter, *TransactionBuil
 	return testCtx, map[string]*Limiter{
 		"inmem": newInmemTestLimiter(t, clk),
 		"redis": newRedisTestLimiter(t, clk),
+		"db":    newDBTestLimiter(t, clk),
 	}, newTestTransactionBuilder(t), clk, randIP.String()
 }
 
@@ -460,6 +461,63 @@ func TestLimiter_RefundAndReset(t *testing.T) {
 	}
 }
 
+func TestLimiter_Inspect(t *testing.T) {
+	t.Parallel()
+	testCtx, limiters, txnBuilder, clk, testIP := setup(t)
+	for name, l := range limiters {
+		t.Run(name, func(t *testing.T) {
+			txn, err := txnBuilder.InspectTransaction(NewRegistrationsPerIPAddress, testIP)
+			test.AssertNotError(t, err, "should not error")
+
+			// A bucket which doesn't exist is full.
+			state, err := l.Inspect(testCtx, txn)
+			test.AssertNotError(t, err, "should not error")
+			test.AssertEquals(t, state.Name, NewRegistrationsPerIPAddress)
+			test.AssertEquals(t, state.BucketKey, "1:"+testIP)
+			test.AssertEquals(t, state.Burst, int64(20))
+			test.AssertEquals(t, state.Count, int64(20))
+			test.AssertEquals(t, state.Period, time.Second)
+			test.Assert(t, !state.Override, "should not be an override")
+			test.Assert(t, state.TAT.IsZero(), "TAT should be zero")
+			test.AssertEquals(t, state.Remaining, int64(20))
+			test.AssertEquals(t, state.ResetAt, clk.Now())
+
+			// Spend 5 requests.
+			limit, err := txnBuilder.getLimit(NewRegistrationsPerIPAddress, state.BucketKey)
+			test.AssertNotError(t, err, "should not error")
+			txn5, err := newTransaction(limit, state.BucketKey, 5)
+			test.AssertNotError(t, err, "txn should be valid")
+			_, err = l.Spend(testCtx, txn5)
+			test.AssertNotError(t, err, "should not error")
+
+			// Inspecting reports the spend, and doesn't spend anything itself.
+			for range 2 {
+				state, err = l.Inspect(testCtx, txn)
+				test.AssertNotError(t, err, "should not error")
+				test.AssertEquals(t, state.Remaining, int64(15))
+				test.AssertEquals(t, state.TAT, clk.Now().Add(250*time.Millisecond))
+				test.AssertEquals(t, state.ResetAt, clk.Now().Add(250*time.Millisecond))
+			}
+
+			// After a reset, the bucket is full again.
+			err = l.Reset(testCtx, state.BucketKey)
+			test.AssertNotError(t, err, "should not error")
+			state, err = l.Inspect(testCtx, txn)
+			test.AssertNotError(t, err, "should not error")
+			test.AssertEquals(t, state.Remaining, int64(20))
+			test.Assert(t, state.TAT.IsZero(), "TAT should be zero")
+		})
+	}
+
+	// The override for 10.0.0.2 is reported as such.
+	txn, err := txnBuilder.InspectTransaction(NewRegistrationsPerIPAddress, tenZeroZeroTwo)
+	test.AssertNotError(t, err, "should not error")
+	state, err := limiters["inmem"].Inspect(testCtx, txn)
+	test.AssertNotError(t, err, "should not error")
+	test.Assert(t, state.Override, "should be an override")
+	test.AssertEquals(t, state.Burst, int64(40))
+}
+
 func TestRateLimitError(t *testing.T) {
 	t.Parallel()
 	now := clock.NewFake().Now()
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/policy"
 )
 
@@ -159,10 +160,10 @@ func validateRegId(id string) error {
 	return nil
 }
 
-// validateDomain validates that the provided string is formatted 'domain',
-// where domain is a domain name.
-func validateDomain(id string) error {
-	err := policy.ValidDomain(id)
+// validateDomainOrIP validates that the provided string is formatted 'domain',
+// where domain is a domain name or an IP address.
+func validateDomainOrIP(id string) error {
+	err := policy.WellFormedIdentifiers(identifier.ACMEIdentifiers{identifier.FromString(id)})
 	if err != nil {
 		return fmt.Errorf("invalid domain, %q must be formatted 'domain': %w", id, err)
 	}
@@ -171,9 +172,10 @@ func validateDomain(id string) error {
 
 // validateRegIdDomain validates that the provided string is formatted
 // 'regId:domain', where regId is an ACME registration Id and domain is a domain
-// name.
+// name or an IP address.
 func validateRegIdDomain(id string) error {
-	regIdDomain := strings.Split(id, ":")
+	// IPv6 addresses contain colons, so split at most once.
+	regIdDomain := strings.SplitN(id, ":", 2)
 	if len(regIdDomain) != 2 {
 		return fmt.Errorf(
 			"invalid regId:domain, %q must be formatted 'regId:domain'", id)
@@ -183,7 +185,7 @@ func validateRegIdDomain(id string) error {
 		return fmt.Errorf(
 			"invalid regId, %q must be formatted 'regId:domain'", id)
 	}
-	err = policy.ValidDomain(regIdDomain[1])
+	err = policy.WellFormedIdentifiers(identifier.ACMEIdentifiers{identifier.FromString(regIdDomain[1])})
 	if err != nil {
 		return fmt.Errorf(
 			"invalid domain, %q must be formatted 'regId:domain': %w", id, err)
@@ -192,14 +194,18 @@ func validateRegIdDomain(id string) error {
 }
 
 // validateFQDNSet validates that the provided string is formatted 'fqdnSet',
-// where fqdnSet is a comma-separated list of domain names.
+// where fqdnSet is a comma-separated list of domain names and IP addresses.
 func validateFQDNSet(id string) error {
-	domains := strings.Split(id, ",")
-	if len(domains) == 0 {
+	values := strings.Split(id, ",")
+	if len(values) == 0 {
 		return fmt.Errorf(
 			"invalid fqdnSet, %q must be formatted 'fqdnSet'", id)
 	}
-	return policy.WellFormedDomainNames(domains)
+	var idents identifier.ACMEIdentifiers
+	for _, value := range values {
+		idents = append(idents, identifier.FromString(value))
+	}
+	return policy.WellFormedIdentifiers(idents)
 }
 
 func validateIdForName(name Name, id string) error {
@@ -236,7 +242,7 @@ func validateIdForName(name Name, id string) error {
 
 	case CertificatesPerDomain:
 		// 'enum:domain'
-		return validateDomain(id)
+		return validateDomainOrIP(id)
 
 	case CertificatesPerFQDNSet:
 		// 'enum:fqdnSet'
@@ -277,3 +283,13 @@ var limitNames = func() []string {
 	}
 	return names
 }()
+
+// NameFromString returns the Name with the provided string representation, as
+// used in the defaults and overrides files (e.g. "NewOrdersPerAccount").
+func NameFromString(s string) (Name, error) {
+	name, ok := stringToName[s]
+	if !ok || !name.isValid() {
+		return Unknown, fmt.Errorf("unrecognized rate limit name %q, must be one of %v", s, limitNames)
+	}
+	return name, nil
+}
@@ -167,6 +167,11 @@ func TestValidateIdForName(t *testing.T) {
 			desc:  "transaction: valid regId and domain",
 			id:    "12345:example.com",
 		},
+		{
+			limit: CertificatesPerDomainPerAccount,
+			desc:  "transaction: valid regId and IPv6 address",
+			id:    "12345:2602:80a:6000::1",
+		},
 		{
 			limit: CertificatesPerDomainPerAccount,
 			desc:  "transaction: invalid regId",
@@ -195,6 +200,22 @@ func TestValidateIdForName(t *testing.T) {
 			desc:  "valid domain",
 			id:    "example.com",
 		},
+		{
+			limit: CertificatesPerDomain,
+			desc:  "valid IPv4 address",
+			id:    "64.112.117.122",
+		},
+		{
+			limit: CertificatesPerDomain,
+			desc:  "valid IPv6 address",
+			id:    "2602:80a:6000::1",
+		},
+		{
+			limit: CertificatesPerDomain,
+			desc:  "reserved IPv4 address",
+			id:    "10.0.0.1",
+			err:   "IP address is in a reserved address block",
+		},
 		{
 			limit: CertificatesPerDomain,
 			desc:  "malformed domain",
@@ -217,6 +238,11 @@ func TestValidateIdForName(t *testing.T) {
 			desc:  "valid fqdnSet containing multiple domains",
 			id:    "example.com,example.org",
 		},
+		{
+			limit: CertificatesPerFQDNSet,
+			desc:  "valid fqdnSet containing domains and IP addresses",
+			id:    "example.com,64.112.117.122,2602:80a:6000::1",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -232,3 +258,17 @@ func TestValidateIdForName(t *testing.T) {
 		})
 	}
 }
+
+func TestNameFromString(t *testing.T) {
+	t.Parallel()
+
+	name, err := NameFromString("NewOrdersPerAccount")
+	test.AssertNotError(t, err, "should parse a valid name")
+	test.AssertEquals(t, name, NewOrdersPerAccount)
+
+	_, err = NameFromString("Unknown")
+	test.AssertError(t, err, "should not parse the Unknown name")
+
+	_, err = NameFromString("NoSuchLimit")
+	test.AssertError(t, err, "should not parse an invalid name")
+}
@@ -0,0 +1,44 @@
+package ratelimits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+// NewSAOverridesSource returns an OverridesSource which loads the unexpired
+// overrides stored in the SA's rateLimitOverrides table. These are managed
+// with the admin tool's ratelimit-override-add and ratelimit-override-remove
+// subcommands.
+func NewSAOverridesSource(sac sapb.StorageAuthorityReadOnlyClient) OverridesSource {
+	return func(ctx context.Context) ([]Override, error) {
+		stream, err := sac.GetRateLimitOverrides(ctx, &emptypb.Empty{})
+		if err != nil {
+			return nil, fmt.Errorf("setting up stream of overrides from SA: %w", err)
+		}
+
+		var overrides []Override
+		for {
+			ov, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, fmt.Errorf("streaming overrides from SA: %w", err)
+			}
+			overrides = append(overrides, Override{
+				Name:   Name(ov.LimitEnum),
+				Id:     ov.BucketId,
+				Burst:  ov.Burst,
+				Count:  ov.Count,
+				Period: ov.Period.AsDuration(),
+			})
+		}
+		return overrides, nil
+	}
+}
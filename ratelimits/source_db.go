@@ -0,0 +1,346 @@
+package ratelimits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/letsencrypt/boulder/db"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// Compile-time check that DBSource implements the source interface.
+var _ Source = (*DBSource)(nil)
+
+// DBSource is a ratelimits source backed by the rateLimitBuckets table of a
+// MariaDB database. It is intended for deployments which don't want to run
+// Redis solely for rate limiting.
+//
+// Each batch write is a single multi-row INSERT ... ON DUPLICATE KEY UPDATE
+// statement, so it is applied atomically. Rows carry an expiry, equivalent to a
+// Redis TTL; expired rows are treated as absent by every method and are
+// removed by DeleteExpired.
+//
+// DBSource relies on the read and write timeouts of the underlying database
+// connection (see cmd.DBConfig) to guarantee that no call blocks indefinitely.
+type DBSource struct {
+	dbMap   db.DatabaseMap
+	clk     clock.Clock
+	latency *prometheus.HistogramVec
+}
+
+// NewDBSource returns a new database backed source using the provided
+// db.DatabaseMap.
+func NewDBSource(dbMap db.DatabaseMap, clk clock.Clock, stats prometheus.Registerer) *DBSource {
+	latency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ratelimits_latency",
+			Help: "Histogram of database call latencies labeled by call=[set|get|delete|deleteexpired] and result=[success|error]",
+			// Exponential buckets ranging from 0.0005s to 3s.
+			Buckets: prometheus.ExponentialBucketsRange(0.0005, 3, 8),
+		},
+		[]string{"call", "result"},
+	)
+	stats.MustRegister(latency)
+
+	return &DBSource{
+		dbMap:   dbMap,
+		clk:     clk,
+		latency: latency,
+	}
+}
+
+// dbResultForError returns a string representing the result of the operation
+// based on the provided error.
+func dbResultForError(err error) string {
+	if errors.Is(err, ErrBucketNotFound) {
+		// Bucket key does not exist.
+		return "notFound"
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		// Client read or write deadline exceeded.
+		return "deadlineExceeded"
+	} else if errors.Is(err, context.Canceled) {
+		// Caller canceled the operation.
+		return "canceled"
+	}
+	return "failed"
+}
+
+func (d *DBSource) observeLatency(call string, latency time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = dbResultForError(err)
+	}
+	d.latency.With(prometheus.Labels{"call": call, "result": result}).Observe(latency.Seconds())
+}
+
+// sortedKeys returns the keys of the provided map in sorted order. Writing rows
+// in a consistent order ensures that concurrent batches acquire their row locks
+// in the same order, avoiding deadlocks.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// BatchSet stores TATs at the specified bucketKeys using a single upsert
+// statement. Like the Redis source, each bucket expires 10 minutes after its
+// TAT to account for clock skew.
+func (d *DBSource) BatchSet(ctx context.Context, buckets map[string]time.Time) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+	start := d.clk.Now()
+
+	args := make([]any, 0, len(buckets)*3)
+	for _, bucketKey := range sortedKeys(buckets) {
+		tat := buckets[bucketKey].UTC()
+		args = append(args, bucketKey, tat.UnixNano(), tat.Add(10*time.Minute))
+	}
+
+	// Safety: the interpolated string consists only of question marks, commas
+	// and parentheses.
+	_, err := d.dbMap.ExecContext(ctx, fmt.Sprintf(
+		`INSERT INTO rateLimitBuckets (bucketKey, tat, expiresAt)
+		VALUES %s
+		ON DUPLICATE KEY UPDATE
+			tat = VALUES(tat),
+			expiresAt = VALUES(expiresAt)`,
+		rowPlaceholders(len(buckets), 3)),
+		args...,
+	)
+	if err != nil {
+		d.observeLatency("batchset", d.clk.Since(start), err)
+		return err
+	}
+
+	totalLatency := d.clk.Since(start)
+	perSetLatency := totalLatency / time.Duration(len(buckets))
+	for range buckets {
+		d.observeLatency("batchset_entry", perSetLatency, nil)
+	}
+
+	d.observeLatency("batchset", totalLatency, nil)
+	return nil
+}
+
+// BatchIncrement updates TATs for the specified bucketKeys using a single
+// upsert statement. As with Redis INCRBY, a bucket which does not exist (or has
+// expired) is treated as having a TAT of zero before the increment is applied.
+func (d *DBSource) BatchIncrement(ctx context.Context, buckets map[string]increment) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+	start := d.clk.Now()
+	now := start.UTC()
+
+	args := make([]any, 0, len(buckets)*3+1)
+	for _, bucketKey := range sortedKeys(buckets) {
+		incr := buckets[bucketKey]
+		args = append(args, bucketKey, incr.cost.Nanoseconds(), now.Add(incr.ttl))
+	}
+	args = append(args, now)
+
+	// Note: MariaDB evaluates the assignments of an ON DUPLICATE KEY UPDATE
+	// clause in order, so tat must be computed before expiresAt is replaced.
+	//
+	// Safety: the interpolated string consists only of question marks, commas
+	// and parentheses.
+	_, err := d.dbMap.ExecContext(ctx, fmt.Sprintf(
+		`INSERT INTO rateLimitBuckets (bucketKey, tat, expiresAt)
+		VALUES %s
+		ON DUPLICATE KEY UPDATE
+			tat = IF(expiresAt > ?, tat + VALUES(tat), VALUES(tat)),
+			expiresAt = VALUES(expiresAt)`,
+		rowPlaceholders(len(buckets), 3)),
+		args...,
+	)
+	if err != nil {
+		d.observeLatency("batchincrby", d.clk.Since(start), err)
+		return err
+	}
+
+	totalLatency := d.clk.Since(start)
+	perSetLatency := totalLatency / time.Duration(len(buckets))
+	for range buckets {
+		d.observeLatency("batchincrby_entry", perSetLatency, nil)
+	}
+
+	d.observeLatency("batchincrby", totalLatency, nil)
+	return nil
+}
+
+// Get retrieves the TAT at the specified bucketKey. If the bucketKey does not
+// exist, or has expired, ErrBucketNotFound is returned.
+func (d *DBSource) Get(ctx context.Context, bucketKey string) (time.Time, error) {
+	start := d.clk.Now()
+
+	var tatNano int64
+	err := d.dbMap.SelectOne(ctx, &tatNano,
+		`SELECT tat FROM rateLimitBuckets WHERE bucketKey = ? AND expiresAt > ?`,
+		bucketKey, start.UTC(),
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			// Bucket key does not exist.
+			d.observeLatency("get", d.clk.Since(start), ErrBucketNotFound)
+			return time.Time{}, ErrBucketNotFound
+		}
+		// An error occurred while retrieving the TAT.
+		d.observeLatency("get", d.clk.Since(start), err)
+		return time.Time{}, err
+	}
+
+	d.observeLatency("get", d.clk.Since(start), nil)
+	return time.Unix(0, tatNano).UTC(), nil
+}
+
+// bucketModel represents a row of the rateLimitBuckets table, less its expiry.
+type bucketModel struct {
+	BucketKey string `db:"bucketKey"`
+	TAT       int64  `db:"tat"`
+}
+
+// BatchGet retrieves the TATs at the specified bucketKeys using a single
+// SELECT statement. If a bucketKey does not exist, or has expired, it WILL NOT
+// be included in the returned map.
+func (d *DBSource) BatchGet(ctx context.Context, bucketKeys []string) (map[string]time.Time, error) {
+	if len(bucketKeys) == 0 {
+		return map[string]time.Time{}, nil
+	}
+	start := d.clk.Now()
+
+	args := make([]any, 0, len(bucketKeys)+1)
+	for _, bucketKey := range bucketKeys {
+		args = append(args, bucketKey)
+	}
+	args = append(args, start.UTC())
+
+	var rows []bucketModel
+	// Safety: the interpolated string consists only of question marks and
+	// commas.
+	_, err := d.dbMap.Select(ctx, &rows, fmt.Sprintf(
+		`SELECT bucketKey, tat FROM rateLimitBuckets WHERE bucketKey IN (%s) AND expiresAt > ?`,
+		db.QuestionMarks(len(bucketKeys))),
+		args...,
+	)
+	if err != nil {
+		d.observeLatency("batchget", d.clk.Since(start), err)
+		return nil, err
+	}
+
+	totalLatency := d.clk.Since(start)
+	perEntryLatency := totalLatency / time.Duration(len(bucketKeys))
+
+	tats := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		tats[row.BucketKey] = time.Unix(0, row.TAT).UTC()
+		d.observeLatency("batchget_entry", perEntryLatency, nil)
+	}
+	for range len(bucketKeys) - len(rows) {
+		d.observeLatency("batchget_entry", perEntryLatency, ErrBucketNotFound)
+	}
+
+	var batchErr error
+	if len(rows) == 0 {
+		// All keys were not found.
+		batchErr = ErrBucketNotFound
+	}
+
+	d.observeLatency("batchget", totalLatency, batchErr)
+	return tats, nil
+}
+
+// Delete deletes the TAT at the specified bucketKey ('name:id'). A nil return
+// value does not indicate that the bucketKey existed.
+func (d *DBSource) Delete(ctx context.Context, bucketKey string) error {
+	start := d.clk.Now()
+
+	_, err := d.dbMap.ExecContext(ctx, `DELETE FROM rateLimitBuckets WHERE bucketKey = ?`, bucketKey)
+	if err != nil {
+		d.observeLatency("delete", d.clk.Since(start), err)
+		return err
+	}
+
+	d.observeLatency("delete", d.clk.Since(start), nil)
+	return nil
+}
+
+// DeleteExpired removes up to batchSize expired buckets and returns the number
+// of rows removed. Expired buckets are already ignored by every other method,
+// so this only serves to keep the table from growing without bound.
+func (d *DBSource) DeleteExpired(ctx context.Context, batchSize int) (int64, error) {
+	start := d.clk.Now()
+
+	res, err := d.dbMap.ExecContext(ctx,
+		`DELETE FROM rateLimitBuckets WHERE expiresAt <= ? LIMIT ?`,
+		start.UTC(), batchSize,
+	)
+	if err != nil {
+		d.observeLatency("deleteexpired", d.clk.Since(start), err)
+		return 0, err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		d.observeLatency("deleteexpired", d.clk.Since(start), err)
+		return 0, err
+	}
+
+	d.observeLatency("deleteexpired", d.clk.Since(start), nil)
+	return deleted, nil
+}
+
+const (
+	// purgeInterval is how often PurgeExpired deletes expired buckets.
+	purgeInterval = time.Minute
+
+	// purgeBatchSize is the maximum number of rows deleted by a single
+	// statement, to avoid holding locks on the table for long.
+	purgeBatchSize = 1000
+)
+
+// PurgeExpired calls DeleteExpired every minute, until the provided context is
+// canceled. Each call is repeated, without waiting, for as long as it removes a
+// full batch. Errors are logged and retried at the next interval. It is
+// intended to be run in its own goroutine.
+func (d *DBSource) PurgeExpired(ctx context.Context, logger blog.Logger) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for {
+			deleted, err := d.DeleteExpired(ctx, purgeBatchSize)
+			if err != nil {
+				logger.Errf("Failed to delete expired rate limit buckets: %s", err)
+				break
+			}
+			if deleted < purgeBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// rowPlaceholders returns a string of n comma-separated parenthesized groups,
+// each containing width question marks, e.g. "(?,?),(?,?)" for n=2, width=2.
+func rowPlaceholders(n, width int) string {
+	row := "(" + db.QuestionMarks(width) + ")"
+	placeholders := make([]string, n)
+	for i := range placeholders {
+		placeholders[i] = row
+	}
+	return strings.Join(placeholders, ",")
+}
@@ -0,0 +1,145 @@
+package ratelimits
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/borp"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/letsencrypt/boulder/test/vars"
+)
+
+func newTestDBSource(t *testing.T, clk clock.FakeClock) *DBSource {
+	t.Helper()
+
+	// The sa package can't be imported here (it imports ratelimits), so build
+	// a minimal borp map directly. DBSource only issues hand-written queries.
+	conf, err := mysql.ParseDSN(vars.DBConnSARatelimits)
+	test.AssertNotError(t, err, "parsing DSN")
+	conf.ParseTime = true
+	conf.Loc = time.UTC
+	conn, err := sql.Open("mysql", conf.FormatDSN())
+	test.AssertNotError(t, err, "opening database")
+	t.Cleanup(func() { _ = conn.Close() })
+
+	dbMap := db.NewWrappedMap(&borp.DbMap{Db: conn, Dialect: borp.MySQLDialect{Engine: "InnoDB", Encoding: "UTF8"}})
+	return NewDBSource(dbMap, clk, metrics.NoopRegisterer)
+}
+
+func newDBTestLimiter(t *testing.T, clk clock.FakeClock) *Limiter {
+	return newTestLimiter(t, newTestDBSource(t, clk), clk)
+}
+
+func TestRowPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	test.AssertEquals(t, rowPlaceholders(1, 1), "(?)")
+	test.AssertEquals(t, rowPlaceholders(2, 3), "(?,?,?),(?,?,?)")
+}
+
+func TestDBSource_BatchSetAndGet(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	s := newTestDBSource(t, clk)
+
+	// Use a random suffix to avoid collisions during and between test runs.
+	suffix := core.RandomString(8)
+	k1, k2, k3, k4 := "test1:"+suffix, "test2:"+suffix, "test3:"+suffix, "test4:"+suffix
+
+	set := map[string]time.Time{
+		k1: clk.Now().Add(time.Second),
+		k2: clk.Now().Add(time.Second * 2),
+		k3: clk.Now().Add(time.Second * 3),
+	}
+
+	incr := map[string]increment{
+		k1: {time.Second, time.Minute},
+		k2: {time.Second * 2, time.Minute},
+		k3: {time.Second * 3, time.Minute},
+	}
+
+	err := s.BatchSet(context.Background(), set)
+	test.AssertNotError(t, err, "BatchSet() should not error")
+
+	got, err := s.BatchGet(context.Background(), []string{k1, k2, k3})
+	test.AssertNotError(t, err, "BatchGet() should not error")
+
+	for k, v := range set {
+		test.AssertEquals(t, got[k], v.UTC())
+	}
+
+	err = s.BatchIncrement(context.Background(), incr)
+	test.AssertNotError(t, err, "BatchIncrement() should not error")
+
+	got, err = s.BatchGet(context.Background(), []string{k1, k2, k3})
+	test.AssertNotError(t, err, "BatchGet() should not error")
+
+	for k := range set {
+		test.AssertEquals(t, got[k], set[k].Add(incr[k].cost).UTC())
+	}
+
+	// Test that BatchGet() returns a zero time for a key that does not exist.
+	got, err = s.BatchGet(context.Background(), []string{k1, k4, k3})
+	test.AssertNotError(t, err, "BatchGet() should not error when a key isn't found")
+	test.Assert(t, got[k4].IsZero(), "BatchGet() should return a zero time for a key that does not exist")
+
+	err = s.Delete(context.Background(), k1)
+	test.AssertNotError(t, err, "Delete() should not error")
+	_, err = s.Get(context.Background(), k1)
+	test.AssertErrorIs(t, err, ErrBucketNotFound)
+}
+
+func TestDBSource_Expiry(t *testing.T) {
+	clk := clock.NewFake()
+	clk.Set(time.Now())
+	s := newTestDBSource(t, clk)
+
+	suffix := core.RandomString(8)
+	setKey, incrKey := "set:"+suffix, "incr:"+suffix
+
+	tat := clk.Now().Add(time.Minute)
+	err := s.BatchSet(context.Background(), map[string]time.Time{setKey: tat})
+	test.AssertNotError(t, err, "BatchSet() should not error")
+	err = s.BatchIncrement(context.Background(), map[string]increment{incrKey: {time.Second, time.Minute}})
+	test.AssertNotError(t, err, "BatchIncrement() should not error")
+
+	got, err := s.Get(context.Background(), setKey)
+	test.AssertNotError(t, err, "Get() should not error")
+	test.AssertEquals(t, got, tat.UTC())
+
+	// A set bucket expires 10 minutes after its TAT, and an incremented bucket
+	// expires after its TTL.
+	clk.Add(time.Minute + 10*time.Minute)
+	_, err = s.Get(context.Background(), setKey)
+	test.AssertErrorIs(t, err, ErrBucketNotFound)
+	_, err = s.Get(context.Background(), incrKey)
+	test.AssertErrorIs(t, err, ErrBucketNotFound)
+	tats, err := s.BatchGet(context.Background(), []string{setKey, incrKey})
+	test.AssertNotError(t, err, "BatchGet() should not error")
+	test.AssertEquals(t, len(tats), 0)
+
+	// Incrementing an expired bucket starts over from zero, like Redis INCRBY
+	// on a key whose TTL has elapsed.
+	err = s.BatchIncrement(context.Background(), map[string]increment{incrKey: {time.Second, time.Minute}})
+	test.AssertNotError(t, err, "BatchIncrement() should not error")
+	got, err = s.Get(context.Background(), incrKey)
+	test.AssertNotError(t, err, "Get() should not error")
+	test.AssertEquals(t, got, time.Unix(0, 0).Add(time.Second).UTC())
+
+	// The expired set bucket is purged, the live incremented one is not.
+	_, err = s.DeleteExpired(context.Background(), 1000)
+	test.AssertNotError(t, err, "DeleteExpired() should not error")
+	var count int
+	err = s.dbMap.SelectOne(context.Background(), &count,
+		`SELECT COUNT(*) FROM rateLimitBuckets WHERE bucketKey IN (?, ?)`, setKey, incrKey)
+	test.AssertNotError(t, err, "counting rows")
+	test.AssertEquals(t, count, 1)
+}
@@ -1,13 +1,19 @@
 package ratelimits
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
 )
 
 // ErrInvalidCost indicates that the cost specified was < 0.
@@ -188,13 +194,98 @@ type TransactionBuilder struct {
 // contain the default and override limits, respectively. Overrides is optional,
 // defaults is required.
 func NewTransactionBuilder(defaults, overrides string) (*TransactionBuilder, error) {
-	registry, err := newLimitRegistry(defaults, overrides)
+	return NewReloadableTransactionBuilder(defaults, overrides, nil, metrics.NoopRegisterer, nil)
+}
+
+// NewReloadableTransactionBuilder returns a new *TransactionBuilder, like
+// NewTransactionBuilder, whose limits can be reloaded without a restart by
+// calling ReloadLimits or ReloadLimitsPeriodically. If source is non-nil, the
+// overrides it provides are loaded in addition to, and take precedence over,
+// those in the overrides file. If the source fails when the limits are first
+// loaded, the error is logged to logger, which is only used if source is
+// non-nil, and the builder starts with the overrides file alone.
+func NewReloadableTransactionBuilder(defaults, overrides string, source OverridesSource, stats prometheus.Registerer, logger blog.Logger) (*TransactionBuilder, error) {
+	registry, err := newLimitRegistry(defaults, overrides, source, stats, logger)
 	if err != nil {
 		return nil, err
 	}
 	return &TransactionBuilder{registry}, nil
 }
 
+// ReloadLimits reloads the defaults file, the overrides file, and the overrides
+// source, if any. The new limits are only swapped in if all of them load and
+// validate successfully, otherwise the current limits remain in effect and an
+// error is returned.
+func (builder *TransactionBuilder) ReloadLimits(ctx context.Context) error {
+	return builder.reload(ctx)
+}
+
+// ReloadLimitsPeriodically calls ReloadLimits at the provided interval, until
+// the provided context is canceled. Errors are logged and the reload is retried
+// at the next interval. It is intended to be run in its own goroutine.
+func (builder *TransactionBuilder) ReloadLimitsPeriodically(ctx context.Context, interval time.Duration, logger blog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := builder.ReloadLimits(ctx)
+		if err != nil {
+			logger.Errf("Failed to reload rate limits, keeping current limits: %s", err)
+		}
+	}
+}
+
+// InspectTransaction returns a check-only Transaction, with a cost of zero, for
+// the bucket identified by the provided limit name and id. It is intended for
+// use with Limiter.Inspect, to report on the state of a bucket without
+// modifying it.
+//
+// The id is formatted as in the overrides file, except that limits whose
+// buckets are tracked per account and domain require the 'regId:domain' form,
+// and CertificatesPerDomain expects the eTLD+1 used for its buckets. If the
+// limit is not configured, an error is returned.
+func (builder *TransactionBuilder) InspectTransaction(name Name, id string) (Transaction, error) {
+	if !name.isValid() {
+		return Transaction{}, fmt.Errorf("specified name enum %q, is invalid", name)
+	}
+	err := validateIdForName(name, id)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	bucketId := id
+	var limitKey string
+	switch name {
+	case FailedAuthorizationsPerDomainPerAccount, CertificatesPerDomainPerAccount, FailedAuthorizationsForPausingPerDomainPerAccount:
+		// These limits use the 'enum:regId:domain' bucket key format for
+		// transactions and the 'enum:regId' bucket key format for overrides.
+		regId, _, ok := strings.Cut(id, ":")
+		if !ok {
+			return Transaction{}, fmt.Errorf("invalid id %q for limit %s, must be formatted 'regId:domain'", id, name)
+		}
+		limitKey = joinWithColon(name.EnumString(), regId)
+	case CertificatesPerFQDNSet:
+		bucketId = fmt.Sprintf("%x", core.HashNames(strings.Split(id, ",")))
+	}
+	bucketKey := joinWithColon(name.EnumString(), bucketId)
+	if limitKey == "" {
+		limitKey = bucketKey
+	}
+
+	limit, err := builder.getLimit(name, limitKey)
+	if err != nil {
+		if errors.Is(err, errLimitDisabled) {
+			return Transaction{}, fmt.Errorf("limit %s is not configured", name)
+		}
+		return Transaction{}, err
+	}
+	return newCheckOnlyTransaction(limit, bucketKey, 0)
+}
+
 // registrationsPerIPAddressTransaction returns a Transaction for the
 // NewRegistrationsPerIPAddress limit for the provided IP address.
 func (builder *TransactionBuilder) registrationsPerIPAddressTransaction(ip net.IP) (Transaction, error) {
@@ -202,3 +202,43 @@ func TestCertificatesPerFQDNSetTransactions(t *testing.T) {
 	test.Assert(t, txn.checkOnly(), "should be check-only")
 	test.Assert(t, !txn.limit.isOverride(), "should not be an override")
 }
+
+func TestInspectTransaction(t *testing.T) {
+	t.Parallel()
+
+	tb, err := NewTransactionBuilder("../test/config-next/wfe2-ratelimit-defaults.yml", "testdata/working_overrides_regid_fqdnset.yml")
+	test.AssertNotError(t, err, "creating TransactionBuilder")
+
+	// A check-only, zero-cost transaction for the default limit.
+	txn, err := tb.InspectTransaction(NewOrdersPerAccount, "123456789")
+	test.AssertNotError(t, err, "creating transaction")
+	test.AssertEquals(t, txn.bucketKey, "3:123456789")
+	test.Assert(t, txn.checkOnly(), "should be check-only")
+	test.AssertEquals(t, txn.cost, int64(0))
+	test.Assert(t, !txn.limit.isOverride(), "should not be an override")
+
+	// FQDN sets are hashed, and their overrides are found.
+	txn, err = tb.InspectTransaction(CertificatesPerFQDNSet, "example.com,example.net")
+	test.AssertNotError(t, err, "creating transaction")
+	test.AssertEquals(t, txn.bucketKey, fmt.Sprintf("7:%x", core.HashNames([]string{"example.com", "example.net"})))
+	test.Assert(t, txn.limit.isOverride(), "should be an override")
+	test.AssertEquals(t, txn.limit.Burst, int64(50))
+
+	// Per domain per account limits use the 'regId:domain' bucket key.
+	txn, err = tb.InspectTransaction(FailedAuthorizationsPerDomainPerAccount, "123456789:example.com")
+	test.AssertNotError(t, err, "creating transaction")
+	test.AssertEquals(t, txn.bucketKey, "4:123456789:example.com")
+
+	// ...and reject the 'regId' form used for their overrides.
+	_, err = tb.InspectTransaction(FailedAuthorizationsPerDomainPerAccount, "123456789")
+	test.AssertError(t, err, "should require regId:domain")
+
+	// Malformed ids are rejected.
+	_, err = tb.InspectTransaction(NewRegistrationsPerIPAddress, "not-an-ip")
+	test.AssertError(t, err, "should reject a malformed id")
+
+	// Limits which aren't configured are rejected.
+	_, err = tb.InspectTransaction(CertificatesPerDomainPerAccount, "123456789:example.com")
+	test.AssertError(t, err, "should reject an unconfigured limit")
+	test.AssertContains(t, err.Error(), "not configured")
+}
@@ -1,6 +1,7 @@
 package ratelimits
 
 import (
+	"net/netip"
 	"strings"
 
 	"github.com/letsencrypt/boulder/core"
@@ -14,10 +15,16 @@ func joinWithColon(args ...string) string {
 
 // FQDNsToETLDsPlusOne transforms a list of FQDNs into a list of eTLD+1's for
 // the CertificatesPerDomain limit. It also de-duplicates the output domains.
-// Exact public suffix matches are included.
+// Exact public suffix matches are included. IP addresses have no eTLD+1, so
+// they are included as-is.
 func FQDNsToETLDsPlusOne(names []string) []string {
 	var domains []string
 	for _, name := range names {
+		_, err := netip.ParseAddr(name)
+		if err == nil {
+			domains = append(domains, name)
+			continue
+		}
 		domain, err := publicsuffix.Domain(name)
 		if err != nil {
 			// The only possible errors are:
@@ -24,4 +24,7 @@ func TestFQDNsToETLDsPlusOne(t *testing.T) {
 
 	domains = FQDNsToETLDsPlusOne([]string{"github.io", "foo.github.io", "bar.github.io"})
 	test.AssertDeepEquals(t, domains, []string{"bar.github.io", "foo.github.io", "github.io"})
+
+	domains = FQDNsToETLDsPlusOne([]string{"www.example.com", "64.112.117.122", "2602:80a:6000::1"})
+	test.AssertDeepEquals(t, domains, []string{"2602:80a:6000::1", "64.112.117.122", "example.com"})
 }
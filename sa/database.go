@@ -268,6 +268,10 @@ func initTables(dbMap *borp.DbMap) {
 	regTable.SetVersionCol("LockCol")
 	regTable.ColMap("Key").SetNotNull(true)
 	regTable.ColMap("KeySHA256").SetNotNull(true).SetUnique(true)
+	regTablev1 := dbMap.AddTableWithName(regModelv1{}, "registrations").SetKeys(true, "ID")
+	regTablev1.SetVersionCol("LockCol")
+	regTablev1.ColMap("Key").SetNotNull(true)
+	regTablev1.ColMap("KeySHA256").SetNotNull(true).SetUnique(true)
 	dbMap.AddTableWithName(issuedNameModel{}, "issuedNames").SetKeys(true, "ID")
 	dbMap.AddTableWithName(core.Certificate{}, "certificates").SetKeys(true, "ID")
 	dbMap.AddTableWithName(core.CertificateStatus{}, "certificateStatus").SetKeys(true, "ID")
@@ -287,6 +291,10 @@ func initTables(dbMap *borp.DbMap) {
 	dbMap.AddTableWithName(revokedCertModel{}, "revokedCertificates").SetKeys(true, "ID")
 	dbMap.AddTableWithName(replacementOrderModel{}, "replacementOrders").SetKeys(true, "ID")
 	dbMap.AddTableWithName(pausedModel{}, "paused")
+	dbMap.AddTableWithName(externalAccountKeyModel{}, "externalAccountKeys").SetKeys(false, "KeyID")
+	dbMap.AddTableWithName(rateLimitOverrideModel{}, "rateLimitOverrides").SetKeys(false, "LimitEnum", "BucketID")
+	dbMap.AddTableWithName(ctSubmissionModel{}, "ctSubmissions").SetKeys(true, "ID")
+	dbMap.AddTableWithName(authzPerspectiveResultModel{}, "authzPerspectiveResults").SetKeys(true, "ID")
 
 	// Read-only maps used for selecting subsets of columns.
 	dbMap.AddTableWithName(CertStatusMetadata{}, "certificateStatus")
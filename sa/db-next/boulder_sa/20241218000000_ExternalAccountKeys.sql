@@ -0,0 +1,23 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- This table holds the MAC keys used for External Account Binding (RFC 8555,
+-- Section 7.3.4). Keys are provisioned out-of-band by an operator and are
+-- never deleted, only revoked, so that the accounts bound to them remain
+-- attributable.
+CREATE TABLE `externalAccountKeys` (
+  `keyID` varchar(255) NOT NULL,
+  `hmacKey` varbinary(255) NOT NULL,
+  `createdAt` datetime NOT NULL,
+  `revokedAt` datetime DEFAULT NULL,
+  PRIMARY KEY (`keyID`)
+);
+
+ALTER TABLE `registrations` ADD COLUMN `externalAccountID` varchar(255) DEFAULT NULL;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE `registrations` DROP COLUMN `externalAccountID`;
+
+DROP TABLE `externalAccountKeys`;
@@ -0,0 +1 @@
+../../db/boulder_sa/20250113000000_RateLimitBuckets.sql
\ No newline at end of file
@@ -0,0 +1 @@
+../../db/boulder_sa/20250127000000_RateLimitOverrides.sql
\ No newline at end of file
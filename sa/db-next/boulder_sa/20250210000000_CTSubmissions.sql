@@ -0,0 +1,29 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- This table is a durable queue of CT submissions which are made on a
+-- best-effort basis: final certificates to the "final" logs, and
+-- precertificates to the "informational" logs. The RA adds one row per
+-- certificate and log, leases rows whose nextAttempt has passed, and deletes
+-- them once the submission succeeds or the certificate expires.
+CREATE TABLE `ctSubmissions` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+  `serial` varchar(255) NOT NULL,
+  `kind` tinyint(4) UNSIGNED NOT NULL,
+  `logURL` varchar(255) NOT NULL,
+  `logPublicKey` varchar(1024) NOT NULL,
+  `staticCT` tinyint(1) NOT NULL,
+  `created` datetime NOT NULL,
+  `expires` datetime NOT NULL,
+  `attempts` int(10) UNSIGNED NOT NULL,
+  `nextAttempt` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `nextAttempt_idx` (`nextAttempt`),
+  KEY `created_idx` (`created`),
+  KEY `serial_idx` (`serial`)
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `ctSubmissions`;
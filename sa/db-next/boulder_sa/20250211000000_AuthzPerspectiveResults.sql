@@ -0,0 +1,31 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- This table is an audit trail of the multi-perspective (MPIC) domain control
+-- validation and CAA checks performed for each authorization. The SA adds one
+-- row per operation and perspective when the authorization is finalized, so it
+-- grows by about a dozen rows for each authorization. Like authz2, it is
+-- partitioned by id so that rows can be dropped a partition at a time once the
+-- authorizations they belong to have expired and passed the audit retention
+-- period; its partitions should be rotated alongside those of authz2.
+CREATE TABLE `authzPerspectiveResults` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+  `authzID` bigint(20) UNSIGNED NOT NULL,
+  `operation` varchar(8) NOT NULL,
+  `perspective` varchar(255) NOT NULL,
+  `rir` varchar(16) NOT NULL,
+  `passed` tinyint(1) NOT NULL,
+  `resolverAddrs` varchar(1024) NOT NULL,
+  `latencyMS` bigint(20) UNSIGNED NOT NULL,
+  `problem` mediumblob DEFAULT NULL,
+  `created` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `authzID_idx` (`authzID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+ PARTITION BY RANGE(id)
+(PARTITION p_start VALUES LESS THAN (MAXVALUE));
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `authzPerspectiveResults`;
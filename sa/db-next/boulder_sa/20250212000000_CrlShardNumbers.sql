@@ -0,0 +1,12 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- The CRL Number of each shard's most recent full CRL, which delta CRLs must
+-- indicate as their base. It can't be derived from thisUpdate, which the
+-- datetime column stores with only seconds precision.
+ALTER TABLE `crlShards` ADD COLUMN `crlNumber` bigint(20) DEFAULT NULL;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE `crlShards` DROP COLUMN `crlNumber`;
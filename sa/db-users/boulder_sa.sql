@@ -12,6 +12,7 @@ CREATE USER IF NOT EXISTS 'mailer'@'localhost';
 CREATE USER IF NOT EXISTS 'cert_checker'@'localhost';
 CREATE USER IF NOT EXISTS 'test_setup'@'localhost';
 CREATE USER IF NOT EXISTS 'badkeyrevoker'@'localhost';
+CREATE USER IF NOT EXISTS 'ratelimits'@'localhost';
 CREATE USER IF NOT EXISTS 'proxysql'@'localhost';
 
 -- Storage Authority
@@ -36,6 +37,10 @@ GRANT SELECT,INSERT,UPDATE ON revokedCertificates TO 'sa'@'localhost';
 GRANT SELECT,INSERT,UPDATE ON replacementOrders TO 'sa'@'localhost';
 -- Tests need to be able to TRUNCATE this table, so DROP is necessary.
 GRANT SELECT,INSERT,UPDATE,DROP ON paused TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON externalAccountKeys TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE,DELETE ON rateLimitOverrides TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE,DELETE ON ctSubmissions TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON authzPerspectiveResults TO 'sa'@'localhost';
 
 GRANT SELECT ON certificates TO 'sa_ro'@'localhost';
 GRANT SELECT ON certificateStatus TO 'sa_ro'@'localhost';
@@ -57,6 +62,10 @@ GRANT SELECT ON crlShards TO 'sa_ro'@'localhost';
 GRANT SELECT ON revokedCertificates TO 'sa_ro'@'localhost';
 GRANT SELECT ON replacementOrders TO 'sa_ro'@'localhost';
 GRANT SELECT ON paused TO 'sa_ro'@'localhost';
+GRANT SELECT ON externalAccountKeys TO 'sa_ro'@'localhost';
+GRANT SELECT ON rateLimitOverrides TO 'sa_ro'@'localhost';
+GRANT SELECT ON ctSubmissions TO 'sa_ro'@'localhost';
+GRANT SELECT ON authzPerspectiveResults TO 'sa_ro'@'localhost';
 
 -- OCSP Responder
 GRANT SELECT ON certificateStatus TO 'ocsp_resp'@'localhost';
@@ -86,6 +95,9 @@ GRANT SELECT ON certificateStatus TO 'badkeyrevoker'@'localhost';
 GRANT SELECT ON precertificates TO 'badkeyrevoker'@'localhost';
 GRANT SELECT ON registrations TO 'badkeyrevoker'@'localhost';
 
+-- Rate limits (WFE and RA, when not using Redis)
+GRANT SELECT,INSERT,UPDATE,DELETE ON rateLimitBuckets TO 'ratelimits'@'localhost';
+
 -- ProxySQL --
 GRANT ALL PRIVILEGES ON monitor TO 'proxysql'@'localhost';
 
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- This table holds the theoretical arrival times (TATs) of the key-value rate
+-- limits in the ratelimits package, for deployments which do not run Redis.
+-- Rows past their expiresAt are ignored on read and periodically purged.
+CREATE TABLE `rateLimitBuckets` (
+  `bucketKey` varchar(255) NOT NULL,
+  `tat` bigint(20) NOT NULL,
+  `expiresAt` datetime NOT NULL,
+  PRIMARY KEY (`bucketKey`),
+  KEY `expiresAt_idx` (`expiresAt`)
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `rateLimitBuckets`;
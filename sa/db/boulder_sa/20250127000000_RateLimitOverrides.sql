@@ -0,0 +1,24 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- This table holds rate limit overrides managed with the admin tool. They are
+-- loaded by the WFE and RA in addition to those in the overrides file. Each
+-- override has an expiry, after which it is no longer loaded, and a free-text
+-- justification.
+CREATE TABLE `rateLimitOverrides` (
+  `limitEnum` tinyint(4) UNSIGNED NOT NULL,
+  `bucketId` varchar(255) NOT NULL,
+  `burst` bigint(20) UNSIGNED NOT NULL,
+  `count` bigint(20) UNSIGNED NOT NULL,
+  `periodNS` bigint(20) UNSIGNED NOT NULL,
+  `comment` varchar(255) NOT NULL,
+  `createdAt` datetime NOT NULL,
+  `expiresAt` datetime NOT NULL,
+  PRIMARY KEY (`limitEnum`, `bucketId`),
+  KEY `expiresAt_idx` (`expiresAt`)
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `rateLimitOverrides`;
@@ -11,18 +11,22 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/netip"
 	"net/url"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
@@ -61,6 +65,10 @@ func badJSONError(msg string, jsonData []byte, err error) error {
 
 const regFields = "id, jwk, jwk_sha256, contact, agreement, createdAt, LockCol, status"
 
+// regFieldsv2 includes the externalAccountID column, which is only present when
+// the ExternalAccountBinding feature is enabled.
+const regFieldsv2 = regFields + ", externalAccountID"
+
 // ClearEmail removes the provided email address from one specified registration. If
 // there are multiple email addresses present, it does not modify other ones. If the email
 // address is not present, it does not modify the registration and will return a nil error.
@@ -129,11 +137,16 @@ func selectRegistration(ctx context.Context, s db.OneSelector, whereCol string,
 		return nil, fmt.Errorf("column name %q invalid for registrations table WHERE clause", whereCol)
 	}
 
+	fields := regFields
+	if features.Get().ExternalAccountBinding {
+		fields = regFieldsv2
+	}
+
 	var model regModel
 	err := s.SelectOne(
 		ctx,
 		&model,
-		"SELECT "+regFields+" FROM registrations WHERE "+whereCol+" = ? LIMIT 1",
+		"SELECT "+fields+" FROM registrations WHERE "+whereCol+" = ? LIMIT 1",
 		args...,
 	)
 	return &model, err
@@ -289,6 +302,40 @@ type regModel struct {
 	CreatedAt time.Time `db:"createdAt"`
 	LockCol   int64
 	Status    string `db:"status"`
+	// ExternalAccountID is the key ID of the External Account Binding used to
+	// create this registration, or nil if none was used.
+	ExternalAccountID *string `db:"externalAccountID"`
+}
+
+// TODO(#7324) regModelv1 is deprecated, use regModel moving forward. It is
+// written in place of regModel while the ExternalAccountBinding feature is
+// disabled, because the registrations table may not yet have the
+// externalAccountID column.
+type regModelv1 struct {
+	ID        int64     `db:"id"`
+	Key       []byte    `db:"jwk"`
+	KeySHA256 string    `db:"jwk_sha256"`
+	Contact   string    `db:"contact"`
+	Agreement string    `db:"agreement"`
+	InitialIP []byte    `db:"initialIp"`
+	CreatedAt time.Time `db:"createdAt"`
+	LockCol   int64
+	Status    string `db:"status"`
+}
+
+// TODO(#7324) regModelToV1 is deprecated, use regModel moving forward.
+func regModelToV1(reg *regModel) *regModelv1 {
+	return &regModelv1{
+		ID:        reg.ID,
+		Key:       reg.Key,
+		KeySHA256: reg.KeySHA256,
+		Contact:   reg.Contact,
+		Agreement: reg.Agreement,
+		InitialIP: reg.InitialIP,
+		CreatedAt: reg.CreatedAt,
+		LockCol:   reg.LockCol,
+		Status:    reg.Status,
+	}
 }
 
 func registrationPbToModel(reg *corepb.Registration) (*regModel, error) {
@@ -322,6 +369,11 @@ func registrationPbToModel(reg *corepb.Registration) (*regModel, error) {
 		createdAt = reg.CreatedAt.AsTime()
 	}
 
+	var externalAccountID *string
+	if reg.ExternalAccountID != "" {
+		externalAccountID = &reg.ExternalAccountID
+	}
+
 	return &regModel{
 		ID:        reg.Id,
 		Key:       reg.Key,
@@ -330,9 +382,10 @@ func registrationPbToModel(reg *corepb.Registration) (*regModel, error) {
 		Agreement: reg.Agreement,
 		// Although deprecated, this column remains NOT NULL in the database, so
 		// a value must still be provided.
-		InitialIP: net.ParseIP("0.0.0.0").To16(),
-		CreatedAt: createdAt,
-		Status:    reg.Status,
+		InitialIP:         net.ParseIP("0.0.0.0").To16(),
+		CreatedAt:         createdAt,
+		Status:            reg.Status,
+		ExternalAccountID: externalAccountID,
 	}, nil
 }
 
@@ -353,14 +406,20 @@ func registrationModelToPb(reg *regModel) (*corepb.Registration, error) {
 		}
 	}
 
+	var externalAccountID string
+	if reg.ExternalAccountID != nil {
+		externalAccountID = *reg.ExternalAccountID
+	}
+
 	return &corepb.Registration{
-		Id:              reg.ID,
-		Key:             reg.Key,
-		Contact:         contact,
-		ContactsPresent: contactsPresent,
-		Agreement:       reg.Agreement,
-		CreatedAt:       timestamppb.New(reg.CreatedAt.UTC()),
-		Status:          reg.Status,
+		Id:                reg.ID,
+		Key:               reg.Key,
+		Contact:           contact,
+		ContactsPresent:   contactsPresent,
+		Agreement:         reg.Agreement,
+		CreatedAt:         timestamppb.New(reg.CreatedAt.UTC()),
+		Status:            reg.Status,
+		ExternalAccountID: externalAccountID,
 	}, nil
 }
 
@@ -505,23 +564,29 @@ func modelToOrderv2(om *orderModelv2) (*corepb.Order, error) {
 }
 
 var challTypeToUint = map[string]uint8{
-	"http-01":     0,
-	"dns-01":      1,
-	"tls-alpn-01": 2,
+	"http-01":        0,
+	"dns-01":         1,
+	"tls-alpn-01":    2,
+	"dns-account-01": 3,
+	"dns-persist-01": 4,
 }
 
 var uintToChallType = map[uint8]string{
 	0: "http-01",
 	1: "dns-01",
 	2: "tls-alpn-01",
+	3: "dns-account-01",
+	4: "dns-persist-01",
 }
 
 var identifierTypeToUint = map[string]uint8{
 	"dns": 0,
+	"ip":  1,
 }
 
 var uintToIdentifierType = map[uint8]string{
 	0: "dns",
+	1: "ip",
 }
 
 var statusToUint = map[core.AcmeStatus]uint8{
@@ -632,17 +697,17 @@ func SelectAuthzsMatchingIssuance(
 	s db.Selector,
 	regID int64,
 	issued time.Time,
-	dnsNames []string,
+	idents identifier.ACMEIdentifiers,
 ) ([]*corepb.Authorization, error) {
+	identConditions, identArgs := buildIdentifierQueryConditions(idents)
 	query := fmt.Sprintf(`SELECT %s FROM authz2 WHERE
 			registrationID = ? AND
 			status IN (?, ?) AND
 			expires >= ? AND
 			attemptedAt <= ? AND
-			identifierType = ? AND
-			identifierValue IN (%s)`,
+			(%s)`,
 		authzFields,
-		db.QuestionMarks(len(dnsNames)))
+		identConditions)
 	var args []any
 	args = append(args,
 		regID,
@@ -650,11 +715,8 @@ func SelectAuthzsMatchingIssuance(
 		statusToUint[core.StatusDeactivated],
 		issued.Add(-1*time.Second), // leeway for clock skew
 		issued.Add(1*time.Second),  // leeway for clock skew
-		identifierTypeToUint[string(identifier.TypeDNS)],
 	)
-	for _, name := range dnsNames {
-		args = append(args, name)
-	}
+	args = append(args, identArgs...)
 
 	var authzModels []authzModel
 	_, err := s.Select(ctx, &authzModels, query, args...)
@@ -694,8 +756,12 @@ func hasMultipleNonPendingChallenges(challenges []*corepb.Challenge) bool {
 // representation. It hardcodes the status to "pending" because it should be
 // impossible to create an authz in any other state.
 func newAuthzReqToModel(authz *sapb.NewAuthzRequest) (*authzModel, error) {
+	identType, ok := identifierTypeToUint[authz.Identifier.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported identifier type %q", authz.Identifier.Type)
+	}
 	am := &authzModel{
-		IdentifierType:  identifierTypeToUint[authz.Identifier.Type],
+		IdentifierType:  identType,
 		IdentifierValue: authz.Identifier.Value,
 		RegistrationID:  authz.RegistrationID,
 		Status:          statusToUint[core.StatusPending],
@@ -719,9 +785,14 @@ func newAuthzReqToModel(authz *sapb.NewAuthzRequest) (*authzModel, error) {
 // authzPBToModel converts a protobuf authorization representation to the
 // authzModel storage representation.
 func authzPBToModel(authz *corepb.Authorization) (*authzModel, error) {
+	ident := identifier.FromProtoWithDefault(authz.Identifier, authz.DnsName)
+	identType, ok := identifierTypeToUint[string(ident.Type)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported identifier type %q", ident.Type)
+	}
 	am := &authzModel{
-		IdentifierType:  identifierTypeToUint[string(identifier.TypeDNS)],
-		IdentifierValue: authz.DnsName,
+		IdentifierType:  identType,
+		IdentifierValue: ident.Value,
 		RegistrationID:  authz.RegistrationID,
 		Status:          statusToUint[core.AcmeStatus(authz.Status)],
 		Expires:         authz.Expires.AsTime(),
@@ -863,17 +934,21 @@ func populateAttemptedFields(am authzModel, challenge *corepb.Challenge) error {
 
 func modelToAuthzPB(am authzModel) (*corepb.Authorization, error) {
 	identType, ok := uintToIdentifierType[am.IdentifierType]
-	if !ok || identType != string(identifier.TypeDNS) {
+	if !ok {
 		return nil, fmt.Errorf("unrecognized identifier type encoding %d", am.IdentifierType)
 	}
 
 	pb := &corepb.Authorization{
 		Id:             fmt.Sprintf("%d", am.ID),
 		Status:         string(uintToStatus[am.Status]),
-		DnsName:        am.IdentifierValue,
+		Identifier:     &corepb.Identifier{Type: identType, Value: am.IdentifierValue},
 		RegistrationID: am.RegistrationID,
 		Expires:        timestamppb.New(am.Expires),
 	}
+	// TODO: Remove DnsName once all services read Identifier.
+	if identType == string(identifier.TypeDNS) {
+		pb.DnsName = am.IdentifierValue
+	}
 	// Populate authorization challenge array. We do this by iterating through
 	// the challenge type bitmap and creating a challenge of each type if its
 	// bit is set. Each of these challenges has the token from the authorization
@@ -978,9 +1053,23 @@ type orderFQDNSet struct {
 	Expires        time.Time
 }
 
-func addFQDNSet(ctx context.Context, db db.Inserter, names []string, serial string, issued time.Time, expires time.Time) error {
+// sanIdentifiers returns the DNS names and IP addresses from a certificate's
+// Subject Alternative Names, ignoring the Subject Common Name (if any).
+func sanIdentifiers(cert *x509.Certificate) identifier.ACMEIdentifiers {
+	idents := identifier.NewDNSSlice(cert.DNSNames)
+	for _, ip := range cert.IPAddresses {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok {
+			continue
+		}
+		idents = append(idents, identifier.NewIP(addr.Unmap()))
+	}
+	return identifier.Normalize(idents)
+}
+
+func addFQDNSet(ctx context.Context, db db.Inserter, idents identifier.ACMEIdentifiers, serial string, issued time.Time, expires time.Time) error {
 	return db.Insert(ctx, &core.FQDNSet{
-		SetHash: core.HashNames(names),
+		SetHash: core.HashIdentifiers(idents),
 		Serial:  serial,
 		Issued:  issued,
 		Expires: expires,
@@ -994,12 +1083,12 @@ func addFQDNSet(ctx context.Context, db db.Inserter, names []string, serial stri
 func addOrderFQDNSet(
 	ctx context.Context,
 	db db.Inserter,
-	names []string,
+	idents identifier.ACMEIdentifiers,
 	orderID int64,
 	regID int64,
 	expires time.Time) error {
 	return db.Insert(ctx, &orderFQDNSet{
-		SetHash:        core.HashNames(names),
+		SetHash:        core.HashIdentifiers(idents),
 		OrderID:        orderID,
 		RegistrationID: regID,
 		Expires:        expires,
@@ -1156,7 +1245,7 @@ func statusForOrder(order *corepb.Order, authzValidityInfo []authzValidity, now
 
 	// An order is fully authorized if it has valid authzs for each of the order
 	// names
-	fullyAuthorized := len(order.DnsNames) == validAuthzs
+	fullyAuthorized := len(identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames)) == validAuthzs
 
 	// If the order isn't fully authorized we've encountered an internal error:
 	// Above we checked for any invalid or pending authzs and should have returned
@@ -1231,8 +1320,9 @@ func authzForOrder(ctx context.Context, s db.Selector, orderID int64) ([]int64,
 	return v2IDs, err
 }
 
-// crlShardModel represents one row in the crlShards table. The ThisUpdate and
-// NextUpdate fields are pointers because they are NULL-able columns.
+// crlShardModel represents one row in the crlShards table. The ThisUpdate,
+// NextUpdate, and CRLNumber fields are pointers because they are NULL-able
+// columns.
 type crlShardModel struct {
 	ID          int64      `db:"id"`
 	IssuerID    int64      `db:"issuerID"`
@@ -1240,6 +1330,7 @@ type crlShardModel struct {
 	ThisUpdate  *time.Time `db:"thisUpdate"`
 	NextUpdate  *time.Time `db:"nextUpdate"`
 	LeasedUntil time.Time  `db:"leasedUntil"`
+	CRLNumber   *int64     `db:"crlNumber"`
 }
 
 // revokedCertModel represents one row in the revokedCertificates table. It
@@ -1376,6 +1467,47 @@ func newIdentifierModelsFromPB(pbs []*corepb.Identifier) ([]identifierModel, err
 	return ids, nil
 }
 
+// buildIdentifierQueryConditions takes a slice of identifiers and returns a
+// string (conditions to use within the prepared statement) and a slice of anys
+// (arguments for the prepared statement), both to use within a WHERE clause for
+// queries against the authz2 table.
+//
+// Although this function takes user-controlled input, it does not include any
+// of that input directly in the returned SQL string. The resulting string
+// contains only column names, boolean operators, and questionmark placeholders.
+func buildIdentifierQueryConditions(idents identifier.ACMEIdentifiers) (string, []any) {
+	if len(idents) == 0 {
+		// No identifier values to check.
+		return "FALSE", nil
+	}
+
+	var types []identifier.IdentifierType
+	valuesByType := make(map[identifier.IdentifierType][]string)
+	for _, ident := range idents {
+		if _, ok := valuesByType[ident.Type]; !ok {
+			types = append(types, ident.Type)
+		}
+		valuesByType[ident.Type] = append(valuesByType[ident.Type], ident.Value)
+	}
+
+	var conditions []string
+	var args []any
+	for _, identType := range types {
+		values := valuesByType[identType]
+		conditions = append(conditions,
+			fmt.Sprintf("(identifierType = ? AND identifierValue IN (%s))",
+				db.QuestionMarks(len(values)),
+			),
+		)
+		args = append(args, identifierTypeToUint[string(identType)])
+		for _, value := range values {
+			args = append(args, value)
+		}
+	}
+
+	return strings.Join(conditions, " OR "), args
+}
+
 func newPBFromIdentifierModels(ids []identifierModel) (*sapb.Identifiers, error) {
 	pbs := make([]*corepb.Identifier, 0, len(ids))
 	for _, id := range ids {
@@ -1400,3 +1532,155 @@ type pausedModel struct {
 	PausedAt       time.Time  `db:"pausedAt"`
 	UnpausedAt     *time.Time `db:"unpausedAt"`
 }
+
+// externalAccountKeyModel represents a row in the "externalAccountKeys" table.
+// RevokedAt is nullable because the key may not have been revoked.
+type externalAccountKeyModel struct {
+	KeyID     string     `db:"keyID"`
+	HMACKey   []byte     `db:"hmacKey"`
+	CreatedAt time.Time  `db:"createdAt"`
+	RevokedAt *time.Time `db:"revokedAt"`
+}
+
+func externalAccountKeyModelToPb(key *externalAccountKeyModel) *sapb.ExternalAccountKey {
+	pb := &sapb.ExternalAccountKey{
+		KeyID:     key.KeyID,
+		HmacKey:   key.HMACKey,
+		CreatedAt: timestamppb.New(key.CreatedAt.UTC()),
+	}
+	if key.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(key.RevokedAt.UTC())
+	}
+	return pb
+}
+
+// rateLimitOverrideModel represents a row in the "rateLimitOverrides" table.
+type rateLimitOverrideModel struct {
+	LimitEnum int64     `db:"limitEnum"`
+	BucketID  string    `db:"bucketId"`
+	Burst     int64     `db:"burst"`
+	Count     int64     `db:"count"`
+	PeriodNS  int64     `db:"periodNS"`
+	Comment   string    `db:"comment"`
+	CreatedAt time.Time `db:"createdAt"`
+	ExpiresAt time.Time `db:"expiresAt"`
+}
+
+func rateLimitOverrideModelToPb(ov *rateLimitOverrideModel) *sapb.RateLimitOverride {
+	return &sapb.RateLimitOverride{
+		LimitEnum: ov.LimitEnum,
+		BucketId:  ov.BucketID,
+		Burst:     ov.Burst,
+		Count:     ov.Count,
+		Period:    durationpb.New(time.Duration(ov.PeriodNS)),
+		Comment:   ov.Comment,
+		CreatedAt: timestamppb.New(ov.CreatedAt.UTC()),
+		ExpiresAt: timestamppb.New(ov.ExpiresAt.UTC()),
+	}
+}
+
+// ctSubmissionModel represents a row in the "ctSubmissions" table.
+type ctSubmissionModel struct {
+	ID           int64     `db:"id"`
+	Serial       string    `db:"serial"`
+	Kind         int64     `db:"kind"`
+	LogURL       string    `db:"logURL"`
+	LogPublicKey string    `db:"logPublicKey"`
+	StaticCT     bool      `db:"staticCT"`
+	Created      time.Time `db:"created"`
+	Expires      time.Time `db:"expires"`
+	Attempts     int64     `db:"attempts"`
+	NextAttempt  time.Time `db:"nextAttempt"`
+}
+
+func ctSubmissionModelToPb(sub *ctSubmissionModel) *sapb.CTSubmission {
+	return &sapb.CTSubmission{
+		Id:           sub.ID,
+		Serial:       sub.Serial,
+		Kind:         sub.Kind,
+		LogURL:       sub.LogURL,
+		LogPublicKey: sub.LogPublicKey,
+		StaticCT:     sub.StaticCT,
+		Created:      timestamppb.New(sub.Created.UTC()),
+		Expires:      timestamppb.New(sub.Expires.UTC()),
+		Attempts:     sub.Attempts,
+		NextAttempt:  timestamppb.New(sub.NextAttempt.UTC()),
+	}
+}
+
+// authzPerspectiveResultModel represents a row in the "authzPerspectiveResults"
+// table.
+type authzPerspectiveResultModel struct {
+	ID            int64     `db:"id"`
+	AuthzID       int64     `db:"authzID"`
+	Operation     string    `db:"operation"`
+	Perspective   string    `db:"perspective"`
+	RIR           string    `db:"rir"`
+	Passed        bool      `db:"passed"`
+	ResolverAddrs []byte    `db:"resolverAddrs"`
+	LatencyMS     int64     `db:"latencyMS"`
+	Problem       []byte    `db:"problem"`
+	Created       time.Time `db:"created"`
+}
+
+// perspectiveResultPBToModel converts a PerspectiveResult received from the RA
+// into a row of the "authzPerspectiveResults" table for the given authz.
+func perspectiveResultPBToModel(authzID int64, pr *corepb.PerspectiveResult, created time.Time) (*authzPerspectiveResultModel, error) {
+	if pr.Operation == "" || pr.Perspective == "" {
+		return nil, errIncompleteRequest
+	}
+	addrs, err := json.Marshal(pr.ResolverAddrs)
+	if err != nil {
+		return nil, err
+	}
+	var probJSON []byte
+	if pr.Problem != nil {
+		prob, err := grpc.PBToProblemDetails(pr.Problem)
+		if err != nil {
+			return nil, err
+		}
+		probJSON, err = json.Marshal(prob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &authzPerspectiveResultModel{
+		AuthzID:       authzID,
+		Operation:     pr.Operation,
+		Perspective:   pr.Perspective,
+		RIR:           pr.Rir,
+		Passed:        pr.Passed,
+		ResolverAddrs: addrs,
+		LatencyMS:     pr.Latency.AsDuration().Milliseconds(),
+		Problem:       probJSON,
+		Created:       created,
+	}, nil
+}
+
+func perspectiveResultModelToPB(m *authzPerspectiveResultModel) (*corepb.PerspectiveResult, error) {
+	var addrs []string
+	err := json.Unmarshal(m.ResolverAddrs, &addrs)
+	if err != nil {
+		return nil, badJSONError("failed to unmarshal perspective result's resolver addresses", m.ResolverAddrs, err)
+	}
+	pr := &corepb.PerspectiveResult{
+		Operation:     m.Operation,
+		Perspective:   m.Perspective,
+		Rir:           m.RIR,
+		Passed:        m.Passed,
+		ResolverAddrs: addrs,
+		Latency:       durationpb.New(time.Duration(m.LatencyMS) * time.Millisecond),
+	}
+	if len(m.Problem) != 0 {
+		var prob probs.ProblemDetails
+		err := json.Unmarshal(m.Problem, &prob)
+		if err != nil {
+			return nil, badJSONError("failed to unmarshal perspective result's problem", m.Problem, err)
+		}
+		pr.Problem, err = grpc.ProblemDetailsToPB(&prob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pr, nil
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"math/big"
+	"net/netip"
 	"os"
 	"testing"
 	"time"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/letsencrypt/boulder/db"
 	"github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
 	"github.com/letsencrypt/boulder/test/vars"
 
@@ -534,3 +536,110 @@ func TestSetReplacementOrderFinalized(t *testing.T) {
 	test.AssertNotError(t, err, "SELECT from replacementOrders failed")
 	test.Assert(t, replacementRow.Replaced, "replacement order should be marked as finalized")
 }
+
+func TestAuthzModelIP(t *testing.T) {
+	authzPB := &corepb.Authorization{
+		Id:             "1",
+		Identifier:     identifier.NewIP(netip.MustParseAddr("64.112.117.122")).AsProto(),
+		RegistrationID: 1,
+		Status:         string(core.StatusPending),
+		Expires:        timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Challenges: []*corepb.Challenge{
+			{
+				Type:   string(core.ChallengeTypeHTTP01),
+				Status: string(core.StatusPending),
+				Token:  "MTIz",
+			},
+		},
+	}
+
+	model, err := authzPBToModel(authzPB)
+	test.AssertNotError(t, err, "authzPBToModel failed")
+	test.AssertEquals(t, model.IdentifierType, identifierTypeToUint[string(identifier.TypeIP)])
+	test.AssertEquals(t, model.IdentifierValue, "64.112.117.122")
+
+	authzPBOut, err := modelToAuthzPB(*model)
+	test.AssertNotError(t, err, "modelToAuthzPB failed")
+	test.AssertDeepEquals(t, authzPBOut.Identifier, authzPB.Identifier)
+	test.AssertEquals(t, authzPBOut.DnsName, "")
+}
+
+func TestAuthzModelNewChallengeTypes(t *testing.T) {
+	authzPB := &corepb.Authorization{
+		Id:             "1",
+		Identifier:     identifier.NewDNS("*.example.com").AsProto(),
+		DnsName:        "*.example.com",
+		RegistrationID: 1,
+		Status:         string(core.StatusPending),
+		Expires:        timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Challenges: []*corepb.Challenge{
+			{
+				Type:   string(core.ChallengeTypeDNS01),
+				Status: string(core.StatusPending),
+				Token:  "MTIz",
+			},
+			{
+				Type:   string(core.ChallengeTypeDNSAccount01),
+				Status: string(core.StatusPending),
+				Token:  "MTIz",
+			},
+			{
+				Type:   string(core.ChallengeTypeDNSPersist01),
+				Status: string(core.StatusPending),
+				Token:  "MTIz",
+			},
+		},
+	}
+
+	model, err := authzPBToModel(authzPB)
+	test.AssertNotError(t, err, "authzPBToModel failed")
+	test.AssertEquals(t, model.Challenges, uint8(1<<1|1<<3|1<<4))
+
+	authzPBOut, err := modelToAuthzPB(*model)
+	test.AssertNotError(t, err, "modelToAuthzPB failed")
+	test.AssertDeepEquals(t, authzPBOut.Challenges, authzPB.Challenges)
+}
+
+func TestBuildIdentifierQueryConditions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		idents         identifier.ACMEIdentifiers
+		wantConditions string
+		wantArgs       []any
+	}{
+		{
+			name:           "nil",
+			idents:         nil,
+			wantConditions: "FALSE",
+			wantArgs:       nil,
+		},
+		{
+			name:           "one DNS",
+			idents:         identifier.ACMEIdentifiers{identifier.NewDNS("example.com")},
+			wantConditions: "(identifierType = ? AND identifierValue IN (?))",
+			wantArgs:       []any{uint8(0), "example.com"},
+		},
+		{
+			name: "DNS and IP",
+			idents: identifier.ACMEIdentifiers{
+				identifier.NewDNS("example.com"),
+				identifier.NewIP(netip.MustParseAddr("64.112.117.122")),
+				identifier.NewDNS("example.net"),
+			},
+			wantConditions: "(identifierType = ? AND identifierValue IN (?,?)) OR (identifierType = ? AND identifierValue IN (?))",
+			wantArgs:       []any{uint8(0), "example.com", "example.net", uint8(1), "64.112.117.122"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conditions, args := buildIdentifierQueryConditions(tc.idents)
+			test.AssertEquals(t, conditions, tc.wantConditions)
+			test.AssertDeepEquals(t, args, tc.wantArgs)
+		})
+	}
+}
@@ -34,6 +34,7 @@ const (
 	StorageAuthorityReadOnly_GetCertificate_FullMethodName               = "/sa.StorageAuthorityReadOnly/GetCertificate"
 	StorageAuthorityReadOnly_GetLintPrecertificate_FullMethodName        = "/sa.StorageAuthorityReadOnly/GetLintPrecertificate"
 	StorageAuthorityReadOnly_GetCertificateStatus_FullMethodName         = "/sa.StorageAuthorityReadOnly/GetCertificateStatus"
+	StorageAuthorityReadOnly_GetCRLShard_FullMethodName                  = "/sa.StorageAuthorityReadOnly/GetCRLShard"
 	StorageAuthorityReadOnly_GetMaxExpiration_FullMethodName             = "/sa.StorageAuthorityReadOnly/GetMaxExpiration"
 	StorageAuthorityReadOnly_GetOrder_FullMethodName                     = "/sa.StorageAuthorityReadOnly/GetOrder"
 	StorageAuthorityReadOnly_GetOrderForNames_FullMethodName             = "/sa.StorageAuthorityReadOnly/GetOrderForNames"
@@ -52,6 +53,9 @@ const (
 	StorageAuthorityReadOnly_SerialsForIncident_FullMethodName           = "/sa.StorageAuthorityReadOnly/SerialsForIncident"
 	StorageAuthorityReadOnly_CheckIdentifiersPaused_FullMethodName       = "/sa.StorageAuthorityReadOnly/CheckIdentifiersPaused"
 	StorageAuthorityReadOnly_GetPausedIdentifiers_FullMethodName         = "/sa.StorageAuthorityReadOnly/GetPausedIdentifiers"
+	StorageAuthorityReadOnly_GetExternalAccountKey_FullMethodName        = "/sa.StorageAuthorityReadOnly/GetExternalAccountKey"
+	StorageAuthorityReadOnly_GetRateLimitOverrides_FullMethodName        = "/sa.StorageAuthorityReadOnly/GetRateLimitOverrides"
+	StorageAuthorityReadOnly_GetCTSubmissionQueueStats_FullMethodName    = "/sa.StorageAuthorityReadOnly/GetCTSubmissionQueueStats"
 )
 
 // StorageAuthorityReadOnlyClient is the client API for StorageAuthorityReadOnly service.
@@ -70,6 +74,7 @@ type StorageAuthorityReadOnlyClient interface {
 	GetCertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error)
 	GetLintPrecertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error)
 	GetCertificateStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.CertificateStatus, error)
+	GetCRLShard(ctx context.Context, in *GetCRLShardRequest, opts ...grpc.CallOption) (*CRLShard, error)
 	GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error)
 	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	GetOrderForNames(ctx context.Context, in *GetOrderForNamesRequest, opts ...grpc.CallOption) (*proto.Order, error)
@@ -88,6 +93,9 @@ type StorageAuthorityReadOnlyClient interface {
 	SerialsForIncident(ctx context.Context, in *SerialsForIncidentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentSerial], error)
 	CheckIdentifiersPaused(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Identifiers, error)
 	GetPausedIdentifiers(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Identifiers, error)
+	GetExternalAccountKey(ctx context.Context, in *ExternalAccountKeyID, opts ...grpc.CallOption) (*ExternalAccountKey, error)
+	GetRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverride], error)
+	GetCTSubmissionQueueStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CTSubmissionQueueStats, error)
 }
 
 type storageAuthorityReadOnlyClient struct {
@@ -218,6 +226,16 @@ func (c *storageAuthorityReadOnlyClient) GetCertificateStatus(ctx context.Contex
 	return out, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetCRLShard(ctx context.Context, in *GetCRLShardRequest, opts ...grpc.CallOption) (*CRLShard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CRLShard)
+	err := c.cc.Invoke(ctx, StorageAuthorityReadOnly_GetCRLShard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityReadOnlyClient) GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(timestamppb.Timestamp)
@@ -434,6 +452,45 @@ func (c *storageAuthorityReadOnlyClient) GetPausedIdentifiers(ctx context.Contex
 	return out, nil
 }
 
+func (c *storageAuthorityReadOnlyClient) GetExternalAccountKey(ctx context.Context, in *ExternalAccountKeyID, opts ...grpc.CallOption) (*ExternalAccountKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExternalAccountKey)
+	err := c.cc.Invoke(ctx, StorageAuthorityReadOnly_GetExternalAccountKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityReadOnlyClient) GetRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverride], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthorityReadOnly_ServiceDesc.Streams[4], StorageAuthorityReadOnly_GetRateLimitOverrides_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, RateLimitOverride]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetRateLimitOverridesClient = grpc.ServerStreamingClient[RateLimitOverride]
+
+func (c *storageAuthorityReadOnlyClient) GetCTSubmissionQueueStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CTSubmissionQueueStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CTSubmissionQueueStats)
+	err := c.cc.Invoke(ctx, StorageAuthorityReadOnly_GetCTSubmissionQueueStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageAuthorityReadOnlyServer is the server API for StorageAuthorityReadOnly service.
 // All implementations must embed UnimplementedStorageAuthorityReadOnlyServer
 // for forward compatibility
@@ -450,6 +507,7 @@ type StorageAuthorityReadOnlyServer interface {
 	GetCertificate(context.Context, *Serial) (*proto.Certificate, error)
 	GetLintPrecertificate(context.Context, *Serial) (*proto.Certificate, error)
 	GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error)
+	GetCRLShard(context.Context, *GetCRLShardRequest) (*CRLShard, error)
 	GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error)
 	GetOrder(context.Context, *OrderRequest) (*proto.Order, error)
 	GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error)
@@ -468,6 +526,9 @@ type StorageAuthorityReadOnlyServer interface {
 	SerialsForIncident(*SerialsForIncidentRequest, grpc.ServerStreamingServer[IncidentSerial]) error
 	CheckIdentifiersPaused(context.Context, *PauseRequest) (*Identifiers, error)
 	GetPausedIdentifiers(context.Context, *RegistrationID) (*Identifiers, error)
+	GetExternalAccountKey(context.Context, *ExternalAccountKeyID) (*ExternalAccountKey, error)
+	GetRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverride]) error
+	GetCTSubmissionQueueStats(context.Context, *emptypb.Empty) (*CTSubmissionQueueStats, error)
 	mustEmbedUnimplementedStorageAuthorityReadOnlyServer()
 }
 
@@ -511,6 +572,9 @@ func (UnimplementedStorageAuthorityReadOnlyServer) GetLintPrecertificate(context
 func (UnimplementedStorageAuthorityReadOnlyServer) GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateStatus not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetCRLShard(context.Context, *GetCRLShardRequest) (*CRLShard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCRLShard not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMaxExpiration not implemented")
 }
@@ -565,6 +629,15 @@ func (UnimplementedStorageAuthorityReadOnlyServer) CheckIdentifiersPaused(contex
 func (UnimplementedStorageAuthorityReadOnlyServer) GetPausedIdentifiers(context.Context, *RegistrationID) (*Identifiers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPausedIdentifiers not implemented")
 }
+func (UnimplementedStorageAuthorityReadOnlyServer) GetExternalAccountKey(context.Context, *ExternalAccountKeyID) (*ExternalAccountKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExternalAccountKey not implemented")
+}
+func (UnimplementedStorageAuthorityReadOnlyServer) GetRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverride]) error {
+	return status.Errorf(codes.Unimplemented, "method GetRateLimitOverrides not implemented")
+}
+func (UnimplementedStorageAuthorityReadOnlyServer) GetCTSubmissionQueueStats(context.Context, *emptypb.Empty) (*CTSubmissionQueueStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCTSubmissionQueueStats not implemented")
+}
 func (UnimplementedStorageAuthorityReadOnlyServer) mustEmbedUnimplementedStorageAuthorityReadOnlyServer() {
 }
 
@@ -795,6 +868,24 @@ func _StorageAuthorityReadOnly_GetCertificateStatus_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthorityReadOnly_GetCRLShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCRLShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetCRLShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthorityReadOnly_GetCRLShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetCRLShard(ctx, req.(*GetCRLShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthorityReadOnly_GetMaxExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1091,6 +1182,53 @@ func _StorageAuthorityReadOnly_GetPausedIdentifiers_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthorityReadOnly_GetExternalAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalAccountKeyID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetExternalAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthorityReadOnly_GetExternalAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetExternalAccountKey(ctx, req.(*ExternalAccountKeyID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthorityReadOnly_GetRateLimitOverrides_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityReadOnlyServer).GetRateLimitOverrides(m, &grpc.GenericServerStream[emptypb.Empty, RateLimitOverride]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthorityReadOnly_GetRateLimitOverridesServer = grpc.ServerStreamingServer[RateLimitOverride]
+
+func _StorageAuthorityReadOnly_GetCTSubmissionQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityReadOnlyServer).GetCTSubmissionQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthorityReadOnly_GetCTSubmissionQueueStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityReadOnlyServer).GetCTSubmissionQueueStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageAuthorityReadOnly_ServiceDesc is the grpc.ServiceDesc for StorageAuthorityReadOnly service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1146,6 +1284,10 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCertificateStatus",
 			Handler:    _StorageAuthorityReadOnly_GetCertificateStatus_Handler,
 		},
+		{
+			MethodName: "GetCRLShard",
+			Handler:    _StorageAuthorityReadOnly_GetCRLShard_Handler,
+		},
 		{
 			MethodName: "GetMaxExpiration",
 			Handler:    _StorageAuthorityReadOnly_GetMaxExpiration_Handler,
@@ -1202,6 +1344,14 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPausedIdentifiers",
 			Handler:    _StorageAuthorityReadOnly_GetPausedIdentifiers_Handler,
 		},
+		{
+			MethodName: "GetExternalAccountKey",
+			Handler:    _StorageAuthorityReadOnly_GetExternalAccountKey_Handler,
+		},
+		{
+			MethodName: "GetCTSubmissionQueueStats",
+			Handler:    _StorageAuthorityReadOnly_GetCTSubmissionQueueStats_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1224,6 +1374,11 @@ var StorageAuthorityReadOnly_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StorageAuthorityReadOnly_SerialsForIncident_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetRateLimitOverrides",
+			Handler:       _StorageAuthorityReadOnly_GetRateLimitOverrides_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "sa.proto",
 }
@@ -1241,6 +1396,7 @@ const (
 	StorageAuthority_GetCertificate_FullMethodName               = "/sa.StorageAuthority/GetCertificate"
 	StorageAuthority_GetLintPrecertificate_FullMethodName        = "/sa.StorageAuthority/GetLintPrecertificate"
 	StorageAuthority_GetCertificateStatus_FullMethodName         = "/sa.StorageAuthority/GetCertificateStatus"
+	StorageAuthority_GetCRLShard_FullMethodName                  = "/sa.StorageAuthority/GetCRLShard"
 	StorageAuthority_GetMaxExpiration_FullMethodName             = "/sa.StorageAuthority/GetMaxExpiration"
 	StorageAuthority_GetOrder_FullMethodName                     = "/sa.StorageAuthority/GetOrder"
 	StorageAuthority_GetOrderForNames_FullMethodName             = "/sa.StorageAuthority/GetOrderForNames"
@@ -1259,6 +1415,9 @@ const (
 	StorageAuthority_SerialsForIncident_FullMethodName           = "/sa.StorageAuthority/SerialsForIncident"
 	StorageAuthority_CheckIdentifiersPaused_FullMethodName       = "/sa.StorageAuthority/CheckIdentifiersPaused"
 	StorageAuthority_GetPausedIdentifiers_FullMethodName         = "/sa.StorageAuthority/GetPausedIdentifiers"
+	StorageAuthority_GetExternalAccountKey_FullMethodName        = "/sa.StorageAuthority/GetExternalAccountKey"
+	StorageAuthority_GetRateLimitOverrides_FullMethodName        = "/sa.StorageAuthority/GetRateLimitOverrides"
+	StorageAuthority_GetCTSubmissionQueueStats_FullMethodName    = "/sa.StorageAuthority/GetCTSubmissionQueueStats"
 	StorageAuthority_AddBlockedKey_FullMethodName                = "/sa.StorageAuthority/AddBlockedKey"
 	StorageAuthority_AddCertificate_FullMethodName               = "/sa.StorageAuthority/AddCertificate"
 	StorageAuthority_AddPrecertificate_FullMethodName            = "/sa.StorageAuthority/AddPrecertificate"
@@ -1281,6 +1440,14 @@ const (
 	StorageAuthority_UpdateCRLShard_FullMethodName               = "/sa.StorageAuthority/UpdateCRLShard"
 	StorageAuthority_PauseIdentifiers_FullMethodName             = "/sa.StorageAuthority/PauseIdentifiers"
 	StorageAuthority_UnpauseAccount_FullMethodName               = "/sa.StorageAuthority/UnpauseAccount"
+	StorageAuthority_AddExternalAccountKey_FullMethodName        = "/sa.StorageAuthority/AddExternalAccountKey"
+	StorageAuthority_RevokeExternalAccountKey_FullMethodName     = "/sa.StorageAuthority/RevokeExternalAccountKey"
+	StorageAuthority_AddRateLimitOverride_FullMethodName         = "/sa.StorageAuthority/AddRateLimitOverride"
+	StorageAuthority_RemoveRateLimitOverride_FullMethodName      = "/sa.StorageAuthority/RemoveRateLimitOverride"
+	StorageAuthority_AddCTSubmissions_FullMethodName             = "/sa.StorageAuthority/AddCTSubmissions"
+	StorageAuthority_LeaseCTSubmissions_FullMethodName           = "/sa.StorageAuthority/LeaseCTSubmissions"
+	StorageAuthority_RescheduleCTSubmission_FullMethodName       = "/sa.StorageAuthority/RescheduleCTSubmission"
+	StorageAuthority_DeleteCTSubmission_FullMethodName           = "/sa.StorageAuthority/DeleteCTSubmission"
 )
 
 // StorageAuthorityClient is the client API for StorageAuthority service.
@@ -1300,6 +1467,7 @@ type StorageAuthorityClient interface {
 	GetCertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error)
 	GetLintPrecertificate(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.Certificate, error)
 	GetCertificateStatus(ctx context.Context, in *Serial, opts ...grpc.CallOption) (*proto.CertificateStatus, error)
+	GetCRLShard(ctx context.Context, in *GetCRLShardRequest, opts ...grpc.CallOption) (*CRLShard, error)
 	GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error)
 	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*proto.Order, error)
 	GetOrderForNames(ctx context.Context, in *GetOrderForNamesRequest, opts ...grpc.CallOption) (*proto.Order, error)
@@ -1318,6 +1486,9 @@ type StorageAuthorityClient interface {
 	SerialsForIncident(ctx context.Context, in *SerialsForIncidentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentSerial], error)
 	CheckIdentifiersPaused(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Identifiers, error)
 	GetPausedIdentifiers(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Identifiers, error)
+	GetExternalAccountKey(ctx context.Context, in *ExternalAccountKeyID, opts ...grpc.CallOption) (*ExternalAccountKey, error)
+	GetRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverride], error)
+	GetCTSubmissionQueueStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CTSubmissionQueueStats, error)
 	// Adders
 	AddBlockedKey(ctx context.Context, in *AddBlockedKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddCertificate(ctx context.Context, in *AddCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -1341,6 +1512,14 @@ type StorageAuthorityClient interface {
 	UpdateCRLShard(ctx context.Context, in *UpdateCRLShardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PauseIdentifiers(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseIdentifiersResponse, error)
 	UnpauseAccount(ctx context.Context, in *RegistrationID, opts ...grpc.CallOption) (*Count, error)
+	AddExternalAccountKey(ctx context.Context, in *ExternalAccountKey, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeExternalAccountKey(ctx context.Context, in *ExternalAccountKeyID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddRateLimitOverride(ctx context.Context, in *RateLimitOverride, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveRateLimitOverride(ctx context.Context, in *RateLimitOverrideID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddCTSubmissions(ctx context.Context, in *CTSubmissions, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LeaseCTSubmissions(ctx context.Context, in *LeaseCTSubmissionsRequest, opts ...grpc.CallOption) (*CTSubmissions, error)
+	RescheduleCTSubmission(ctx context.Context, in *RescheduleCTSubmissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCTSubmission(ctx context.Context, in *DeleteCTSubmissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type storageAuthorityClient struct {
@@ -1471,6 +1650,16 @@ func (c *storageAuthorityClient) GetCertificateStatus(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetCRLShard(ctx context.Context, in *GetCRLShardRequest, opts ...grpc.CallOption) (*CRLShard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CRLShard)
+	err := c.cc.Invoke(ctx, StorageAuthority_GetCRLShard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) GetMaxExpiration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(timestamppb.Timestamp)
@@ -1687,6 +1876,45 @@ func (c *storageAuthorityClient) GetPausedIdentifiers(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *storageAuthorityClient) GetExternalAccountKey(ctx context.Context, in *ExternalAccountKeyID, opts ...grpc.CallOption) (*ExternalAccountKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExternalAccountKey)
+	err := c.cc.Invoke(ctx, StorageAuthority_GetExternalAccountKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) GetRateLimitOverrides(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RateLimitOverride], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageAuthority_ServiceDesc.Streams[4], StorageAuthority_GetRateLimitOverrides_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, RateLimitOverride]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetRateLimitOverridesClient = grpc.ServerStreamingClient[RateLimitOverride]
+
+func (c *storageAuthorityClient) GetCTSubmissionQueueStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CTSubmissionQueueStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CTSubmissionQueueStats)
+	err := c.cc.Invoke(ctx, StorageAuthority_GetCTSubmissionQueueStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageAuthorityClient) AddBlockedKey(ctx context.Context, in *AddBlockedKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -1907,6 +2135,86 @@ func (c *storageAuthorityClient) UnpauseAccount(ctx context.Context, in *Registr
 	return out, nil
 }
 
+func (c *storageAuthorityClient) AddExternalAccountKey(ctx context.Context, in *ExternalAccountKey, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StorageAuthority_AddExternalAccountKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) RevokeExternalAccountKey(ctx context.Context, in *ExternalAccountKeyID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StorageAuthority_RevokeExternalAccountKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) AddRateLimitOverride(ctx context.Context, in *RateLimitOverride, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StorageAuthority_AddRateLimitOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) RemoveRateLimitOverride(ctx context.Context, in *RateLimitOverrideID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StorageAuthority_RemoveRateLimitOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) AddCTSubmissions(ctx context.Context, in *CTSubmissions, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StorageAuthority_AddCTSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) LeaseCTSubmissions(ctx context.Context, in *LeaseCTSubmissionsRequest, opts ...grpc.CallOption) (*CTSubmissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CTSubmissions)
+	err := c.cc.Invoke(ctx, StorageAuthority_LeaseCTSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) RescheduleCTSubmission(ctx context.Context, in *RescheduleCTSubmissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StorageAuthority_RescheduleCTSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageAuthorityClient) DeleteCTSubmission(ctx context.Context, in *DeleteCTSubmissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StorageAuthority_DeleteCTSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageAuthorityServer is the server API for StorageAuthority service.
 // All implementations must embed UnimplementedStorageAuthorityServer
 // for forward compatibility
@@ -1924,6 +2232,7 @@ type StorageAuthorityServer interface {
 	GetCertificate(context.Context, *Serial) (*proto.Certificate, error)
 	GetLintPrecertificate(context.Context, *Serial) (*proto.Certificate, error)
 	GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error)
+	GetCRLShard(context.Context, *GetCRLShardRequest) (*CRLShard, error)
 	GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error)
 	GetOrder(context.Context, *OrderRequest) (*proto.Order, error)
 	GetOrderForNames(context.Context, *GetOrderForNamesRequest) (*proto.Order, error)
@@ -1942,6 +2251,9 @@ type StorageAuthorityServer interface {
 	SerialsForIncident(*SerialsForIncidentRequest, grpc.ServerStreamingServer[IncidentSerial]) error
 	CheckIdentifiersPaused(context.Context, *PauseRequest) (*Identifiers, error)
 	GetPausedIdentifiers(context.Context, *RegistrationID) (*Identifiers, error)
+	GetExternalAccountKey(context.Context, *ExternalAccountKeyID) (*ExternalAccountKey, error)
+	GetRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverride]) error
+	GetCTSubmissionQueueStats(context.Context, *emptypb.Empty) (*CTSubmissionQueueStats, error)
 	// Adders
 	AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error)
 	AddCertificate(context.Context, *AddCertificateRequest) (*emptypb.Empty, error)
@@ -1965,6 +2277,14 @@ type StorageAuthorityServer interface {
 	UpdateCRLShard(context.Context, *UpdateCRLShardRequest) (*emptypb.Empty, error)
 	PauseIdentifiers(context.Context, *PauseRequest) (*PauseIdentifiersResponse, error)
 	UnpauseAccount(context.Context, *RegistrationID) (*Count, error)
+	AddExternalAccountKey(context.Context, *ExternalAccountKey) (*emptypb.Empty, error)
+	RevokeExternalAccountKey(context.Context, *ExternalAccountKeyID) (*emptypb.Empty, error)
+	AddRateLimitOverride(context.Context, *RateLimitOverride) (*emptypb.Empty, error)
+	RemoveRateLimitOverride(context.Context, *RateLimitOverrideID) (*emptypb.Empty, error)
+	AddCTSubmissions(context.Context, *CTSubmissions) (*emptypb.Empty, error)
+	LeaseCTSubmissions(context.Context, *LeaseCTSubmissionsRequest) (*CTSubmissions, error)
+	RescheduleCTSubmission(context.Context, *RescheduleCTSubmissionRequest) (*emptypb.Empty, error)
+	DeleteCTSubmission(context.Context, *DeleteCTSubmissionRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedStorageAuthorityServer()
 }
 
@@ -2008,6 +2328,9 @@ func (UnimplementedStorageAuthorityServer) GetLintPrecertificate(context.Context
 func (UnimplementedStorageAuthorityServer) GetCertificateStatus(context.Context, *Serial) (*proto.CertificateStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateStatus not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetCRLShard(context.Context, *GetCRLShardRequest) (*CRLShard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCRLShard not implemented")
+}
 func (UnimplementedStorageAuthorityServer) GetMaxExpiration(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMaxExpiration not implemented")
 }
@@ -2062,6 +2385,15 @@ func (UnimplementedStorageAuthorityServer) CheckIdentifiersPaused(context.Contex
 func (UnimplementedStorageAuthorityServer) GetPausedIdentifiers(context.Context, *RegistrationID) (*Identifiers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPausedIdentifiers not implemented")
 }
+func (UnimplementedStorageAuthorityServer) GetExternalAccountKey(context.Context, *ExternalAccountKeyID) (*ExternalAccountKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExternalAccountKey not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetRateLimitOverrides(*emptypb.Empty, grpc.ServerStreamingServer[RateLimitOverride]) error {
+	return status.Errorf(codes.Unimplemented, "method GetRateLimitOverrides not implemented")
+}
+func (UnimplementedStorageAuthorityServer) GetCTSubmissionQueueStats(context.Context, *emptypb.Empty) (*CTSubmissionQueueStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCTSubmissionQueueStats not implemented")
+}
 func (UnimplementedStorageAuthorityServer) AddBlockedKey(context.Context, *AddBlockedKeyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedKey not implemented")
 }
@@ -2128,6 +2460,30 @@ func (UnimplementedStorageAuthorityServer) PauseIdentifiers(context.Context, *Pa
 func (UnimplementedStorageAuthorityServer) UnpauseAccount(context.Context, *RegistrationID) (*Count, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnpauseAccount not implemented")
 }
+func (UnimplementedStorageAuthorityServer) AddExternalAccountKey(context.Context, *ExternalAccountKey) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExternalAccountKey not implemented")
+}
+func (UnimplementedStorageAuthorityServer) RevokeExternalAccountKey(context.Context, *ExternalAccountKeyID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeExternalAccountKey not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddRateLimitOverride(context.Context, *RateLimitOverride) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRateLimitOverride not implemented")
+}
+func (UnimplementedStorageAuthorityServer) RemoveRateLimitOverride(context.Context, *RateLimitOverrideID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimitOverride not implemented")
+}
+func (UnimplementedStorageAuthorityServer) AddCTSubmissions(context.Context, *CTSubmissions) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCTSubmissions not implemented")
+}
+func (UnimplementedStorageAuthorityServer) LeaseCTSubmissions(context.Context, *LeaseCTSubmissionsRequest) (*CTSubmissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseCTSubmissions not implemented")
+}
+func (UnimplementedStorageAuthorityServer) RescheduleCTSubmission(context.Context, *RescheduleCTSubmissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleCTSubmission not implemented")
+}
+func (UnimplementedStorageAuthorityServer) DeleteCTSubmission(context.Context, *DeleteCTSubmissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCTSubmission not implemented")
+}
 func (UnimplementedStorageAuthorityServer) mustEmbedUnimplementedStorageAuthorityServer() {}
 
 // UnsafeStorageAuthorityServer may be embedded to opt out of forward compatibility for this service.
@@ -2357,6 +2713,24 @@ func _StorageAuthority_GetCertificateStatus_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_GetCRLShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCRLShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetCRLShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_GetCRLShard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetCRLShard(ctx, req.(*GetCRLShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_GetMaxExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2653,6 +3027,53 @@ func _StorageAuthority_GetPausedIdentifiers_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_GetExternalAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalAccountKeyID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetExternalAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_GetExternalAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetExternalAccountKey(ctx, req.(*ExternalAccountKeyID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_GetRateLimitOverrides_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageAuthorityServer).GetRateLimitOverrides(m, &grpc.GenericServerStream[emptypb.Empty, RateLimitOverride]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageAuthority_GetRateLimitOverridesServer = grpc.ServerStreamingServer[RateLimitOverride]
+
+func _StorageAuthority_GetCTSubmissionQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).GetCTSubmissionQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_GetCTSubmissionQueueStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).GetCTSubmissionQueueStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StorageAuthority_AddBlockedKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddBlockedKeyRequest)
 	if err := dec(in); err != nil {
@@ -3049,6 +3470,150 @@ func _StorageAuthority_UnpauseAccount_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageAuthority_AddExternalAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalAccountKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).AddExternalAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_AddExternalAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).AddExternalAccountKey(ctx, req.(*ExternalAccountKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_RevokeExternalAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalAccountKeyID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).RevokeExternalAccountKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_RevokeExternalAccountKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).RevokeExternalAccountKey(ctx, req.(*ExternalAccountKeyID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_AddRateLimitOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).AddRateLimitOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_AddRateLimitOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).AddRateLimitOverride(ctx, req.(*RateLimitOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_RemoveRateLimitOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitOverrideID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).RemoveRateLimitOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_RemoveRateLimitOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).RemoveRateLimitOverride(ctx, req.(*RateLimitOverrideID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_AddCTSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CTSubmissions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).AddCTSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_AddCTSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).AddCTSubmissions(ctx, req.(*CTSubmissions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_LeaseCTSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseCTSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).LeaseCTSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_LeaseCTSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).LeaseCTSubmissions(ctx, req.(*LeaseCTSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_RescheduleCTSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleCTSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).RescheduleCTSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_RescheduleCTSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).RescheduleCTSubmission(ctx, req.(*RescheduleCTSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageAuthority_DeleteCTSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCTSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageAuthorityServer).DeleteCTSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageAuthority_DeleteCTSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageAuthorityServer).DeleteCTSubmission(ctx, req.(*DeleteCTSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageAuthority_ServiceDesc is the grpc.ServiceDesc for StorageAuthority service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3104,6 +3669,10 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCertificateStatus",
 			Handler:    _StorageAuthority_GetCertificateStatus_Handler,
 		},
+		{
+			MethodName: "GetCRLShard",
+			Handler:    _StorageAuthority_GetCRLShard_Handler,
+		},
 		{
 			MethodName: "GetMaxExpiration",
 			Handler:    _StorageAuthority_GetMaxExpiration_Handler,
@@ -3160,6 +3729,14 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPausedIdentifiers",
 			Handler:    _StorageAuthority_GetPausedIdentifiers_Handler,
 		},
+		{
+			MethodName: "GetExternalAccountKey",
+			Handler:    _StorageAuthority_GetExternalAccountKey_Handler,
+		},
+		{
+			MethodName: "GetCTSubmissionQueueStats",
+			Handler:    _StorageAuthority_GetCTSubmissionQueueStats_Handler,
+		},
 		{
 			MethodName: "AddBlockedKey",
 			Handler:    _StorageAuthority_AddBlockedKey_Handler,
@@ -3248,6 +3825,38 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnpauseAccount",
 			Handler:    _StorageAuthority_UnpauseAccount_Handler,
 		},
+		{
+			MethodName: "AddExternalAccountKey",
+			Handler:    _StorageAuthority_AddExternalAccountKey_Handler,
+		},
+		{
+			MethodName: "RevokeExternalAccountKey",
+			Handler:    _StorageAuthority_RevokeExternalAccountKey_Handler,
+		},
+		{
+			MethodName: "AddRateLimitOverride",
+			Handler:    _StorageAuthority_AddRateLimitOverride_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimitOverride",
+			Handler:    _StorageAuthority_RemoveRateLimitOverride_Handler,
+		},
+		{
+			MethodName: "AddCTSubmissions",
+			Handler:    _StorageAuthority_AddCTSubmissions_Handler,
+		},
+		{
+			MethodName: "LeaseCTSubmissions",
+			Handler:    _StorageAuthority_LeaseCTSubmissions_Handler,
+		},
+		{
+			MethodName: "RescheduleCTSubmission",
+			Handler:    _StorageAuthority_RescheduleCTSubmission_Handler,
+		},
+		{
+			MethodName: "DeleteCTSubmission",
+			Handler:    _StorageAuthority_DeleteCTSubmission_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3270,6 +3879,11 @@ var StorageAuthority_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StorageAuthority_SerialsForIncident_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetRateLimitOverrides",
+			Handler:       _StorageAuthority_GetRateLimitOverrides_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "sa.proto",
 }
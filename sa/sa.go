@@ -23,6 +23,7 @@ import (
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/identifier"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/revocation"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
@@ -31,6 +32,10 @@ import (
 
 var (
 	errIncompleteRequest = errors.New("incomplete gRPC request message")
+	// errCTSubmissionQueueDisabled is returned by the CT submission queue
+	// methods when the CTSubmissionQueue feature flag is not enabled, because
+	// the ctSubmissions table may not exist.
+	errCTSubmissionQueueDisabled = errors.New("CT submission queue is not enabled")
 )
 
 // SQLStorageAuthority defines a Storage Authority.
@@ -113,14 +118,50 @@ func (ssa *SQLStorageAuthority) NewRegistration(ctx context.Context, req *corepb
 
 	reg.CreatedAt = ssa.clk.Now()
 
-	err = ssa.dbMap.Insert(ctx, reg)
-	if err != nil {
-		if db.IsDuplicate(err) {
-			// duplicate entry error can only happen when jwk_sha256 collides, indicate
-			// to caller that the provided key is already in use
-			return nil, berrors.DuplicateError("key is already in use for a different account")
+	if reg.ExternalAccountID != nil && !features.Get().ExternalAccountBinding {
+		return nil, berrors.UnauthorizedError("external account key %q does not exist", *reg.ExternalAccountID)
+	}
+
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(tx db.Executor) (interface{}, error) {
+		if reg.ExternalAccountID != nil {
+			// Ensure the External Account Binding key wasn't revoked between
+			// the WFE verifying the binding and the account being created.
+			var key externalAccountKeyModel
+			err := tx.SelectOne(ctx, &key,
+				"SELECT keyID, hmacKey, createdAt, revokedAt FROM externalAccountKeys WHERE keyID = ? LIMIT 1",
+				*reg.ExternalAccountID,
+			)
+			if err != nil {
+				if db.IsNoRows(err) {
+					return nil, berrors.UnauthorizedError("external account key %q does not exist", *reg.ExternalAccountID)
+				}
+				return nil, err
+			}
+			if key.RevokedAt != nil {
+				return nil, berrors.UnauthorizedError("external account key %q has been revoked", *reg.ExternalAccountID)
+			}
 		}
-		return nil, err
+
+		var err error
+		if features.Get().ExternalAccountBinding {
+			err = tx.Insert(ctx, reg)
+		} else {
+			regv1 := regModelToV1(reg)
+			err = tx.Insert(ctx, regv1)
+			reg.ID = regv1.ID
+		}
+		if err != nil {
+			if db.IsDuplicate(err) {
+				// duplicate entry error can only happen when jwk_sha256 collides, indicate
+				// to caller that the provided key is already in use
+				return nil, berrors.DuplicateError("key is already in use for a different account")
+			}
+			return nil, err
+		}
+		return nil, nil
+	})
+	if overallError != nil {
+		return nil, overallError
 	}
 	return registrationModelToPb(reg)
 }
@@ -149,7 +190,14 @@ func (ssa *SQLStorageAuthority) UpdateRegistration(ctx context.Context, req *cor
 	// Copy the existing registration model's LockCol to the new updated
 	// registration model's LockCol
 	update.LockCol = curr.LockCol
-	n, err := ssa.dbMap.Update(ctx, update)
+	// An account's External Account Binding is fixed at creation time.
+	update.ExternalAccountID = curr.ExternalAccountID
+	var n int64
+	if features.Get().ExternalAccountBinding {
+		n, err = ssa.dbMap.Update(ctx, update)
+	} else {
+		n, err = ssa.dbMap.Update(ctx, regModelToV1(update))
+	}
 	if err != nil {
 		if db.IsDuplicate(err) {
 			// duplicate entry error can only happen when jwk_sha256 collides, indicate
@@ -389,15 +437,16 @@ func (ssa *SQLStorageAuthority) AddPrecertificate(ctx context.Context, req *sapb
 		}
 
 		// NOTE(@cpu): When we collect up names to check if an FQDN set exists (e.g.
-		// that it is a renewal) we use just the DNSNames from the certificate and
-		// ignore the Subject Common Name (if any). This is a safe assumption because
-		// if a certificate we issued were to have a Subj. CN not present as a SAN it
-		// would be a misissuance and miscalculating whether the cert is a renewal or
-		// not for the purpose of rate limiting is the least of our troubles.
+		// that it is a renewal) we use just the DNSNames and IPAddresses from the
+		// certificate and ignore the Subject Common Name (if any). This is a safe
+		// assumption because if a certificate we issued were to have a Subj. CN
+		// not present as a SAN it would be a misissuance and miscalculating whether
+		// the cert is a renewal or not for the purpose of rate limiting is the
+		// least of our troubles.
 		isRenewal, err := ssa.checkFQDNSetExists(
 			ctx,
 			tx.SelectOne,
-			parsed.DNSNames)
+			sanIdentifiers(parsed))
 		if err != nil {
 			return nil, err
 		}
@@ -464,15 +513,16 @@ func (ssa *SQLStorageAuthority) AddCertificate(ctx context.Context, req *sapb.Ad
 		}
 
 		// NOTE(@cpu): When we collect up names to check if an FQDN set exists (e.g.
-		// that it is a renewal) we use just the DNSNames from the certificate and
-		// ignore the Subject Common Name (if any). This is a safe assumption because
-		// if a certificate we issued were to have a Subj. CN not present as a SAN it
-		// would be a misissuance and miscalculating whether the cert is a renewal or
-		// not for the purpose of rate limiting is the least of our troubles.
+		// that it is a renewal) we use just the DNSNames and IPAddresses from the
+		// certificate and ignore the Subject Common Name (if any). This is a safe
+		// assumption because if a certificate we issued were to have a Subj. CN
+		// not present as a SAN it would be a misissuance and miscalculating whether
+		// the cert is a renewal or not for the purpose of rate limiting is the
+		// least of our troubles.
 		isRenewal, err := ssa.checkFQDNSetExists(
 			ctx,
 			tx.SelectOne,
-			parsedCertificate.DNSNames)
+			sanIdentifiers(parsedCertificate))
 		if err != nil {
 			return nil, err
 		}
@@ -514,7 +564,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(ctx context.Context, req *sapb.Ad
 		err = addFQDNSet(
 			ctx,
 			tx,
-			parsedCertificate.DNSNames,
+			sanIdentifiers(parsedCertificate),
 			core.SerialToString(parsedCertificate.SerialNumber),
 			parsedCertificate.NotBefore,
 			parsedCertificate.NotAfter,
@@ -688,7 +738,7 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 		}
 
 		// Fourth, insert the FQDNSet entry for the order.
-		err = addOrderFQDNSet(ctx, tx, req.NewOrder.DnsNames, orderID, req.NewOrder.RegistrationID, req.NewOrder.Expires.AsTime())
+		err = addOrderFQDNSet(ctx, tx, identifier.FromProtoSliceWithDefault(req.NewOrder.Identifiers, req.NewOrder.DnsNames), orderID, req.NewOrder.RegistrationID, req.NewOrder.Expires.AsTime())
 		if err != nil {
 			return nil, err
 		}
@@ -718,6 +768,7 @@ func (ssa *SQLStorageAuthority) NewOrderAndAuthzs(ctx context.Context, req *sapb
 			RegistrationID: req.NewOrder.RegistrationID,
 			Expires:        req.NewOrder.Expires,
 			DnsNames:       req.NewOrder.DnsNames,
+			Identifiers:    req.NewOrder.Identifiers,
 			// This includes both reused and newly created authz IDs.
 			V2Authorizations: allAuthzIds,
 			// A new order is never processing because it can't be finalized yet.
@@ -876,6 +927,8 @@ func (ssa *SQLStorageAuthority) FinalizeOrder(ctx context.Context, req *sapb.Fin
 // FinalizeAuthorization2 moves a pending authorization to either the valid or invalid status. If
 // the authorization is being moved to invalid the validationError field must be set. If the
 // authorization is being moved to valid the validationRecord and expires fields must be set.
+// The per-perspective results, if any, are only stored if the StoreAuthzPerspectiveResults
+// feature flag is enabled.
 func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req *sapb.FinalizeAuthorizationRequest) (*emptypb.Empty, error) {
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
 	if req.Status == "" || req.Attempted == "" || req.Id == 0 || core.IsAnyNilOrZero(req.Expires) {
@@ -944,18 +997,43 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req
 		"validationError": veJSON,
 	}
 
-	res, err := ssa.dbMap.ExecContext(ctx, query, params)
-	if err != nil {
-		return nil, err
-	}
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return nil, err
+	var perspectiveResults []*authzPerspectiveResultModel
+	if features.Get().StoreAuthzPerspectiveResults {
+		now := ssa.clk.Now()
+		for _, pr := range req.PerspectiveResults {
+			row, err := perspectiveResultPBToModel(req.Id, pr, now)
+			if err != nil {
+				return nil, err
+			}
+			perspectiveResults = append(perspectiveResults, row)
+		}
 	}
-	if rows == 0 {
-		return nil, berrors.NotFoundError("no pending authorization with id %d", req.Id)
-	} else if rows > 1 {
-		return nil, berrors.InternalServerError("multiple rows updated for authorization id %d", req.Id)
+
+	_, overallError := db.WithTransaction(ctx, ssa.dbMap, func(tx db.Executor) (interface{}, error) {
+		res, err := tx.ExecContext(ctx, query, params)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			return nil, berrors.NotFoundError("no pending authorization with id %d", req.Id)
+		} else if rows > 1 {
+			return nil, berrors.InternalServerError("multiple rows updated for authorization id %d", req.Id)
+		}
+
+		for _, row := range perspectiveResults {
+			err = tx.Insert(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if overallError != nil {
+		return nil, overallError
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -1369,10 +1447,11 @@ func (ssa *SQLStorageAuthority) leaseSpecificCRLShard(ctx context.Context, req *
 	}, nil
 }
 
-// UpdateCRLShard updates the thisUpdate and nextUpdate timestamps of a CRL
-// shard. It rejects the update if it would cause the thisUpdate timestamp to
-// move backwards, but if thisUpdate would stay the same (for instance, multiple
-// CRL generations within a single second), it will succeed.
+// UpdateCRLShard updates the thisUpdate and nextUpdate timestamps, and the CRL
+// Number if StoreCRLShardNumbers is enabled, of a CRL shard. It rejects the
+// update if it would cause the thisUpdate timestamp to move backwards, but if
+// thisUpdate would stay the same (for instance, multiple CRL generations
+// within a single second), it will succeed.
 //
 // It does *not* reject the update if the shard is no longer
 // leased: although this would be unexpected (because the lease timestamp should
@@ -1393,20 +1472,30 @@ func (ssa *SQLStorageAuthority) UpdateCRLShard(ctx context.Context, req *sapb.Up
 		nextUpdate = &nut
 	}
 
+	// Likewise the crlNumber, so that it's never recorded as zero.
+	var crlNumber *int64
+	if req.CrlNumber != 0 {
+		crlNumber = &req.CrlNumber
+	}
+
+	// The crlNumber column may not exist unless StoreCRLShardNumbers is enabled.
+	setCRLNumber := ""
+	args := []interface{}{req.ThisUpdate.AsTime(), nextUpdate, req.ThisUpdate.AsTime()}
+	if features.Get().StoreCRLShardNumbers {
+		setCRLNumber = ", crlNumber = ?"
+		args = append(args, crlNumber)
+	}
+	args = append(args, req.IssuerNameID, req.ShardIdx, req.ThisUpdate.AsTime())
+
 	_, err := db.WithTransaction(ctx, ssa.dbMap, func(tx db.Executor) (interface{}, error) {
 		res, err := tx.ExecContext(ctx,
 			`UPDATE crlShards
-				SET thisUpdate = ?, nextUpdate = ?, leasedUntil = ?
+				SET thisUpdate = ?, nextUpdate = ?, leasedUntil = ?`+setCRLNumber+`
 				WHERE issuerID = ?
 				AND idx = ?
 				AND (thisUpdate is NULL OR thisUpdate <= ?)
 				LIMIT 1`,
-			req.ThisUpdate.AsTime(),
-			nextUpdate,
-			req.ThisUpdate.AsTime(),
-			req.IssuerNameID,
-			req.ShardIdx,
-			req.ThisUpdate.AsTime(),
+			args...,
 		)
 		if err != nil {
 			return nil, err
@@ -1581,3 +1670,287 @@ func (ssa *SQLStorageAuthority) UnpauseAccount(ctx context.Context, req *sapb.Re
 
 	return total, nil
 }
+
+// AddExternalAccountKey stores a new External Account Binding MAC key. Key IDs
+// are unique: it is an error to add a key whose ID already exists, even if that
+// key has been revoked.
+func (ssa *SQLStorageAuthority) AddExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKey) (*emptypb.Empty, error) {
+	if core.IsAnyNilOrZero(req.KeyID, req.HmacKey) {
+		return nil, errIncompleteRequest
+	}
+
+	err := ssa.dbMap.Insert(ctx, &externalAccountKeyModel{
+		KeyID:     req.KeyID,
+		HMACKey:   req.HmacKey,
+		CreatedAt: ssa.clk.Now(),
+	})
+	if err != nil {
+		if db.IsDuplicate(err) {
+			return nil, berrors.DuplicateError("external account key %q already exists", req.KeyID)
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeExternalAccountKey marks an External Account Binding MAC key as
+// revoked, so that it can no longer be used to create new accounts. Accounts
+// which were previously bound to the key are unaffected.
+func (ssa *SQLStorageAuthority) RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*emptypb.Empty, error) {
+	if core.IsAnyNilOrZero(req.KeyID) {
+		return nil, errIncompleteRequest
+	}
+
+	res, err := ssa.dbMap.ExecContext(ctx,
+		"UPDATE externalAccountKeys SET revokedAt = ? WHERE keyID = ? AND revokedAt IS NULL LIMIT 1",
+		ssa.clk.Now(),
+		req.KeyID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no unrevoked external account key %q found", req.KeyID)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AddRateLimitOverride stores a rate limit override, replacing any existing
+// override for the same limit and bucket id. The override is validated by the
+// services which load it, not by the SA, so callers should validate it first.
+func (ssa *SQLStorageAuthority) AddRateLimitOverride(ctx context.Context, req *sapb.RateLimitOverride) (*emptypb.Empty, error) {
+	if core.IsAnyNilOrZero(req.LimitEnum, req.BucketId, req.Burst, req.Count, req.Period, req.Comment, req.ExpiresAt) {
+		return nil, errIncompleteRequest
+	}
+
+	_, err := ssa.dbMap.ExecContext(ctx,
+		`INSERT INTO rateLimitOverrides (limitEnum, bucketId, burst, count, periodNS, comment, createdAt, expiresAt)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			burst = VALUES(burst),
+			count = VALUES(count),
+			periodNS = VALUES(periodNS),
+			comment = VALUES(comment),
+			createdAt = VALUES(createdAt),
+			expiresAt = VALUES(expiresAt)`,
+		req.LimitEnum,
+		req.BucketId,
+		req.Burst,
+		req.Count,
+		req.Period.AsDuration().Nanoseconds(),
+		req.Comment,
+		ssa.clk.Now(),
+		req.ExpiresAt.AsTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveRateLimitOverride deletes the rate limit override for the given limit
+// and bucket id. It returns a NotFound error if no such override exists.
+func (ssa *SQLStorageAuthority) RemoveRateLimitOverride(ctx context.Context, req *sapb.RateLimitOverrideID) (*emptypb.Empty, error) {
+	if core.IsAnyNilOrZero(req.LimitEnum, req.BucketId) {
+		return nil, errIncompleteRequest
+	}
+
+	res, err := ssa.dbMap.ExecContext(ctx,
+		"DELETE FROM rateLimitOverrides WHERE limitEnum = ? AND bucketId = ? LIMIT 1",
+		req.LimitEnum,
+		req.BucketId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no rate limit override found for limit %d and bucket id %q", req.LimitEnum, req.BucketId)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// AddCTSubmissions adds the given CT submissions to the queue, to be attempted
+// as soon as they are leased. The id, created, attempts, and nextAttempt
+// fields of the submissions are ignored.
+func (ssa *SQLStorageAuthority) AddCTSubmissions(ctx context.Context, req *sapb.CTSubmissions) (*emptypb.Empty, error) {
+	if len(req.Submissions) == 0 {
+		return nil, errIncompleteRequest
+	}
+	if !features.Get().CTSubmissionQueue {
+		return nil, errCTSubmissionQueueDisabled
+	}
+
+	inserter, err := db.NewMultiInserter("ctSubmissions", []string{"serial", "kind", "logURL", "logPublicKey", "staticCT", "created", "expires", "attempts", "nextAttempt"}, "")
+	if err != nil {
+		return nil, err
+	}
+	now := ssa.clk.Now()
+	for _, sub := range req.Submissions {
+		if core.IsAnyNilOrZero(sub.Serial, sub.Kind, sub.LogURL, sub.LogPublicKey, sub.Expires) {
+			return nil, errIncompleteRequest
+		}
+		err = inserter.Add([]interface{}{
+			sub.Serial,
+			sub.Kind,
+			sub.LogURL,
+			sub.LogPublicKey,
+			sub.StaticCT,
+			now,
+			sub.Expires.AsTime(),
+			0,
+			now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, err = inserter.Insert(ctx, ssa.dbMap)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// LeaseCTSubmissions returns up to the requested number of CT submissions
+// which are due to be attempted, oldest first. It increments their attempt
+// counts and postpones their next attempts by the requested lease, so that
+// they aren't returned to another caller while this one is attempting them.
+// Rows already locked by a concurrent caller are skipped rather than waited
+// for. Callers must delete or reschedule each submission once it has been
+// attempted, passing back its nextAttempt as the lease; a submission which is
+// neither will be returned again once its lease expires.
+func (ssa *SQLStorageAuthority) LeaseCTSubmissions(ctx context.Context, req *sapb.LeaseCTSubmissionsRequest) (*sapb.CTSubmissions, error) {
+	if core.IsAnyNilOrZero(req.Limit, req.Lease) {
+		return nil, errIncompleteRequest
+	}
+	if req.Lease.AsDuration() < time.Second {
+		return nil, errors.New("lease must be at least one second")
+	}
+	if !features.Get().CTSubmissionQueue {
+		return nil, errCTSubmissionQueueDisabled
+	}
+
+	now := ssa.clk.Now()
+	// The lease's expiry identifies its holder, so it's truncated to the
+	// precision of the nextAttempt column in order to be compared with it.
+	until := now.Add(req.Lease.AsDuration()).Truncate(time.Second)
+	result, err := db.WithTransaction(ctx, ssa.dbMap, func(tx db.Executor) (interface{}, error) {
+		var subs []*ctSubmissionModel
+		_, err := tx.Select(
+			ctx,
+			&subs,
+			`SELECT id, serial, kind, logURL, logPublicKey, staticCT, created, expires, attempts, nextAttempt
+				FROM ctSubmissions
+				WHERE nextAttempt <= ?
+				ORDER BY nextAttempt
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED`,
+			now,
+			req.Limit,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("selecting due submissions: %w", err)
+		}
+		if len(subs) == 0 {
+			return subs, nil
+		}
+
+		args := []interface{}{until}
+		for _, sub := range subs {
+			args = append(args, sub.ID)
+			sub.Attempts++
+			sub.NextAttempt = until
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(
+			`UPDATE ctSubmissions
+				SET attempts = attempts + 1, nextAttempt = ?
+				WHERE id IN (%s)`,
+			db.QuestionMarks(len(subs))),
+			args...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("leasing submissions: %w", err)
+		}
+		return subs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subs, ok := result.([]*ctSubmissionModel)
+	if !ok {
+		return nil, fmt.Errorf("leasing submissions: unexpected result type %T", result)
+	}
+	resp := &sapb.CTSubmissions{}
+	for _, sub := range subs {
+		resp.Submissions = append(resp.Submissions, ctSubmissionModelToPb(sub))
+	}
+	return resp, nil
+}
+
+// RescheduleCTSubmission sets the time at which the given CT submission will
+// next be returned by LeaseCTSubmissions. It returns a NotFound error if the
+// submission is no longer held under the given lease, for instance because the
+// lease expired and another caller has leased it since.
+func (ssa *SQLStorageAuthority) RescheduleCTSubmission(ctx context.Context, req *sapb.RescheduleCTSubmissionRequest) (*emptypb.Empty, error) {
+	if core.IsAnyNilOrZero(req.Id, req.NextAttempt, req.LeasedUntil) {
+		return nil, errIncompleteRequest
+	}
+	if !features.Get().CTSubmissionQueue {
+		return nil, errCTSubmissionQueueDisabled
+	}
+
+	res, err := ssa.dbMap.ExecContext(ctx,
+		"UPDATE ctSubmissions SET nextAttempt = ? WHERE id = ? AND nextAttempt = ? LIMIT 1",
+		req.NextAttempt.AsTime(),
+		req.Id,
+		req.LeasedUntil.AsTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no CT submission found with id %d leased until %s", req.Id, req.LeasedUntil.AsTime())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteCTSubmission removes the given CT submission from the queue. It returns
+// a NotFound error if no such submission is held under the given lease.
+func (ssa *SQLStorageAuthority) DeleteCTSubmission(ctx context.Context, req *sapb.DeleteCTSubmissionRequest) (*emptypb.Empty, error) {
+	if core.IsAnyNilOrZero(req.Id, req.LeasedUntil) {
+		return nil, errIncompleteRequest
+	}
+	if !features.Get().CTSubmissionQueue {
+		return nil, errCTSubmissionQueueDisabled
+	}
+
+	res, err := ssa.dbMap.ExecContext(ctx,
+		"DELETE FROM ctSubmissions WHERE id = ? AND nextAttempt = ? LIMIT 1",
+		req.Id,
+		req.LeasedUntil.AsTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no CT submission found with id %d leased until %s", req.Id, req.LeasedUntil.AsTime())
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -747,7 +747,7 @@ func TestFQDNSets(t *testing.T) {
 	names := []string{"a.example.com", "B.example.com"}
 	expires := fc.Now().Add(time.Hour * 2).UTC()
 	issued := fc.Now()
-	err = addFQDNSet(ctx, tx, names, "serial", issued, expires)
+	err = addFQDNSet(ctx, tx, identifier.NewDNSSlice(names), "serial", issued, expires)
 	test.AssertNotError(t, err, "Failed to add name set")
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
@@ -778,7 +778,7 @@ func TestFQDNSets(t *testing.T) {
 	// add another valid set
 	tx, err = sa.dbMap.BeginTx(ctx)
 	test.AssertNotError(t, err, "Failed to open transaction")
-	err = addFQDNSet(ctx, tx, names, "anotherSerial", issued, expires)
+	err = addFQDNSet(ctx, tx, identifier.NewDNSSlice(names), "anotherSerial", issued, expires)
 	test.AssertNotError(t, err, "Failed to add name set")
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
@@ -794,7 +794,7 @@ func TestFQDNSets(t *testing.T) {
 	err = addFQDNSet(
 		ctx,
 		tx,
-		names,
+		identifier.NewDNSSlice(names),
 		"yetAnotherSerial",
 		issued.Add(-threeHours),
 		expires.Add(-threeHours),
@@ -839,7 +839,7 @@ func TestFQDNSetTimestampsForWindow(t *testing.T) {
 	// Add an issuance for names inside the window.
 	expires := fc.Now().Add(time.Hour * 2).UTC()
 	firstIssued := fc.Now()
-	err = addFQDNSet(ctx, tx, names, "serial", firstIssued, expires)
+	err = addFQDNSet(ctx, tx, identifier.NewDNSSlice(names), "serial", firstIssued, expires)
 	test.AssertNotError(t, err, "Failed to add name set")
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
@@ -859,7 +859,7 @@ func TestFQDNSetTimestampsForWindow(t *testing.T) {
 	// Add another issuance for names inside the window.
 	tx, err = sa.dbMap.BeginTx(ctx)
 	test.AssertNotError(t, err, "Failed to open transaction")
-	err = addFQDNSet(ctx, tx, names, "anotherSerial", firstIssued, expires)
+	err = addFQDNSet(ctx, tx, identifier.NewDNSSlice(names), "anotherSerial", firstIssued, expires)
 	test.AssertNotError(t, err, "Failed to add name set")
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
@@ -873,7 +873,7 @@ func TestFQDNSetTimestampsForWindow(t *testing.T) {
 	// Add another issuance for names but just outside the window.
 	tx, err = sa.dbMap.BeginTx(ctx)
 	test.AssertNotError(t, err, "Failed to open transaction")
-	err = addFQDNSet(ctx, tx, names, "yetAnotherSerial", firstIssued.Add(-window), expires)
+	err = addFQDNSet(ctx, tx, identifier.NewDNSSlice(names), "yetAnotherSerial", firstIssued.Add(-window), expires)
 	test.AssertNotError(t, err, "Failed to add name set")
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
@@ -897,7 +897,7 @@ func TestFQDNSetsExists(t *testing.T) {
 	test.AssertNotError(t, err, "Failed to open transaction")
 	expires := fc.Now().Add(time.Hour * 2).UTC()
 	issued := fc.Now()
-	err = addFQDNSet(ctx, tx, names, "serial", issued, expires)
+	err = addFQDNSet(ctx, tx, identifier.NewDNSSlice(names), "serial", issued, expires)
 	test.AssertNotError(t, err, "Failed to add name set")
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
@@ -2417,7 +2417,7 @@ func TestAddCertificateRenewalBit(t *testing.T) {
 	// Make a new cert and add its FQDN set to the db so it will be considered a
 	// renewal
 	serial, testCert := test.ThrowAwayCert(t, fc)
-	err = addFQDNSet(ctx, sa.dbMap, testCert.DNSNames, serial, testCert.NotBefore, testCert.NotAfter)
+	err = addFQDNSet(ctx, sa.dbMap, identifier.NewDNSSlice(testCert.DNSNames), serial, testCert.NotBefore, testCert.NotAfter)
 	test.AssertNotError(t, err, "Failed to add name set")
 	_, err = sa.AddPrecertificate(ctx, &sapb.AddCertificateRequest{
 		Der:          testCert.Raw,
@@ -2609,6 +2609,84 @@ func TestFinalizeAuthorization2(t *testing.T) {
 	test.AssertDeepEquals(t, dbVer.Challenges[0].Error, prob)
 }
 
+func TestFinalizeAuthorization2PerspectiveResults(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("Test requires authzPerspectiveResults database table")
+	}
+	features.Set(features.Config{StoreAuthzPerspectiveResults: true})
+	defer features.Reset()
+
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	fc.Set(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	authzID := createPendingAuthorization(t, sa, "aaa", fc.Now().Add(time.Hour))
+	prob, _ := bgrpc.ProblemDetailsToPB(probs.CAA("CAA record forbids issuance"))
+	perspectives := []*corepb.PerspectiveResult{
+		{
+			Operation:     "dcv",
+			Perspective:   "primary",
+			Passed:        true,
+			ResolverAddrs: []string{"A:127.0.0.1:53"},
+			Latency:       durationpb.New(120 * time.Millisecond),
+		},
+		{
+			Operation:     "caa",
+			Perspective:   "dadaist",
+			Rir:           "ARIN",
+			Passed:        false,
+			ResolverAddrs: []string{"A:127.0.0.2:53", "AAAA:[::1]:53"},
+			Latency:       durationpb.New(2 * time.Second),
+			Problem:       prob,
+		},
+	}
+
+	_, err := sa.FinalizeAuthorization2(context.Background(), &sapb.FinalizeAuthorizationRequest{
+		Id:                 authzID,
+		Status:             string(core.StatusValid),
+		Expires:            timestamppb.New(fc.Now().Add(time.Hour * 2)),
+		Attempted:          string(core.ChallengeTypeHTTP01),
+		AttemptedAt:        timestamppb.New(fc.Now()),
+		PerspectiveResults: perspectives,
+	})
+	test.AssertNotError(t, err, "sa.FinalizeAuthorization2 failed")
+
+	dbVer, err := sa.GetAuthorization2(context.Background(), &sapb.AuthorizationID2{Id: authzID})
+	test.AssertNotError(t, err, "sa.GetAuthorization2 failed")
+	test.AssertEquals(t, len(dbVer.PerspectiveResults), 0)
+
+	dbVer, err = sa.GetAuthorization2(context.Background(), &sapb.AuthorizationID2{Id: authzID, IncludePerspectiveResults: true})
+	test.AssertNotError(t, err, "sa.GetAuthorization2 failed")
+	test.AssertEquals(t, len(dbVer.PerspectiveResults), 2)
+	for i, want := range perspectives {
+		got := dbVer.PerspectiveResults[i]
+		test.AssertEquals(t, got.Operation, want.Operation)
+		test.AssertEquals(t, got.Perspective, want.Perspective)
+		test.AssertEquals(t, got.Rir, want.Rir)
+		test.AssertEquals(t, got.Passed, want.Passed)
+		test.AssertDeepEquals(t, got.ResolverAddrs, want.ResolverAddrs)
+		test.AssertEquals(t, got.Latency.AsDuration(), want.Latency.AsDuration())
+		test.AssertDeepEquals(t, got.Problem, want.Problem)
+	}
+
+	// A perspective result without an operation is rejected, and the
+	// authorization is left pending.
+	authzID = createPendingAuthorization(t, sa, "aaa", fc.Now().Add(time.Hour))
+	_, err = sa.FinalizeAuthorization2(context.Background(), &sapb.FinalizeAuthorizationRequest{
+		Id:                 authzID,
+		Status:             string(core.StatusValid),
+		Expires:            timestamppb.New(fc.Now().Add(time.Hour * 2)),
+		Attempted:          string(core.ChallengeTypeHTTP01),
+		PerspectiveResults: []*corepb.PerspectiveResult{{Perspective: "primary"}},
+	})
+	test.AssertError(t, err, "sa.FinalizeAuthorization2 accepted an incomplete perspective result")
+	dbVer, err = sa.GetAuthorization2(context.Background(), &sapb.AuthorizationID2{Id: authzID, IncludePerspectiveResults: true})
+	test.AssertNotError(t, err, "sa.GetAuthorization2 failed")
+	test.AssertEquals(t, dbVer.Status, string(core.StatusPending))
+	test.AssertEquals(t, len(dbVer.PerspectiveResults), 0)
+}
+
 func TestRehydrateHostPort(t *testing.T) {
 	sa, fc, cleanUp := initSA(t)
 	defer cleanUp()
@@ -3331,6 +3409,30 @@ func TestGetRevokedCerts(t *testing.T) {
 	test.AssertNotError(t, err, "normal usage shouldn't result in error")
 	test.AssertEquals(t, count, 1)
 
+	// Asking for certs revoked since the revocation date, as for a delta CRL,
+	// should return one result.
+	count, err = countRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerNameID:  1,
+		ExpiresAfter:  timestamppb.New(expiresAfter),
+		ExpiresBefore: timestamppb.New(expiresBefore),
+		RevokedBefore: timestamppb.New(revokedBefore),
+		RevokedAfter:  timestamppb.New(date),
+	})
+	test.AssertNotError(t, err, "delta usage shouldn't result in error")
+	test.AssertEquals(t, count, 1)
+
+	// Asking for certs revoked after the revocation date should return no
+	// results.
+	count, err = countRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerNameID:  1,
+		ExpiresAfter:  timestamppb.New(expiresAfter),
+		ExpiresBefore: timestamppb.New(expiresBefore),
+		RevokedBefore: timestamppb.New(revokedBefore),
+		RevokedAfter:  timestamppb.New(date.Add(time.Second)),
+	})
+	test.AssertNotError(t, err, "zero rows shouldn't result in error")
+	test.AssertEquals(t, count, 0)
+
 	// Asking for revoked certs with an old RevokedBefore should return no results.
 	expiresAfter = time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
 	expiresBefore = time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
@@ -3465,6 +3567,18 @@ func TestGetRevokedCertsByShard(t *testing.T) {
 	test.AssertNotError(t, err, "normal usage shouldn't result in error")
 	test.AssertEquals(t, count, 1)
 
+	// Asking for certs revoked after the revocation date, as for a delta CRL,
+	// should return zero results.
+	count, err = countRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerNameID:  1,
+		ShardIdx:      9,
+		ExpiresAfter:  timestamppb.New(expiresAfter),
+		RevokedBefore: timestamppb.New(revokedBefore),
+		RevokedAfter:  timestamppb.New(date.Add(time.Second)),
+	})
+	test.AssertNotError(t, err, "zero rows shouldn't result in error")
+	test.AssertEquals(t, count, 0)
+
 	// Asking for revoked certs from a different issuer should return zero results.
 	expiresAfter = time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
 	revokedBefore = time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
@@ -3500,6 +3614,41 @@ func TestGetRevokedCertsByShard(t *testing.T) {
 	})
 	test.AssertNotError(t, err, "zero rows shouldn't result in error")
 	test.AssertEquals(t, count, 0)
+
+	// Asking for revoked certs by expiry chunk should return the cert from the
+	// certificateStatus table, unless serial-sharded certs are excluded.
+	expiresAfter = time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	expiresBefore := time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+	revokedBefore = time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)
+	count, err = countRevokedCerts(&sapb.GetRevokedCertsRequest{
+		IssuerNameID:  1,
+		ExpiresAfter:  timestamppb.New(expiresAfter),
+		ExpiresBefore: timestamppb.New(expiresBefore),
+		RevokedBefore: timestamppb.New(revokedBefore),
+	})
+	test.AssertNotError(t, err, "normal usage shouldn't result in error")
+	test.AssertEquals(t, count, 1)
+
+	excludeReq := &sapb.GetRevokedCertsRequest{
+		IssuerNameID:         1,
+		ExpiresAfter:         timestamppb.New(expiresAfter),
+		ExpiresBefore:        timestamppb.New(expiresBefore),
+		RevokedBefore:        timestamppb.New(revokedBefore),
+		ExcludeSerialSharded: true,
+	}
+	_, err = countRevokedCerts(excludeReq)
+	test.AssertError(t, err, "excluding serial-sharded certs should fail when not enabled")
+
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		// The rest of this test requires the revokedCertificates serial index.
+		return
+	}
+	features.Set(features.Config{ExcludeSerialShardedCerts: true})
+	defer features.Reset()
+
+	count, err = countRevokedCerts(excludeReq)
+	test.AssertNotError(t, err, "zero rows shouldn't result in error")
+	test.AssertEquals(t, count, 0)
 }
 
 func TestGetMaxExpiration(t *testing.T) {
@@ -4894,3 +5043,303 @@ func TestUpdateRegistrationKey(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalAccountKeys(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("Test requires externalAccountKeys database table")
+	}
+	features.Set(features.Config{ExternalAccountBinding: true})
+	defer features.Reset()
+
+	sa, clk, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.AddExternalAccountKey(ctx, &sapb.ExternalAccountKey{KeyID: "customer-1"})
+	test.AssertErrorIs(t, err, errIncompleteRequest)
+
+	hmacKey := []byte("this is an external account key.")
+	_, err = sa.AddExternalAccountKey(ctx, &sapb.ExternalAccountKey{KeyID: "customer-1", HmacKey: hmacKey})
+	test.AssertNotError(t, err, "adding external account key")
+
+	_, err = sa.AddExternalAccountKey(ctx, &sapb.ExternalAccountKey{KeyID: "customer-1", HmacKey: hmacKey})
+	test.AssertErrorIs(t, err, berrors.Duplicate)
+
+	eak, err := sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "customer-1"})
+	test.AssertNotError(t, err, "getting external account key")
+	test.AssertEquals(t, eak.KeyID, "customer-1")
+	test.AssertByteEquals(t, eak.HmacKey, hmacKey)
+	test.AssertEquals(t, eak.CreatedAt.AsTime(), clk.Now())
+	test.AssertBoxedNil(t, eak.RevokedAt, "new key should not be revoked")
+
+	_, err = sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "customer-2"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	clk.Add(time.Hour)
+	_, err = sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "customer-1"})
+	test.AssertNotError(t, err, "revoking external account key")
+
+	eak, err = sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "customer-1"})
+	test.AssertNotError(t, err, "getting revoked external account key")
+	test.AssertEquals(t, eak.RevokedAt.AsTime(), clk.Now())
+
+	// Revoking an already-revoked or nonexistent key should fail.
+	_, err = sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "customer-1"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+	_, err = sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "customer-2"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+}
+
+func TestNewRegistrationExternalAccountBinding(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("Test requires externalAccountKeys database table")
+	}
+	features.Set(features.Config{ExternalAccountBinding: true})
+	defer features.Reset()
+
+	sa, _, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.AddExternalAccountKey(ctx, &sapb.ExternalAccountKey{KeyID: "customer-1", HmacKey: []byte("this is an external account key.")})
+	test.AssertNotError(t, err, "adding external account key")
+
+	jwk := goodTestJWK()
+	jwkJSON, _ := jwk.MarshalJSON()
+
+	// A registration bound to an unknown key should be rejected.
+	_, err = sa.NewRegistration(ctx, &corepb.Registration{Key: jwkJSON, ExternalAccountID: "customer-2"})
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+
+	reg, err := sa.NewRegistration(ctx, &corepb.Registration{Key: jwkJSON, ExternalAccountID: "customer-1"})
+	test.AssertNotError(t, err, "creating registration bound to external account key")
+	test.AssertEquals(t, reg.ExternalAccountID, "customer-1")
+
+	dbReg, err := sa.GetRegistration(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "getting bound registration")
+	test.AssertEquals(t, dbReg.ExternalAccountID, "customer-1")
+
+	// Updating the registration must not clear the binding.
+	dbReg.Contact = []string{"mailto:foo@example.com"}
+	_, err = sa.UpdateRegistration(ctx, dbReg)
+	test.AssertNotError(t, err, "updating bound registration")
+	dbReg, err = sa.GetRegistration(ctx, &sapb.RegistrationID{Id: reg.Id})
+	test.AssertNotError(t, err, "getting updated registration")
+	test.AssertEquals(t, dbReg.ExternalAccountID, "customer-1")
+
+	// Once the key is revoked, it can no longer be used to create accounts.
+	_, err = sa.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: "customer-1"})
+	test.AssertNotError(t, err, "revoking external account key")
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating account key")
+	otherJWK, err := (&jose.JSONWebKey{Key: otherKey.Public()}).MarshalJSON()
+	test.AssertNotError(t, err, "marshaling account key")
+	_, err = sa.NewRegistration(ctx, &corepb.Registration{Key: otherJWK, ExternalAccountID: "customer-1"})
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+}
+
+func TestRateLimitOverrides(t *testing.T) {
+	sa, clk, cleanUp := initSA(t)
+	defer cleanUp()
+
+	getOverrides := func() ([]*sapb.RateLimitOverride, error) {
+		stream := make(chan *sapb.RateLimitOverride)
+		mockServerStream := &fakeServerStream[sapb.RateLimitOverride]{output: stream}
+		var err error
+		go func() {
+			err = sa.GetRateLimitOverrides(&emptypb.Empty{}, mockServerStream)
+			close(stream)
+		}()
+		var overrides []*sapb.RateLimitOverride
+		for ov := range stream {
+			overrides = append(overrides, ov)
+		}
+		return overrides, err
+	}
+
+	ov := &sapb.RateLimitOverride{
+		LimitEnum: 3,
+		BucketId:  "1234",
+		Burst:     100,
+		Count:     100,
+		Period:    durationpb.New(time.Hour),
+		Comment:   "Hosting provider, see ticket 42",
+		ExpiresAt: timestamppb.New(clk.Now().Add(24 * time.Hour)),
+	}
+
+	_, err := sa.AddRateLimitOverride(ctx, &sapb.RateLimitOverride{LimitEnum: 3, BucketId: "1234"})
+	test.AssertErrorIs(t, err, errIncompleteRequest)
+
+	_, err = sa.AddRateLimitOverride(ctx, ov)
+	test.AssertNotError(t, err, "adding rate limit override")
+
+	overrides, err := getOverrides()
+	test.AssertNotError(t, err, "getting rate limit overrides")
+	test.AssertEquals(t, len(overrides), 1)
+	test.AssertEquals(t, overrides[0].BucketId, "1234")
+	test.AssertEquals(t, overrides[0].Burst, int64(100))
+	test.AssertEquals(t, overrides[0].Period.AsDuration(), time.Hour)
+	test.AssertEquals(t, overrides[0].Comment, "Hosting provider, see ticket 42")
+	test.AssertEquals(t, overrides[0].CreatedAt.AsTime(), clk.Now())
+
+	// Adding an override for the same bucket replaces it.
+	ov.Burst = 200
+	_, err = sa.AddRateLimitOverride(ctx, ov)
+	test.AssertNotError(t, err, "replacing rate limit override")
+	overrides, err = getOverrides()
+	test.AssertNotError(t, err, "getting rate limit overrides")
+	test.AssertEquals(t, len(overrides), 1)
+	test.AssertEquals(t, overrides[0].Burst, int64(200))
+
+	// Expired overrides are not returned.
+	clk.Add(25 * time.Hour)
+	overrides, err = getOverrides()
+	test.AssertNotError(t, err, "getting rate limit overrides")
+	test.AssertEquals(t, len(overrides), 0)
+
+	_, err = sa.RemoveRateLimitOverride(ctx, &sapb.RateLimitOverrideID{LimitEnum: 3, BucketId: "1234"})
+	test.AssertNotError(t, err, "removing rate limit override")
+	_, err = sa.RemoveRateLimitOverride(ctx, &sapb.RateLimitOverrideID{LimitEnum: 3, BucketId: "1234"})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+}
+
+func TestCTSubmissionQueue(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("Test requires ctSubmissions database table")
+	}
+	features.Set(features.Config{CTSubmissionQueue: true})
+	defer features.Reset()
+
+	sa, clk, cleanUp := initSA(t)
+	defer cleanUp()
+
+	lease := func(limit int64) []*sapb.CTSubmission {
+		t.Helper()
+		resp, err := sa.LeaseCTSubmissions(ctx, &sapb.LeaseCTSubmissionsRequest{Limit: limit, Lease: durationpb.New(time.Minute)})
+		test.AssertNotError(t, err, "leasing CT submissions")
+		return resp.Submissions
+	}
+
+	stats, err := sa.GetCTSubmissionQueueStats(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "getting empty queue stats")
+	test.AssertEquals(t, stats.Count, int64(0))
+	test.Assert(t, stats.Oldest == nil, "empty queue should have no oldest submission")
+
+	_, err = sa.AddCTSubmissions(ctx, &sapb.CTSubmissions{})
+	test.AssertErrorIs(t, err, errIncompleteRequest)
+	_, err = sa.AddCTSubmissions(ctx, &sapb.CTSubmissions{Submissions: []*sapb.CTSubmission{{Serial: "00"}}})
+	test.AssertErrorIs(t, err, errIncompleteRequest)
+
+	expires := timestamppb.New(clk.Now().Add(90 * 24 * time.Hour))
+	created := clk.Now()
+	_, err = sa.AddCTSubmissions(ctx, &sapb.CTSubmissions{Submissions: []*sapb.CTSubmission{
+		{Serial: "00aa", Kind: 3, LogURL: "https://a.example.com/", LogPublicKey: "KA", Expires: expires},
+		{Serial: "00aa", Kind: 3, LogURL: "https://b.example.com/", LogPublicKey: "KB", StaticCT: true, Expires: expires},
+	}})
+	test.AssertNotError(t, err, "adding CT submissions")
+
+	clk.Add(time.Second)
+	stats, err = sa.GetCTSubmissionQueueStats(ctx, &emptypb.Empty{})
+	test.AssertNotError(t, err, "getting queue stats")
+	test.AssertEquals(t, stats.Count, int64(2))
+	test.AssertEquals(t, stats.Oldest.AsTime(), created)
+
+	// Leasing returns due submissions and hides them from other callers.
+	subs := lease(1)
+	test.AssertEquals(t, len(subs), 1)
+	test.AssertEquals(t, subs[0].Serial, "00aa")
+	test.AssertEquals(t, subs[0].Attempts, int64(1))
+	test.AssertEquals(t, subs[0].NextAttempt.AsTime(), clk.Now().Add(time.Minute).Truncate(time.Second))
+	first := subs[0]
+
+	subs = lease(10)
+	test.AssertEquals(t, len(subs), 1)
+	test.Assert(t, subs[0].Id != first.Id, "leased submission should not be returned again")
+	second := subs[0]
+	test.AssertEquals(t, len(lease(10)), 0)
+
+	// A submission whose lease expires is returned again, and can then only
+	// be rescheduled or deleted by the new lease holder.
+	clk.Add(2 * time.Minute)
+	subs = lease(10)
+	test.AssertEquals(t, len(subs), 2)
+	test.AssertEquals(t, subs[0].Attempts, int64(2))
+	current := make(map[int64]*sapb.CTSubmission)
+	for _, sub := range subs {
+		current[sub.Id] = sub
+	}
+	_, err = sa.RescheduleCTSubmission(ctx, &sapb.RescheduleCTSubmissionRequest{Id: second.Id, NextAttempt: timestamppb.New(clk.Now()), LeasedUntil: second.NextAttempt})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+	_, err = sa.DeleteCTSubmission(ctx, &sapb.DeleteCTSubmissionRequest{Id: first.Id, LeasedUntil: first.NextAttempt})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	// A rescheduled submission is returned at its next attempt.
+	_, err = sa.RescheduleCTSubmission(ctx, &sapb.RescheduleCTSubmissionRequest{Id: second.Id, NextAttempt: timestamppb.New(clk.Now().Add(time.Hour)), LeasedUntil: current[second.Id].NextAttempt})
+	test.AssertNotError(t, err, "rescheduling CT submission")
+	_, err = sa.DeleteCTSubmission(ctx, &sapb.DeleteCTSubmissionRequest{Id: first.Id, LeasedUntil: current[first.Id].NextAttempt})
+	test.AssertNotError(t, err, "deleting CT submission")
+	_, err = sa.DeleteCTSubmission(ctx, &sapb.DeleteCTSubmissionRequest{Id: first.Id, LeasedUntil: current[first.Id].NextAttempt})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	clk.Add(30 * time.Minute)
+	test.AssertEquals(t, len(lease(10)), 0)
+	clk.Add(time.Hour)
+	subs = lease(10)
+	test.AssertEquals(t, len(subs), 1)
+	test.AssertEquals(t, subs[0].Id, second.Id)
+	test.Assert(t, subs[0].StaticCT, "static CT flag should be preserved")
+}
+
+func TestGetCRLShard(t *testing.T) {
+	if os.Getenv("BOULDER_CONFIG_DIR") != "test/config-next" {
+		t.Skip("Test requires crlShards.crlNumber database column")
+	}
+	features.Set(features.Config{StoreCRLShardNumbers: true})
+	defer features.Reset()
+
+	sa, clk, cleanUp := initSA(t)
+	defer cleanUp()
+
+	_, err := sa.dbMap.ExecContext(ctx,
+		`INSERT INTO crlShards (issuerID, idx, thisUpdate, nextUpdate, leasedUntil) VALUES
+		(1, 0, NULL, NULL, ?),
+		(1, 1, NULL, NULL, ?);`,
+		clk.Now().Add(-time.Hour),
+		clk.Now().Add(-time.Hour),
+	)
+	test.AssertNotError(t, err, "setting up test shards")
+
+	// The CRL Number of a full CRL is derived from its thisUpdate with
+	// nanosecond precision, which the thisUpdate column doesn't preserve.
+	thisUpdate := clk.Now().Add(-24*time.Hour + 123456789*time.Nanosecond).UTC()
+	nextUpdate := thisUpdate.Add(10 * 24 * time.Hour)
+	_, err = sa.UpdateCRLShard(ctx, &sapb.UpdateCRLShardRequest{
+		IssuerNameID: 1,
+		ShardIdx:     0,
+		ThisUpdate:   timestamppb.New(thisUpdate),
+		NextUpdate:   timestamppb.New(nextUpdate),
+		CrlNumber:    thisUpdate.UnixNano(),
+	})
+	test.AssertNotError(t, err, "updating shard 0")
+
+	// A shard with a full CRL should return its thisUpdate, nextUpdate, and
+	// exact CRL Number.
+	shard, err := sa.GetCRLShard(ctx, &sapb.GetCRLShardRequest{IssuerNameID: 1, ShardIdx: 0})
+	test.AssertNotError(t, err, "getting shard 0")
+	test.AssertEquals(t, shard.ShardIdx, int64(0))
+	test.Assert(t, shard.ThisUpdate.AsTime().Equal(thisUpdate.Truncate(time.Second)), "checking thisUpdate")
+	test.Assert(t, shard.NextUpdate.AsTime().Equal(nextUpdate.Truncate(time.Second)), "checking nextUpdate")
+	test.AssertEquals(t, shard.CrlNumber, thisUpdate.UnixNano())
+
+	// A shard which has never been updated should have no thisUpdate.
+	shard, err = sa.GetCRLShard(ctx, &sapb.GetCRLShardRequest{IssuerNameID: 1, ShardIdx: 1})
+	test.AssertNotError(t, err, "getting shard 1")
+	test.AssertBoxedNil(t, shard.ThisUpdate, "checking thisUpdate")
+	test.AssertEquals(t, shard.CrlNumber, int64(0))
+
+	// An unknown shard should be NotFound.
+	_, err = sa.GetCRLShard(ctx, &sapb.GetCRLShardRequest{IssuerNameID: 1, ShardIdx: 2})
+	test.AssertErrorIs(t, err, berrors.NotFound)
+
+	// A request without an issuer should fail.
+	_, err = sa.GetCRLShard(ctx, &sapb.GetCRLShardRequest{ShardIdx: 0})
+	test.AssertError(t, err, "getting shard without issuer")
+}
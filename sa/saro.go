@@ -434,7 +434,8 @@ func (ssa *SQLStorageAuthorityRO) CountOrders(ctx context.Context, req *sapb.Cou
 // CountFQDNSets counts the total number of issuances, for a set of domains,
 // that occurred during a given window of time.
 func (ssa *SQLStorageAuthorityRO) CountFQDNSets(ctx context.Context, req *sapb.CountFQDNSetsRequest) (*sapb.Count, error) {
-	if core.IsAnyNilOrZero(req.Window) || len(req.DnsNames) == 0 {
+	idents := identifier.FromProtoSliceWithDefault(req.Identifiers, req.DnsNames)
+	if core.IsAnyNilOrZero(req.Window) || len(idents) == 0 {
 		return nil, errIncompleteRequest
 	}
 
@@ -445,7 +446,7 @@ func (ssa *SQLStorageAuthorityRO) CountFQDNSets(ctx context.Context, req *sapb.C
 		`SELECT COUNT(*) FROM fqdnSets
 		WHERE setHash = ?
 		AND issued > ?`,
-		core.HashNames(req.DnsNames),
+		core.HashIdentifiers(idents),
 		ssa.clk.Now().Add(-req.Window.AsDuration()),
 	)
 	return &sapb.Count{Count: count}, err
@@ -455,7 +456,8 @@ func (ssa *SQLStorageAuthorityRO) CountFQDNSets(ctx context.Context, req *sapb.C
 // certificate, issued for a set of domains, during a given window of time,
 // starting from the most recent issuance.
 func (ssa *SQLStorageAuthorityRO) FQDNSetTimestampsForWindow(ctx context.Context, req *sapb.CountFQDNSetsRequest) (*sapb.Timestamps, error) {
-	if core.IsAnyNilOrZero(req.Window) || len(req.DnsNames) == 0 {
+	idents := identifier.FromProtoSliceWithDefault(req.Identifiers, req.DnsNames)
+	if core.IsAnyNilOrZero(req.Window) || len(idents) == 0 {
 		return nil, errIncompleteRequest
 	}
 	type row struct {
@@ -469,7 +471,7 @@ func (ssa *SQLStorageAuthorityRO) FQDNSetTimestampsForWindow(ctx context.Context
 		WHERE setHash = ?
 		AND issued > ?
 		ORDER BY issued DESC`,
-		core.HashNames(req.DnsNames),
+		core.HashIdentifiers(idents),
 		ssa.clk.Now().Add(-req.Window.AsDuration()),
 	)
 	if err != nil {
@@ -486,10 +488,11 @@ func (ssa *SQLStorageAuthorityRO) FQDNSetTimestampsForWindow(ctx context.Context
 // FQDNSetExists returns a bool indicating if one or more FQDN sets |names|
 // exists in the database
 func (ssa *SQLStorageAuthorityRO) FQDNSetExists(ctx context.Context, req *sapb.FQDNSetExistsRequest) (*sapb.Exists, error) {
-	if len(req.DnsNames) == 0 {
+	idents := identifier.FromProtoSliceWithDefault(req.Identifiers, req.DnsNames)
+	if len(idents) == 0 {
 		return nil, errIncompleteRequest
 	}
-	exists, err := ssa.checkFQDNSetExists(ctx, ssa.dbReadOnlyMap.SelectOne, req.DnsNames)
+	exists, err := ssa.checkFQDNSetExists(ctx, ssa.dbReadOnlyMap.SelectOne, idents)
 	if err != nil {
 		return nil, err
 	}
@@ -501,9 +504,9 @@ func (ssa *SQLStorageAuthorityRO) FQDNSetExists(ctx context.Context, req *sapb.F
 type oneSelectorFunc func(ctx context.Context, holder interface{}, query string, args ...interface{}) error
 
 // checkFQDNSetExists uses the given oneSelectorFunc to check whether an fqdnSet
-// for the given names exists.
-func (ssa *SQLStorageAuthorityRO) checkFQDNSetExists(ctx context.Context, selector oneSelectorFunc, names []string) (bool, error) {
-	namehash := core.HashNames(names)
+// for the given identifiers exists.
+func (ssa *SQLStorageAuthorityRO) checkFQDNSetExists(ctx context.Context, selector oneSelectorFunc, idents identifier.ACMEIdentifiers) (bool, error) {
+	namehash := core.HashIdentifiers(idents)
 	var exists bool
 	err := selector(
 		ctx,
@@ -566,11 +569,16 @@ func (ssa *SQLStorageAuthorityRO) GetOrder(ctx context.Context, req *sapb.OrderR
 			return nil, err
 		}
 
-		names := make([]string, 0, len(authzValidityInfo))
+		var idents identifier.ACMEIdentifiers
 		for _, a := range authzValidityInfo {
-			names = append(names, a.IdentifierValue)
+			idents = append(idents, identifier.ACMEIdentifier{
+				Type:  identifier.IdentifierType(uintToIdentifierType[a.IdentifierType]),
+				Value: a.IdentifierValue,
+			})
 		}
-		order.DnsNames = names
+		order.Identifiers = idents.ToProtoSlice()
+		// TODO: Remove DnsNames once all services read Identifiers.
+		order.DnsNames = idents.DNSNames()
 
 		// Calculate the status for the order
 		status, err := statusForOrder(order, authzValidityInfo, ssa.clk.Now())
@@ -616,12 +624,13 @@ func (ssa *SQLStorageAuthorityRO) GetOrder(ctx context.Context, req *sapb.OrderR
 // unexpired orders are considered. If no order meeting these requirements is
 // found a nil corepb.Order pointer is returned.
 func (ssa *SQLStorageAuthorityRO) GetOrderForNames(ctx context.Context, req *sapb.GetOrderForNamesRequest) (*corepb.Order, error) {
-	if req.AcctID == 0 || len(req.DnsNames) == 0 {
+	idents := identifier.FromProtoSliceWithDefault(req.Identifiers, req.DnsNames)
+	if req.AcctID == 0 || len(idents) == 0 {
 		return nil, errIncompleteRequest
 	}
 
-	// Hash the names requested for lookup in the orderFqdnSets table
-	fqdnHash := core.HashNames(req.DnsNames)
+	// Hash the identifiers requested for lookup in the orderFqdnSets table
+	fqdnHash := core.HashIdentifiers(idents)
 
 	// Find a possibly-suitable order. We don't include the account ID or order
 	// status in this query because there's no index that includes those, so
@@ -701,7 +710,45 @@ func (ssa *SQLStorageAuthorityRO) GetAuthorization2(ctx context.Context, req *sa
 	if obj == nil {
 		return nil, berrors.NotFoundError("authorization %d not found", req.Id)
 	}
-	return modelToAuthzPB(*(obj.(*authzModel)))
+	authz, err := modelToAuthzPB(*(obj.(*authzModel)))
+	if err != nil {
+		return nil, err
+	}
+	if req.IncludePerspectiveResults && features.Get().StoreAuthzPerspectiveResults {
+		authz.PerspectiveResults, err = ssa.getPerspectiveResults(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return authz, nil
+}
+
+// getPerspectiveResults returns the per-perspective DCV and CAA results
+// recorded when the given authorization was finalized, in the order the RA
+// submitted them.
+func (ssa *SQLStorageAuthorityRO) getPerspectiveResults(ctx context.Context, authzID int64) ([]*corepb.PerspectiveResult, error) {
+	var rows []*authzPerspectiveResultModel
+	_, err := ssa.dbReadOnlyMap.Select(
+		ctx,
+		&rows,
+		`SELECT id, authzID, operation, perspective, rir, passed, resolverAddrs, latencyMS, problem, created
+		FROM authzPerspectiveResults
+		WHERE authzID = ?
+		ORDER BY id`,
+		authzID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var results []*corepb.PerspectiveResult
+	for _, row := range rows {
+		pr, err := perspectiveResultModelToPB(row)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, pr)
+	}
+	return results, nil
 }
 
 // authzModelMapToPB converts a mapping of domain name to authzModels into a
@@ -720,36 +767,33 @@ func authzModelMapToPB(m map[string]authzModel) (*sapb.Authorizations, error) {
 
 // GetAuthorizations2 returns a single pending or valid authorization owned by
 // the given account for all given identifiers. If both a valid and pending
-// authorization exist only the valid one will be returned. Currently only dns
-// identifiers are supported.
+// authorization exist only the valid one will be returned.
 //
 // Deprecated: Use GetValidAuthorizations2, as we stop pending authz reuse.
 func (ssa *SQLStorageAuthorityRO) GetAuthorizations2(ctx context.Context, req *sapb.GetAuthorizationsRequest) (*sapb.Authorizations, error) {
-	if core.IsAnyNilOrZero(req, req.RegistrationID, req.DnsNames, req.ValidUntil) {
+	idents := identifier.FromProtoSliceWithDefault(req.GetIdentifiers(), req.GetDnsNames())
+	if core.IsAnyNilOrZero(req, req.RegistrationID, idents, req.ValidUntil) {
 		return nil, errIncompleteRequest
 	}
 
+	identConditions, identArgs := buildIdentifierQueryConditions(idents)
 	query := fmt.Sprintf(
 		`SELECT %s FROM authz2
 			USE INDEX (regID_identifier_status_expires_idx)
 			WHERE registrationID = ? AND
 			status IN (?,?) AND
 			expires > ? AND
-			identifierType = ? AND
-			identifierValue IN (%s)`,
+			(%s)`,
 		authzFields,
-		db.QuestionMarks(len(req.DnsNames)),
+		identConditions,
 	)
 
 	params := []interface{}{
 		req.RegistrationID,
 		statusUint(core.StatusValid), statusUint(core.StatusPending),
 		req.ValidUntil.AsTime(),
-		identifierTypeToUint[string(identifier.TypeDNS)],
-	}
-	for _, dnsName := range req.DnsNames {
-		params = append(params, dnsName)
 	}
+	params = append(params, identArgs...)
 
 	var authzModels []authzModel
 	_, err := ssa.dbReadOnlyMap.Select(
@@ -849,7 +893,7 @@ func (ssa *SQLStorageAuthorityRO) GetValidOrderAuthorizations2(ctx context.Conte
 	// other identifier types, and is an inefficient wire format.
 	byName := make(map[string]authzModel)
 	for _, am := range ams {
-		if uintToIdentifierType[am.IdentifierType] != string(identifier.TypeDNS) {
+		if _, ok := uintToIdentifierType[am.IdentifierType]; !ok {
 			return nil, fmt.Errorf("unknown identifier type: %q on authz id %d", am.IdentifierType, am.ID)
 		}
 		_, present := byName[am.IdentifierValue]
@@ -898,34 +942,31 @@ func (ssa *SQLStorageAuthorityRO) CountInvalidAuthorizations2(ctx context.Contex
 
 // GetValidAuthorizations2 returns a single valid authorization owned by the
 // given account for all given identifiers. If more than one valid authorization
-// exists, only the one with the latest expiry will be returned. Currently only
-// dns identifiers are supported.
+// exists, only the one with the latest expiry will be returned.
 func (ssa *SQLStorageAuthorityRO) GetValidAuthorizations2(ctx context.Context, req *sapb.GetValidAuthorizationsRequest) (*sapb.Authorizations, error) {
-	if core.IsAnyNilOrZero(req, req.RegistrationID, req.DnsNames, req.ValidUntil) {
+	idents := identifier.FromProtoSliceWithDefault(req.GetIdentifiers(), req.GetDnsNames())
+	if core.IsAnyNilOrZero(req, req.RegistrationID, idents, req.ValidUntil) {
 		return nil, errIncompleteRequest
 	}
 
+	identConditions, identArgs := buildIdentifierQueryConditions(idents)
 	query := fmt.Sprintf(
 		`SELECT %s FROM authz2
 			USE INDEX (regID_identifier_status_expires_idx)
 			WHERE registrationID = ? AND
 			status = ? AND
 			expires > ? AND
-			identifierType = ? AND
-			identifierValue IN (%s)`,
+			(%s)`,
 		authzFields,
-		db.QuestionMarks(len(req.DnsNames)),
+		identConditions,
 	)
 
 	params := []interface{}{
 		req.RegistrationID,
 		statusUint(core.StatusValid),
 		req.ValidUntil.AsTime(),
-		identifierTypeToUint[string(identifier.TypeDNS)],
-	}
-	for _, dnsName := range req.DnsNames {
-		params = append(params, dnsName)
 	}
+	params = append(params, identArgs...)
 
 	var authzModels []authzModel
 	_, err := ssa.dbReadOnlyMap.Select(
@@ -1069,7 +1110,11 @@ func (ssa *SQLStorageAuthorityRO) SerialsForIncident(req *sapb.SerialsForInciden
 // issuer which expire during that period of time and which have been revoked.
 // The starting timestamp is treated as inclusive (certs with exactly that
 // notAfter date are included), but the ending timestamp is exclusive (certs
-// with exactly that notAfter date are *not* included).
+// with exactly that notAfter date are *not* included). If the request has a
+// RevokedAfter timestamp, as it does for delta CRLs, only certs revoked at or
+// after that time are included. Because re-revocation for keyCompromise keeps
+// the original revocation date, such reason changes are left to the next full
+// CRL.
 func (ssa *SQLStorageAuthorityRO) GetRevokedCerts(req *sapb.GetRevokedCertsRequest, stream grpc.ServerStreamingServer[corepb.CRLEntry]) error {
 	if req.ShardIdx != 0 {
 		return ssa.getRevokedCertsFromRevokedCertificatesTable(req, stream)
@@ -1118,6 +1163,9 @@ func (ssa *SQLStorageAuthorityRO) getRevokedCertsFromRevokedCertificatesTable(re
 		if row.RevokedDate.After(atTime) || row.RevokedDate.Equal(atTime) {
 			return nil
 		}
+		if req.RevokedAfter != nil && row.RevokedDate.Before(req.RevokedAfter.AsTime()) {
+			return nil
+		}
 
 		return stream.Send(&corepb.CRLEntry{
 			Serial:    row.Serial,
@@ -1144,6 +1192,22 @@ func (ssa *SQLStorageAuthorityRO) getRevokedCertsFromCertificateStatusTable(req
 		core.OCSPStatusRevoked,
 	}
 
+	// Certs which have been assigned to a serial-hash CRL shard are also present
+	// in the revokedCertificates table. While both sharding strategies are in
+	// use, the crl-updater asks us to leave those certs out of the expiry-based
+	// shards, so that no cert appears in two different CRLs. The anti-join
+	// looks up each revoked cert by the revokedCertificates serial index.
+	if req.ExcludeSerialSharded {
+		if !features.Get().ExcludeSerialShardedCerts {
+			return errors.New("excluding serial-sharded certs is not enabled")
+		}
+		clauses += `
+		AND NOT EXISTS (
+			SELECT 1 FROM revokedCertificates
+			WHERE revokedCertificates.serial = certificateStatus.serial
+		)`
+	}
+
 	selector, err := db.NewMappedSelector[crlEntryModel](ssa.dbReadOnlyMap)
 	if err != nil {
 		return fmt.Errorf("initializing db map: %w", err)
@@ -1162,6 +1226,9 @@ func (ssa *SQLStorageAuthorityRO) getRevokedCertsFromCertificateStatusTable(req
 		if row.RevokedDate.After(atTime) || row.RevokedDate.Equal(atTime) {
 			return nil
 		}
+		if req.RevokedAfter != nil && row.RevokedDate.Before(req.RevokedAfter.AsTime()) {
+			return nil
+		}
 
 		return stream.Send(&corepb.CRLEntry{
 			Serial:    row.Serial,
@@ -1193,6 +1260,57 @@ func (ssa *SQLStorageAuthorityRO) GetMaxExpiration(ctx context.Context, req *emp
 	return timestamppb.New(*model.MaxNotAfter), err
 }
 
+// GetCRLShard returns the thisUpdate, nextUpdate, and CRL Number of the most
+// recent full CRL for the given shard. The thisUpdate is unset if no full CRL
+// has been generated for the shard yet, and the CRL Number is unset unless
+// StoreCRLShardNumbers is enabled.
+func (ssa *SQLStorageAuthorityRO) GetCRLShard(ctx context.Context, req *sapb.GetCRLShardRequest) (*sapb.CRLShard, error) {
+	if req.IssuerNameID == 0 {
+		return nil, errIncompleteRequest
+	}
+
+	// The crlNumber column may not exist unless StoreCRLShardNumbers is
+	// enabled.
+	columns := "id, issuerID, idx, thisUpdate, nextUpdate, leasedUntil"
+	if features.Get().StoreCRLShardNumbers {
+		columns += ", crlNumber"
+	}
+
+	var shard crlShardModel
+	err := ssa.dbReadOnlyMap.SelectOne(
+		ctx,
+		&shard,
+		`SELECT `+columns+`
+			FROM crlShards
+			WHERE issuerID = ?
+			AND idx = ?
+			LIMIT 1`,
+		req.IssuerNameID,
+		req.ShardIdx,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("no CRL shard %d for issuer %d", req.ShardIdx, req.IssuerNameID)
+		}
+		return nil, fmt.Errorf("selecting CRL shard: %w", err)
+	}
+
+	res := &sapb.CRLShard{
+		IssuerNameID: shard.IssuerID,
+		ShardIdx:     int64(shard.Idx),
+	}
+	if shard.ThisUpdate != nil {
+		res.ThisUpdate = timestamppb.New(*shard.ThisUpdate)
+	}
+	if shard.NextUpdate != nil {
+		res.NextUpdate = timestamppb.New(*shard.NextUpdate)
+	}
+	if shard.CRLNumber != nil {
+		res.CrlNumber = *shard.CRLNumber
+	}
+	return res, nil
+}
+
 // Health implements the grpc.checker interface.
 func (ssa *SQLStorageAuthorityRO) Health(ctx context.Context) error {
 	err := ssa.dbReadOnlyMap.SelectOne(ctx, new(int), "SELECT 1")
@@ -1407,3 +1525,71 @@ func (ssa *SQLStorageAuthorityRO) GetPausedIdentifiers(ctx context.Context, req
 
 	return newPBFromIdentifierModels(matches)
 }
+
+// GetExternalAccountKey returns the External Account Binding MAC key with the
+// given key ID, including revoked keys. Callers must check the RevokedAt field
+// before accepting the key.
+func (ssa *SQLStorageAuthorityRO) GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	if core.IsAnyNilOrZero(req.KeyID) {
+		return nil, errIncompleteRequest
+	}
+
+	if !features.Get().ExternalAccountBinding {
+		// The externalAccountKeys table may not exist, and if it doesn't, no
+		// keys have been provisioned.
+		return nil, berrors.NotFoundError("no external account key %q found", req.KeyID)
+	}
+
+	var model externalAccountKeyModel
+	err := ssa.dbReadOnlyMap.SelectOne(ctx, &model,
+		"SELECT keyID, hmacKey, createdAt, revokedAt FROM externalAccountKeys WHERE keyID = ? LIMIT 1",
+		req.KeyID,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("no external account key %q found", req.KeyID)
+		}
+		return nil, err
+	}
+	return externalAccountKeyModelToPb(&model), nil
+}
+
+// GetRateLimitOverrides returns a stream of all rate limit overrides which have
+// not yet expired.
+func (ssa *SQLStorageAuthorityRO) GetRateLimitOverrides(_ *emptypb.Empty, stream grpc.ServerStreamingServer[sapb.RateLimitOverride]) error {
+	selector, err := db.NewMappedSelector[rateLimitOverrideModel](ssa.dbReadOnlyMap)
+	if err != nil {
+		return fmt.Errorf("initializing db map: %w", err)
+	}
+
+	rows, err := selector.QueryContext(stream.Context(), "WHERE expiresAt > ?", ssa.clk.Now())
+	if err != nil {
+		return fmt.Errorf("reading db: %w", err)
+	}
+
+	return rows.ForEach(func(row *rateLimitOverrideModel) error {
+		return stream.Send(rateLimitOverrideModelToPb(row))
+	})
+}
+
+// GetCTSubmissionQueueStats returns the number of CT submissions in the queue,
+// and the creation time of the oldest of them.
+func (ssa *SQLStorageAuthorityRO) GetCTSubmissionQueueStats(ctx context.Context, _ *emptypb.Empty) (*sapb.CTSubmissionQueueStats, error) {
+	if !features.Get().CTSubmissionQueue {
+		return nil, errCTSubmissionQueueDisabled
+	}
+
+	var stats struct {
+		Count  int64      `db:"count"`
+		Oldest *time.Time `db:"oldest"`
+	}
+	err := ssa.dbReadOnlyMap.SelectOne(ctx, &stats, "SELECT COUNT(*) AS count, MIN(created) AS oldest FROM ctSubmissions")
+	if err != nil {
+		return nil, err
+	}
+	resp := &sapb.CTSubmissionQueueStats{Count: stats.Count}
+	if stats.Oldest != nil {
+		resp.Oldest = timestamppb.New(stats.Oldest.UTC())
+	}
+	return resp, nil
+}
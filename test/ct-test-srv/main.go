@@ -20,6 +20,8 @@ import (
 	"sync"
 	"time"
 
+	ct "github.com/google/certificate-transparency-go"
+
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/publisher"
 )
@@ -39,6 +41,10 @@ type integrationSrv struct {
 	key           *ecdsa.PrivateKey
 	flakinessRate int
 	userAgent     string
+	// If true, SCTs carry a leaf_index extension, as returned by logs
+	// implementing the Static CT API.
+	staticCT  bool
+	leafIndex uint64
 }
 
 func readJSON(r *http.Request, output interface{}) error {
@@ -159,6 +165,11 @@ func (is *integrationSrv) addChainOrPre(w http.ResponseWriter, r *http.Request,
 
 	is.Lock()
 	is.submissions[hostnames]++
+	var extensions ct.CTExtensions
+	if is.staticCT {
+		extensions = publisher.MarshalLeafIndexExtension(is.leafIndex)
+		is.leafIndex++
+	}
 	is.Unlock()
 
 	if is.flakinessRate != 0 && rand.IntN(100) < is.flakinessRate {
@@ -166,7 +177,7 @@ func (is *integrationSrv) addChainOrPre(w http.ResponseWriter, r *http.Request,
 	}
 
 	w.WriteHeader(http.StatusOK)
-	w.Write(publisher.CreateTestingSignedSCT(addChainReq.Chain, is.key, precert, time.Now()))
+	w.Write(publisher.CreateTestingSignedSCT(addChainReq.Chain, is.key, precert, time.Now(), extensions))
 }
 
 func (is *integrationSrv) getSubmissions(w http.ResponseWriter, r *http.Request) {
@@ -186,6 +197,41 @@ func (is *integrationSrv) getSubmissions(w http.ResponseWriter, r *http.Request)
 
 type config struct {
 	Personalities []Personality
+	// LogList, if present, configures a server for the log list and its
+	// signature, for use by log-list-updater.
+	LogList *LogListServer
+}
+
+// LogListServer serves a log list and a detached signature over it, in the
+// same way as Chrome's log list is published. After editing the log list,
+// re-sign it with:
+// openssl dgst -sha256 -sign test/ct-test-srv/log_list.key -out test/ct-test-srv/log_list.sig test/ct-test-srv/log_list.json
+type LogListServer struct {
+	// Port (and optionally IP) to listen on
+	Addr string
+	// Path to the log list, served at /log_list.json
+	File string
+	// Path to the signature, served at /log_list.sig
+	SigFile string
+}
+
+func runLogListServer(l LogListServer) {
+	m := http.NewServeMux()
+	m.HandleFunc("/log_list.json", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, l.File)
+	})
+	m.HandleFunc("/log_list.sig", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, l.SigFile)
+	})
+	// The gosec linter complains that ReadHeaderTimeout is not set. That's fine,
+	// because this is test-only code.
+	////nolint:gosec
+	srv := &http.Server{
+		Addr:    l.Addr,
+		Handler: m,
+	}
+	log.Printf("ct-test-srv serving log list on %s", l.Addr)
+	log.Fatal(srv.ListenAndServe())
 }
 
 type Personality struct {
@@ -200,6 +246,9 @@ type Personality struct {
 	// FlakinessRate is an integer between 0-100 that controls how often the log
 	// "flakes", i.e. fails to respond in a reasonable time frame.
 	FlakinessRate int
+	// StaticCT makes this log emulate the submission API of a Static CT API
+	// log, whose SCTs carry a leaf_index extension.
+	StaticCT bool
 }
 
 func runPersonality(p Personality) {
@@ -221,6 +270,7 @@ func runPersonality(p Personality) {
 		submissions:   make(map[string]int64),
 		rejectHosts:   make(map[string]bool),
 		userAgent:     p.UserAgent,
+		staticCT:      p.StaticCT,
 	}
 	m := http.NewServeMux()
 	m.HandleFunc("/submissions", is.getSubmissions)
@@ -257,5 +307,8 @@ func main() {
 	for _, p := range c.Personalities {
 		go runPersonality(p)
 	}
+	if c.LogList != nil {
+		go runLogListServer(*c.LogList)
+	}
 	cmd.WaitForSignal()
 }
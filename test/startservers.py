@@ -28,6 +28,14 @@ SERVICES = (
         8023, 9499, 'rva.boulder',
         ('./bin/boulder', 'remoteva', '--config', os.path.join(config_dir, 'remoteva-c.json'), '--addr', ':9499', '--debug-addr', ':8023'),
         None),
+    Service('remoteva-d',
+        8024, 9510, 'rva.boulder',
+        ('./bin/boulder', 'remoteva', '--config', os.path.join(config_dir, 'remoteva-d.json'), '--addr', ':9510', '--debug-addr', ':8024'),
+        None),
+    Service('remoteva-e',
+        8025, 9511, 'rva.boulder',
+        ('./bin/boulder', 'remoteva', '--config', os.path.join(config_dir, 'remoteva-e.json'), '--addr', ':9511', '--debug-addr', ':8025'),
+        None),
     Service('boulder-sa-1',
         8003, 9395, 'sa.boulder',
         ('./bin/boulder', 'boulder-sa', '--config', os.path.join(config_dir, 'sa.json'), '--addr', ':9395', '--debug-addr', ':8003'),
@@ -89,7 +97,7 @@ SERVICES = (
     Service('crl-storer',
         9667, None, None,
         ('./bin/boulder', 'crl-storer', '--config', os.path.join(config_dir, 'crl-storer.json'), '--addr', ':9309', '--debug-addr', ':9667'),
-        ('s3-test-srv',)),
+        ('s3-test-srv', 'akamai-purger')),
     Service('crl-updater',
         8021, None, None,
         ('./bin/boulder', 'crl-updater', '--config', os.path.join(config_dir, 'crl-updater.json'), '--debug-addr', ':8021'),
@@ -1006,7 +1006,7 @@ def test_http_multiva_threshold_pass():
     # Configure a guestlist that will pass the multiVA threshold test by
     # allowing the primary VA at some, but not all, remotes.
     # In particular, remoteva-c is missing.
-    guestlist = {"boulder": 1, "remoteva-a": 1, "remoteva-b": 1}
+    guestlist = {"boulder": 1, "remoteva-a": 1, "remoteva-b": 1, "remoteva-d": 1, "remoteva-e": 1}
 
     hostname, cleanup = multiva_setup(client, guestlist)
 
@@ -1022,7 +1022,7 @@ def test_http_multiva_primary_fail_remote_pass():
 
     # Configure a guestlist that will fail the primary VA check but allow all of
     # the remote VAs.
-    guestlist = {"boulder": 0, "remoteva-a": 1, "remoteva-b": 1}
+    guestlist = {"boulder": 0, "remoteva-a": 1, "remoteva-b": 1, "remoteva-d": 1, "remoteva-e": 1}
 
     hostname, cleanup = multiva_setup(client, guestlist)
 
@@ -11,6 +11,8 @@ var (
 	DBConnSA = fmt.Sprintf(dbURL, "sa", "boulder_sa_test")
 	// DBConnSAMailer is the sa mailer database connection
 	DBConnSAMailer = fmt.Sprintf(dbURL, "mailer", "boulder_sa_test")
+	// DBConnSARatelimits is the rate limits database connection
+	DBConnSARatelimits = fmt.Sprintf(dbURL, "ratelimits", "boulder_sa_test")
 	// DBConnSAFullPerms is the sa database connection with full perms
 	DBConnSAFullPerms = fmt.Sprintf(dbURL, "test_setup", "boulder_sa_test")
 	// DBConnSAIntegrationFullPerms is the sa database connection for the
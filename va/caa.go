@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -79,7 +80,7 @@ func (va *ValidationAuthorityImpl) IsCAAValid(ctx context.Context, req *vapb.IsC
 		va.log.AuditObject("CAA check result", logEvent)
 	}()
 
-	internalErr = va.checkCAA(ctx, acmeID, params)
+	_, internalErr = va.checkCAA(ctx, acmeID, params)
 
 	// Stop the clock for local check latency.
 	localLatency = va.clk.Since(start)
@@ -129,26 +130,33 @@ func (va *ValidationAuthorityImpl) IsCAAValid(ctx context.Context, req *vapb.IsC
 }
 
 // checkCAA performs a CAA lookup & validation for the provided identifier. If
-// the CAA lookup & validation fail a problem is returned.
+// the CAA lookup & validation fail a problem is returned. The addresses of the
+// resolvers used for the lookup are returned in either case.
 func (va *ValidationAuthorityImpl) checkCAA(
 	ctx context.Context,
-	identifier identifier.ACMEIdentifier,
-	params *caaParams) error {
+	ident identifier.ACMEIdentifier,
+	params *caaParams) (bdns.ResolverAddrs, error) {
 	if core.IsAnyNilOrZero(params, params.validationMethod, params.accountURIID) {
-		return probs.ServerInternal("expected validationMethod or accountURIID not provided to checkCAA")
+		return nil, probs.ServerInternal("expected validationMethod or accountURIID not provided to checkCAA")
+	}
+
+	if ident.Type == identifier.TypeIP {
+		// RFC 8738, Sec. 7: CAA checking is not applicable to IP address
+		// identifiers, because there is no domain in which to publish records.
+		return nil, nil
 	}
 
-	foundAt, valid, response, err := va.checkCAARecords(ctx, identifier, params)
+	foundAt, valid, response, resolvers, err := va.checkCAARecords(ctx, ident, params)
 	if err != nil {
-		return berrors.DNSError("%s", err)
+		return resolvers, berrors.DNSError("%s", err)
 	}
 
 	va.log.AuditInfof("Checked CAA records for %s, [Present: %t, Account ID: %d, Challenge: %s, Valid for issuance: %t, Found at: %q] Response=%q",
-		identifier.Value, foundAt != "", params.accountURIID, params.validationMethod, valid, foundAt, response)
+		ident.Value, foundAt != "", params.accountURIID, params.validationMethod, valid, foundAt, response)
 	if !valid {
-		return berrors.CAAError("CAA record for %s prevents issuance", foundAt)
+		return resolvers, berrors.CAAError("CAA record for %s prevents issuance", foundAt)
 	}
-	return nil
+	return resolvers, nil
 }
 
 // caaResult represents the result of querying CAA for a single name. It breaks
@@ -257,10 +265,11 @@ func selectCAA(rrs []caaResult) (*caaResult, error) {
 // first CAA RRSet found by traversing upwards from the FQDN by removing the
 // leftmost label. It returns nil if no RRSet is found on any parent of the
 // given FQDN. The returned result also contains the raw CAA response, and an
-// error if one is encountered while querying or parsing the records.
+// error if one is encountered while querying or parsing the records. The
+// addresses of the resolvers used for all of the lookups are also returned.
 //
 // [1]: https://datatracker.ietf.org/doc/html/rfc8659#name-relevant-resource-record-se
-func (va *ValidationAuthorityImpl) getCAA(ctx context.Context, hostname string) (*caaResult, error) {
+func (va *ValidationAuthorityImpl) getCAA(ctx context.Context, hostname string) (*caaResult, bdns.ResolverAddrs, error) {
 	hostname = strings.TrimRight(hostname, ".")
 
 	// See RFC 6844 "Certification Authority Processing" for pseudocode, as
@@ -273,23 +282,33 @@ func (va *ValidationAuthorityImpl) getCAA(ctx context.Context, hostname string)
 	//
 	// We depend on our resolver to snap CNAME and DNAME records.
 	results := va.parallelCAALookup(ctx, hostname)
-	return selectCAA(results)
+	var resolvers bdns.ResolverAddrs
+	for _, res := range results {
+		for _, addr := range res.resolvers {
+			if !slices.Contains(resolvers, addr) {
+				resolvers = append(resolvers, addr)
+			}
+		}
+	}
+	caaSet, err := selectCAA(results)
+	return caaSet, resolvers, err
 }
 
 // checkCAARecords fetches the CAA records for the given identifier and then
 // validates them. If the identifier argument's value has a wildcard prefix then
 // the prefix is stripped and validation will be performed against the base
 // domain, honouring any issueWild CAA records encountered as appropriate.
-// checkCAARecords returns four values: the first is a string indicating at
+// checkCAARecords returns five values: the first is a string indicating at
 // which name (i.e. FQDN or parent thereof) CAA records were found, if any. The
 // second is a bool indicating whether issuance for the identifier is valid. The
 // unmodified *dns.CAA records that were processed/filtered are returned as the
-// third argument. Any  errors encountered are returned as the fourth return
-// value (or nil).
+// third argument. The addresses of the resolvers used are returned as the
+// fourth. Any  errors encountered are returned as the fifth return value (or
+// nil).
 func (va *ValidationAuthorityImpl) checkCAARecords(
 	ctx context.Context,
 	identifier identifier.ACMEIdentifier,
-	params *caaParams) (string, bool, string, error) {
+	params *caaParams) (string, bool, string, bdns.ResolverAddrs, error) {
 	hostname := strings.ToLower(identifier.Value)
 	// If this is a wildcard name, remove the prefix
 	var wildcard bool
@@ -297,16 +316,16 @@ func (va *ValidationAuthorityImpl) checkCAARecords(
 		hostname = strings.TrimPrefix(identifier.Value, `*.`)
 		wildcard = true
 	}
-	caaSet, err := va.getCAA(ctx, hostname)
+	caaSet, resolvers, err := va.getCAA(ctx, hostname)
 	if err != nil {
-		return "", false, "", err
+		return "", false, "", resolvers, err
 	}
 	raw := ""
 	if caaSet != nil {
 		raw = caaSet.dig
 	}
 	valid, foundAt := va.validateCAA(caaSet, wildcard, params)
-	return foundAt, valid, raw, nil
+	return foundAt, valid, raw, resolvers, nil
 }
 
 // validateCAA checks a provided *caaResult. When the wildcard argument is true
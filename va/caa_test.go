@@ -201,7 +201,7 @@ func TestCAATimeout(t *testing.T) {
 		validationMethod: core.ChallengeTypeHTTP01,
 	}
 
-	err := va.checkCAA(ctx, identifier.NewDNS("caa-timeout.com"), params)
+	_, err := va.checkCAA(ctx, identifier.NewDNS("caa-timeout.com"), params)
 	test.AssertErrorIs(t, err, berrors.DNS)
 	test.AssertContains(t, err.Error(), "error")
 }
@@ -419,7 +419,7 @@ func TestCAAChecking(t *testing.T) {
 		defer mockLog.Clear()
 		t.Run(caaTest.Name, func(t *testing.T) {
 			ident := identifier.NewDNS(caaTest.Domain)
-			foundAt, valid, _, err := va.checkCAARecords(ctx, ident, params)
+			foundAt, valid, _, _, err := va.checkCAARecords(ctx, ident, params)
 			if err != nil {
 				t.Errorf("checkCAARecords error for %s: %s", caaTest.Domain, err)
 			}
@@ -508,7 +508,7 @@ func TestCAALogging(t *testing.T) {
 				accountURIID:     tc.AccountURIID,
 				validationMethod: tc.ChallengeType,
 			}
-			_ = va.checkCAA(ctx, identifier.NewDNS(tc.Domain), params)
+			_, _ = va.checkCAA(ctx, identifier.NewDNS(tc.Domain), params)
 
 			caaLogLines := mockLog.GetAllMatching(`Checked CAA records for`)
 			if len(caaLogLines) != 1 {
@@ -1206,6 +1206,24 @@ func TestMultiCAARechecking(t *testing.T) {
 					slices.Sort(tc.expectedSummary.Failed)
 					slices.Sort(tc.expectedSummary.PassedRIRs)
 					test.AssertDeepEquals(t, gotAuditLog.Summary, tc.expectedSummary)
+
+					// The primary's result comes first, followed by one
+					// result from each remote perspective.
+					test.AssertEquals(t, len(isValidRes.PerspectiveResults), len(tc.remoteVAs)+1)
+					test.AssertEquals(t, isValidRes.PerspectiveResults[0].Perspective, PrimaryPerspective)
+					var passed []string
+					for _, res := range isValidRes.PerspectiveResults {
+						test.AssertEquals(t, res.Operation, opCAA)
+						test.AssertEquals(t, res.Passed, res.Problem == nil)
+						if res.Passed && res.Perspective != PrimaryPerspective {
+							passed = append(passed, res.Perspective)
+						}
+					}
+					slices.Sort(passed)
+					if passed == nil {
+						passed = []string{}
+					}
+					test.AssertDeepEquals(t, passed, tc.expectedSummary.Passed)
 				}
 
 				gotAnyRemoteFailures := mockLog.GetAllMatching("CAA check failed due to remote failures:")
@@ -1231,13 +1249,13 @@ func TestCAAFailure(t *testing.T) {
 
 	va, _ := setup(hs, "", nil, caaMockDNS{})
 
-	err := va.checkCAA(ctx, dnsi("reserved.com"), &caaParams{1, core.ChallengeTypeHTTP01})
+	_, err := va.checkCAA(ctx, dnsi("reserved.com"), &caaParams{1, core.ChallengeTypeHTTP01})
 	if err == nil {
 		t.Fatalf("Expected CAA rejection for reserved.com, got success")
 	}
 	test.AssertErrorIs(t, err, berrors.CAA)
 
-	err = va.checkCAA(ctx, dnsi("example.gonetld"), &caaParams{1, core.ChallengeTypeHTTP01})
+	_, err = va.checkCAA(ctx, dnsi("example.gonetld"), &caaParams{1, core.ChallengeTypeHTTP01})
 	if err == nil {
 		t.Fatalf("Expected CAA rejection for gonetld, got success")
 	}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/base32"
 	"encoding/base64"
 	"fmt"
 	"net"
+	"net/netip"
+	"strings"
 
 	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/iana"
 	"github.com/letsencrypt/boulder/identifier"
 )
 
@@ -19,8 +23,19 @@ import (
 // resolved. This is the same choice made by the Go internal resolution library
 // used by net/http. If there is an error resolving the hostname, or if no
 // usable IP addresses are available then a berrors.DNSError instance is
-// returned with a nil net.IP slice.
+// returned with a nil net.IP slice. If hostname is an IP address literal, as
+// for an RFC 8738 IP identifier, no lookup is performed and the address itself
+// is returned, provided it isn't reserved.
 func (va ValidationAuthorityImpl) getAddrs(ctx context.Context, hostname string) ([]net.IP, bdns.ResolverAddrs, error) {
+	ip, err := netip.ParseAddr(hostname)
+	if err == nil {
+		err = iana.IsReservedAddr(ip)
+		if err != nil {
+			return nil, nil, berrors.MalformedError("%s", err)
+		}
+		return []net.IP{ip.AsSlice()}, nil, nil
+	}
+
 	addrs, resolvers, err := va.dnsClient.LookupHost(ctx, hostname)
 	if err != nil {
 		return nil, resolvers, berrors.DNSError("%v", err)
@@ -54,13 +69,60 @@ func (va *ValidationAuthorityImpl) validateDNS01(ctx context.Context, ident iden
 		return nil, berrors.MalformedError("Identifier type for DNS was not itself DNS")
 	}
 
+	// Look for the required record in the DNS
+	challengeSubdomain := fmt.Sprintf("%s.%s", core.DNSPrefix, ident.Value)
+	return va.validateTXT(ctx, ident, challengeSubdomain, keyAuthorization)
+}
+
+// dnsAccountLabel returns the account-scoped label under which a DNS-ACCOUNT-01
+// TXT record is provisioned for the account with the given URL: an underscore
+// followed by the lowercase base32 encoding of the first 10 bytes of the
+// SHA-256 digest of the account URL.
+// See draft-ietf-acme-dns-account-label Section 3.2.
+func dnsAccountLabel(accountURL string) string {
+	digest := sha256.Sum256([]byte(accountURL))
+	return "_" + strings.ToLower(base32.StdEncoding.EncodeToString(digest[:10]))
+}
+
+// validateDNSAccount01 performs a DNS-ACCOUNT-01 validation. This is identical
+// to DNS-01, except that the TXT record is looked up at a label which is unique
+// to the requesting account, so that multiple accounts can validate the same
+// domain concurrently without contending for a single record. Because an
+// account may be known by more than one URL (see caaAccountURIMatches), the
+// label for each configured account URI prefix is tried in turn.
+func (va *ValidationAuthorityImpl) validateDNSAccount01(ctx context.Context, ident identifier.ACMEIdentifier, regID int64, keyAuthorization string) ([]core.ValidationRecord, error) {
+	if ident.Type != identifier.TypeDNS {
+		va.log.Infof("Identifier type for DNS challenge was not DNS: %s", ident)
+		return nil, berrors.MalformedError("Identifier type for DNS was not itself DNS")
+	}
+	if regID == 0 {
+		return nil, berrors.InternalServerError("DNS-ACCOUNT-01 validation requires an account ID")
+	}
+
+	var firstErr error
+	for _, prefix := range va.accountURIPrefixes {
+		accountURL := fmt.Sprintf("%s%d", prefix, regID)
+		challengeSubdomain := fmt.Sprintf("%s.%s.%s", dnsAccountLabel(accountURL), core.DNSPrefix, ident.Value)
+		records, err := va.validateTXT(ctx, ident, challengeSubdomain, keyAuthorization)
+		if err == nil {
+			return records, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+// validateTXT checks that one of the TXT records at challengeSubdomain contains
+// the base64url-encoded SHA-256 digest of the key authorization, as required by
+// both DNS-01 and DNS-ACCOUNT-01.
+func (va *ValidationAuthorityImpl) validateTXT(ctx context.Context, ident identifier.ACMEIdentifier, challengeSubdomain string, keyAuthorization string) ([]core.ValidationRecord, error) {
 	// Compute the digest of the key authorization file
 	h := sha256.New()
 	h.Write([]byte(keyAuthorization))
 	authorizedKeysDigest := base64.RawURLEncoding.EncodeToString(h.Sum(nil))
 
-	// Look for the required record in the DNS
-	challengeSubdomain := fmt.Sprintf("%s.%s", core.DNSPrefix, ident.Value)
 	txts, resolvers, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
 	if err != nil {
 		return nil, berrors.DNSError("%s", err)
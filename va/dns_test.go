@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/probs"
@@ -136,6 +138,81 @@ func TestDNSValidationNoAuthorityOK(t *testing.T) {
 	test.Assert(t, prob == nil, "Should be valid.")
 }
 
+func TestDNSAccountLabel(t *testing.T) {
+	t.Parallel()
+
+	// This is the example from draft-ietf-acme-dns-account-label Section 3.2.
+	test.AssertEquals(t, dnsAccountLabel("https://example.com/acme/acct/ExampleAccount"), "_ujmmovf2vn55tgye")
+}
+
+// dnsAccountMockDNS is a mock DNS client which serves the good DNS-01 TXT
+// record for good-dns01.com only at the account-scoped label for the given
+// account URL, and no records at any other name.
+type dnsAccountMockDNS struct {
+	bdns.MockClient
+	accountURL string
+}
+
+func (m *dnsAccountMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, bdns.ResolverAddrs, error) {
+	if hostname == fmt.Sprintf("%s.%s.good-dns01.com", dnsAccountLabel(m.accountURL), core.DNSPrefix) {
+		// See bdns.MockClient.LookupTXT for the derivation of this value.
+		return []string{"LPsIwTo7o8BoG0-vjCyGQGBWSVIPxI-i_X336eUOQZo"}, bdns.ResolverAddrs{"MockClient"}, nil
+	}
+	return nil, bdns.ResolverAddrs{"MockClient"}, nil
+}
+
+func TestDNSAccountValidation(t *testing.T) {
+	t.Parallel()
+
+	accountURL := fmt.Sprintf("%s%d", accountURIPrefixes[0], 1)
+	va, _ := setup(nil, "", nil, &dnsAccountMockDNS{accountURL: accountURL})
+
+	records, err := va.validateDNSAccount01(ctx, dnsi("good-dns01.com"), 1, expectedKeyAuthorization)
+	test.AssertNotError(t, err, "DNS-ACCOUNT-01 validation should succeed")
+	test.AssertEquals(t, len(records), 1)
+	test.AssertEquals(t, records[0].DnsName, "good-dns01.com")
+
+	// The record is scoped to account 1, so account 2 must not be able to use it.
+	_, err = va.validateDNSAccount01(ctx, dnsi("good-dns01.com"), 2, expectedKeyAuthorization)
+	test.AssertErrorIs(t, err, berrors.Unauthorized)
+	label := dnsAccountLabel(fmt.Sprintf("%s%d", accountURIPrefixes[0], 2))
+	test.AssertContains(t, err.Error(), fmt.Sprintf("No TXT record found at %s._acme-challenge.good-dns01.com", label))
+
+	_, err = va.validateDNSAccount01(ctx, dnsi("good-dns01.com"), 0, expectedKeyAuthorization)
+	test.AssertErrorIs(t, err, berrors.InternalServer)
+
+	_, err = va.validateDNSAccount01(ctx, identifier.NewIP(netip.MustParseAddr("127.0.0.1")), 1, expectedKeyAuthorization)
+	test.AssertErrorIs(t, err, berrors.Malformed)
+}
+
+func TestDNSAccountValidationMPIC(t *testing.T) {
+	t.Parallel()
+
+	dns := &dnsAccountMockDNS{accountURL: fmt.Sprintf("%s%d", accountURIPrefixes[0], 1)}
+	remotes := []remoteConf{
+		{rir: arin, dns: dns},
+		{rir: ripe, dns: dns},
+		{rir: apnic, dns: dns},
+	}
+	va, _ := setupWithRemotes(nil, "", remotes, dns)
+
+	req := createValidationRequest("good-dns01.com", core.ChallengeTypeDNSAccount01)
+	res, err := va.DoDCV(ctx, req)
+	test.AssertNotError(t, err, "DoDCV failed")
+	test.Assert(t, res.Problem == nil, fmt.Sprintf("expected no problem, got %#v", res.Problem))
+
+	// When the remotes can't see the account-scoped record, corroboration fails.
+	remotes = []remoteConf{
+		{rir: arin},
+		{rir: ripe},
+		{rir: apnic},
+	}
+	va, _ = setupWithRemotes(nil, "", remotes, dns)
+	res, err = va.DoDCV(ctx, req)
+	test.AssertNotError(t, err, "DoDCV failed")
+	test.AssertNotNil(t, res.Problem, "expected a problem when remote perspectives fail")
+}
+
 func TestAvailableAddresses(t *testing.T) {
 	v6a := net.ParseIP("::1")
 	v6b := net.ParseIP("2001:db8::2:1") // 2001:DB8 is reserved for docs (RFC 3849)
@@ -0,0 +1,158 @@
+package va
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/identifier"
+)
+
+// persistRecord is a parsed DNS-PERSIST-01 TXT record. Its presentation format
+// is an issuer domain name followed by semicolon-separated tag=value
+// parameters, similar to a CAA issue property, for example:
+//
+//	letsencrypt.org; accounturi=https://acme-v02.api.letsencrypt.org/acme/acct/1; policy=wildcard; persistUntil=1767225600
+type persistRecord struct {
+	issuerDomainName string
+	accountURI       string
+	// wildcard is true if the record includes the "policy=wildcard" parameter,
+	// which extends the standing authorization to wildcard names.
+	wildcard bool
+	// persistUntil is the time after which the record may no longer be used.
+	// If zero, the record does not expire.
+	persistUntil time.Time
+}
+
+// parsePersistRecord parses the value of a single DNS-PERSIST-01 TXT record.
+// Tag names are case-insensitive, and unrecognized tags are ignored. A record
+// with a missing issuer domain name or accounturi, a repeated tag, or a
+// malformed persistUntil is rejected.
+func parsePersistRecord(txt string) (*persistRecord, error) {
+	fields := strings.Split(txt, ";")
+	issuer := strings.TrimSpace(fields[0])
+	if issuer == "" {
+		return nil, errors.New("missing issuer domain name")
+	}
+	rec := &persistRecord{issuerDomainName: strings.ToLower(strings.TrimSuffix(issuer, "."))}
+
+	seen := make(map[string]bool)
+	for _, field := range fields[1:] {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		tag, val, ok := strings.Cut(field, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed parameter %q", field)
+		}
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		val = strings.TrimSpace(val)
+		if seen[tag] {
+			return nil, fmt.Errorf("repeated parameter %q", tag)
+		}
+		seen[tag] = true
+
+		switch tag {
+		case "accounturi":
+			rec.accountURI = val
+		case "policy":
+			rec.wildcard = strings.EqualFold(val, "wildcard")
+		case "persistuntil":
+			secs, err := strconv.ParseInt(val, 10, 64)
+			if err != nil || secs <= 0 {
+				return nil, fmt.Errorf("malformed persistUntil %q", val)
+			}
+			rec.persistUntil = time.Unix(secs, 0)
+		}
+	}
+
+	if rec.accountURI == "" {
+		return nil, errors.New("missing accounturi")
+	}
+	return rec, nil
+}
+
+// checkPersistRecord determines whether the given parsed record grants a
+// standing authorization to this CA, for the given account, at the current
+// time, and (if wildcard is true) for wildcard names.
+func (va *ValidationAuthorityImpl) checkPersistRecord(rec *persistRecord, regID int64, wildcard bool) error {
+	if rec.issuerDomainName != strings.ToLower(va.issuerDomain) {
+		return fmt.Errorf("issuer domain name %q does not match %q", rec.issuerDomainName, va.issuerDomain)
+	}
+
+	var accountMatches bool
+	for _, prefix := range va.accountURIPrefixes {
+		if rec.accountURI == fmt.Sprintf("%s%d", prefix, regID) {
+			accountMatches = true
+			break
+		}
+	}
+	if !accountMatches {
+		return fmt.Errorf("accounturi %q does not match the requesting account", rec.accountURI)
+	}
+
+	if wildcard && !rec.wildcard {
+		return errors.New("policy=wildcard is required to validate a wildcard name")
+	}
+
+	if !rec.persistUntil.IsZero() && !va.clk.Now().Before(rec.persistUntil) {
+		return fmt.Errorf("record expired at %s", rec.persistUntil.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// validateDNSPersist01 performs a DNS-PERSIST-01 validation. Rather than a TXT
+// record containing a digest of a fresh key authorization, it looks for a
+// long-lived TXT record at the _validation-persist label which names this CA's
+// issuer domain and the requesting account's URI. Because the record is not
+// tied to any particular challenge token, it can be published once and then
+// satisfy every subsequent authorization for the same name and account.
+func (va *ValidationAuthorityImpl) validateDNSPersist01(ctx context.Context, ident identifier.ACMEIdentifier, regID int64, wildcard bool) ([]core.ValidationRecord, error) {
+	if ident.Type != identifier.TypeDNS {
+		va.log.Infof("Identifier type for DNS challenge was not DNS: %s", ident)
+		return nil, berrors.MalformedError("Identifier type for DNS was not itself DNS")
+	}
+	if regID == 0 {
+		return nil, berrors.InternalServerError("DNS-PERSIST-01 validation requires an account ID")
+	}
+
+	challengeSubdomain := fmt.Sprintf("%s.%s", core.DNSPersistPrefix, ident.Value)
+	txts, resolvers, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
+	if err != nil {
+		return nil, berrors.DNSError("%s", err)
+	}
+
+	if len(txts) == 0 {
+		return nil, berrors.UnauthorizedError("No TXT record found at %s", challengeSubdomain)
+	}
+
+	// Any single record which grants us a standing authorization is sufficient.
+	// If none do, report why the first one was rejected.
+	var firstErr error
+	for _, txt := range txts {
+		rec, err := parsePersistRecord(txt)
+		if err == nil {
+			err = va.checkPersistRecord(rec, regID, wildcard)
+		}
+		if err == nil {
+			// Successful challenge validation
+			return []core.ValidationRecord{{DnsName: ident.Value, ResolverAddrs: resolvers}}, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	var andMore string
+	if len(txts) > 1 {
+		andMore = fmt.Sprintf(" (and %d more)", len(txts)-1)
+	}
+	return nil, berrors.UnauthorizedError("No valid persistent validation record found at %s: %s%s",
+		challengeSubdomain, firstErr, andMore)
+}
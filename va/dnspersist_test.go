@@ -0,0 +1,220 @@
+package va
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/bdns"
+	"github.com/letsencrypt/boulder/core"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/identifier"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestParsePersistRecord(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		txt     string
+		want    *persistRecord
+		wantErr string
+	}{
+		{
+			name: "minimal",
+			txt:  "letsencrypt.org; accounturi=https://example.com/acct/1",
+			want: &persistRecord{issuerDomainName: "letsencrypt.org", accountURI: "https://example.com/acct/1"},
+		},
+		{
+			name: "all parameters, odd spacing and case",
+			txt:  " LetsEncrypt.org. ;accounturi = https://example.com/acct/1 ;Policy=WILDCARD; persistUntil=1767225600;",
+			want: &persistRecord{
+				issuerDomainName: "letsencrypt.org",
+				accountURI:       "https://example.com/acct/1",
+				wildcard:         true,
+				persistUntil:     time.Unix(1767225600, 0),
+			},
+		},
+		{
+			name: "unknown parameters are ignored",
+			txt:  "letsencrypt.org; accounturi=https://example.com/acct/1; foo=bar; policy=other",
+			want: &persistRecord{issuerDomainName: "letsencrypt.org", accountURI: "https://example.com/acct/1"},
+		},
+		{
+			name:    "missing issuer",
+			txt:     "; accounturi=https://example.com/acct/1",
+			wantErr: "missing issuer domain name",
+		},
+		{
+			name:    "missing accounturi",
+			txt:     "letsencrypt.org; policy=wildcard",
+			wantErr: "missing accounturi",
+		},
+		{
+			name:    "repeated parameter",
+			txt:     "letsencrypt.org; accounturi=https://example.com/acct/1; accounturi=https://example.com/acct/2",
+			wantErr: "repeated parameter",
+		},
+		{
+			name:    "parameter without value",
+			txt:     "letsencrypt.org; accounturi",
+			wantErr: "malformed parameter",
+		},
+		{
+			name:    "malformed persistUntil",
+			txt:     "letsencrypt.org; accounturi=https://example.com/acct/1; persistUntil=tomorrow",
+			wantErr: "malformed persistUntil",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parsePersistRecord(tc.txt)
+			if tc.wantErr != "" {
+				test.AssertError(t, err, "expected parsing to fail")
+				test.AssertContains(t, err.Error(), tc.wantErr)
+				return
+			}
+			test.AssertNotError(t, err, "parsing failed")
+			test.AssertDeepEquals(t, got, tc.want)
+		})
+	}
+}
+
+// dnsPersistMockDNS is a mock DNS client which serves the configured TXT
+// records at _validation-persist.example.com, and no records elsewhere.
+type dnsPersistMockDNS struct {
+	bdns.MockClient
+	txts []string
+}
+
+func (m *dnsPersistMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, bdns.ResolverAddrs, error) {
+	if hostname == "_validation-persist.example.com" {
+		return m.txts, bdns.ResolverAddrs{"MockClient"}, nil
+	}
+	return nil, bdns.ResolverAddrs{"MockClient"}, nil
+}
+
+func TestValidateDNSPersist01(t *testing.T) {
+	t.Parallel()
+
+	accountURI := fmt.Sprintf("%s%d", accountURIPrefixes[0], 1)
+
+	testCases := []struct {
+		name    string
+		txts    []string
+		domain  string
+		regID   int64
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			txts:   []string{"letsencrypt.org; accounturi=" + accountURI},
+			domain: "example.com",
+			regID:  1,
+		},
+		{
+			name:   "valid wildcard",
+			txts:   []string{"letsencrypt.org; accounturi=" + accountURI + "; policy=wildcard"},
+			domain: "*.example.com",
+			regID:  1,
+		},
+		{
+			name:   "one of several records valid",
+			txts:   []string{"otherca.example; accounturi=" + accountURI, "letsencrypt.org; accounturi=" + accountURI},
+			domain: "example.com",
+			regID:  1,
+		},
+		{
+			name:    "no records",
+			domain:  "example.com",
+			regID:   1,
+			wantErr: "No TXT record found at _validation-persist.example.com",
+		},
+		{
+			name:    "wrong issuer",
+			txts:    []string{"otherca.example; accounturi=" + accountURI},
+			domain:  "example.com",
+			regID:   1,
+			wantErr: `issuer domain name "otherca.example" does not match "letsencrypt.org"`,
+		},
+		{
+			name:    "wrong account",
+			txts:    []string{"letsencrypt.org; accounturi=" + accountURI},
+			domain:  "example.com",
+			regID:   2,
+			wantErr: "does not match the requesting account",
+		},
+		{
+			name:    "wildcard without policy",
+			txts:    []string{"letsencrypt.org; accounturi=" + accountURI},
+			domain:  "*.example.com",
+			regID:   1,
+			wantErr: "policy=wildcard is required",
+		},
+		{
+			name:    "expired",
+			txts:    []string{"letsencrypt.org; accounturi=" + accountURI + "; persistUntil=1"},
+			domain:  "example.com",
+			regID:   1,
+			wantErr: "record expired",
+		},
+		{
+			name:    "malformed record",
+			txts:    []string{"letsencrypt.org", "letsencrypt.org; accounturi=" + accountURI + "; persistUntil=1"},
+			domain:  "example.com",
+			regID:   1,
+			wantErr: "missing accounturi (and 1 more)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			va, _ := setup(nil, "", nil, &dnsPersistMockDNS{txts: tc.txts})
+			va.clk.(clock.FakeClock).Set(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			records, err := va.validateChallenge(ctx, dnsi(tc.domain), tc.regID, core.ChallengeTypeDNSPersist01, expectedToken, expectedKeyAuthorization)
+			if tc.wantErr != "" {
+				test.AssertErrorIs(t, err, berrors.Unauthorized)
+				test.AssertContains(t, err.Error(), tc.wantErr)
+				return
+			}
+			test.AssertNotError(t, err, "validation failed")
+			test.AssertEquals(t, len(records), 1)
+			test.AssertEquals(t, records[0].DnsName, "example.com")
+		})
+	}
+}
+
+func TestValidateDNSPersist01NotYetExpired(t *testing.T) {
+	t.Parallel()
+
+	va, _ := setup(nil, "", nil, nil)
+	accountURI := fmt.Sprintf("%s%d", accountURIPrefixes[0], 1)
+	persistUntil := va.clk.Now().Add(time.Hour).Unix()
+	va.dnsClient = &dnsPersistMockDNS{txts: []string{
+		fmt.Sprintf("letsencrypt.org; accounturi=%s; persistUntil=%d", accountURI, persistUntil),
+	}}
+
+	_, err := va.validateDNSPersist01(ctx, dnsi("example.com"), 1, false)
+	test.AssertNotError(t, err, "unexpired record should be valid")
+}
+
+func TestValidateDNSPersist01BadRequests(t *testing.T) {
+	t.Parallel()
+
+	va, _ := setup(nil, "", nil, nil)
+
+	_, err := va.validateDNSPersist01(ctx, dnsi("example.com"), 0, false)
+	test.AssertErrorIs(t, err, berrors.InternalServer)
+
+	_, err = va.validateDNSPersist01(ctx, identifier.NewIP(netip.MustParseAddr("127.0.0.1")), 1, false)
+	test.AssertErrorIs(t, err, berrors.Malformed)
+}
@@ -0,0 +1,286 @@
+package va
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+)
+
+const (
+	// minRemoteVAs is the fewest remote VAs the VA will perform an MPIC
+	// operation with. It is the BRs' earliest required number of remote
+	// perspectives; later phases are enforced by minRemotePerspectives.
+	minRemoteVAs = 3
+
+	// healthDecay is the weight given to each new observation in a remote VA's
+	// error rate and latency moving averages.
+	healthDecay = 0.2
+
+	// minHealthSamples is the number of observations required before a remote
+	// VA's circuit breaker can trip.
+	minHealthSamples = 10
+
+	// circuitOpenErrorRate is the error rate at or above which a remote VA's
+	// circuit breaker trips.
+	circuitOpenErrorRate = 0.5
+
+	// circuitOpenDuration is how long a tripped circuit breaker excludes its
+	// remote VA from MPIC operations before a single trial request is allowed.
+	circuitOpenDuration = 30 * time.Second
+)
+
+// remotePerspectivesPhaseIn is the "Phased Implementation Timeline" in BRs
+// Section 3.2.2.9: the minimum number of remote perspectives which must be
+// attempted for each MPIC operation, starting on each date.
+var remotePerspectivesPhaseIn = []struct {
+	start time.Time
+	min   int
+}{
+	{time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC), 3},
+	{time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC), 4},
+	{time.Date(2026, time.December, 15, 0, 0, 0, 0, time.UTC), 5},
+}
+
+// minRemotePerspectives returns the minimum number of remote perspectives
+// which must be attempted for an MPIC operation performed at now. Before the
+// first phase it returns minRemoteVAs.
+func minRemotePerspectives(now time.Time) int {
+	required := minRemoteVAs
+	for _, phase := range remotePerspectivesPhaseIn {
+		if !now.Before(phase.start) {
+			required = phase.min
+		}
+	}
+	return required
+}
+
+// circuitState is the state of a remote VA's circuit breaker.
+type circuitState int
+
+const (
+	// circuitClosed remote VAs are included in every MPIC operation.
+	circuitClosed circuitState = iota
+	// circuitOpen remote VAs are excluded from MPIC operations, unless quorum
+	// cannot be reached without them.
+	circuitOpen
+	// circuitHalfOpen remote VAs have been open for circuitOpenDuration, and
+	// are allowed a single trial request to determine whether they've
+	// recovered.
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("circuitState(%d)", int(s))
+}
+
+// healthOutcome is the outcome of a single request to a remote VA, from the
+// perspective of that remote VA's health.
+type healthOutcome int
+
+const (
+	// healthUnknown outcomes, such as requests canceled because quorum was
+	// already reached, say nothing about the remote VA's health.
+	healthUnknown healthOutcome = iota
+	healthSuccess
+	healthFailure
+)
+
+// remoteVAHealth tracks the recent error rate and latency of a single remote
+// VA, and the state of its circuit breaker. It is safe for concurrent use.
+type remoteVAHealth struct {
+	sync.Mutex
+	clk clock.Clock
+
+	samples   int
+	errorRate float64
+	latency   time.Duration
+
+	state         circuitState
+	openedAt      time.Time
+	trialInFlight bool
+}
+
+func newRemoteVAHealth(clk clock.Clock) *remoteVAHealth {
+	return &remoteVAHealth{clk: clk}
+}
+
+// available returns true if the remote VA should be included in the next MPIC
+// operation. A half-open circuit only admits one trial request at a time.
+func (h *remoteVAHealth) available() bool {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.state == circuitOpen && h.clk.Since(h.openedAt) >= circuitOpenDuration {
+		h.state = circuitHalfOpen
+	}
+	switch h.state {
+	case circuitOpen:
+		return false
+	case circuitHalfOpen:
+		if h.trialInFlight {
+			return false
+		}
+		h.trialInFlight = true
+		return true
+	}
+	return true
+}
+
+// observe records the outcome and latency of a request to the remote VA and
+// returns the resulting circuit state, and whether it changed.
+func (h *remoteVAHealth) observe(outcome healthOutcome, latency time.Duration) (circuitState, bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	before := h.state
+	if h.state == circuitHalfOpen {
+		h.trialInFlight = false
+	}
+	if outcome == healthUnknown {
+		return h.state, false
+	}
+
+	errVal := 0.0
+	if outcome == healthFailure {
+		errVal = 1.0
+	}
+	if h.samples == 0 {
+		h.errorRate = errVal
+		h.latency = latency
+	} else {
+		h.errorRate = healthDecay*errVal + (1-healthDecay)*h.errorRate
+		h.latency = time.Duration(healthDecay*float64(latency) + (1-healthDecay)*float64(h.latency))
+	}
+	h.samples++
+
+	switch h.state {
+	case circuitClosed:
+		if h.samples >= minHealthSamples && h.errorRate >= circuitOpenErrorRate {
+			h.state = circuitOpen
+			h.openedAt = h.clk.Now()
+		}
+	case circuitOpen, circuitHalfOpen:
+		// Open circuits are only attempted when quorum can't be reached
+		// without them; either way a single success is enough to close them.
+		if outcome == healthSuccess {
+			h.state = circuitClosed
+			h.samples = 1
+			h.errorRate = 0
+		} else {
+			h.state = circuitOpen
+			h.openedAt = h.clk.Now()
+		}
+	}
+	return h.state, h.state != before
+}
+
+// String returns a human-readable summary of the remote VA's health, for
+// logging.
+func (h *remoteVAHealth) String() string {
+	h.Lock()
+	defer h.Unlock()
+	return fmt.Sprintf("circuit %s, error rate %.2f, latency %s", h.state, h.errorRate, h.latency.Round(time.Millisecond))
+}
+
+// quorumPlan is the set of remote VAs chosen for a single MPIC operation, and
+// the number of failures it may tolerate.
+type quorumPlan struct {
+	// remotes are the remote VAs to attempt.
+	remotes []RemoteVA
+	// health is the health of each remote VA, in the same order as remotes.
+	health []*remoteVAHealth
+	// maxFailures is the number of failures which may be tolerated from
+	// remotes, per the "Quorum Requirements" table in BRs Section 3.2.2.9.
+	maxFailures int
+	// decision is "full" if every remote VA is attempted, "degraded" if
+	// remote VAs with open circuits are skipped, and "at_risk" if too few
+	// remote VAs are healthy to reach quorum, so all are attempted regardless.
+	decision string
+}
+
+// planRemoteOperation chooses the remote VAs to attempt for an MPIC operation.
+// Remote VAs whose circuit breakers are open are skipped, as long as the
+// remaining remote VAs still number at least minRemotePerspectives for the
+// current date and span at least requiredRIRs RIRs, so that quorum is still achievable within the BRs.
+// Otherwise every remote VA is attempted. Each decision other than "full" is
+// logged with the health of the skipped or unhealthy remote VAs.
+func (va *ValidationAuthorityImpl) planRemoteOperation() *quorumPlan {
+	var healthy []int
+	var unhealthy []int
+	healthyRIRs := make(map[string]struct{})
+	for i, rva := range va.remoteVAs {
+		if va.remoteHealth[i].available() {
+			healthy = append(healthy, i)
+			healthyRIRs[rva.RIR] = struct{}{}
+		} else {
+			unhealthy = append(unhealthy, i)
+		}
+	}
+
+	required := minRemotePerspectives(va.clk.Now())
+	plan := &quorumPlan{decision: "full"}
+	chosen := healthy
+	if len(unhealthy) > 0 {
+		if len(healthy) >= required && len(healthyRIRs) >= requiredRIRs {
+			plan.decision = "degraded"
+			va.log.Warningf(
+				"MPIC degraded: skipping remote VAs %s; quorum of %d/%d across %d RIRs remains achievable",
+				va.describeRemotes(unhealthy), len(healthy)-maxAllowedFailures(len(healthy)), len(healthy), len(healthyRIRs))
+		} else {
+			plan.decision = "at_risk"
+			chosen = make([]int, len(va.remoteVAs))
+			for i := range va.remoteVAs {
+				chosen[i] = i
+			}
+			va.log.Warningf(
+				"MPIC quorum at risk: only %d remote VAs across %d RIRs are healthy, need %d across %d RIRs; attempting unhealthy remote VAs %s",
+				len(healthy), len(healthyRIRs), required, requiredRIRs, va.describeRemotes(unhealthy))
+		}
+	}
+	va.metrics.mpicQuorumDecisions.WithLabelValues(plan.decision).Inc()
+
+	for _, i := range chosen {
+		plan.remotes = append(plan.remotes, va.remoteVAs[i])
+		plan.health = append(plan.health, va.remoteHealth[i])
+	}
+	plan.maxFailures = maxAllowedFailures(len(plan.remotes))
+	return plan
+}
+
+// describeRemotes returns a human-readable summary of the perspective and
+// health of each of the remote VAs at the given indices, for logging.
+func (va *ValidationAuthorityImpl) describeRemotes(indices []int) string {
+	var descs []string
+	for _, i := range indices {
+		descs = append(descs, fmt.Sprintf("%q (%s)", va.remoteVAs[i].Perspective, va.remoteHealth[i]))
+	}
+	return "[" + strings.Join(descs, ", ") + "]"
+}
+
+// observeRemoteHealth records the outcome of a request to a remote VA, updates
+// its health metrics, and logs any change to its circuit state.
+func (va *ValidationAuthorityImpl) observeRemoteHealth(rva RemoteVA, health *remoteVAHealth, outcome healthOutcome, latency time.Duration) {
+	state, changed := health.observe(outcome, latency)
+
+	health.Lock()
+	errorRate, avgLatency := health.errorRate, health.latency
+	health.Unlock()
+	va.metrics.remoteVAErrorRate.WithLabelValues(rva.Perspective).Set(errorRate)
+	va.metrics.remoteVALatency.WithLabelValues(rva.Perspective).Set(avgLatency.Seconds())
+	va.metrics.remoteVACircuitState.WithLabelValues(rva.Perspective).Set(float64(state))
+
+	if changed {
+		va.log.Warningf("Remote VA %q (%s) circuit is now %s: %s", rva.Perspective, rva.Address, state, health)
+	}
+}
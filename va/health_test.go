@@ -0,0 +1,281 @@
+package va
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// tripCircuit records enough failures against h to open its circuit.
+func tripCircuit(t *testing.T, h *remoteVAHealth) {
+	t.Helper()
+	for range minHealthSamples {
+		h.observe(healthFailure, time.Second)
+	}
+	test.AssertEquals(t, h.state, circuitOpen)
+}
+
+func TestRemoteVAHealthCircuit(t *testing.T) {
+	t.Parallel()
+
+	fc := clock.NewFake()
+	h := newRemoteVAHealth(fc)
+	test.Assert(t, h.available(), "new remote VA should be available")
+
+	// Failures below the minimum sample count don't trip the circuit.
+	for range minHealthSamples - 1 {
+		state, changed := h.observe(healthFailure, time.Second)
+		test.AssertEquals(t, state, circuitClosed)
+		test.Assert(t, !changed, "circuit should not have changed state")
+	}
+	state, changed := h.observe(healthFailure, time.Second)
+	test.AssertEquals(t, state, circuitOpen)
+	test.Assert(t, changed, "circuit should have opened")
+	test.Assert(t, !h.available(), "open circuit should not be available")
+
+	// Once circuitOpenDuration has passed, a single trial request is allowed.
+	fc.Add(circuitOpenDuration)
+	test.Assert(t, h.available(), "half-open circuit should allow a trial request")
+	test.AssertEquals(t, h.state, circuitHalfOpen)
+	test.Assert(t, !h.available(), "half-open circuit should allow only one trial request")
+
+	// A canceled trial releases the half-open circuit for another trial.
+	state, changed = h.observe(healthUnknown, 0)
+	test.AssertEquals(t, state, circuitHalfOpen)
+	test.Assert(t, !changed, "circuit should not have changed state")
+	test.Assert(t, h.available(), "half-open circuit should allow another trial request")
+
+	// A failed trial re-opens the circuit.
+	state, _ = h.observe(healthFailure, time.Second)
+	test.AssertEquals(t, state, circuitOpen)
+	test.Assert(t, !h.available(), "re-opened circuit should not be available")
+
+	// A successful trial closes the circuit and resets the error rate.
+	fc.Add(circuitOpenDuration)
+	test.Assert(t, h.available(), "half-open circuit should allow a trial request")
+	state, changed = h.observe(healthSuccess, time.Second)
+	test.AssertEquals(t, state, circuitClosed)
+	test.Assert(t, changed, "circuit should have closed")
+	test.AssertEquals(t, h.errorRate, 0.0)
+	test.Assert(t, h.available(), "closed circuit should be available")
+}
+
+func TestRemoteVAHealthScoring(t *testing.T) {
+	t.Parallel()
+
+	h := newRemoteVAHealth(clock.NewFake())
+	h.observe(healthSuccess, 100*time.Millisecond)
+	test.AssertEquals(t, h.errorRate, 0.0)
+	test.AssertEquals(t, h.latency, 100*time.Millisecond)
+
+	h.observe(healthFailure, 600*time.Millisecond)
+	test.AssertEquals(t, h.errorRate, healthDecay)
+	test.AssertEquals(t, h.latency, 200*time.Millisecond)
+
+	// Occasional failures don't trip the circuit.
+	for range 5 * minHealthSamples {
+		h.observe(healthSuccess, 100*time.Millisecond)
+		h.observe(healthSuccess, 100*time.Millisecond)
+		h.observe(healthFailure, 100*time.Millisecond)
+	}
+	test.AssertEquals(t, h.state, circuitClosed)
+	test.AssertContains(t, h.String(), "circuit closed")
+}
+
+func TestPlanRemoteOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		now              time.Time
+		remotes          []remoteConf
+		tripped          []int
+		expectedDecision string
+		expectedRemotes  int
+		expectedFailures int
+		expectedLog      string
+	}{
+		{
+			name:             "all healthy",
+			remotes:          []remoteConf{{rir: arin}, {rir: ripe}, {rir: apnic}, {rir: arin}},
+			expectedDecision: "full",
+			expectedRemotes:  4,
+			expectedFailures: 1,
+		},
+		{
+			name:             "one open circuit, quorum achievable",
+			remotes:          []remoteConf{{rir: arin}, {rir: ripe}, {rir: apnic}, {rir: arin}},
+			tripped:          []int{0},
+			expectedDecision: "degraded",
+			expectedRemotes:  3,
+			expectedFailures: 1,
+			expectedLog:      `MPIC degraded: skipping remote VAs ["dc-0-ARIN" (circuit open, error rate 1.00, latency 1s)]; quorum of 2/3 across 3 RIRs remains achievable`,
+		},
+		{
+			name:             "seven remotes, one open circuit",
+			remotes:          []remoteConf{{rir: arin}, {rir: ripe}, {rir: apnic}, {rir: arin}, {rir: ripe}, {rir: apnic}, {rir: arin}},
+			tripped:          []int{6},
+			expectedDecision: "degraded",
+			expectedRemotes:  6,
+			expectedFailures: 2,
+			expectedLog:      "quorum of 4/6 across 3 RIRs remains achievable",
+		},
+		{
+			name:             "too few healthy remotes",
+			remotes:          []remoteConf{{rir: arin}, {rir: ripe}, {rir: apnic}, {rir: arin}},
+			tripped:          []int{0, 1},
+			expectedDecision: "at_risk",
+			expectedRemotes:  4,
+			expectedFailures: 1,
+			expectedLog:      "MPIC quorum at risk: only 2 remote VAs across 2 RIRs are healthy, need 3 across 2 RIRs",
+		},
+		{
+			name:             "too few healthy RIRs",
+			remotes:          []remoteConf{{rir: arin}, {rir: ripe}, {rir: arin}, {rir: arin}},
+			tripped:          []int{1},
+			expectedDecision: "at_risk",
+			expectedRemotes:  4,
+			expectedFailures: 1,
+			expectedLog:      "MPIC quorum at risk: only 3 remote VAs across 1 RIRs are healthy",
+		},
+		{
+			name:             "four healthy remotes after Jun 15, 2026",
+			now:              time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC),
+			remotes:          []remoteConf{{rir: arin}, {rir: ripe}, {rir: apnic}, {rir: arin}, {rir: ripe}},
+			tripped:          []int{0},
+			expectedDecision: "degraded",
+			expectedRemotes:  4,
+			expectedFailures: 1,
+			expectedLog:      "quorum of 3/4 across 3 RIRs remains achievable",
+		},
+		{
+			name:             "three healthy remotes after Jun 15, 2026",
+			now:              time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC),
+			remotes:          []remoteConf{{rir: arin}, {rir: ripe}, {rir: apnic}, {rir: arin}, {rir: ripe}},
+			tripped:          []int{0, 1},
+			expectedDecision: "at_risk",
+			expectedRemotes:  5,
+			expectedFailures: 1,
+			expectedLog:      "MPIC quorum at risk: only 3 remote VAs across 3 RIRs are healthy, need 4 across 2 RIRs",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			va, mockLog := setupWithRemotes(nil, "", tc.remotes, nil)
+			if !tc.now.IsZero() {
+				va.clk.(clock.FakeClock).Set(tc.now)
+			}
+			for _, i := range tc.tripped {
+				tripCircuit(t, va.remoteHealth[i])
+			}
+
+			plan := va.planRemoteOperation()
+			test.AssertEquals(t, plan.decision, tc.expectedDecision)
+			test.AssertEquals(t, len(plan.remotes), tc.expectedRemotes)
+			test.AssertEquals(t, len(plan.health), tc.expectedRemotes)
+			test.AssertEquals(t, plan.maxFailures, tc.expectedFailures)
+			if tc.expectedLog == "" {
+				test.AssertEquals(t, len(mockLog.GetAllMatching("MPIC")), 0)
+			} else {
+				test.AssertEquals(t, len(mockLog.GetAllMatching(regexp.QuoteMeta(tc.expectedLog))), 1)
+			}
+		})
+	}
+}
+
+func TestMinRemotePerspectives(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		now      time.Time
+		expected int
+	}{
+		{time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), 3},
+		{time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC), 3},
+		{time.Date(2026, time.June, 14, 23, 59, 59, 0, time.UTC), 3},
+		{time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC), 4},
+		{time.Date(2026, time.December, 14, 23, 59, 59, 0, time.UTC), 4},
+		{time.Date(2026, time.December, 15, 0, 0, 0, 0, time.UTC), 5},
+		{time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC), 5},
+	}
+	for _, tc := range testCases {
+		test.AssertEquals(t, minRemotePerspectives(tc.now), tc.expected)
+	}
+}
+
+func TestDoDCVSkipsOpenCircuits(t *testing.T) {
+	t.Parallel()
+
+	ms := httpMultiSrv(t, expectedToken, map[string]bool{pass: true, fail: false})
+	defer ms.Close()
+
+	brokenVA := RemoteClients{
+		VAClient:  brokenRemoteVA{},
+		CAAClient: brokenRemoteVA{},
+	}
+	remotes := []remoteConf{
+		{ua: pass, rir: arin},
+		{ua: pass, rir: ripe},
+		{ua: pass, rir: apnic},
+		{ua: "broken", rir: arin, impl: brokenVA},
+	}
+	va, mockLog := setupWithRemotes(ms.Server, pass, remotes, nil)
+	req := createValidationRequest("localhost", core.ChallengeTypeHTTP01)
+
+	// The broken remote VA is attempted, and tolerated, until its circuit
+	// opens.
+	for range minHealthSamples {
+		res, err := va.DoDCV(ctx, req)
+		test.AssertNotError(t, err, "DoDCV failed")
+		test.Assert(t, res.Problem == nil, "DoDCV returned a problem")
+		test.AssertEquals(t, len(res.PerspectiveResults), 5)
+	}
+	test.AssertEquals(t, len(mockLog.GetAllMatching(`Remote VA "dc-3-ARIN" \(broken\) circuit is now open`)), 1)
+
+	// Once it's open, the broken remote VA is skipped and quorum is computed
+	// from the remaining remote VAs.
+	mockLog.Clear()
+	res, err := va.DoDCV(ctx, req)
+	test.AssertNotError(t, err, "DoDCV failed")
+	test.Assert(t, res.Problem == nil, "DoDCV returned a problem")
+	test.AssertEquals(t, len(res.PerspectiveResults), 4)
+	for _, pr := range res.PerspectiveResults {
+		test.AssertNotEquals(t, pr.Perspective, "dc-3-ARIN")
+	}
+	test.AssertEquals(t, len(mockLog.GetAllMatching(`MPIC degraded: skipping remote VAs \["dc-3-ARIN"`)), 1)
+	test.AssertEquals(t, len(mockLog.GetAllMatching("dc-3-ARIN")), 1)
+}
+
+func TestDoDCVRequiresPhasedInPerspectives(t *testing.T) {
+	t.Parallel()
+
+	ms := httpMultiSrv(t, expectedToken, map[string]bool{pass: true})
+	defer ms.Close()
+
+	remotes := []remoteConf{
+		{ua: pass, rir: arin},
+		{ua: pass, rir: ripe},
+		{ua: pass, rir: apnic},
+	}
+	va, _ := setupWithRemotes(ms.Server, pass, remotes, nil)
+	req := createValidationRequest("localhost", core.ChallengeTypeHTTP01)
+
+	res, err := va.DoDCV(ctx, req)
+	test.AssertNotError(t, err, "DoDCV failed")
+	test.Assert(t, res.Problem == nil, "DoDCV returned a problem")
+
+	// Three remote VAs no longer suffice once four perspectives are required.
+	va.clk.(clock.FakeClock).Set(time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC))
+	res, err = va.DoDCV(ctx, req)
+	test.AssertNotError(t, err, "DoDCV failed")
+	test.Assert(t, res.Problem != nil, "DoDCV should fail with too few remote VAs")
+	test.AssertContains(t, res.Problem.Detail, "Insufficient remote perspectives: need at least 4")
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"strconv"
 	"strings"
@@ -425,10 +426,15 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 		return nil, nil, err
 	}
 
-	// Create an initial GET Request
+	// Create an initial GET Request. An IPv6 address literal must be enclosed
+	// in brackets when used as the host of a URL.
+	urlHost := host
+	if ip, err := netip.ParseAddr(host); err == nil && ip.Is6() {
+		urlHost = "[" + host + "]"
+	}
 	initialURL := url.URL{
 		Scheme: "http",
-		Host:   host,
+		Host:   urlHost,
 		Path:   path,
 	}
 	initialReq, err := http.NewRequest("GET", initialURL.String(), nil)
@@ -640,9 +646,9 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 }
 
 func (va *ValidationAuthorityImpl) validateHTTP01(ctx context.Context, ident identifier.ACMEIdentifier, token string, keyAuthorization string) ([]core.ValidationRecord, error) {
-	if ident.Type != identifier.TypeDNS {
-		va.log.Infof("Got non-DNS identifier for HTTP validation: %s", ident)
-		return nil, berrors.MalformedError("Identifier type for HTTP validation was not DNS")
+	if ident.Type != identifier.TypeDNS && ident.Type != identifier.TypeIP {
+		va.log.Infof("Got non-DNS or IP identifier for HTTP validation: %s", ident)
+		return nil, berrors.MalformedError("Identifier type for HTTP validation was not DNS or IP")
 	}
 
 	// Perform the fetch
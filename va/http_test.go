@@ -1307,7 +1307,7 @@ func TestHTTP(t *testing.T) {
 
 	_, err = va.validateHTTP01(ctx, identifier.NewIP(netip.MustParseAddr("127.0.0.1")), pathFound, ka(pathFound))
 	if err == nil {
-		t.Fatalf("IdentifierType IP shouldn't have worked.")
+		t.Fatalf("Reserved IP address shouldn't have worked.")
 	}
 	test.AssertErrorIs(t, err, berrors.Malformed)
 
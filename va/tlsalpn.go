@@ -13,9 +13,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"strconv"
 	"strings"
 
+	"github.com/miekg/dns"
+
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/identifier"
@@ -161,6 +164,10 @@ func (va *ValidationAuthorityImpl) getChallengeCert(
 }
 
 func checkExpectedSAN(cert *x509.Certificate, name identifier.ACMEIdentifier) error {
+	if name.Type == identifier.TypeIP {
+		return checkExpectedIPSAN(cert, name)
+	}
+
 	if len(cert.DNSNames) != 1 {
 		return errors.New("wrong number of dNSNames")
 	}
@@ -183,6 +190,37 @@ func checkExpectedSAN(cert *x509.Certificate, name identifier.ACMEIdentifier) er
 	return nil
 }
 
+// checkExpectedIPSAN confirms that the certificate's subjectAltName extension
+// contains only a single iPAddress matching the provided IP identifier, as
+// required by RFC 8738, Sec. 6.
+func checkExpectedIPSAN(cert *x509.Certificate, ident identifier.ACMEIdentifier) error {
+	if len(cert.DNSNames) != 0 || len(cert.IPAddresses) != 1 {
+		return errors.New("wrong number of identifiers")
+	}
+
+	for _, ext := range cert.Extensions {
+		if IdCeSubjectAltName.Equal(ext.Id) {
+			expectedSANs, err := asn1.Marshal([]asn1.RawValue{
+				{Tag: 7, Class: 2, Bytes: cert.IPAddresses[0]},
+			})
+			if err != nil || !bytes.Equal(expectedSANs, ext.Value) {
+				return errors.New("SAN extension does not match expected bytes")
+			}
+		}
+	}
+
+	expected, err := netip.ParseAddr(ident.Value)
+	if err != nil {
+		return fmt.Errorf("parsing IP identifier: %w", err)
+	}
+	got, ok := netip.AddrFromSlice(cert.IPAddresses[0])
+	if !ok || got.Unmap() != expected {
+		return errors.New("iPAddress does not match expected identifier")
+	}
+
+	return nil
+}
+
 // Confirm that of the OIDs provided, all of them are in the provided list of
 // extensions. Also confirms that of the extensions provided that none are
 // repeated. Per RFC8737, allows unexpected extensions.
@@ -206,15 +244,27 @@ func checkAcceptableExtensions(exts []pkix.Extension, requiredOIDs []asn1.Object
 }
 
 func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, identifier identifier.ACMEIdentifier, keyAuthorization string) ([]core.ValidationRecord, error) {
-	if identifier.Type != "dns" {
-		va.log.Info(fmt.Sprintf("Identifier type for TLS-ALPN-01 was not DNS: %s", identifier))
-		return nil, berrors.MalformedError("Identifier type for TLS-ALPN-01 was not DNS")
+	var serverName string
+	switch identifier.Type {
+	case "dns":
+		serverName = identifier.Value
+	case "ip":
+		// RFC 8738, Sec. 6: For IP identifiers, the SNI extension carries the
+		// reverse mapping DNS name of the IP address, without a trailing dot.
+		reverse, err := dns.ReverseAddr(identifier.Value)
+		if err != nil {
+			return nil, berrors.MalformedError("Invalid IP address identifier for TLS-ALPN-01: %q", identifier.Value)
+		}
+		serverName = strings.TrimSuffix(reverse, ".")
+	default:
+		va.log.Info(fmt.Sprintf("Identifier type for TLS-ALPN-01 was not DNS or IP: %s", identifier))
+		return nil, berrors.MalformedError("Identifier type for TLS-ALPN-01 was not DNS or IP")
 	}
 
 	cert, cs, tvr, problem := va.tryGetChallengeCert(ctx, identifier, &tls.Config{
 		MinVersion: tls.VersionTLS12,
 		NextProtos: []string{ACMETLS1Protocol},
-		ServerName: identifier.Value,
+		ServerName: serverName,
 	})
 	// Copy the single validationRecord into the slice that we have to return, and
 	// get a reference to it so we can modify it if we have to.
@@ -259,7 +309,7 @@ func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, identi
 	}
 
 	// The certificate returned must have a subjectAltName extension containing
-	// only the dNSName being validated and no other entries.
+	// only the dNSName or iPAddress being validated and no other entries.
 	err = checkExpectedSAN(cert, identifier)
 	if err != nil {
 		names := strings.Join(certAltNames(cert), ", ")
@@ -2,6 +2,8 @@ package va
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha256"
 	"crypto/tls"
@@ -133,7 +135,7 @@ func tlsalpn01Srv(
 	return tlsalpn01SrvWithCert(t, acmeCert, tlsVersion), nil
 }
 
-func TestTLSALPN01FailIP(t *testing.T) {
+func TestTLSALPN01FailReservedIP(t *testing.T) {
 	hs, err := tlsalpn01Srv(t, expectedKeyAuthorization, IdPeAcmeIdentifier, 0, "expected")
 	test.AssertNotError(t, err, "Error creating test server")
 
@@ -141,7 +143,7 @@ func TestTLSALPN01FailIP(t *testing.T) {
 
 	_, err = va.validateTLSALPN01(ctx, identifier.NewIP(netip.MustParseAddr("127.0.0.1")), expectedKeyAuthorization)
 	if err == nil {
-		t.Fatalf("IdentifierType IP shouldn't have worked.")
+		t.Fatalf("Reserved IP address shouldn't have worked.")
 	}
 	prob := detailedError(err)
 	test.AssertEquals(t, prob.Type, probs.MalformedProblem)
@@ -854,3 +856,41 @@ func TestAcceptableExtensions(t *testing.T) {
 	err = checkAcceptableExtensions(okayWithUnexpectedExt, requireAcmeAndSAN)
 	test.AssertNotError(t, err, "Correct type and number of extensions")
 }
+
+func TestCheckExpectedIPSAN(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+
+	makeCert := func(dnsNames []string, ips []net.IP) *x509.Certificate {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1337),
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().AddDate(0, 0, 1),
+			DNSNames:     dnsNames,
+			IPAddresses:  ips,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+		test.AssertNotError(t, err, "creating certificate")
+		cert, err := x509.ParseCertificate(der)
+		test.AssertNotError(t, err, "parsing certificate")
+		return cert
+	}
+
+	v4 := identifier.NewIP(netip.MustParseAddr("64.112.117.122"))
+	v6 := identifier.NewIP(netip.MustParseAddr("2602:80a:6000::1"))
+
+	err = checkExpectedSAN(makeCert(nil, []net.IP{net.ParseIP("64.112.117.122")}), v4)
+	test.AssertNotError(t, err, "matching IPv4 iPAddress should be accepted")
+
+	err = checkExpectedSAN(makeCert(nil, []net.IP{net.ParseIP("2602:80a:6000::1")}), v6)
+	test.AssertNotError(t, err, "matching IPv6 iPAddress should be accepted")
+
+	err = checkExpectedSAN(makeCert(nil, []net.IP{net.ParseIP("64.112.117.123")}), v4)
+	test.AssertError(t, err, "mismatched iPAddress should be rejected")
+
+	err = checkExpectedSAN(makeCert([]string{"example.com"}, []net.IP{net.ParseIP("64.112.117.122")}), v4)
+	test.AssertError(t, err, "extra dNSName should be rejected")
+
+	err = checkExpectedSAN(makeCert(nil, []net.IP{net.ParseIP("64.112.117.122"), net.ParseIP("2602:80a:6000::1")}), v4)
+	test.AssertError(t, err, "extra iPAddress should be rejected")
+}
@@ -110,6 +110,10 @@ type vaMetrics struct {
 	http01Redirects                   prometheus.Counter
 	caaCounter                        *prometheus.CounterVec
 	ipv4FallbackCounter               prometheus.Counter
+	remoteVAErrorRate                 *prometheus.GaugeVec
+	remoteVALatency                   *prometheus.GaugeVec
+	remoteVACircuitState              *prometheus.GaugeVec
+	mpicQuorumDecisions               *prometheus.CounterVec
 }
 
 func initMetrics(stats prometheus.Registerer) *vaMetrics {
@@ -158,6 +162,26 @@ func initMetrics(stats prometheus.Registerer) *vaMetrics {
 		Help: "A counter of IPv4 fallbacks during TLS ALPN validation",
 	})
 	stats.MustRegister(ipv4FallbackCounter)
+	remoteVAErrorRate := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "remote_va_error_rate",
+		Help: "Moving average of the rate of failed requests to each remote VA, labelled by perspective",
+	}, []string{"perspective"})
+	stats.MustRegister(remoteVAErrorRate)
+	remoteVALatency := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "remote_va_latency_seconds",
+		Help: "Moving average of the latency of requests to each remote VA, labelled by perspective",
+	}, []string{"perspective"})
+	stats.MustRegister(remoteVALatency)
+	remoteVACircuitState := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "remote_va_circuit_state",
+		Help: "State of each remote VA's circuit breaker (0=closed, 1=open, 2=half-open), labelled by perspective",
+	}, []string{"perspective"})
+	stats.MustRegister(remoteVACircuitState)
+	mpicQuorumDecisions := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mpic_quorum_decisions",
+		Help: "A counter of MPIC operations labelled by which remote VAs were attempted [full|degraded|at_risk]",
+	}, []string{"decision"})
+	stats.MustRegister(mpicQuorumDecisions)
 
 	return &vaMetrics{
 		validationLatency:                 validationLatency,
@@ -167,6 +191,10 @@ func initMetrics(stats prometheus.Registerer) *vaMetrics {
 		http01Redirects:                   http01Redirects,
 		caaCounter:                        caaCounter,
 		ipv4FallbackCounter:               ipv4FallbackCounter,
+		remoteVAErrorRate:                 remoteVAErrorRate,
+		remoteVALatency:                   remoteVALatency,
+		remoteVACircuitState:              remoteVACircuitState,
+		mpicQuorumDecisions:               mpicQuorumDecisions,
 	}
 }
 
@@ -210,7 +238,7 @@ type ValidationAuthorityImpl struct {
 	userAgent          string
 	clk                clock.Clock
 	remoteVAs          []RemoteVA
-	maxRemoteFailures  int
+	remoteHealth       []*remoteVAHealth
 	accountURIPrefixes []string
 	singleDialTimeout  time.Duration
 	perspective        string
@@ -249,6 +277,11 @@ func NewValidationAuthorityImpl(
 		}
 	}
 
+	remoteHealth := make([]*remoteVAHealth, len(remoteVAs))
+	for i := range remoteVAs {
+		remoteHealth[i] = newRemoteVAHealth(clk)
+	}
+
 	pc := newDefaultPortConfig()
 
 	va := &ValidationAuthorityImpl{
@@ -262,7 +295,7 @@ func NewValidationAuthorityImpl(
 		clk:                clk,
 		metrics:            initMetrics(stats),
 		remoteVAs:          remoteVAs,
-		maxRemoteFailures:  maxAllowedFailures(len(remoteVAs)),
+		remoteHealth:       remoteHealth,
 		accountURIPrefixes: accountURIPrefixes,
 		// singleDialTimeout specifies how long an individual `DialContext` operation may take
 		// before timing out. This timeout ignores the base RPC timeout and is strictly
@@ -418,11 +451,15 @@ func (va *ValidationAuthorityImpl) isPrimaryVA() bool {
 func (va *ValidationAuthorityImpl) validateChallenge(
 	ctx context.Context,
 	ident identifier.ACMEIdentifier,
+	regID int64,
 	kind core.AcmeChallenge,
 	token string,
 	keyAuthorization string,
 ) ([]core.ValidationRecord, error) {
-	// Strip a (potential) leading wildcard token from the identifier.
+	// Strip a (potential) leading wildcard token from the identifier, noting
+	// whether it was present for challenges which must treat wildcards
+	// differently.
+	wildcard := strings.HasPrefix(ident.Value, "*.")
 	ident.Value = strings.TrimPrefix(ident.Value, "*.")
 
 	switch kind {
@@ -432,6 +469,10 @@ func (va *ValidationAuthorityImpl) validateChallenge(
 		return va.validateDNS01(ctx, ident, keyAuthorization)
 	case core.ChallengeTypeTLSALPN01:
 		return va.validateTLSALPN01(ctx, ident, keyAuthorization)
+	case core.ChallengeTypeDNSAccount01:
+		return va.validateDNSAccount01(ctx, ident, regID, keyAuthorization)
+	case core.ChallengeTypeDNSPersist01:
+		return va.validateDNSPersist01(ctx, ident, regID, wildcard)
 	}
 	return nil, berrors.MalformedError("invalid challenge type %s", kind)
 }
@@ -478,10 +519,10 @@ var _ remoteResult = (*vapb.IsCAAValidResponse)(nil)
 // performRemoteOperation concurrently calls the provided operation with `req` and a
 // RemoteVA once for each configured RemoteVA. It cancels remaining operations and returns
 // early if either the required number of successful results is obtained or the number of
-// failures exceeds va.maxRemoteFailures.
+// failures exceeds maxAllowedFailures.
 //
 // Internal logic errors are logged. If the number of operation failures exceeds
-// va.maxRemoteFailures, the first encountered problem is returned as a
+// maxAllowedFailures, the first encountered problem is returned as a
 // *probs.ProblemDetails.
 func (va *ValidationAuthorityImpl) performRemoteOperation(ctx context.Context, op remoteOperation, req proto.Message) *probs.ProblemDetails {
 	remoteVACount := len(va.remoteVAs)
@@ -522,7 +563,8 @@ func (va *ValidationAuthorityImpl) performRemoteOperation(ctx context.Context, o
 		}(va.remoteVAs[i])
 	}
 
-	required := remoteVACount - va.maxRemoteFailures
+	maxRemoteFailures := maxAllowedFailures(remoteVACount)
+	required := remoteVACount - maxRemoteFailures
 	var passed []string
 	var failed []string
 	var firstProb *probs.ProblemDetails
@@ -570,7 +612,7 @@ func (va *ValidationAuthorityImpl) performRemoteOperation(ctx context.Context, o
 		if len(passed) >= required {
 			cancel()
 		}
-		if len(failed) > va.maxRemoteFailures {
+		if len(failed) > maxRemoteFailures {
 			cancel()
 		}
 
@@ -587,7 +629,7 @@ func (va *ValidationAuthorityImpl) performRemoteOperation(ctx context.Context, o
 
 	if len(passed) >= required {
 		return nil
-	} else if len(failed) > va.maxRemoteFailures {
+	} else if len(failed) > maxRemoteFailures {
 		firstProb.Detail = fmt.Sprintf("During secondary validation: %s", firstProb.Detail)
 		return firstProb
 	} else {
@@ -646,7 +688,7 @@ func (va *ValidationAuthorityImpl) performLocalValidation(
 	// Do primary domain control validation. Any kind of error returned by this
 	// counts as a validation error, and will be converted into an appropriate
 	// probs.ProblemDetails by the calling function.
-	records, err := va.validateChallenge(ctx, ident, kind, token, keyAuthorization)
+	records, err := va.validateChallenge(ctx, ident, regid, kind, token, keyAuthorization)
 	if err != nil {
 		return records, err
 	}
@@ -654,7 +696,7 @@ func (va *ValidationAuthorityImpl) performLocalValidation(
 	// Do primary CAA checks. Any kind of error returned by this counts as not
 	// receiving permission to issue, and will be converted into an appropriate
 	// probs.ProblemDetails by the calling function.
-	err = va.checkCAA(ctx, ident, &caaParams{
+	_, err = va.checkCAA(ctx, ident, &caaParams{
 		accountURIID:     regid,
 		validationMethod: kind,
 	})
@@ -676,7 +718,11 @@ func (va *ValidationAuthorityImpl) performLocalValidation(
 // also contains Problems. This method does NOT implement Multi-Perspective
 // Issuance Corroboration as defined in BRs Sections 3.2.2.9 and 5.4.1.
 func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *vapb.PerformValidationRequest) (*vapb.ValidationResult, error) {
-	if core.IsAnyNilOrZero(req, req.DnsName, req.Challenge, req.Authz, req.ExpectedKeyAuthorization) {
+	if core.IsAnyNilOrZero(req, req.Challenge, req.Authz, req.ExpectedKeyAuthorization) {
+		return nil, berrors.InternalServerError("Incomplete validation request")
+	}
+	ident := identifier.FromProtoWithDefault(req.Identifier, req.DnsName)
+	if ident.Value == "" {
 		return nil, berrors.InternalServerError("Incomplete validation request")
 	}
 
@@ -699,7 +745,7 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 	logEvent := verificationRequestEvent{
 		AuthzID:    req.Authz.Id,
 		Requester:  req.Authz.RegID,
-		Identifier: req.DnsName,
+		Identifier: ident.Value,
 		Challenge:  chall,
 	}
 	defer func() {
@@ -732,7 +778,7 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 	// was successful or not, and cannot themselves fail.
 	records, err := va.performLocalValidation(
 		ctx,
-		identifier.NewDNS(req.DnsName),
+		ident,
 		req.Authz.RegID,
 		chall.Type,
 		chall.Token,
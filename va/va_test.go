@@ -456,7 +456,7 @@ func TestPerformValidationWithMismatchedRemoteVARIRs(t *testing.T) {
 func TestValidateMalformedChallenge(t *testing.T) {
 	va, _ := setup(nil, "", nil, nil)
 
-	_, err := va.validateChallenge(ctx, dnsi("example.com"), "fake-type-01", expectedToken, expectedKeyAuthorization)
+	_, err := va.validateChallenge(ctx, dnsi("example.com"), 1, "fake-type-01", expectedToken, expectedKeyAuthorization)
 
 	prob := detailedError(err)
 	test.AssertEquals(t, prob.Type, probs.MalformedProblem)
@@ -964,6 +964,21 @@ func TestMultiVA(t *testing.T) {
 						t.Fatalf("Got log %v; expected %q", mockLog.GetAll(), tc.ExpectedLogContains)
 					}
 				}
+
+				if testFunc.name == "DoDCV" {
+					// The primary's result comes first, followed by one result
+					// from each remote perspective if the primary passed.
+					test.Assert(t, len(res.PerspectiveResults) > 0, "expected perspective results")
+					primary := res.PerspectiveResults[0]
+					test.AssertEquals(t, primary.Perspective, PrimaryPerspective)
+					test.AssertEquals(t, primary.Operation, opDCV)
+					if primary.Passed {
+						test.AssertEquals(t, len(res.PerspectiveResults), len(tc.Remotes)+1)
+					} else {
+						test.AssertEquals(t, len(res.PerspectiveResults), 1)
+						test.AssertNotNil(t, primary.Problem, "expected primary problem")
+					}
+				}
 			})
 		}
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/letsencrypt/boulder/probs"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
@@ -47,6 +48,10 @@ type mpicSummary struct {
 	// Perspectives corroborated the determinations made by the Primary Network
 	// Perspective".
 	QuorumResult string `json:"quorumResult"`
+
+	// Perspectives are the detailed results from each remote perspective. They
+	// are not logged, but are returned to the RA for storage by the SA.
+	Perspectives []*corepb.PerspectiveResult `json:"-"`
 }
 
 // summarizeMPIC prepares an *mpicSummary for logging, ensuring there are no nil
@@ -78,101 +83,120 @@ func summarizeMPIC(passed, failed []string, passedRIRSet map[string]struct{}) *m
 }
 
 // doRemoteOperation concurrently calls the provided operation with `req` and a
-// RemoteVA once for each configured RemoteVA. It cancels remaining operations
-// and returns early if either the required number of successful results is
-// obtained or the number of failures exceeds va.maxRemoteFailures.
+// RemoteVA once for each RemoteVA chosen by planRemoteOperation. It cancels
+// remaining operations and returns early if either the required number of
+// successful results is obtained or the number of failures exceeds the number
+// allowed for the chosen RemoteVAs.
 //
-// Internal logic errors are logged. If the number of operation failures exceeds
-// va.maxRemoteFailures, the first encountered problem is returned as a
-// *probs.ProblemDetails.
+// Internal logic errors are logged. If fewer RemoteVAs are configured than
+// minRemotePerspectives requires for the current date, or the number of
+// operation failures exceeds the number allowed, a *probs.ProblemDetails is
+// returned; in the latter case it is the first encountered problem.
 func (va *ValidationAuthorityImpl) doRemoteOperation(ctx context.Context, op remoteOperation, req proto.Message) (*mpicSummary, *probs.ProblemDetails) {
-	remoteVACount := len(va.remoteVAs)
-	//  - Mar 15, 2026: MUST implement using at least 3 perspectives
-	//  - Jun 15, 2026: MUST implement using at least 4 perspectives
-	//  - Dec 15, 2026: MUST implement using at least 5 perspectives
 	// See "Phased Implementation Timeline" in
 	// https://github.com/cabforum/servercert/blob/main/docs/BR.md#3229-multi-perspective-issuance-corroboration
-	if remoteVACount < 3 {
-		return nil, probs.ServerInternal("Insufficient remote perspectives: need at least 3")
+	minPerspectives := minRemotePerspectives(va.clk.Now())
+	if len(va.remoteVAs) < minPerspectives {
+		return nil, probs.ServerInternal(fmt.Sprintf("Insufficient remote perspectives: need at least %d", minPerspectives))
 	}
 
+	plan := va.planRemoteOperation()
+	remoteVACount := len(plan.remotes)
+
 	type response struct {
-		addr        string
-		perspective string
-		rir         string
-		result      remoteResult
-		err         error
+		rva     RemoteVA
+		health  *remoteVAHealth
+		result  remoteResult
+		err     error
+		latency time.Duration
 	}
 
 	subCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	start := va.clk.Now()
 	responses := make(chan *response, remoteVACount)
 	for _, i := range rand.Perm(remoteVACount) {
-		go func(rva RemoteVA) {
+		go func(rva RemoteVA, health *remoteVAHealth) {
 			res, err := op(subCtx, rva, req)
 			if err != nil {
-				responses <- &response{rva.Address, rva.Perspective, rva.RIR, res, err}
+				responses <- &response{rva, health, res, err, va.clk.Since(start)}
 				return
 			}
 			if res.GetPerspective() != rva.Perspective || res.GetRir() != rva.RIR {
 				err = fmt.Errorf(
 					"Expected perspective %q (%q) but got reply from %q (%q) - misconfiguration likely", rva.Perspective, rva.RIR, res.GetPerspective(), res.GetRir(),
 				)
-				responses <- &response{rva.Address, rva.Perspective, rva.RIR, res, err}
+				responses <- &response{rva, health, res, err, va.clk.Since(start)}
 				return
 			}
-			responses <- &response{rva.Address, rva.Perspective, rva.RIR, res, err}
-		}(va.remoteVAs[i])
+			responses <- &response{rva, health, res, err, va.clk.Since(start)}
+		}(plan.remotes[i], plan.health[i])
 	}
 
-	required := remoteVACount - va.maxRemoteFailures
+	required := remoteVACount - plan.maxFailures
 	var passed []string
 	var failed []string
 	var passedRIRs = map[string]struct{}{}
 	var firstProb *probs.ProblemDetails
+	var perspectives []*corepb.PerspectiveResult
 
 	for resp := range responses {
 		var currProb *probs.ProblemDetails
+		outcome := healthSuccess
 
 		if resp.err != nil {
 			// Failed to communicate with the remote VA.
-			failed = append(failed, resp.perspective)
+			failed = append(failed, resp.rva.Perspective)
 
 			if core.IsCanceled(resp.err) {
 				currProb = probs.ServerInternal("Secondary validation RPC canceled")
+				outcome = healthUnknown
 			} else {
-				va.log.Errf("Operation on remote VA (%s) failed: %s", resp.addr, resp.err)
+				va.log.Errf("Operation on remote VA (%s) failed: %s", resp.rva.Address, resp.err)
 				currProb = probs.ServerInternal("Secondary validation RPC failed")
+				outcome = healthFailure
 			}
 		} else if resp.result.GetProblem() != nil {
 			// The remote VA returned a problem.
-			failed = append(failed, resp.perspective)
+			failed = append(failed, resp.rva.Perspective)
 
 			var err error
 			currProb, err = bgrpc.PBToProblemDetails(resp.result.GetProblem())
 			if err != nil {
-				va.log.Errf("Operation on Remote VA (%s) returned malformed problem: %s", resp.addr, err)
+				va.log.Errf("Operation on Remote VA (%s) returned malformed problem: %s", resp.rva.Address, err)
 				currProb = probs.ServerInternal("Secondary validation RPC returned malformed result")
 			}
+			// Problems with the domain being validated say nothing about the
+			// remote VA's health, but internal errors do.
+			if currProb.Type == probs.ServerInternalProblem {
+				outcome = healthFailure
+			}
 		} else {
 			// The remote VA returned a successful result.
-			passed = append(passed, resp.perspective)
-			passedRIRs[resp.rir] = struct{}{}
+			passed = append(passed, resp.rva.Perspective)
+			passedRIRs[resp.rva.RIR] = struct{}{}
 		}
+		va.observeRemoteHealth(resp.rva, resp.health, outcome, resp.latency)
 
 		if firstProb == nil && currProb != nil {
 			// A problem was encountered for the first time.
 			firstProb = currProb
 		}
 
+		var resolvers []string
+		if resp.err == nil {
+			resolvers = remoteResolverAddrs(resp.result)
+		}
+		perspectives = append(perspectives, perspectiveResult(resp.rva.Perspective, resp.rva.RIR, resolvers, resp.latency, currProb))
+
 		// To respond faster, if we get enough successes or too many failures, we cancel remaining RPCs.
 		// Finish the loop to collect remaining responses into `failed` so we can rely on having a response
 		// for every request we made.
 		if len(passed) >= required && len(passedRIRs) >= requiredRIRs {
 			cancel()
 		}
-		if len(failed) > va.maxRemoteFailures {
+		if len(failed) > plan.maxFailures {
 			cancel()
 		}
 
@@ -181,17 +205,99 @@ func (va *ValidationAuthorityImpl) doRemoteOperation(ctx context.Context, op rem
 			break
 		}
 	}
+	summary := summarizeMPIC(passed, failed, passedRIRs)
+	summary.Perspectives = perspectives
 	if len(passed) >= required && len(passedRIRs) >= requiredRIRs {
-		return summarizeMPIC(passed, failed, passedRIRs), nil
+		return summary, nil
 	}
 	if firstProb == nil {
 		// This should never happen. If we didn't meet the thresholds above we
 		// should have seen at least one error.
-		return summarizeMPIC(passed, failed, passedRIRs), probs.ServerInternal(
+		return summary, probs.ServerInternal(
 			"During secondary validation: validation failed but the problem is unavailable")
 	}
 	firstProb.Detail = fmt.Sprintf("During secondary validation: %s", firstProb.Detail)
-	return summarizeMPIC(passed, failed, passedRIRs), firstProb
+	return summary, firstProb
+}
+
+// remoteResolverAddrs returns the addresses of the DNS resolvers used by a
+// remote VA to produce the given result.
+func remoteResolverAddrs(res remoteResult) []string {
+	var resolvers []string
+	switch res := res.(type) {
+	case *vapb.ValidationResult:
+		for _, record := range res.GetRecords() {
+			for _, addr := range record.ResolverAddrs {
+				if !slices.Contains(resolvers, addr) {
+					resolvers = append(resolvers, addr)
+				}
+			}
+		}
+	case *vapb.IsCAAValidResponse:
+		resolvers = res.GetResolverAddrs()
+	}
+	return resolvers
+}
+
+// perspectiveResult summarizes the outcome of a DCV or CAA check performed by a
+// single perspective. A nil prob indicates that the check passed.
+func perspectiveResult(perspective, rir string, resolvers []string, latency time.Duration, prob *probs.ProblemDetails) *corepb.PerspectiveResult {
+	var probPB *corepb.ProblemDetails
+	if prob != nil {
+		// The problem has already been converted from protobuf or constructed
+		// by us, so this cannot fail.
+		probPB, _ = bgrpc.ProblemDetailsToPB(filterProblemDetails(prob))
+	}
+	return &corepb.PerspectiveResult{
+		Perspective:   perspective,
+		Rir:           rir,
+		Passed:        prob == nil,
+		ResolverAddrs: resolvers,
+		Latency:       durationpb.New(latency),
+		Problem:       probPB,
+	}
+}
+
+// mpicResults returns the results of a DCV or CAA check from every perspective,
+// starting with the primary, for storage by the SA. The summary is nil if no
+// remote checks were performed. On remote VAs, it returns nil.
+func (va *ValidationAuthorityImpl) mpicResults(op string, resolvers []string, localLatency time.Duration, localProb *probs.ProblemDetails, summary *mpicSummary) []*corepb.PerspectiveResult {
+	if !va.isPrimaryVA() {
+		return nil
+	}
+	results := []*corepb.PerspectiveResult{perspectiveResult(va.perspective, va.rir, resolvers, localLatency, localProb)}
+	if summary != nil {
+		results = append(results, summary.Perspectives...)
+	}
+	for _, res := range results {
+		res.Operation = op
+	}
+	return results
+}
+
+// recordResolverAddrs returns the addresses of the DNS resolvers used to
+// produce the given validation records.
+func recordResolverAddrs(records []core.ValidationRecord) []string {
+	var resolvers []string
+	for _, record := range records {
+		for _, addr := range record.ResolverAddrs {
+			if !slices.Contains(resolvers, addr) {
+				resolvers = append(resolvers, addr)
+			}
+		}
+	}
+	return resolvers
+}
+
+// validationResultToPB is like bgrpc.ValidationResultToPB, but also includes
+// the given per-perspective results.
+func (va *ValidationAuthorityImpl) validationResultToPB(records []core.ValidationRecord, prob *probs.ProblemDetails, perspectives []*corepb.PerspectiveResult) (*vapb.ValidationResult, error) {
+	res, err := bgrpc.ValidationResultToPB(records, filterProblemDetails(prob), va.perspective, va.rir)
+	if err != nil {
+		return nil, err
+	}
+	res.PerspectiveResults = perspectives
+	return res, nil
 }
 
 // validationLogEvent is a struct that contains the information needed to log
@@ -218,7 +324,11 @@ type validationLogEvent struct {
 // implements the DCV portion of Multi-Perspective Issuance Corroboration as
 // defined in BRs Sections 3.2.2.9 and 5.4.1.
 func (va *ValidationAuthorityImpl) DoDCV(ctx context.Context, req *vapb.PerformValidationRequest) (*vapb.ValidationResult, error) {
-	if core.IsAnyNilOrZero(req, req.DnsName, req.Challenge, req.Authz, req.ExpectedKeyAuthorization) {
+	if core.IsAnyNilOrZero(req, req.Challenge, req.Authz, req.ExpectedKeyAuthorization) {
+		return nil, berrors.InternalServerError("Incomplete validation request")
+	}
+	ident := identifier.FromProtoWithDefault(req.Identifier, req.DnsName)
+	if ident.Value == "" {
 		return nil, berrors.InternalServerError("Incomplete validation request")
 	}
 
@@ -242,7 +352,7 @@ func (va *ValidationAuthorityImpl) DoDCV(ctx context.Context, req *vapb.PerformV
 	logEvent := validationLogEvent{
 		AuthzID:    req.Authz.Id,
 		Requester:  req.Authz.RegID,
-		Identifier: req.DnsName,
+		Identifier: ident.Value,
 		Challenge:  chall,
 	}
 	defer func() {
@@ -276,7 +386,8 @@ func (va *ValidationAuthorityImpl) DoDCV(ctx context.Context, req *vapb.PerformV
 	// was successful or not, and cannot themselves fail.
 	records, err := va.validateChallenge(
 		ctx,
-		identifier.NewDNS(req.DnsName),
+		ident,
+		req.Authz.RegID,
 		chall.Type,
 		chall.Token,
 		req.ExpectedKeyAuthorization,
@@ -294,7 +405,7 @@ func (va *ValidationAuthorityImpl) DoDCV(ctx context.Context, req *vapb.PerformV
 	if err != nil {
 		logEvent.InternalError = err.Error()
 		prob = detailedError(err)
-		return bgrpc.ValidationResultToPB(records, filterProblemDetails(prob), va.perspective, va.rir)
+		return va.validationResultToPB(records, prob, va.mpicResults(opDCV, recordResolverAddrs(records), localLatency, prob, nil))
 	}
 
 	if va.isPrimaryVA() {
@@ -312,7 +423,7 @@ func (va *ValidationAuthorityImpl) DoDCV(ctx context.Context, req *vapb.PerformV
 		}
 		summary, prob = va.doRemoteOperation(ctx, op, req)
 	}
-	return bgrpc.ValidationResultToPB(records, filterProblemDetails(prob), va.perspective, va.rir)
+	return va.validationResultToPB(records, prob, va.mpicResults(opDCV, recordResolverAddrs(records), localLatency, nil, summary))
 }
 
 // DoCAA conducts a CAA check for the specified dnsName. When invoked on the
@@ -377,7 +488,7 @@ func (va *ValidationAuthorityImpl) DoCAA(ctx context.Context, req *vapb.IsCAAVal
 		va.log.AuditObject("CAA check result", logEvent)
 	}()
 
-	internalErr = va.checkCAA(ctx, acmeID, params)
+	resolvers, internalErr := va.checkCAA(ctx, acmeID, params)
 
 	// Stop the clock for local check latency.
 	localLatency = va.clk.Since(start)
@@ -387,6 +498,7 @@ func (va *ValidationAuthorityImpl) DoCAA(ctx context.Context, req *vapb.IsCAAVal
 		prob = detailedError(internalErr)
 		prob.Detail = fmt.Sprintf("While processing CAA for %s: %s", req.Domain, prob.Detail)
 	}
+	localProb := prob
 
 	if va.isPrimaryVA() {
 		op := func(ctx context.Context, remoteva RemoteVA, req proto.Message) (remoteResult, error) {
@@ -416,13 +528,17 @@ func (va *ValidationAuthorityImpl) DoCAA(ctx context.Context, req *vapb.IsCAAVal
 				ProblemType: string(prob.Type),
 				Detail:      replaceInvalidUTF8([]byte(prob.Detail)),
 			},
-			Perspective: va.perspective,
-			Rir:         va.rir,
+			Perspective:        va.perspective,
+			Rir:                va.rir,
+			ResolverAddrs:      resolvers,
+			PerspectiveResults: va.mpicResults(opCAA, resolvers, localLatency, localProb, summary),
 		}, nil
 	} else {
 		return &vapb.IsCAAValidResponse{
-			Perspective: va.perspective,
-			Rir:         va.rir,
+			Perspective:        va.perspective,
+			Rir:                va.rir,
+			ResolverAddrs:      resolvers,
+			PerspectiveResults: va.mpicResults(opCAA, resolvers, localLatency, localProb, summary),
 		}, nil
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"encoding/json"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/letsencrypt/boulder/features"
+	"github.com/letsencrypt/boulder/goodkey"
 	blog "github.com/letsencrypt/boulder/log"
 )
 
@@ -57,6 +59,9 @@ type RequestEvent struct {
 	// The set of DNS names, if there are potentially multiple relevant
 	// names, for instance in a new-order, finalize, or revoke request.
 	DNSNames []string `json:",omitempty"`
+	// The set of IP addresses, if there are potentially multiple relevant
+	// addresses, for instance in a new-order or finalize request.
+	IPAddresses []string `json:",omitempty"`
 
 	// For challenge POSTs, the challenge type.
 	ChallengeType string `json:",omitempty"`
@@ -203,6 +208,11 @@ func KeyTypeToString(pub crypto.PublicKey) string {
 		return fmt.Sprintf("RSA %d", pk.N.BitLen())
 	case *ecdsa.PublicKey:
 		return fmt.Sprintf("ECDSA %s", pk.Params().Name)
+	case ed25519.PublicKey:
+		return "Ed25519"
+	}
+	if goodkey.IsMLDSA(pub) {
+		return goodkey.Describe(pub)
 	}
 	return "unknown"
 }
@@ -837,3 +837,76 @@ func (wfe *WebFrontEndImpl) validKeyRollover(
 		NewKey: *innerJWK,
 	}, nil
 }
+
+// eabAlgorithms are the MAC algorithms accepted for an External Account
+// Binding's inner JWS, per RFC 8555 Section 7.3.4.
+var eabAlgorithms = []jose.SignatureAlgorithm{jose.HS256, jose.HS384, jose.HS512}
+
+// validExternalAccountBinding verifies the "externalAccountBinding" field of a
+// newAccount request, as described in RFC 8555 Section 7.3.4. The binding is a
+// JWS, MAC'd with a key provisioned out-of-band, whose payload is the account
+// key used to sign the outer JWS. If the binding is valid the key ID of the
+// external account is returned, otherwise a problem is returned.
+func (wfe *WebFrontEndImpl) validExternalAccountBinding(
+	ctx context.Context,
+	eab json.RawMessage,
+	accountKey *jose.JSONWebKey,
+	request *http.Request) (string, *probs.ProblemDetails) {
+	eabJWS, err := jose.ParseSigned(string(eab), eabAlgorithms)
+	if err != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABParseError"}).Inc()
+		return "", probs.Malformed("Unable to parse externalAccountBinding JWS: %s", err)
+	}
+	if len(eabJWS.Signatures) != 1 {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABTooManySignatures"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS must have exactly one signature")
+	}
+	header := eabJWS.Signatures[0].Protected
+	if header.KeyID == "" {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABMissingKeyID"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS header parameter 'kid' required")
+	}
+	if header.Nonce != "" {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABUnexpectedNonce"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS header parameter 'nonce' must not be present")
+	}
+	prob := wfe.validPOSTURL(request, header)
+	if prob != nil {
+		return "", prob
+	}
+
+	eak, err := wfe.sa.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: header.KeyID})
+	if err != nil {
+		if errors.Is(err, berrors.NotFound) {
+			wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyIDNotFound"}).Inc()
+			return "", probs.Unauthorized(fmt.Sprintf("External account key %q not found", header.KeyID))
+		}
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyIDLookupFailed"}).Inc()
+		return "", web.ProblemDetailsForError(err, "Error retrieving external account key")
+	}
+	if eak.RevokedAt != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyRevoked"}).Inc()
+		return "", probs.Unauthorized(fmt.Sprintf("External account key %q has been revoked", header.KeyID))
+	}
+
+	payload, err := eabJWS.Verify(eak.HmacKey)
+	if err != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABVerifyFailed"}).Inc()
+		return "", probs.Unauthorized("externalAccountBinding JWS verification error")
+	}
+
+	var boundKey jose.JSONWebKey
+	err = json.Unmarshal(payload, &boundKey)
+	if err != nil || boundKey.Key == nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABPayloadUnmarshalFailed"}).Inc()
+		return "", probs.Malformed("externalAccountBinding JWS payload did not parse as a JWK")
+	}
+	if keysEqual, err := core.PublicKeysEqual(boundKey.Key, accountKey.Key); err != nil {
+		return "", probs.Malformed("Unable to compare externalAccountBinding and account keys: %s", err.Error())
+	} else if !keysEqual {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABWrongAccountKey"}).Inc()
+		return "", probs.Unauthorized("externalAccountBinding JWS payload does not match the account key")
+	}
+
+	return header.KeyID, nil
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rsa"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -1773,3 +1774,164 @@ func TestMatchJWSURLs(t *testing.T) {
 		})
 	}
 }
+
+// externalAccountBinding creates an RFC 8555 Section 7.3.4 External Account
+// Binding JWS, MAC'd with the provided HMAC key and carrying the provided
+// account key as its payload. If nonce is true a nonce header is included,
+// which a valid binding must not have. The JWS in serialized string form is
+// returned.
+func (rs requestSigner) externalAccountBinding(
+	keyID string,
+	hmacKey []byte,
+	accountKey *jose.JSONWebKey,
+	url string,
+	nonce bool) string {
+	opts := &jose.SignerOptions{}
+	opts.WithHeader("kid", keyID)
+	opts.WithHeader("url", url)
+	if nonce {
+		opts.NonceSource = rs.nonceService
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: hmacKey}, opts)
+	test.AssertNotError(rs.t, err, "Failed to make EAB signer")
+
+	payload, err := accountKey.MarshalJSON()
+	test.AssertNotError(rs.t, err, "Failed to marshal EAB payload")
+
+	jws, err := signer.Sign(payload)
+	test.AssertNotError(rs.t, err, "Failed to sign EAB")
+
+	return jws.FullSerialize()
+}
+
+func TestValidExternalAccountBinding(t *testing.T) {
+	wfe, _, signer := setupWFE(t)
+
+	eabKey := []byte("this is an external account key.")
+	wrongKey := []byte("this is not the right HMAC key!!")
+	url := "http://localhost/acme/new-acct"
+
+	accountKey := loadKey(t, []byte(test2KeyPrivatePEM))
+	accountJWK := &jose.JSONWebKey{Key: accountKey.Public()}
+	otherKey := loadKey(t, []byte(test1KeyPrivatePEM))
+	otherJWK := &jose.JSONWebKey{Key: otherKey.Public()}
+
+	testCases := []struct {
+		Name            string
+		EAB             string
+		ExpectedKeyID   string
+		ExpectedProblem *probs.ProblemDetails
+		ErrorStatType   string
+	}{
+		{
+			Name:          "Valid binding",
+			EAB:           signer.externalAccountBinding("eab-key", eabKey, accountJWK, url, false),
+			ExpectedKeyID: "eab-key",
+		},
+		{
+			Name: "Not a JWS",
+			EAB:  `"foo"`,
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.MalformedProblem,
+				HTTPStatus: http.StatusBadRequest,
+			},
+			ErrorStatType: "EABParseError",
+		},
+		{
+			Name: "Missing key ID",
+			EAB:  signer.externalAccountBinding("", eabKey, accountJWK, url, false),
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.MalformedProblem,
+				Detail:     "externalAccountBinding JWS header parameter 'kid' required",
+				HTTPStatus: http.StatusBadRequest,
+			},
+			ErrorStatType: "EABMissingKeyID",
+		},
+		{
+			Name: "Nonce present",
+			EAB:  signer.externalAccountBinding("eab-key", eabKey, accountJWK, url, true),
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.MalformedProblem,
+				Detail:     "externalAccountBinding JWS header parameter 'nonce' must not be present",
+				HTTPStatus: http.StatusBadRequest,
+			},
+			ErrorStatType: "EABUnexpectedNonce",
+		},
+		{
+			Name: "Wrong URL",
+			EAB:  signer.externalAccountBinding("eab-key", eabKey, accountJWK, "http://localhost/wrong", false),
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.MalformedProblem,
+				Detail:     `JWS header parameter 'url' incorrect. Expected "http://localhost/acme/new-acct" got "http://localhost/wrong"`,
+				HTTPStatus: http.StatusBadRequest,
+			},
+			ErrorStatType: "JWSMismatchedURL",
+		},
+		{
+			Name: "Unknown key ID",
+			EAB:  signer.externalAccountBinding("unknown-key", eabKey, accountJWK, url, false),
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.UnauthorizedProblem,
+				Detail:     `External account key "unknown-key" not found`,
+				HTTPStatus: http.StatusForbidden,
+			},
+			ErrorStatType: "EABKeyIDNotFound",
+		},
+		{
+			Name: "Revoked key ID",
+			EAB:  signer.externalAccountBinding("eab-revoked-key", eabKey, accountJWK, url, false),
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.UnauthorizedProblem,
+				Detail:     `External account key "eab-revoked-key" has been revoked`,
+				HTTPStatus: http.StatusForbidden,
+			},
+			ErrorStatType: "EABKeyRevoked",
+		},
+		{
+			Name: "Wrong HMAC key",
+			EAB:  signer.externalAccountBinding("eab-key", wrongKey, accountJWK, url, false),
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.UnauthorizedProblem,
+				Detail:     "externalAccountBinding JWS verification error",
+				HTTPStatus: http.StatusForbidden,
+			},
+			ErrorStatType: "EABVerifyFailed",
+		},
+		{
+			Name: "Wrong account key",
+			EAB:  signer.externalAccountBinding("eab-key", eabKey, otherJWK, url, false),
+			ExpectedProblem: &probs.ProblemDetails{
+				Type:       probs.UnauthorizedProblem,
+				Detail:     "externalAccountBinding JWS payload does not match the account key",
+				HTTPStatus: http.StatusForbidden,
+			},
+			ErrorStatType: "EABWrongAccountKey",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			wfe.stats.joseErrorCount.Reset()
+			request := makePostRequestWithPath(newAcctPath, "")
+			keyID, prob := wfe.validExternalAccountBinding(context.Background(), json.RawMessage(tc.EAB), accountJWK, request)
+			if tc.ExpectedProblem == nil {
+				if prob != nil {
+					t.Fatalf("Expected nil problem, got %#v\n", prob)
+				}
+				test.AssertEquals(t, keyID, tc.ExpectedKeyID)
+			} else {
+				test.AssertNotNil(t, prob, "Expected a problem, got nil")
+				test.AssertEquals(t, prob.Type, tc.ExpectedProblem.Type)
+				test.AssertEquals(t, prob.HTTPStatus, tc.ExpectedProblem.HTTPStatus)
+				if tc.ExpectedProblem.Detail != "" {
+					test.AssertEquals(t, prob.Detail, tc.ExpectedProblem.Detail)
+				}
+			}
+			if tc.ErrorStatType != "" {
+				test.AssertMetricWithLabelsEquals(
+					t, wfe.stats.joseErrorCount, prometheus.Labels{"type": tc.ErrorStatType}, 1)
+			}
+		})
+	}
+}
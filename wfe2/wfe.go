@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/letsencrypt/boulder/ari"
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	berrors "github.com/letsencrypt/boulder/errors"
@@ -77,8 +78,15 @@ const (
 	getChallengePath = getAPIPrefix + "chall-v3/"
 	getCertPath      = getAPIPrefix + "cert/"
 
+	// Endpoint for RFC 9773 ACME Renewal Information
+	renewalInfoPath = "/acme/renewal-info/"
+
 	// Draft or likely-to-change paths
-	renewalInfoPath = "/draft-ietf-acme-ari-03/renewalInfo/"
+
+	// draftRenewalInfoPath is the renewalInfo path from draft-ietf-acme-ari-03.
+	// It is no longer advertised in the directory, but remains routed for
+	// clients which cached it.
+	draftRenewalInfoPath = "/draft-ietf-acme-ari-03/renewalInfo/"
 )
 
 const (
@@ -139,6 +147,12 @@ type WebFrontEndImpl struct {
 	// "website" field.
 	DirectoryWebsite string
 
+	// RequireExternalAccountBinding, if true, causes new account requests which
+	// do not include an RFC 8555 Section 7.3.4 "externalAccountBinding" to be
+	// rejected, and is advertised in the /directory response's "meta" element's
+	// "externalAccountRequired" field.
+	RequireExternalAccountBinding bool
+
 	// Allowed prefix for legacy accounts used by verify.go's `lookupJWK`.
 	// See `cmd/boulder-wfe2/main.go`'s comment on the configuration field
 	// `LegacyKeyIDPrefix` for more information.
@@ -177,6 +191,11 @@ type WebFrontEndImpl struct {
 	// descriptions (perhaps including URLs) of those profiles. NewOrder
 	// Requests with a profile name not present in this map will be rejected.
 	certProfiles map[string]string
+
+	// renewalInfoPolicy determines the suggested renewal windows served by
+	// the renewalInfo endpoint and used to exempt replacement orders from
+	// rate limits.
+	renewalInfoPolicy *ari.Policy
 }
 
 // NewWebFrontEndImpl constructs a web service for Boulder
@@ -204,6 +223,7 @@ func NewWebFrontEndImpl(
 	unpauseSigner unpause.JWTSigner,
 	unpauseJWTLifetime time.Duration,
 	unpauseURL string,
+	renewalInfoPolicy *ari.Policy,
 ) (WebFrontEndImpl, error) {
 	if len(issuerCertificates) == 0 {
 		return WebFrontEndImpl{}, errors.New("must provide at least one issuer certificate")
@@ -221,6 +241,10 @@ func NewWebFrontEndImpl(
 		return WebFrontEndImpl{}, errors.New("must provide a service for nonce redemption")
 	}
 
+	if renewalInfoPolicy == nil {
+		return WebFrontEndImpl{}, errors.New("must provide a renewal info policy")
+	}
+
 	wfe := WebFrontEndImpl{
 		log:                          logger,
 		clk:                          clk,
@@ -245,6 +269,7 @@ func NewWebFrontEndImpl(
 		unpauseSigner:                unpauseSigner,
 		unpauseJWTLifetime:           unpauseJWTLifetime,
 		unpauseURL:                   unpauseURL,
+		renewalInfoPolicy:            renewalInfoPolicy,
 	}
 
 	return wfe, nil
@@ -446,9 +471,10 @@ func (wfe *WebFrontEndImpl) Handler(stats prometheus.Registerer, oTelHTTPOptions
 	wfe.HandleFunc(m, getChallengePath, wfe.DeprecatedChallengeHandler, "GET")
 	wfe.HandleFunc(m, getCertPath, wfe.Certificate, "GET")
 
-	// Endpoint for draft-ietf-acme-ari
+	// Endpoints for RFC 9773 ACME Renewal Information
 	if features.Get().ServeRenewalInfo {
 		wfe.HandleFunc(m, renewalInfoPath, wfe.RenewalInfo, "GET", "POST")
+		wfe.HandleFunc(m, draftRenewalInfoPath, wfe.RenewalInfo, "GET", "POST")
 	}
 
 	// We don't use our special HandleFunc for "/" because it matches everything,
@@ -568,6 +594,9 @@ func (wfe *WebFrontEndImpl) Directory(
 	if wfe.DirectoryWebsite != "" {
 		metaMap["website"] = wfe.DirectoryWebsite
 	}
+	if wfe.RequireExternalAccountBinding {
+		metaMap["externalAccountRequired"] = true
+	}
 	directoryEndpoints["meta"] = metaMap
 
 	response.Header().Set("Content-Type", "application/json")
@@ -696,6 +725,9 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		Contact              *[]string `json:"contact"`
 		TermsOfServiceAgreed bool      `json:"termsOfServiceAgreed"`
 		OnlyReturnExisting   bool      `json:"onlyReturnExisting"`
+		// ExternalAccountBinding is left as raw JSON so that it can be parsed
+		// and verified as a JWS by validExternalAccountBinding.
+		ExternalAccountBinding json.RawMessage `json:"externalAccountBinding"`
 	}
 
 	err := json.Unmarshal(body, &accountCreateRequest)
@@ -763,6 +795,19 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		return
 	}
 
+	var externalAccountID string
+	if len(accountCreateRequest.ExternalAccountBinding) != 0 {
+		externalAccountID, prob = wfe.validExternalAccountBinding(ctx, accountCreateRequest.ExternalAccountBinding, key, request)
+		if prob != nil {
+			wfe.sendError(response, logEvent, prob, nil)
+			return
+		}
+	} else if wfe.RequireExternalAccountBinding {
+		wfe.sendError(response, logEvent, probs.ExternalAccountRequired(
+			"this server requires an externalAccountBinding to create new accounts"), nil)
+		return
+	}
+
 	ip, err := extractRequesterIP(request)
 	if err != nil {
 		wfe.sendError(
@@ -789,7 +834,8 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		Key:             keyBytes,
 		// TODO(#7671): This must remain until InitialIP is removed from
 		// corepb.Registration.
-		InitialIP: net.ParseIP("0.0.0.0").To16(),
+		InitialIP:         net.ParseIP("0.0.0.0").To16(),
+		ExternalAccountID: externalAccountID,
 	}
 
 	refundLimits, err := wfe.checkNewAccountLimits(ctx, ip)
@@ -1145,7 +1191,7 @@ func (wfe *WebFrontEndImpl) Challenge(
 
 	// Ensure gRPC response is complete.
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
-	if authzPB.Id == "" || authzPB.DnsName == "" || authzPB.Status == "" || core.IsAnyNilOrZero(authzPB.Expires) {
+	if authzPB.Id == "" || identifier.FromProtoWithDefault(authzPB.Identifier, authzPB.DnsName).Value == "" || authzPB.Status == "" || core.IsAnyNilOrZero(authzPB.Expires) {
 		wfe.sendError(response, logEvent, probs.ServerInternal("Problem getting authorization"), errIncompleteGRPCResponse)
 		return
 	}
@@ -1239,6 +1285,12 @@ func (wfe *WebFrontEndImpl) prepChallengeForDisplay(
 	for idx := range challenge.ValidationRecord {
 		challenge.ValidationRecord[idx].ResolverAddrs = nil
 	}
+
+	// A DNS-PERSIST-01 record must name one of the CA's issuer domain names,
+	// which are the same as those which the VA accepts in CAA records.
+	if challenge.Type == core.ChallengeTypeDNSPersist01 && wfe.DirectoryCAAIdentity != "" {
+		challenge.IssuerDomainNames = []string{wfe.DirectoryCAAIdentity}
+	}
 }
 
 // prepAuthorizationForDisplay takes a core.Authorization and prepares it for
@@ -1354,7 +1406,7 @@ func (wfe *WebFrontEndImpl) postChallenge(
 			ChallengeIndex: int64(challengeIndex),
 		})
 		// TODO(#7153): Check each value via core.IsAnyNilOrZero
-		if err != nil || authzPB == nil || authzPB.Id == "" || authzPB.DnsName == "" || authzPB.Status == "" || core.IsAnyNilOrZero(authzPB.Expires) {
+		if err != nil || authzPB == nil || authzPB.Id == "" || identifier.FromProtoWithDefault(authzPB.Identifier, authzPB.DnsName).Value == "" || authzPB.Status == "" || core.IsAnyNilOrZero(authzPB.Expires) {
 			wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Unable to update challenge"), err)
 			return
 		}
@@ -1602,13 +1654,14 @@ func (wfe *WebFrontEndImpl) Authorization(
 
 	// Ensure gRPC response is complete.
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
-	if authzPB.Id == "" || authzPB.DnsName == "" || authzPB.Status == "" || core.IsAnyNilOrZero(authzPB.Expires) {
+	if authzPB.Id == "" || identifier.FromProtoWithDefault(authzPB.Identifier, authzPB.DnsName).Value == "" || authzPB.Status == "" || core.IsAnyNilOrZero(authzPB.Expires) {
 		wfe.sendError(response, logEvent, probs.ServerInternal("Problem getting authorization"), errIncompleteGRPCResponse)
 		return
 	}
 
-	if identifier.IdentifierType(authzPB.DnsName) == identifier.TypeDNS {
-		logEvent.DNSName = authzPB.DnsName
+	ident := identifier.FromProtoWithDefault(authzPB.Identifier, authzPB.DnsName)
+	if ident.Type == identifier.TypeDNS {
+		logEvent.DNSName = ident.Value
 	}
 	logEvent.Status = authzPB.Status
 
@@ -2016,14 +2069,12 @@ type orderJSON struct {
 }
 
 // orderToOrderJSON converts a *corepb.Order instance into an orderJSON struct
-// that is returned in HTTP API responses. It will convert the order names to
-// DNS type identifiers and additionally create absolute URLs for the finalize
-// URL and the certificate URL as appropriate.
+// that is returned in HTTP API responses. It will convert the order's legacy
+// names to DNS type identifiers if no identifiers are present, and additionally
+// create absolute URLs for the finalize URL and the certificate URL as
+// appropriate.
 func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corepb.Order) orderJSON {
-	idents := make([]identifier.ACMEIdentifier, len(order.DnsNames))
-	for i, name := range order.DnsNames {
-		idents[i] = identifier.NewDNS(name)
-	}
+	idents := identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames)
 	finalizeURL := web.RelativeEndpoint(request,
 		fmt.Sprintf("%s%d/%d", finalizeOrderPath, order.RegistrationID, order.Id))
 	respObj := orderJSON{
@@ -2092,8 +2143,8 @@ func (wfe *WebFrontEndImpl) checkNewOrderLimits(ctx context.Context, regId int64
 // as identified by the provided ARI CertID. This function ensures that:
 //   - the certificate being replaced exists,
 //   - the requesting account owns that certificate, and
-//   - a name in this new order matches a name in the certificate being
-//     replaced.
+//   - an identifier in this new order matches a DNS name or IP address in the
+//     certificate being replaced.
 func (wfe *WebFrontEndImpl) orderMatchesReplacement(ctx context.Context, acct *core.Registration, names []string, serial string) error {
 	// It's okay to use GetCertificate (vs trying to get a precertificate),
 	// because we don't intend to serve ARI for certs that never made it past
@@ -2116,8 +2167,10 @@ func (wfe *WebFrontEndImpl) orderMatchesReplacement(ctx context.Context, acct *c
 
 	var nameMatch bool
 	for _, name := range names {
+		// VerifyHostname matches IP address values against the IP address
+		// SANs of the certificate, and everything else against its DNS SANs.
 		if parsedCert.VerifyHostname(name) == nil {
-			// At least one name in the new order matches a name in the
+			// At least one identifier in the new order matches a name in the
 			// predecessor certificate.
 			nameMatch = true
 			break
@@ -2129,51 +2182,7 @@ func (wfe *WebFrontEndImpl) orderMatchesReplacement(ctx context.Context, acct *c
 	return nil
 }
 
-func (wfe *WebFrontEndImpl) determineARIWindow(ctx context.Context, serial string) (core.RenewalInfo, error) {
-	// Check if the serial is impacted by an incident.
-	result, err := wfe.sa.IncidentsForSerial(ctx, &sapb.Serial{Serial: serial})
-	if err != nil {
-		return core.RenewalInfo{}, fmt.Errorf("checking if existing certificate is impacted by an incident: %w", err)
-	}
-
-	if len(result.Incidents) > 0 {
-		// Find the earliest incident.
-		var earliest *sapb.Incident
-		for _, incident := range result.Incidents {
-			if earliest == nil || incident.RenewBy.AsTime().Before(earliest.RenewBy.AsTime()) {
-				earliest = incident
-			}
-		}
-		// The existing cert is impacted by an incident, renew immediately.
-		return core.RenewalInfoImmediate(wfe.clk.Now(), earliest.Url), nil
-	}
-
-	// Check if the serial is revoked.
-	status, err := wfe.sa.GetCertificateStatus(ctx, &sapb.Serial{Serial: serial})
-	if err != nil {
-		return core.RenewalInfo{}, fmt.Errorf("checking if existing certificate has been revoked: %w", err)
-	}
-
-	if status.Status == string(core.OCSPStatusRevoked) {
-		// The existing certificate is revoked, renew immediately.
-		return core.RenewalInfoImmediate(wfe.clk.Now(), ""), nil
-	}
-
-	// It's okay to use GetCertificate (vs trying to get a precertificate),
-	// because we don't intend to serve ARI for certs that never made it past
-	// the precert stage.
-	cert, err := wfe.sa.GetCertificate(ctx, &sapb.Serial{Serial: serial})
-	if err != nil {
-		if errors.Is(err, berrors.NotFound) {
-			return core.RenewalInfo{}, err
-		}
-		return core.RenewalInfo{}, fmt.Errorf("failed to retrieve existing certificate: %w", err)
-	}
-
-	return core.RenewalInfoSimple(cert.Issued.AsTime(), cert.Expires.AsTime()), nil
-}
-
-// validateReplacementOrder implements draft-ietf-acme-ari-03. For a new order
+// validateReplacementOrder implements RFC 9773 Section 5. For a new order
 // to be considered a replacement for an existing certificate, the existing
 // certificate:
 //  1. MUST NOT have been replaced by another finalized order,
@@ -2224,7 +2233,7 @@ func (wfe *WebFrontEndImpl) validateReplacementOrder(ctx context.Context, acct *
 
 	// For an order to be exempt from rate limits, it must be a replacement
 	// and the request must be made within the suggested renewal window.
-	renewalInfo, err := wfe.determineARIWindow(ctx, replaces)
+	renewalInfo, err := wfe.renewalInfoPolicy.RenewalInfo(ctx, wfe.sa, replaces)
 	if err != nil {
 		return "", false, fmt.Errorf("while determining the current ARI renewal window: %w", err)
 	}
@@ -2245,19 +2254,11 @@ func (wfe *WebFrontEndImpl) validateCertificateProfileName(profile string) error
 	return nil
 }
 
-func (wfe *WebFrontEndImpl) checkIdentifiersPaused(ctx context.Context, orderIdentifiers []identifier.ACMEIdentifier, regID int64) ([]string, error) {
-	uniqueOrderIdentifiers := core.NormalizeIdentifiers(orderIdentifiers)
-	var identifiers []*corepb.Identifier
-	for _, ident := range uniqueOrderIdentifiers {
-		identifiers = append(identifiers, &corepb.Identifier{
-			Type:  string(ident.Type),
-			Value: ident.Value,
-		})
-	}
-
+func (wfe *WebFrontEndImpl) checkIdentifiersPaused(ctx context.Context, orderIdentifiers identifier.ACMEIdentifiers, regID int64) ([]string, error) {
+	uniqueOrderIdentifiers := identifier.Normalize(orderIdentifiers)
 	paused, err := wfe.sa.CheckIdentifiersPaused(ctx, &sapb.PauseRequest{
 		RegistrationID: regID,
-		Identifiers:    identifiers,
+		Identifiers:    uniqueOrderIdentifiers.ToProtoSlice(),
 	})
 	if err != nil {
 		return nil, err
@@ -2318,41 +2319,46 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		return
 	}
 
-	// Collect up all of the DNS identifier values into a []string for
-	// subsequent layers to process. We reject anything with a non-DNS
-	// type identifier here. Check to make sure one of the strings is
-	// short enough to meet the max CN bytes requirement.
-	names := make([]string, len(newOrderRequest.Identifiers))
-	for i, ident := range newOrderRequest.Identifiers {
-		if ident.Type != identifier.TypeDNS {
+	// Reject anything with an identifier type we don't support, or an empty
+	// identifier value, before normalizing the identifiers for subsequent
+	// layers to process.
+	for _, ident := range newOrderRequest.Identifiers {
+		if ident.Type != identifier.TypeDNS && ident.Type != identifier.TypeIP {
 			wfe.sendError(response, logEvent,
-				probs.UnsupportedIdentifier("NewOrder request included invalid non-DNS type identifier: type %q, value %q",
+				probs.UnsupportedIdentifier("NewOrder request included unsupported identifier: type %q, value %q",
 					ident.Type, ident.Value),
 				nil)
 			return
 		}
 		if ident.Value == "" {
-			wfe.sendError(response, logEvent, probs.Malformed("NewOrder request included empty domain name"), nil)
+			wfe.sendError(response, logEvent, probs.Malformed("NewOrder request included empty identifier"), nil)
 			return
 		}
-		names[i] = ident.Value
 	}
 
-	names = core.UniqueLowerNames(names)
-	err = policy.WellFormedDomainNames(names)
+	idents := identifier.Normalize(newOrderRequest.Identifiers)
+	err = policy.WellFormedIdentifiers(idents)
 	if err != nil {
 		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Invalid identifiers requested"), nil)
 		return
 	}
-	if len(names) > wfe.maxNames {
-		wfe.sendError(response, logEvent, probs.Malformed("Order cannot contain more than %d DNS names", wfe.maxNames), nil)
+	if len(idents) > wfe.maxNames {
+		wfe.sendError(response, logEvent, probs.Malformed("Order cannot contain more than %d identifiers", wfe.maxNames), nil)
 		return
 	}
 
-	logEvent.DNSNames = names
+	// names contains the values of all identifiers, DNS names and IP addresses
+	// alike, for the purposes of renewal detection and rate limiting.
+	names := idents.ToValues()
+	logEvent.DNSNames = idents.DNSNames()
+	for _, ident := range idents {
+		if ident.Type == identifier.TypeIP {
+			logEvent.IPAddresses = append(logEvent.IPAddresses, ident.Value)
+		}
+	}
 
 	if features.Get().CheckIdentifiersPaused {
-		pausedValues, err := wfe.checkIdentifiersPaused(ctx, newOrderRequest.Identifiers, acct.ID)
+		pausedValues, err := wfe.checkIdentifiersPaused(ctx, idents, acct.ID)
 		if err != nil {
 			wfe.sendError(response, logEvent, probs.ServerInternal("Failure while checking pause status of identifiers"), err)
 			return
@@ -2385,7 +2391,10 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		// The Subscriber does not have an ARI exemption. However, we can check
 		// if the order is a renewal, and thus exempt from the NewOrdersPerAccount
 		// and CertificatesPerDomain limits.
-		exists, err := wfe.sa.FQDNSetExists(ctx, &sapb.FQDNSetExistsRequest{DnsNames: names})
+		exists, err := wfe.sa.FQDNSetExists(ctx, &sapb.FQDNSetExistsRequest{
+			DnsNames:    idents.DNSNames(),
+			Identifiers: idents.ToProtoSlice(),
+		})
 		if err != nil {
 			wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "While checking renewal exemption status"), err)
 			return
@@ -2427,14 +2436,15 @@ func (wfe *WebFrontEndImpl) NewOrder(
 
 	order, err := wfe.ra.NewOrder(ctx, &rapb.NewOrderRequest{
 		RegistrationID:         acct.ID,
-		DnsNames:               names,
+		DnsNames:               idents.DNSNames(),
+		Identifiers:            idents.ToProtoSlice(),
 		ReplacesSerial:         replaces,
 		CertificateProfileName: newOrderRequest.Profile,
 		IsARIRenewal:           isARIRenewal,
 		IsRenewal:              isRenewal,
 	})
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
-	if err != nil || order == nil || order.Id == 0 || order.RegistrationID == 0 || len(order.DnsNames) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
+	if err != nil || order == nil || order.Id == 0 || order.RegistrationID == 0 || len(identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames)) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
 		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error creating new order"), err)
 		if errors.Is(err, berrors.RateLimit) {
 			// Request was denied by a legacy rate limit. In this error case we
@@ -2505,7 +2515,7 @@ func (wfe *WebFrontEndImpl) GetOrder(ctx context.Context, logEvent *web.RequestE
 	}
 
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
-	if order.Id == 0 || order.Status == "" || order.RegistrationID == 0 || len(order.DnsNames) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
+	if order.Id == 0 || order.Status == "" || order.RegistrationID == 0 || len(identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames)) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
 		wfe.sendError(response, logEvent, probs.ServerInternal(fmt.Sprintf("Failed to retrieve order for ID %d", orderID)), errIncompleteGRPCResponse)
 		return
 	}
@@ -2586,7 +2596,7 @@ func (wfe *WebFrontEndImpl) FinalizeOrder(ctx context.Context, logEvent *web.Req
 	}
 
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
-	if order.Id == 0 || order.Status == "" || order.RegistrationID == 0 || len(order.DnsNames) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
+	if order.Id == 0 || order.Status == "" || order.RegistrationID == 0 || len(identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames)) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
 		wfe.sendError(response, logEvent, probs.ServerInternal(fmt.Sprintf("Failed to retrieve order for ID %d", orderID)), errIncompleteGRPCResponse)
 		return
 	}
@@ -2632,7 +2642,13 @@ func (wfe *WebFrontEndImpl) FinalizeOrder(ctx context.Context, logEvent *web.Req
 		return
 	}
 
-	logEvent.DNSNames = order.DnsNames
+	orderIdents := identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames)
+	logEvent.DNSNames = orderIdents.DNSNames()
+	for _, ident := range orderIdents {
+		if ident.Type == identifier.TypeIP {
+			logEvent.IPAddresses = append(logEvent.IPAddresses, ident.Value)
+		}
+	}
 	logEvent.Extra["KeyType"] = web.KeyTypeToString(csr.PublicKey)
 
 	updatedOrder, err := wfe.ra.FinalizeOrder(ctx, &rapb.FinalizeOrderRequest{
@@ -2644,7 +2660,7 @@ func (wfe *WebFrontEndImpl) FinalizeOrder(ctx context.Context, logEvent *web.Req
 		return
 	}
 	// TODO(#7153): Check each value via core.IsAnyNilOrZero
-	if updatedOrder == nil || order.Id == 0 || order.RegistrationID == 0 || len(order.DnsNames) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
+	if updatedOrder == nil || order.Id == 0 || order.RegistrationID == 0 || len(identifier.FromProtoSliceWithDefault(order.Identifiers, order.DnsNames)) == 0 || core.IsAnyNilOrZero(order.Created, order.Expires) {
 		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error validating order"), errIncompleteGRPCResponse)
 		return
 	}
@@ -2670,11 +2686,11 @@ func (wfe *WebFrontEndImpl) FinalizeOrder(ctx context.Context, logEvent *web.Req
 }
 
 // parseARICertID parses the "certID", a unique identifier specified in
-// draft-ietf-acme-ari-03. It takes the composite string as input returns a
+// RFC 9773. It takes the composite string as input returns a
 // extracted and decoded certificate serial. If the decoded AKID does not match
 // any known issuer or the serial number is not valid, an error is returned. For
 // more details see:
-// https://datatracker.ietf.org/doc/html/draft-ietf-acme-ari-03#section-4.1.
+// https://datatracker.ietf.org/doc/html/rfc9773#section-4.1.
 func parseARICertID(path string, issuerCertificates map[issuance.NameID]*issuance.Certificate) (string, error) {
 	parts := strings.Split(path, ".")
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -2728,7 +2744,7 @@ func (wfe *WebFrontEndImpl) RenewalInfo(ctx context.Context, logEvent *web.Reque
 	// does not re-use the same serial across multiple issuers.
 	logEvent.Extra["RequestedSerial"] = decodedSerial
 
-	renewalInfo, err := wfe.determineARIWindow(ctx, decodedSerial)
+	renewalInfo, err := wfe.renewalInfoPolicy.RenewalInfo(ctx, wfe.sa, decodedSerial)
 	if err != nil {
 		if errors.Is(err, berrors.NotFound) {
 			wfe.sendError(response, logEvent, probs.NotFound("Certificate replaced by this order was not found"), nil)
@@ -2738,7 +2754,7 @@ func (wfe *WebFrontEndImpl) RenewalInfo(ctx context.Context, logEvent *web.Reque
 		return
 	}
 
-	response.Header().Set(headerRetryAfter, fmt.Sprintf("%d", int(6*time.Hour/time.Second)))
+	response.Header().Set(headerRetryAfter, fmt.Sprintf("%d", int(wfe.renewalInfoPolicy.RetryAfter()/time.Second)))
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, renewalInfo)
 	if err != nil {
 		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshalling renewalInfo"), err)
@@ -35,6 +35,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/letsencrypt/boulder/ari"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
@@ -298,6 +299,7 @@ func (ra *MockRegistrationAuthority) NewOrder(ctx context.Context, in *rapb.NewO
 		Created:          timestamppb.New(created),
 		Expires:          timestamppb.New(expires),
 		DnsNames:         in.DnsNames,
+		Identifiers:      in.Identifiers,
 		Status:           string(core.StatusPending),
 		V2Authorizations: []int64{1},
 	}, nil
@@ -417,6 +419,9 @@ func setupWFE(t *testing.T) (WebFrontEndImpl, clock.FakeClock, requestSigner) {
 	test.AssertNotError(t, err, "making unpause signer")
 	unpauseLifetime := time.Hour * 24 * 14
 	unpauseURL := "https://boulder.service.consul:4003"
+	renewalInfoPolicy, err := ari.New(ari.Config{}, fc)
+	test.AssertNotError(t, err, "making renewal info policy")
+
 	wfe, err := NewWebFrontEndImpl(
 		stats,
 		fc,
@@ -441,6 +446,7 @@ func setupWFE(t *testing.T) (WebFrontEndImpl, clock.FakeClock, requestSigner) {
 		unpauseSigner,
 		unpauseLifetime,
 		unpauseURL,
+		renewalInfoPolicy,
 	)
 	test.AssertNotError(t, err, "Unable to create WFE")
 
@@ -799,6 +805,7 @@ func TestDirectory(t *testing.T) {
 		name         string
 		caaIdent     string
 		website      string
+		requireEAB   bool
 		expectedJSON string
 		request      *http.Request
 	}{
@@ -857,6 +864,23 @@ func TestDirectory(t *testing.T) {
   "newNonce": "http://localhost/acme/new-nonce",
   "newOrder": "http://localhost/acme/new-order",
   "revokeCert": "http://localhost/acme/revoke-cert"
+}`,
+		},
+		{
+			name:       "standard GET, external account required",
+			requireEAB: true,
+			request:    getReq,
+			expectedJSON: `{
+  "AAAAAAAAAAA": "https://community.letsencrypt.org/t/adding-random-entries-to-the-directory/33417",
+  "keyChange": "http://localhost:4300/acme/key-change",
+  "meta": {
+    "externalAccountRequired": true,
+    "termsOfService": "http://example.invalid/terms"
+  },
+  "newAccount": "http://localhost:4300/acme/new-acct",
+  "newNonce": "http://localhost:4300/acme/new-nonce",
+  "newOrder": "http://localhost:4300/acme/new-order",
+  "revokeCert": "http://localhost:4300/acme/revoke-cert"
 }`,
 		},
 	}
@@ -866,6 +890,7 @@ func TestDirectory(t *testing.T) {
 			// Configure a caaIdentity and website for the /directory meta based on the tc
 			wfe.DirectoryCAAIdentity = tc.caaIdent // "Radiant Lock"
 			wfe.DirectoryWebsite = tc.website      //"zombo.com"
+			wfe.RequireExternalAccountBinding = tc.requireEAB
 			responseWriter := httptest.NewRecorder()
 			// Serve the /directory response for this request into a recorder
 			mux.ServeHTTP(responseWriter, tc.request)
@@ -1803,6 +1828,63 @@ func TestNewAccountNoID(t *testing.T) {
 	}`)
 }
 
+// mockRANewRegistrationRecorder is a mock RA which records the registration
+// most recently passed to NewRegistration.
+type mockRANewRegistrationRecorder struct {
+	MockRegistrationAuthority
+	lastReg *corepb.Registration
+}
+
+func (ra *mockRANewRegistrationRecorder) NewRegistration(ctx context.Context, in *corepb.Registration, opts ...grpc.CallOption) (*corepb.Registration, error) {
+	ra.lastReg = in
+	return ra.MockRegistrationAuthority.NewRegistration(ctx, in, opts...)
+}
+
+func TestNewAccountExternalAccountBinding(t *testing.T) {
+	wfe, _, signer := setupWFE(t)
+	ra := &mockRANewRegistrationRecorder{}
+	wfe.ra = ra
+	wfe.RequireExternalAccountBinding = true
+
+	key := loadKey(t, []byte(test2KeyPrivatePEM))
+	accountJWK := &jose.JSONWebKey{Key: key.Public()}
+	signedURL := fmt.Sprintf("http://localhost%s", newAcctPath)
+
+	// Without an externalAccountBinding the request should be rejected.
+	payload := `{"contact":["mailto:person@mail.com"],"termsOfServiceAgreed":true}`
+	_, _, body := signer.embeddedJWK(key, signedURL, payload)
+	responseWriter := httptest.NewRecorder()
+	wfe.NewAccount(ctx, newRequestEvent(), responseWriter, makePostRequestWithPath(newAcctPath, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+	test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), `{
+		"type": "`+probs.ErrorNS+`externalAccountRequired",
+		"detail": "this server requires an externalAccountBinding to create new accounts",
+		"status": 403
+	}`)
+	test.AssertBoxedNil(t, ra.lastReg, "RA should not have been called")
+
+	// An invalid externalAccountBinding should be rejected.
+	eab := signer.externalAccountBinding("eab-revoked-key", []byte("this is an external account key."), accountJWK, signedURL, false)
+	payload = fmt.Sprintf(`{"contact":["mailto:person@mail.com"],"termsOfServiceAgreed":true,"externalAccountBinding":%s}`, eab)
+	_, _, body = signer.embeddedJWK(key, signedURL, payload)
+	responseWriter = httptest.NewRecorder()
+	wfe.NewAccount(ctx, newRequestEvent(), responseWriter, makePostRequestWithPath(newAcctPath, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusForbidden)
+	test.AssertContains(t, responseWriter.Body.String(), probs.ErrorNS+"unauthorized")
+	test.AssertBoxedNil(t, ra.lastReg, "RA should not have been called")
+
+	// A valid externalAccountBinding should result in a new account bound to
+	// the external account.
+	eab = signer.externalAccountBinding("eab-key", []byte("this is an external account key."), accountJWK, signedURL, false)
+	payload = fmt.Sprintf(`{"contact":["mailto:person@mail.com"],"termsOfServiceAgreed":true,"externalAccountBinding":%s}`, eab)
+	_, _, body = signer.embeddedJWK(key, signedURL, payload)
+	responseWriter = httptest.NewRecorder()
+	wfe.NewAccount(ctx, newRequestEvent(), responseWriter, makePostRequestWithPath(newAcctPath, body))
+	test.AssertEquals(t, responseWriter.Code, http.StatusCreated)
+	test.AssertNotNil(t, ra.lastReg, "RA should have been called")
+	test.AssertEquals(t, ra.lastReg.ExternalAccountID, "eab-key")
+}
+
 func TestGetAuthorizationHandler(t *testing.T) {
 	wfe, _, signer := setupWFE(t)
 
@@ -2892,7 +2974,7 @@ func TestNewOrder(t *testing.T) {
 		{
 			Name:         "POST, empty domain name identifier",
 			Request:      signAndPost(signer, targetPath, signedURL, `{"identifiers":[{"type":"dns","value":""}]}`),
-			ExpectedBody: `{"type":"` + probs.ErrorNS + `malformed","detail":"NewOrder request included empty domain name","status":400}`,
+			ExpectedBody: `{"type":"` + probs.ErrorNS + `malformed","detail":"NewOrder request included empty identifier","status":400}`,
 		},
 		{
 			Name:         "POST, invalid domain name identifier",
@@ -2907,7 +2989,30 @@ func TestNewOrder(t *testing.T) {
 		{
 			Name:         "POST, non-DNS identifier in payload",
 			Request:      signAndPost(signer, targetPath, signedURL, nonDNSIdentifierBody),
-			ExpectedBody: `{"type":"` + probs.ErrorNS + `unsupportedIdentifier","detail":"NewOrder request included invalid non-DNS type identifier: type \"fakeID\", value \"www.i-am-21.com\"","status":400}`,
+			ExpectedBody: `{"type":"` + probs.ErrorNS + `unsupportedIdentifier","detail":"NewOrder request included unsupported identifier: type \"fakeID\", value \"www.i-am-21.com\"","status":400}`,
+		},
+		{
+			Name:         "POST, non-canonical IP identifier",
+			Request:      signAndPost(signer, targetPath, signedURL, `{"identifiers":[{"type":"ip","value":"2001:0db8::1"}]}`),
+			ExpectedBody: `{"type":"` + probs.ErrorNS + `rejectedIdentifier","detail":"Invalid identifiers requested :: Cannot issue for \"2001:0db8::1\": IP address is not in its canonical textual form","status":400}`,
+		},
+		{
+			Name:    "POST, good payload with DNS and IP identifiers",
+			Request: signAndPost(signer, targetPath, signedURL, `{"identifiers":[{"type":"ip","value":"64.112.117.122"},{"type":"dns","value":"not-example.com"},{"type":"ip","value":"2602:80a:6000::1"}]}`),
+			ExpectedBody: `
+			{
+				"status": "pending",
+				"expires": "2021-02-01T01:01:01Z",
+				"identifiers": [
+					{ "type": "dns", "value": "not-example.com"},
+					{ "type": "ip", "value": "2602:80a:6000::1"},
+					{ "type": "ip", "value": "64.112.117.122"}
+				],
+				"authorizations": [
+					"http://localhost/acme/authz/1/1"
+				],
+				"finalize": "http://localhost/acme/finalize/1/1"
+			}`,
 		},
 		{
 			Name:         "POST, notAfter and notBefore in payload",
@@ -3682,6 +3787,7 @@ func TestPrepAuthzForDisplay(t *testing.T) {
 			{Type: core.ChallengeTypeDNS01, Status: core.StatusPending, Token: "token"},
 			{Type: core.ChallengeTypeHTTP01, Status: core.StatusPending, Token: "token"},
 			{Type: core.ChallengeTypeTLSALPN01, Status: core.StatusPending, Token: "token"},
+			{Type: core.ChallengeTypeDNSAccount01, Status: core.StatusPending, Token: "token"},
 		},
 	}
 
@@ -3693,6 +3799,46 @@ func TestPrepAuthzForDisplay(t *testing.T) {
 	test.AssertNotError(t, err, "Failed to marshal authz")
 	test.AssertNotContains(t, string(authzJSON), "\"id\":\"12345\"")
 	test.AssertNotContains(t, string(authzJSON), "\"registrationID\":\"1\"")
+
+	// Ensure every challenge type, including dns-account-01, is rendered with
+	// a URL.
+	test.AssertContains(t, string(authzJSON), "\"type\":\"dns-account-01\"")
+	for _, chall := range authz.Challenges {
+		test.AssertContains(t, chall.URL, "http://localhost/acme/chall-v3/12345/")
+	}
+}
+
+func TestPrepDNSPersistChallengeForDisplay(t *testing.T) {
+	t.Parallel()
+	wfe, _, _ := setupWFE(t)
+	wfe.DirectoryCAAIdentity = "happy-hacker-ca.invalid"
+
+	authz := &core.Authorization{
+		ID:             "12345",
+		Status:         core.StatusPending,
+		RegistrationID: 1,
+		Identifier:     identifier.NewDNS("example.com"),
+		Challenges: []core.Challenge{
+			{Type: core.ChallengeTypeDNS01, Status: core.StatusPending, Token: "token"},
+			{Type: core.ChallengeTypeDNSPersist01, Status: core.StatusPending, Token: "token"},
+		},
+	}
+
+	// This modifies the authz in-place.
+	wfe.prepAuthorizationForDisplay(deprecatedAuthzPath, &http.Request{Host: "localhost"}, authz)
+
+	// Only the dns-persist-01 challenge should list the issuer domain names.
+	for _, chall := range authz.Challenges {
+		if chall.Type == core.ChallengeTypeDNSPersist01 {
+			test.AssertDeepEquals(t, chall.IssuerDomainNames, []string{"happy-hacker-ca.invalid"})
+		} else {
+			test.AssertEquals(t, len(chall.IssuerDomainNames), 0)
+		}
+	}
+
+	authzJSON, err := json.Marshal(authz)
+	test.AssertNotError(t, err, "Failed to marshal authz")
+	test.AssertContains(t, string(authzJSON), `"issuer-domain-names":["happy-hacker-ca.invalid"]`)
 }
 
 func TestPrepAuthzWithAccountForDisplay(t *testing.T) {
@@ -4190,6 +4336,49 @@ func TestIncidentARI(t *testing.T) {
 	test.AssertEquals(t, ri.ExplanationURL, "http://big.bad/incident")
 }
 
+// TestARIPaths tests that the RFC 9773 renewalInfo path is advertised in the
+// directory, and that both it and the draft path serve renewal info.
+func TestARIPaths(t *testing.T) {
+	wfe, _, _ := setupWFE(t)
+	wfe.sa = newMockSAWithCert(t, wfe.sa)
+
+	features.Set(features.Config{ServeRenewalInfo: true})
+	defer features.Reset()
+	mux := wfe.Handler(metrics.NoopRegisterer)
+
+	resp := httptest.NewRecorder()
+	mux.ServeHTTP(resp, &http.Request{
+		Method: http.MethodGet,
+		URL:    mustParseURL("/directory"),
+		Host:   "localhost:4300",
+	})
+	test.AssertEquals(t, resp.Code, http.StatusOK)
+	var dir map[string]interface{}
+	err := json.Unmarshal(resp.Body.Bytes(), &dir)
+	test.AssertNotError(t, err, "unmarshalling directory")
+	test.AssertEquals(t, dir["renewalInfo"], "http://localhost:4300/acme/renewal-info")
+
+	cert, err := core.LoadCert("../test/hierarchy/ee-r3.cert.pem")
+	test.AssertNotError(t, err, "failed to load test certificate")
+	certID := fmt.Sprintf("%s.%s",
+		base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId),
+		base64.RawURLEncoding.EncodeToString(cert.SerialNumber.Bytes()),
+	)
+	for _, path := range []string{renewalInfoPath, draftRenewalInfoPath} {
+		resp := httptest.NewRecorder()
+		mux.ServeHTTP(resp, &http.Request{
+			Method: http.MethodGet,
+			URL:    mustParseURL(path + certID),
+		})
+		test.AssertEquals(t, resp.Code, http.StatusOK)
+		test.AssertEquals(t, resp.Header().Get("Retry-After"), "21600")
+		var ri core.RenewalInfo
+		err = json.Unmarshal(resp.Body.Bytes(), &ri)
+		test.AssertNotError(t, err, "unmarshalling renewal info")
+		test.Assert(t, ri.SuggestedWindow.Start.After(cert.NotBefore), "suggested window begins before cert issuance")
+	}
+}
+
 func Test_sendError(t *testing.T) {
 	features.Reset()
 	wfe, _, _ := setupWFE(t)